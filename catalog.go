@@ -0,0 +1,452 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgtype"
+	"github.com/jackc/pgx/v4"
+)
+
+type Queryer interface {
+	Query(ctx context.Context, sql string, args ...interface{}) (pgx.Rows, error)
+	QueryRow(ctx context.Context, sql string, args ...interface{}) pgx.Row
+	Exec(ctx context.Context, sql string, arguments ...interface{}) (pgconn.CommandTag, error)
+}
+
+// catalog provides the table metadata code is generated from. It is
+// implemented by a live database and by a schema snapshot.
+type catalog interface {
+	// searchPath returns the schemas in the search_path.
+	searchPath() ([]string, error)
+
+	// searchPathSchema returns the first schema in the search_path that
+	// contains tableName.
+	searchPathSchema(tableName string) (string, error)
+
+	// tableNames returns the names of the base tables in schemas ordered by
+	// schema and table name.
+	tableNames(schemas []string) ([]Table, error)
+
+	// table returns the columns, primary key, indexes and foreign keys of a
+	// table or view.
+	// It returns nil if the table does not exist.
+	table(schema, tableName string) (*Table, error)
+
+	// enum returns the enum type schema.name. It returns nil if the type is not
+	// an enum.
+	enum(schema, name string) (*Enum, error)
+
+	// compositeType returns the composite type schema.name. It returns nil if
+	// the type is not a composite type created with CREATE TYPE.
+	compositeType(schema, name string) (*CompositeType, error)
+}
+
+type dbCatalog struct {
+	db Queryer
+}
+
+func (dc dbCatalog) searchPath() ([]string, error) {
+	rows, err := dc.db.Query(context.Background(), "select unnest(current_schemas(false))::text")
+	if err != nil {
+		return nil, err
+	}
+
+	var schemas []string
+	for rows.Next() {
+		var schema string
+		rows.Scan(&schema)
+		schemas = append(schemas, schema)
+	}
+
+	if rows.Err() != nil {
+		return nil, rows.Err()
+	}
+
+	return schemas, nil
+}
+
+func (dc dbCatalog) searchPathSchema(tableName string) (string, error) {
+	var schema string
+	err := dc.db.QueryRow(context.Background(), `select n.nspname
+from pg_catalog.pg_class c
+  join pg_catalog.pg_namespace n on c.relnamespace=n.oid
+where c.oid=to_regclass($1)`, quoteIdentifier(tableName)).Scan(&schema)
+	if err == pgx.ErrNoRows {
+		return "", fmt.Errorf("table %s not found in search_path", tableName)
+	} else if err != nil {
+		return "", err
+	}
+
+	return schema, nil
+}
+
+func (dc dbCatalog) tableNames(schemas []string) ([]Table, error) {
+	rows, err := dc.db.Query(context.Background(), `select table_schema, table_name
+from information_schema.tables
+where table_schema::text=any($1::text[]) and table_type='BASE TABLE'
+order by table_schema, table_name`, schemas)
+	if err != nil {
+		return nil, err
+	}
+
+	var tables []Table
+	for rows.Next() {
+		var t Table
+		rows.Scan(&t.Schema, &t.TableName)
+		tables = append(tables, t)
+	}
+
+	if rows.Err() != nil {
+		return nil, rows.Err()
+	}
+
+	return tables, nil
+}
+
+func (dc dbCatalog) table(schema, tableName string) (*Table, error) {
+	rows, err := dc.db.Query(context.Background(), `select column_name, data_type, udt_schema, udt_name, coalesce(domain_schema, ''), coalesce(domain_name, ''), is_nullable='NO',
+  coalesce(column_default, ''), coalesce(identity_generation, ''), is_generated='ALWAYS', coalesce(character_maximum_length, 0),
+  case when data_type='numeric' then coalesce(numeric_precision, 0) else 0 end,
+  case when data_type='numeric' then coalesce(numeric_scale, 0) else 0 end,
+  ordinal_position
+from information_schema.columns
+where table_schema=$1 and table_name=$2
+order by ordinal_position`, schema, tableName)
+	if err != nil {
+		return nil, err
+	}
+
+	var columns []Column
+	for rows.Next() {
+		var c Column
+		rows.Scan(&c.ColumnName, &c.DataType, &c.UDTSchema, &c.UDTName, &c.DomainSchema, &c.DomainName, &c.NotNull,
+			&c.Default, &c.Identity, &c.Generated, &c.CharacterMaxLength, &c.NumericPrecision, &c.NumericScale, &c.OrdinalPosition)
+		columns = append(columns, c)
+	}
+
+	if rows.Err() != nil {
+		return nil, rows.Err()
+	}
+
+	if len(columns) == 0 {
+		return nil, nil
+	}
+
+	pkColumnNames, err := dc.primaryKeyColumnNames(schema, tableName)
+	if err != nil {
+		return nil, err
+	}
+
+	indexes, err := dc.indexes(schema, tableName)
+	if err != nil {
+		return nil, err
+	}
+
+	foreignKeys, err := dc.foreignKeys(schema, tableName)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Table{
+		Schema:                schema,
+		TableName:             tableName,
+		PrimaryKeyColumnNames: pkColumnNames,
+		Columns:               columns,
+		Indexes:               indexes,
+		ForeignKeys:           foreignKeys,
+	}, nil
+}
+
+// foreignKeys returns the foreign keys of table ordered by name.
+func (dc dbCatalog) foreignKeys(schema, tableName string) ([]ForeignKey, error) {
+	rows, err := dc.db.Query(context.Background(), `select con.conname::text, rn.nspname::text, rc.relname::text,
+  array(
+    select a.attname::text
+    from unnest(con.conkey) with ordinality as k(attnum, position)
+      join pg_catalog.pg_attribute a on a.attrelid=con.conrelid and a.attnum=k.attnum
+    order by k.position
+  ),
+  array(
+    select a.attname::text
+    from unnest(con.confkey) with ordinality as k(attnum, position)
+      join pg_catalog.pg_attribute a on a.attrelid=con.confrelid and a.attnum=k.attnum
+    order by k.position
+  )
+from pg_catalog.pg_constraint con
+  join pg_catalog.pg_class rc on rc.oid=con.confrelid
+  join pg_catalog.pg_namespace rn on rn.oid=rc.relnamespace
+where con.conrelid=to_regclass($1) and con.contype='f'
+order by con.conname`, quoteIdentifier(schema)+"."+quoteIdentifier(tableName))
+	if err != nil {
+		return nil, err
+	}
+
+	var foreignKeys []ForeignKey
+	for rows.Next() {
+		var fk ForeignKey
+		rows.Scan(&fk.Name, &fk.ReferencedSchema, &fk.ReferencedTableName, &fk.ColumnNames, &fk.ReferencedColumnNames)
+		foreignKeys = append(foreignKeys, fk)
+	}
+
+	if rows.Err() != nil {
+		return nil, rows.Err()
+	}
+
+	return foreignKeys, nil
+}
+
+// indexes returns the indexes of table on plain columns ordered by name.
+// Primary key, partial and expression indexes are skipped.
+func (dc dbCatalog) indexes(schema, tableName string) ([]Index, error) {
+	rows, err := dc.db.Query(context.Background(), `select c.relname::text, am.amname::text, i.indisunique,
+  array(
+    select a.attname::text
+    from unnest(i.indkey::int2[]) with ordinality as k(attnum, position)
+      join pg_catalog.pg_attribute a on a.attrelid=i.indrelid and a.attnum=k.attnum
+    where k.position <= i.indnkeyatts
+    order by k.position
+  )
+from pg_catalog.pg_index i
+  join pg_catalog.pg_class c on c.oid=i.indexrelid
+  join pg_catalog.pg_am am on am.oid=c.relam
+where i.indrelid=to_regclass($1)
+  and not i.indisprimary
+  and i.indpred is null
+  and not 0=any(i.indkey::int2[])
+order by c.relname`, quoteIdentifier(schema)+"."+quoteIdentifier(tableName))
+	if err != nil {
+		return nil, err
+	}
+
+	var indexes []Index
+	for rows.Next() {
+		var index Index
+		rows.Scan(&index.Name, &index.Method, &index.Unique, &index.ColumnNames)
+		indexes = append(indexes, index)
+	}
+
+	if rows.Err() != nil {
+		return nil, rows.Err()
+	}
+
+	return indexes, nil
+}
+
+// primaryKeyColumnNames returns the names of the primary key columns of table
+// in key order. It returns nil if the table does not have a primary key.
+func (dc dbCatalog) primaryKeyColumnNames(schema, tableName string) ([]string, error) {
+	rows, err := dc.db.Query(context.Background(), `select a.attname
+from pg_catalog.pg_index i
+  cross join unnest(i.indkey::int2[]) with ordinality as k(attnum, position)
+  join pg_catalog.pg_attribute a on a.attrelid=i.indrelid and a.attnum=k.attnum
+where i.indrelid=to_regclass($1) and i.indisprimary and k.position <= i.indnkeyatts
+order by k.position`, quoteIdentifier(schema)+"."+quoteIdentifier(tableName))
+	if err != nil {
+		return nil, err
+	}
+
+	var names []string
+	for rows.Next() {
+		var name string
+		rows.Scan(&name)
+		names = append(names, name)
+	}
+
+	if rows.Err() != nil {
+		return nil, rows.Err()
+	}
+
+	return names, nil
+}
+
+func (dc dbCatalog) enum(schema, name string) (*Enum, error) {
+	e := &Enum{Schema: schema, Name: name}
+	err := dc.db.QueryRow(context.Background(), `select coalesce(array_agg(e.enumlabel::text order by e.enumsortorder) filter (where e.enumlabel is not null), '{}')
+from pg_catalog.pg_type t
+  join pg_catalog.pg_namespace n on n.oid=t.typnamespace
+  left join pg_catalog.pg_enum e on e.enumtypid=t.oid
+where n.nspname=$1 and t.typname=$2 and t.typtype='e'
+group by t.oid`, schema, name).Scan(&e.Labels)
+	if err == pgx.ErrNoRows {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	return e, nil
+}
+
+func (dc dbCatalog) compositeType(schema, name string) (*CompositeType, error) {
+	rows, err := dc.db.Query(context.Background(), `select attribute_name, data_type, attribute_udt_schema, attribute_udt_name, ordinal_position
+from information_schema.attributes
+where udt_schema=$1 and udt_name=$2
+order by ordinal_position`, schema, name)
+	if err != nil {
+		return nil, err
+	}
+
+	var attributes []Column
+	for rows.Next() {
+		var a Column
+		rows.Scan(&a.ColumnName, &a.DataType, &a.UDTSchema, &a.UDTName, &a.OrdinalPosition)
+		attributes = append(attributes, a)
+	}
+
+	if rows.Err() != nil {
+		return nil, rows.Err()
+	}
+
+	if len(attributes) == 0 {
+		return nil, nil
+	}
+
+	return &CompositeType{Schema: schema, Name: name, Attributes: attributes}, nil
+}
+
+// function returns the signature of the function or procedure schema.name from
+// pg_proc. Its arguments and results are described like table columns. When
+// schema is empty the first schema in the search_path that has a function
+// named name is used. It returns nil if the function does not exist.
+func (dc dbCatalog) function(schema, name string) (*Function, error) {
+	type signature struct {
+		schema     string
+		kind       string
+		returnsSet bool
+		returnType int64
+		returnKind string
+		argTypes   []int64
+		argModes   []string
+		argNames   []string
+	}
+
+	rows, err := dc.db.Query(context.Background(), `select n.nspname::text, p.prokind::text, p.proretset, p.prorettype::int8,
+  coalesce(rc.relkind::text, ''),
+  coalesce(p.proallargtypes, p.proargtypes::oid[])::int8[],
+  coalesce(p.proargmodes::text[], '{}'),
+  coalesce(p.proargnames, '{}')
+from pg_catalog.pg_proc p
+  join pg_catalog.pg_namespace n on n.oid=p.pronamespace
+  left join pg_catalog.pg_type rt on rt.oid=p.prorettype
+  left join pg_catalog.pg_class rc on rc.oid=rt.typrelid
+where p.proname=$1 and case when $2::text='' then n.nspname=any(current_schemas(false)) else n.nspname=$2::text end
+order by array_position(current_schemas(false), n.nspname::text)`, name, schema)
+	if err != nil {
+		return nil, err
+	}
+
+	var signatures []signature
+	for rows.Next() {
+		var s signature
+		if err := rows.Scan(&s.schema, &s.kind, &s.returnsSet, &s.returnType, &s.returnKind, &s.argTypes, &s.argModes, &s.argNames); err != nil {
+			rows.Close()
+			return nil, err
+		}
+		signatures = append(signatures, s)
+	}
+
+	if rows.Err() != nil {
+		return nil, rows.Err()
+	}
+
+	if len(signatures) == 0 {
+		return nil, nil
+	}
+	if len(signatures) > 1 && signatures[1].schema == signatures[0].schema {
+		return nil, fmt.Errorf("function %s.%s is overloaded", signatures[0].schema, name)
+	}
+	s := signatures[0]
+
+	if s.kind != "f" && s.kind != "p" {
+		return nil, fmt.Errorf("%s.%s is not a function or procedure", s.schema, name)
+	}
+
+	f := &Function{
+		Schema:       s.schema,
+		FunctionName: name,
+		Procedure:    s.kind == "p",
+		ReturnsSet:   s.returnsSet,
+	}
+
+	for i, oid := range s.argTypes {
+		mode := "i"
+		if i < len(s.argModes) {
+			mode = s.argModes[i]
+		}
+		var argName string
+		if i < len(s.argNames) {
+			argName = s.argNames[i]
+		}
+
+		c, err := describeType(dc.db, pgtype.OID(oid))
+		if err != nil {
+			return nil, err
+		}
+
+		switch mode {
+		case "i", "b", "v":
+			c.ColumnName = argName
+			if c.ColumnName == "" {
+				c.ColumnName = fmt.Sprintf("arg%d", len(f.Params)+1)
+			}
+			f.Params = append(f.Params, c)
+			arg := fmt.Sprintf("$%d", len(f.Params))
+			if mode == "v" {
+				arg = "variadic " + arg
+			}
+			f.args = append(f.args, arg)
+		case "o":
+			// OUT arguments of procedures are passed as NULL by CALL.
+			if f.Procedure {
+				f.args = append(f.args, "null")
+			}
+		}
+		if mode == "o" || mode == "b" || mode == "t" {
+			c.ColumnName = argName
+			if c.ColumnName == "" {
+				c.ColumnName = fmt.Sprintf("column%d", len(f.Columns)+1)
+			}
+			f.Columns = append(f.Columns, c)
+		}
+	}
+
+	if len(f.Columns) > 0 || f.Procedure {
+		return f, nil
+	}
+
+	result, err := describeType(dc.db, pgtype.OID(s.returnType))
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case result.DataType == "void":
+	case result.DataType == "record":
+		return nil, fmt.Errorf("function %s.%s returns record without OUT parameters or RETURNS TABLE", s.schema, name)
+	case s.returnKind == "c":
+		ct, err := dc.compositeType(result.UDTSchema, result.UDTName)
+		if err != nil {
+			return nil, err
+		}
+		if ct == nil {
+			return nil, fmt.Errorf("function %s.%s return type %s.%s not found", s.schema, name, result.UDTSchema, result.UDTName)
+		}
+		f.Columns = ct.Attributes
+	case s.returnKind != "":
+		t, err := dc.table(result.UDTSchema, result.UDTName)
+		if err != nil {
+			return nil, err
+		}
+		if t == nil {
+			return nil, fmt.Errorf("function %s.%s return type %s.%s not found", s.schema, name, result.UDTSchema, result.UDTName)
+		}
+		f.Columns = t.Columns
+		f.returnSchema, f.returnTableName = result.UDTSchema, result.UDTName
+	default:
+		result.ColumnName = name
+		f.Result = &result
+	}
+
+	return f, nil
+}
@@ -0,0 +1,58 @@
+package main
+
+import (
+	"io"
+	"sort"
+	"text/template"
+)
+
+// CompositeType is a PostgreSQL composite type created with CREATE TYPE ... AS.
+// Attributes are described like table columns.
+type CompositeType struct {
+	Schema     string   `json:"schema"`
+	Name       string   `json:"name"`
+	Attributes []Column `json:"attributes"`
+
+	GoName string `json:"-"`
+}
+
+func (ct *CompositeType) boxTypeName() string {
+	return ct.GoName + "Box"
+}
+
+// imports returns the imports needed by the attribute types other than those
+// always imported by the composite template.
+func (ct *CompositeType) imports() []string {
+	set := make(map[string]struct{})
+	for _, a := range ct.Attributes {
+		if a.BoxTypeImport != "" {
+			set[a.BoxTypeImport] = struct{}{}
+		}
+	}
+
+	imports := make([]string, 0, len(set))
+	for i := range set {
+		imports = append(imports, i)
+	}
+	sort.Strings(imports)
+
+	return imports
+}
+
+func writeCompositeType(w io.Writer, templates *template.Template, pkgName string, ct *CompositeType) error {
+	return templates.ExecuteTemplate(w, "composite", struct {
+		PkgName       string
+		Imports       []string
+		QualifiedName string
+		GoName        string
+		BoxTypeName   string
+		Attributes    []Column
+	}{
+		PkgName:       pkgName,
+		Imports:       ct.imports(),
+		QualifiedName: ct.Schema + "." + ct.Name,
+		GoName:        ct.GoName,
+		BoxTypeName:   ct.boxTypeName(),
+		Attributes:    ct.Attributes,
+	})
+}
@@ -0,0 +1,1843 @@
+package main
+
+import (
+	"fmt"
+	"io/ioutil"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// The DDL parser understands the subset of PostgreSQL needed to build a
+// Snapshot from a schema dump or migration: CREATE TABLE, ALTER TABLE (ADD and
+// DROP columns and constraints, SET and DROP NOT NULL, DEFAULT, IDENTITY and
+// EXPRESSION, and RENAME), DROP TABLE, CREATE, ALTER and DROP INDEX, CREATE,
+// ALTER and DROP TYPE for enums and composite types, and CREATE and DROP
+// DOMAIN. All other statements are ignored. Unqualified table and type names
+// are in the public schema.
+
+type ddlTokenKind int
+
+const (
+	ddlWord ddlTokenKind = iota
+	ddlQuotedIdent
+	ddlString
+	ddlNumber
+	ddlPunct
+)
+
+type ddlToken struct {
+	kind ddlTokenKind
+	text string
+	line int
+}
+
+// is returns true if t is the unquoted keyword or punctuation s.
+func (t ddlToken) is(s string) bool {
+	return (t.kind == ddlWord || t.kind == ddlPunct) && strings.EqualFold(t.text, s)
+}
+
+// ident returns the identifier t refers to. Unquoted identifiers are folded to
+// lower case.
+func (t ddlToken) ident() string {
+	if t.kind == ddlQuotedIdent {
+		return t.text
+	}
+	return strings.ToLower(t.text)
+}
+
+var ddlTypeNames = map[string]string{
+	"smallint":                    "smallint",
+	"int2":                        "smallint",
+	"smallserial":                 "smallint",
+	"serial2":                     "smallint",
+	"integer":                     "integer",
+	"int":                         "integer",
+	"int4":                        "integer",
+	"serial":                      "integer",
+	"serial4":                     "integer",
+	"bigint":                      "bigint",
+	"int8":                        "bigint",
+	"bigserial":                   "bigint",
+	"serial8":                     "bigint",
+	"real":                        "real",
+	"float4":                      "real",
+	"double precision":            "double precision",
+	"float8":                      "double precision",
+	"float":                       "double precision",
+	"numeric":                     "numeric",
+	"decimal":                     "numeric",
+	"money":                       "money",
+	"boolean":                     "boolean",
+	"bool":                        "boolean",
+	"character varying":           "character varying",
+	"varchar":                     "character varying",
+	"character":                   "character",
+	"char":                        "character",
+	"bpchar":                      "character",
+	"text":                        "text",
+	"name":                        "name",
+	"bytea":                       "bytea",
+	"date":                        "date",
+	"timestamp":                   "timestamp without time zone",
+	"timestamp without time zone": "timestamp without time zone",
+	"timestamptz":                 "timestamp with time zone",
+	"timestamp with time zone":    "timestamp with time zone",
+	"time":                        "time without time zone",
+	"time without time zone":      "time without time zone",
+	"timetz":                      "time with time zone",
+	"time with time zone":         "time with time zone",
+	"interval":                    "interval",
+	"bit":                         "bit",
+	"bit varying":                 "bit varying",
+	"varbit":                      "bit varying",
+	"uuid":                        "uuid",
+	"json":                        "json",
+	"jsonb":                       "jsonb",
+	"xml":                         "xml",
+	"inet":                        "inet",
+	"cidr":                        "cidr",
+	"macaddr":                     "macaddr",
+	"oid":                         "oid",
+	"tsvector":                    "tsvector",
+	"tsquery":                     "tsquery",
+	"point":                       "point",
+	"line":                        "line",
+	"lseg":                        "lseg",
+	"box":                         "box",
+	"path":                        "path",
+	"polygon":                     "polygon",
+	"circle":                      "circle",
+	"int4range":                   "int4range",
+	"int8range":                   "int8range",
+	"numrange":                    "numrange",
+	"tsrange":                     "tsrange",
+	"tstzrange":                   "tstzrange",
+	"daterange":                   "daterange",
+}
+
+// ddlSerialTypes are the serial types in ddlTypeNames.
+var ddlSerialTypes = map[string]bool{
+	"smallserial": true,
+	"serial2":     true,
+	"serial":      true,
+	"serial4":     true,
+	"bigserial":   true,
+	"serial8":     true,
+}
+
+// ddlUDTNames are the udt_names of the types in ddlTypeNames whose udt_name
+// differs from their data_type.
+var ddlUDTNames = map[string]string{
+	"smallint":                    "int2",
+	"integer":                     "int4",
+	"bigint":                      "int8",
+	"real":                        "float4",
+	"double precision":            "float8",
+	"boolean":                     "bool",
+	"character varying":           "varchar",
+	"character":                   "bpchar",
+	"timestamp without time zone": "timestamp",
+	"timestamp with time zone":    "timestamptz",
+	"time without time zone":      "time",
+	"time with time zone":         "timetz",
+	"bit varying":                 "varbit",
+}
+
+// ddlColumnConstraintKeywords end the type of a column definition.
+var ddlColumnConstraintKeywords = map[string]bool{
+	"constraint": true,
+	"not":        true,
+	"null":       true,
+	"default":    true,
+	"primary":    true,
+	"unique":     true,
+	"check":      true,
+	"references": true,
+	"generated":  true,
+	"collate":    true,
+}
+
+func loadDDL(path string) (*Snapshot, error) {
+	buf, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	snapshot, err := parseDDL(string(buf))
+	if err != nil {
+		return nil, fmt.Errorf("%s: %v", path, err)
+	}
+
+	return snapshot, nil
+}
+
+// parseDDL builds a Snapshot from the DDL statements in src.
+func parseDDL(src string) (*Snapshot, error) {
+	tokens, err := tokenizeDDL(src)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &ddlParser{
+		snapshot:             &Snapshot{SearchPath: []string{"public"}},
+		lastOrdinalPositions: make(map[string]int32),
+		domains:              make(map[string]Column),
+	}
+
+	var stmt []ddlToken
+	for _, t := range tokens {
+		if t.is(";") {
+			if err := p.statement(stmt); err != nil {
+				return nil, err
+			}
+			stmt = stmt[:0]
+			continue
+		}
+		stmt = append(stmt, t)
+	}
+	if err := p.statement(stmt); err != nil {
+		return nil, err
+	}
+
+	if err := p.resolveForeignKeys(); err != nil {
+		return nil, err
+	}
+
+	for _, t := range p.snapshot.Tables {
+		sort.Slice(t.Indexes, func(i, j int) bool { return t.Indexes[i].Name < t.Indexes[j].Name })
+		sort.Slice(t.ForeignKeys, func(i, j int) bool { return t.ForeignKeys[i].Name < t.ForeignKeys[j].Name })
+	}
+
+	return p.snapshot, nil
+}
+
+func tokenizeDDL(src string) ([]ddlToken, error) {
+	var tokens []ddlToken
+	line := 1
+	rs := []rune(src)
+
+	for i := 0; i < len(rs); {
+		r := rs[i]
+		start := i
+		startLine := line
+
+		switch {
+		case r == '\n':
+			line++
+			i++
+		case unicode.IsSpace(r):
+			i++
+		case r == '-' && i+1 < len(rs) && rs[i+1] == '-':
+			for i < len(rs) && rs[i] != '\n' {
+				i++
+			}
+		case r == '/' && i+1 < len(rs) && rs[i+1] == '*':
+			depth := 0
+			for i < len(rs) {
+				if rs[i] == '/' && i+1 < len(rs) && rs[i+1] == '*' {
+					depth++
+					i += 2
+				} else if rs[i] == '*' && i+1 < len(rs) && rs[i+1] == '/' {
+					depth--
+					i += 2
+					if depth == 0 {
+						break
+					}
+				} else {
+					if rs[i] == '\n' {
+						line++
+					}
+					i++
+				}
+			}
+			if depth != 0 {
+				return nil, fmt.Errorf("line %d: unterminated comment", startLine)
+			}
+		case r == '\'' || ((r == 'E' || r == 'e') && i+1 < len(rs) && rs[i+1] == '\''):
+			if r != '\'' {
+				i++
+			}
+			i++
+			var sb strings.Builder
+			for {
+				if i >= len(rs) {
+					return nil, fmt.Errorf("line %d: unterminated string", startLine)
+				}
+				if rs[i] == '\\' && r != '\'' && i+1 < len(rs) {
+					sb.WriteRune(rs[i+1])
+					i += 2
+					continue
+				}
+				if rs[i] == '\'' {
+					if i+1 < len(rs) && rs[i+1] == '\'' {
+						sb.WriteRune('\'')
+						i += 2
+						continue
+					}
+					i++
+					break
+				}
+				if rs[i] == '\n' {
+					line++
+				}
+				sb.WriteRune(rs[i])
+				i++
+			}
+			tokens = append(tokens, ddlToken{kind: ddlString, text: sb.String(), line: startLine})
+		case r == '"':
+			i++
+			var sb strings.Builder
+			for {
+				if i >= len(rs) {
+					return nil, fmt.Errorf("line %d: unterminated quoted identifier", startLine)
+				}
+				if rs[i] == '"' {
+					if i+1 < len(rs) && rs[i+1] == '"' {
+						sb.WriteRune('"')
+						i += 2
+						continue
+					}
+					i++
+					break
+				}
+				if rs[i] == '\n' {
+					line++
+				}
+				sb.WriteRune(rs[i])
+				i++
+			}
+			tokens = append(tokens, ddlToken{kind: ddlQuotedIdent, text: sb.String(), line: startLine})
+		case r == '$' && dollarQuoteTag(rs[i:]) != "":
+			tag := dollarQuoteTag(rs[i:])
+			i += len([]rune(tag))
+			end := strings.Index(string(rs[i:]), tag)
+			if end < 0 {
+				return nil, fmt.Errorf("line %d: unterminated dollar-quoted string", startLine)
+			}
+			body := []rune(string(rs[i:])[:end])
+			line += strings.Count(string(body), "\n")
+			i += len(body) + len([]rune(tag))
+			tokens = append(tokens, ddlToken{kind: ddlString, text: string(body), line: startLine})
+		case unicode.IsLetter(r) || r == '_':
+			for i < len(rs) && (unicode.IsLetter(rs[i]) || unicode.IsDigit(rs[i]) || rs[i] == '_' || rs[i] == '$') {
+				i++
+			}
+			tokens = append(tokens, ddlToken{kind: ddlWord, text: string(rs[start:i]), line: startLine})
+		case unicode.IsDigit(r):
+			for i < len(rs) && (unicode.IsDigit(rs[i]) || rs[i] == '.') {
+				i++
+			}
+			tokens = append(tokens, ddlToken{kind: ddlNumber, text: string(rs[start:i]), line: startLine})
+		case r == ':' && i+1 < len(rs) && rs[i+1] == ':':
+			i += 2
+			tokens = append(tokens, ddlToken{kind: ddlPunct, text: "::", line: startLine})
+		default:
+			i++
+			tokens = append(tokens, ddlToken{kind: ddlPunct, text: string(r), line: startLine})
+		}
+	}
+
+	return tokens, nil
+}
+
+// dollarQuoteTag returns the opening tag of a dollar-quoted string at the start
+// of rs (e.g. "$$" or "$body$") or "" if rs does not start with one.
+func dollarQuoteTag(rs []rune) string {
+	for i := 1; i < len(rs); i++ {
+		if rs[i] == '$' {
+			return string(rs[:i+1])
+		}
+		if !(unicode.IsLetter(rs[i]) || rs[i] == '_' || (i > 1 && unicode.IsDigit(rs[i]))) {
+			return ""
+		}
+	}
+	return ""
+}
+
+type ddlParser struct {
+	snapshot *Snapshot
+
+	// lastOrdinalPositions is keyed by schema-qualified table or composite type
+	// name. Like PostgreSQL, ordinal positions of dropped columns are not
+	// reused.
+	lastOrdinalPositions map[string]int32
+
+	// domains maps schema-qualified domain names to a Column holding the
+	// DataType, UDTSchema and UDTName of their base type.
+	domains map[string]Column
+}
+
+func (p *ddlParser) statement(stmt []ddlToken) error {
+	if len(stmt) == 0 {
+		return nil
+	}
+
+	switch {
+	case stmt[0].is("create"):
+		i := 1
+		for i < len(stmt) && (stmt[i].is("temp") || stmt[i].is("temporary") || stmt[i].is("unlogged") || stmt[i].is("global") || stmt[i].is("local")) {
+			i++
+		}
+		if i < len(stmt) && stmt[i].is("table") {
+			return p.createTable(stmt, i+1)
+		}
+		if i < len(stmt) && stmt[i].is("type") {
+			return p.createType(stmt, i+1)
+		}
+		if i < len(stmt) && stmt[i].is("domain") {
+			return p.createDomain(stmt, i+1)
+		}
+		if i < len(stmt) && stmt[i].is("unique") {
+			i++
+		}
+		if i < len(stmt) && stmt[i].is("index") {
+			return p.createIndex(stmt, i+1)
+		}
+	case stmt[0].is("alter") && len(stmt) > 1 && stmt[1].is("table"):
+		return p.alterTable(stmt)
+	case stmt[0].is("alter") && len(stmt) > 1 && stmt[1].is("type"):
+		return p.alterType(stmt)
+	case stmt[0].is("drop") && len(stmt) > 1 && stmt[1].is("table"):
+		return p.dropTable(stmt)
+	case stmt[0].is("drop") && len(stmt) > 1 && stmt[1].is("type"):
+		return p.dropType(stmt)
+	case stmt[0].is("drop") && len(stmt) > 1 && stmt[1].is("domain"):
+		return p.dropDomain(stmt)
+	case stmt[0].is("alter") && len(stmt) > 1 && stmt[1].is("index"):
+		return p.alterIndex(stmt)
+	case stmt[0].is("drop") && len(stmt) > 1 && stmt[1].is("index"):
+		return p.dropIndex(stmt)
+	}
+
+	return nil
+}
+
+func (p *ddlParser) createTable(stmt []ddlToken, i int) error {
+	if i+2 < len(stmt) && stmt[i].is("if") && stmt[i+1].is("not") && stmt[i+2].is("exists") {
+		i += 3
+	}
+
+	schema, tableName, i, err := ddlQualifiedName(stmt, i)
+	if err != nil {
+		return err
+	}
+
+	// CREATE TABLE ... AS and CREATE TABLE ... PARTITION OF do not define their
+	// own columns.
+	if i >= len(stmt) || !stmt[i].is("(") {
+		return nil
+	}
+
+	elements, _, err := ddlParenList(stmt, i)
+	if err != nil {
+		return err
+	}
+
+	if p.tablePtr(schema, tableName) != nil {
+		return fmt.Errorf("line %d: table %s.%s already exists", stmt[0].line, schema, tableName)
+	}
+
+	p.snapshot.Tables = append(p.snapshot.Tables, Table{Schema: schema, TableName: tableName})
+	table := &p.snapshot.Tables[len(p.snapshot.Tables)-1]
+	delete(p.lastOrdinalPositions, schema+"."+tableName)
+
+	for _, element := range elements {
+		if err := p.tableElement(table, element); err != nil {
+			return err
+		}
+	}
+	primaryKeyNotNull(table)
+
+	return nil
+}
+
+// tableElement adds a column or table constraint from a CREATE TABLE element
+// or an ALTER TABLE ADD action.
+func (p *ddlParser) tableElement(table *Table, element []ddlToken) error {
+	if len(element) == 0 {
+		return nil
+	}
+
+	constraintName := ""
+	if element[0].is("constraint") {
+		if len(element) < 3 {
+			return fmt.Errorf("line %d: incomplete constraint", element[0].line)
+		}
+		constraintName = element[1].ident()
+		element = element[2:]
+	}
+
+	switch {
+	case element[0].is("primary"):
+		if len(element) < 3 || !element[1].is("key") || !element[2].is("(") {
+			return fmt.Errorf("line %d: expected PRIMARY KEY (columns)", element[0].line)
+		}
+		columns, _, err := ddlParenList(element, 2)
+		if err != nil {
+			return err
+		}
+		return p.setPrimaryKey(table, element[0].line, columns)
+	case element[0].is("unique"):
+		i := 1
+		if i < len(element) && element[i].is("nulls") {
+			i++
+			if i < len(element) && element[i].is("not") {
+				i++
+			}
+			i++
+		}
+		// UNIQUE USING INDEX converts an existing index.
+		if i >= len(element) || !element[i].is("(") {
+			return nil
+		}
+		columns, next, err := ddlParenList(element, i)
+		if err != nil {
+			return err
+		}
+		return p.addIndex(table, constraintName, "key", "btree", true, columns, element[next:])
+	case element[0].is("exclude"):
+		method := "btree"
+		i := 1
+		if i+1 < len(element) && element[i].is("using") {
+			method = element[i+1].ident()
+			i += 2
+		}
+		if i >= len(element) || !element[i].is("(") {
+			return fmt.Errorf("line %d: expected EXCLUDE (elements)", element[0].line)
+		}
+		elements, next, err := ddlParenList(element, i)
+		if err != nil {
+			return err
+		}
+		columns := make([][]ddlToken, len(elements))
+		for j, e := range elements {
+			k := 0
+			for k < len(e) && !e[k].is("with") {
+				k++
+			}
+			columns[j] = e[:k]
+		}
+		return p.addIndex(table, constraintName, "excl", method, false, columns, element[next:])
+	case element[0].is("foreign"):
+		if len(element) < 3 || !element[1].is("key") || !element[2].is("(") {
+			return fmt.Errorf("line %d: expected FOREIGN KEY (columns)", element[0].line)
+		}
+		columns, next, err := ddlParenList(element, 2)
+		if err != nil {
+			return err
+		}
+		if next >= len(element) || !element[next].is("references") {
+			return fmt.Errorf("line %d: expected REFERENCES", element[0].line)
+		}
+		var columnNames []string
+		for _, c := range columns {
+			if len(c) != 1 {
+				return fmt.Errorf("line %d: expected foreign key column name", element[0].line)
+			}
+			columnNames = append(columnNames, c[0].ident())
+		}
+		_, err = p.addForeignKey(table, constraintName, columnNames, element, next+1)
+		return err
+	case element[0].is("check") || element[0].is("like"):
+		return nil
+	}
+
+	return p.addColumn(table, element)
+}
+
+func (p *ddlParser) addColumn(table *Table, def []ddlToken) error {
+	if def[0].kind != ddlWord && def[0].kind != ddlQuotedIdent {
+		return fmt.Errorf("line %d: expected column name, got %s", def[0].line, def[0].text)
+	}
+
+	column := Column{ColumnName: def[0].ident()}
+	for _, c := range table.Columns {
+		if c.ColumnName == column.ColumnName {
+			return fmt.Errorf("line %d: column %s of table %s.%s already exists", def[0].line, column.ColumnName, table.Schema, table.TableName)
+		}
+	}
+
+	i := ddlTypeEnd(def, 1)
+	if i == 1 {
+		return fmt.Errorf("line %d: column %s has no type", def[0].line, column.ColumnName)
+	}
+	column.DataType, column.UDTSchema, column.UDTName = ddlDataType(def[1:i])
+	ddlTypeModifiers(&column, def[1:i])
+
+	// Like information_schema.columns, columns of a domain are described by the
+	// base type of the domain.
+	if base, ok := p.domains[column.UDTSchema+"."+column.UDTName]; ok && column.DataType == "USER-DEFINED" {
+		column.DomainSchema, column.DomainName = column.UDTSchema, column.UDTName
+		column.DataType, column.UDTSchema, column.UDTName = base.DataType, base.UDTSchema, base.UDTName
+		column.CharacterMaxLength, column.NumericPrecision, column.NumericScale = base.CharacterMaxLength, base.NumericPrecision, base.NumericScale
+	}
+
+	// serial types are integers with a default from a sequence named like
+	// PostgreSQL names it.
+	if i == 2 && def[1].kind == ddlWord && ddlSerialTypes[strings.ToLower(def[1].text)] {
+		seq := p.chooseRelationName(table.Schema, table.TableName, column.ColumnName, "seq")
+		if table.Schema != "public" {
+			seq = table.Schema + "." + seq
+		}
+		column.Default = "nextval('" + seq + "'::regclass)"
+		column.NotNull = true
+	}
+
+	key := table.Schema + "." + table.TableName
+	p.lastOrdinalPositions[key]++
+	column.OrdinalPosition = p.lastOrdinalPositions[key]
+	table.Columns = append(table.Columns, column)
+	col := &table.Columns[len(table.Columns)-1]
+
+	constraintName := ""
+	for depth := 0; i < len(def); i++ {
+		switch {
+		case def[i].is("("):
+			depth++
+		case def[i].is(")"):
+			depth--
+		case depth > 0:
+		case def[i].is("constraint") && i+1 < len(def):
+			constraintName = def[i+1].ident()
+			i++
+		case def[i].is("not") && i+1 < len(def) && def[i+1].is("null"):
+			col.NotNull = true
+			constraintName = ""
+			i++
+		case def[i].is("default") && i+1 < len(def):
+			end := ddlExprEnd(def, i+1)
+			col.Default = ddlExpr(def[i+1 : end])
+			constraintName = ""
+			i = end - 1
+		case def[i].is("generated"):
+			j := i + 1
+			generation := ""
+			switch {
+			case j < len(def) && def[j].is("always"):
+				generation = "ALWAYS"
+				j++
+			case j+1 < len(def) && def[j].is("by") && def[j+1].is("default"):
+				generation = "BY DEFAULT"
+				j += 2
+			}
+			if generation == "" || j+1 >= len(def) || !def[j].is("as") {
+				return fmt.Errorf("line %d: expected GENERATED ALWAYS or GENERATED BY DEFAULT AS", def[i].line)
+			}
+			if def[j+1].is("identity") {
+				// Identity columns are implicitly NOT NULL.
+				col.Identity = generation
+				col.NotNull = true
+				i = j + 1
+			} else {
+				// The parenthesized generation expression and STORED follow.
+				col.Generated = true
+				i = j
+			}
+			constraintName = ""
+		case def[i].is("primary") && i+1 < len(def) && def[i+1].is("key"):
+			if err := p.setPrimaryKey(table, def[i].line, [][]ddlToken{def[:1]}); err != nil {
+				return err
+			}
+			constraintName = ""
+		case def[i].is("unique"):
+			if err := p.addIndex(table, constraintName, "key", "btree", true, [][]ddlToken{def[:1]}, nil); err != nil {
+				return err
+			}
+			constraintName = ""
+		case def[i].is("references"):
+			next, err := p.addForeignKey(table, constraintName, []string{col.ColumnName}, def, i+1)
+			if err != nil {
+				return err
+			}
+			constraintName = ""
+			i = next - 1
+		}
+	}
+
+	return nil
+}
+
+// ddlTypeEnd returns the index of the first token of def at or after i that
+// ends the type of a column, attribute or domain definition.
+func ddlTypeEnd(def []ddlToken, i int) int {
+	for i < len(def) && !(def[i].kind == ddlWord && ddlColumnConstraintKeywords[strings.ToLower(def[i].text)]) {
+		i++
+	}
+	return i
+}
+
+// ddlTypeModifiers sets the character maximum length or the numeric precision
+// and scale of c from the type modifiers in tokens, e.g. varchar(50) or
+// numeric(10, 2). Like information_schema.columns, they are not set for
+// arrays.
+func ddlTypeModifiers(c *Column, tokens []ddlToken) {
+	if c.DataType == "ARRAY" {
+		return
+	}
+
+	var modifiers []int32
+	for i := range tokens {
+		if tokens[i].is("(") {
+			for j := i + 1; j < len(tokens) && !tokens[j].is(")"); j++ {
+				if n, err := strconv.ParseInt(tokens[j].text, 10, 32); err == nil && tokens[j].kind == ddlNumber {
+					modifiers = append(modifiers, int32(n))
+				}
+			}
+			break
+		}
+	}
+
+	switch c.DataType {
+	case "character varying", "character", "bit varying", "bit":
+		if len(modifiers) > 0 {
+			c.CharacterMaxLength = modifiers[0]
+		} else if c.DataType == "character" || c.DataType == "bit" {
+			// char and bit without a length have a length of 1.
+			c.CharacterMaxLength = 1
+		}
+	case "numeric":
+		if len(modifiers) > 0 {
+			c.NumericPrecision = modifiers[0]
+		}
+		if len(modifiers) > 1 {
+			c.NumericScale = modifiers[1]
+		}
+	}
+}
+
+// ddlExprEnd returns the index of the first token of def after the start of
+// the expression at def[i] that is a column constraint keyword outside of
+// parentheses.
+func ddlExprEnd(def []ddlToken, i int) int {
+	depth := 0
+	for j := i; j < len(def); j++ {
+		switch {
+		case def[j].is("("):
+			depth++
+		case def[j].is(")"):
+			depth--
+		case depth == 0 && j > i && def[j].kind == ddlWord && ddlColumnConstraintKeywords[strings.ToLower(def[j].text)]:
+			return j
+		}
+	}
+	return len(def)
+}
+
+// ddlExpr returns the SQL text of the expression in tokens. Unlike the
+// column_default of information_schema.columns it is not normalized by
+// PostgreSQL.
+func ddlExpr(tokens []ddlToken) string {
+	var sb strings.Builder
+	for i, t := range tokens {
+		if i > 0 {
+			prev := tokens[i-1]
+			call := t.is("(") && (prev.kind == ddlWord || prev.kind == ddlQuotedIdent)
+			operator := prev.kind == ddlPunct && t.kind == ddlPunct && strings.ContainsAny(prev.text+t.text, "+-*/<>=~!@#%^&|?") && !strings.ContainsAny(prev.text+t.text, "(),")
+			if !(prev.is("(") || prev.is("::") || prev.is(".") || t.is(")") || t.is(",") || t.is("::") || t.is(".") || call || operator) {
+				sb.WriteByte(' ')
+			}
+		}
+		switch t.kind {
+		case ddlString:
+			sb.WriteString("'" + strings.Replace(t.text, "'", "''", -1) + "'")
+		case ddlQuotedIdent:
+			sb.WriteString(quoteIdentifier(t.text))
+		default:
+			sb.WriteString(t.text)
+		}
+	}
+	return sb.String()
+}
+
+func (p *ddlParser) setPrimaryKey(table *Table, line int, columns [][]ddlToken) error {
+	if len(table.PrimaryKeyColumnNames) > 0 {
+		return fmt.Errorf("line %d: multiple primary keys for table %s.%s are not allowed", line, table.Schema, table.TableName)
+	}
+
+	for _, c := range columns {
+		if len(c) != 1 || (c[0].kind != ddlWord && c[0].kind != ddlQuotedIdent) {
+			return fmt.Errorf("line %d: expected primary key column name", line)
+		}
+		table.PrimaryKeyColumnNames = append(table.PrimaryKeyColumnNames, c[0].ident())
+	}
+
+	return nil
+}
+
+func (p *ddlParser) alterTable(stmt []ddlToken) error {
+	i := 2
+	if i+1 < len(stmt) && stmt[i].is("if") && stmt[i+1].is("exists") {
+		i += 2
+	}
+	if i < len(stmt) && stmt[i].is("only") {
+		i++
+	}
+
+	schema, tableName, i, err := ddlQualifiedName(stmt, i)
+	if err != nil {
+		return err
+	}
+
+	table := p.tablePtr(schema, tableName)
+	if table == nil {
+		// The table was not created by a statement this parser understands
+		// (e.g. a foreign table). Ignore it.
+		return nil
+	}
+
+	var actions [][]ddlToken
+	var action []ddlToken
+	depth := 0
+	for _, t := range stmt[i:] {
+		switch {
+		case t.is("("):
+			depth++
+		case t.is(")"):
+			depth--
+		case t.is(",") && depth == 0:
+			actions = append(actions, action)
+			action = nil
+			continue
+		}
+		action = append(action, t)
+	}
+	actions = append(actions, action)
+
+	for _, a := range actions {
+		if err := p.alterTableAction(table, a); err != nil {
+			return err
+		}
+	}
+	primaryKeyNotNull(table)
+
+	return nil
+}
+
+// primaryKeyNotNull marks the primary key columns of table NOT NULL like
+// PostgreSQL does when the primary key is added.
+func primaryKeyNotNull(table *Table) {
+	for i := range table.Columns {
+		if stringIndex(table.PrimaryKeyColumnNames, table.Columns[i].ColumnName) >= 0 {
+			table.Columns[i].NotNull = true
+		}
+	}
+}
+
+func (p *ddlParser) alterTableAction(table *Table, action []ddlToken) error {
+	if len(action) < 2 {
+		return nil
+	}
+
+	switch {
+	case action[0].is("alter"):
+		action = action[1:]
+		if action[0].is("column") {
+			action = action[1:]
+		}
+		p.alterColumn(table, action)
+	case action[0].is("add"):
+		action = action[1:]
+		if action[0].is("column") {
+			line := action[0].line
+			action = action[1:]
+			if len(action) == 0 {
+				return fmt.Errorf("line %d: expected column definition after ADD COLUMN", line)
+			}
+			if len(action) > 3 && action[0].is("if") && action[1].is("not") && action[2].is("exists") {
+				action = action[3:]
+				for _, c := range table.Columns {
+					if c.ColumnName == action[0].ident() {
+						return nil
+					}
+				}
+			}
+			return p.addColumn(table, action)
+		}
+		return p.tableElement(table, action)
+	case action[0].is("drop"):
+		action = action[1:]
+		if action[0].is("constraint") {
+			action = action[1:]
+			if len(action) > 2 && action[0].is("if") && action[1].is("exists") {
+				action = action[2:]
+			}
+			if len(action) > 0 {
+				p.removeIndex(table, action[0].ident())
+				p.removeForeignKey(table, action[0].ident())
+			}
+			return nil
+		}
+		if action[0].is("column") {
+			action = action[1:]
+		}
+		ifExists := false
+		if len(action) > 2 && action[0].is("if") && action[1].is("exists") {
+			action = action[2:]
+			ifExists = true
+		}
+		if len(action) == 0 {
+			return nil
+		}
+		name := action[0].ident()
+		for j, c := range table.Columns {
+			if c.ColumnName == name {
+				table.Columns = append(table.Columns[:j], table.Columns[j+1:]...)
+
+				// Like PostgreSQL, indexes on the column are dropped as well.
+				indexes := table.Indexes[:0]
+				for _, index := range table.Indexes {
+					if stringIndex(index.ColumnNames, name) < 0 {
+						indexes = append(indexes, index)
+					}
+				}
+				table.Indexes = indexes
+
+				foreignKeys := table.ForeignKeys[:0]
+				for _, fk := range table.ForeignKeys {
+					if stringIndex(fk.ColumnNames, name) < 0 {
+						foreignKeys = append(foreignKeys, fk)
+					}
+				}
+				table.ForeignKeys = foreignKeys
+				return nil
+			}
+		}
+		if !ifExists {
+			return fmt.Errorf("line %d: column %s of table %s.%s does not exist", action[0].line, name, table.Schema, table.TableName)
+		}
+	case action[0].is("rename"):
+		action = action[1:]
+		if action[0].is("to") && len(action) > 1 {
+			key := table.Schema + "." + table.TableName
+			p.eachReferencingForeignKey(table, func(fk *ForeignKey) {
+				fk.ReferencedTableName = action[1].ident()
+			})
+			table.TableName = action[1].ident()
+			p.lastOrdinalPositions[table.Schema+"."+table.TableName] = p.lastOrdinalPositions[key]
+			delete(p.lastOrdinalPositions, key)
+			return nil
+		}
+		if action[0].is("column") {
+			action = action[1:]
+		}
+		if len(action) == 3 && action[1].is("to") {
+			from, to := action[0].ident(), action[2].ident()
+			for j := range table.Columns {
+				if table.Columns[j].ColumnName == from {
+					table.Columns[j].ColumnName = to
+				}
+			}
+			for j := range table.PrimaryKeyColumnNames {
+				if table.PrimaryKeyColumnNames[j] == from {
+					table.PrimaryKeyColumnNames[j] = to
+				}
+			}
+			for _, index := range table.Indexes {
+				for j := range index.ColumnNames {
+					if index.ColumnNames[j] == from {
+						index.ColumnNames[j] = to
+					}
+				}
+			}
+			for _, fk := range table.ForeignKeys {
+				for j := range fk.ColumnNames {
+					if fk.ColumnNames[j] == from {
+						fk.ColumnNames[j] = to
+					}
+				}
+			}
+			p.eachReferencingForeignKey(table, func(fk *ForeignKey) {
+				for j := range fk.ReferencedColumnNames {
+					if fk.ReferencedColumnNames[j] == from {
+						fk.ReferencedColumnNames[j] = to
+					}
+				}
+			})
+		}
+	}
+
+	return nil
+}
+
+// alterColumn applies an ALTER TABLE ALTER COLUMN action to table. Changes of
+// nullability, defaults, identity and generation are tracked and all others
+// are ignored.
+func (p *ddlParser) alterColumn(table *Table, action []ddlToken) {
+	if len(action) < 3 {
+		return
+	}
+
+	var c *Column
+	for j := range table.Columns {
+		if table.Columns[j].ColumnName == action[0].ident() {
+			c = &table.Columns[j]
+		}
+	}
+	if c == nil {
+		return
+	}
+
+	action = action[1:]
+	switch {
+	case len(action) == 3 && action[1].is("not") && action[2].is("null") && (action[0].is("set") || action[0].is("drop")):
+		c.NotNull = action[0].is("set")
+	case action[0].is("set") && action[1].is("default") && len(action) > 2:
+		c.Default = ddlExpr(action[2:])
+	case action[0].is("drop") && action[1].is("default"):
+		c.Default = ""
+	case action[0].is("drop") && action[1].is("identity"):
+		c.Identity = ""
+	case action[0].is("drop") && action[1].is("expression"):
+		c.Generated = false
+	case (action[0].is("add") || action[0].is("set")) && action[1].is("generated") && len(action) > 2:
+		if action[2].is("always") {
+			c.Identity = "ALWAYS"
+		} else {
+			c.Identity = "BY DEFAULT"
+		}
+		c.NotNull = true
+	}
+}
+
+func (p *ddlParser) dropTable(stmt []ddlToken) error {
+	i := 2
+	if i+1 < len(stmt) && stmt[i].is("if") && stmt[i+1].is("exists") {
+		i += 2
+	}
+
+	for i < len(stmt) {
+		schema, tableName, next, err := ddlQualifiedName(stmt, i)
+		if err != nil {
+			return err
+		}
+
+		for j := range p.snapshot.Tables {
+			if p.snapshot.Tables[j].Schema == schema && p.snapshot.Tables[j].TableName == tableName {
+				p.snapshot.Tables = append(p.snapshot.Tables[:j], p.snapshot.Tables[j+1:]...)
+				break
+			}
+		}
+
+		// Foreign keys referencing the table are dropped with it by CASCADE.
+		for j := range p.snapshot.Tables {
+			t := &p.snapshot.Tables[j]
+			foreignKeys := t.ForeignKeys[:0]
+			for _, fk := range t.ForeignKeys {
+				if fk.ReferencedSchema != schema || fk.ReferencedTableName != tableName {
+					foreignKeys = append(foreignKeys, fk)
+				}
+			}
+			t.ForeignKeys = foreignKeys
+		}
+
+		if next >= len(stmt) || !stmt[next].is(",") {
+			break
+		}
+		i = next + 1
+	}
+
+	return nil
+}
+
+func (p *ddlParser) createIndex(stmt []ddlToken, i int) error {
+	unique := stmt[i-2].is("unique")
+	if i < len(stmt) && stmt[i].is("concurrently") {
+		i++
+	}
+	ifNotExists := false
+	if i+2 < len(stmt) && stmt[i].is("if") && stmt[i+1].is("not") && stmt[i+2].is("exists") {
+		ifNotExists = true
+		i += 3
+	}
+	name := ""
+	if i < len(stmt) && !stmt[i].is("on") {
+		name = stmt[i].ident()
+		i++
+	}
+	if i >= len(stmt) || !stmt[i].is("on") {
+		return fmt.Errorf("line %d: expected ON table", stmt[0].line)
+	}
+	i++
+	if i < len(stmt) && stmt[i].is("only") {
+		i++
+	}
+
+	schema, tableName, i, err := ddlQualifiedName(stmt, i)
+	if err != nil {
+		return err
+	}
+
+	method := "btree"
+	if i+1 < len(stmt) && stmt[i].is("using") {
+		method = stmt[i+1].ident()
+		i += 2
+	}
+	if i >= len(stmt) || !stmt[i].is("(") {
+		return fmt.Errorf("line %d: expected index columns", stmt[0].line)
+	}
+	columns, next, err := ddlParenList(stmt, i)
+	if err != nil {
+		return err
+	}
+
+	table := p.tablePtr(schema, tableName)
+	if table == nil {
+		return nil
+	}
+	if name != "" && ifNotExists && p.relationExists(schema, name) {
+		return nil
+	}
+
+	return p.addIndex(table, name, "idx", method, unique, columns, stmt[next:])
+}
+
+// addIndex adds an index on columns to table. Indexes on expressions and
+// partial indexes, recognized by a WHERE clause in rest, are ignored. A name
+// is chosen like PostgreSQL does when name is empty.
+func (p *ddlParser) addIndex(table *Table, name, label, method string, unique bool, columns [][]ddlToken, rest []ddlToken) error {
+	for _, t := range rest {
+		if t.is("where") {
+			return nil
+		}
+	}
+
+	index := Index{Method: method, Unique: unique}
+	for _, c := range columns {
+		if len(c) == 0 || (c[0].kind != ddlWord && c[0].kind != ddlQuotedIdent) || (len(c) > 1 && c[1].is("(")) {
+			return nil
+		}
+		index.ColumnNames = append(index.ColumnNames, c[0].ident())
+	}
+
+	if name == "" {
+		name = p.chooseRelationName(table.Schema, table.TableName, strings.Join(index.ColumnNames, "_"), label)
+	} else if p.relationExists(table.Schema, name) {
+		return fmt.Errorf("line %d: relation %s.%s already exists", columns[0][0].line, table.Schema, name)
+	}
+	index.Name = name
+
+	table.Indexes = append(table.Indexes, index)
+	return nil
+}
+
+// chooseRelationName returns the name PostgreSQL chooses for an index without
+// an explicit name: name1_name2_label truncated to the maximum identifier
+// length and made unique with a numeric suffix.
+func (p *ddlParser) chooseRelationName(schema, name1, name2, label string) string {
+	return ddlChooseName(name1, name2, label, func(name string) bool { return p.relationExists(schema, name) })
+}
+
+// ddlChooseName returns name1_name2_label truncated to the maximum identifier
+// length and made unique with a numeric suffix among the names exists reports.
+func ddlChooseName(name1, name2, label string, exists func(string) bool) string {
+	const maxIdentifierLength = 63
+
+	for pass := 0; ; pass++ {
+		suffix := label
+		if pass > 0 {
+			suffix += strconv.Itoa(pass)
+		}
+
+		// The longer of name1 and name2 is truncated first.
+		n1, n2 := len(name1), len(name2)
+		available := maxIdentifierLength - len(suffix) - 1
+		if name2 != "" {
+			available--
+		}
+		for n1+n2 > available {
+			if n1 > n2 {
+				n1--
+			} else {
+				n2--
+			}
+		}
+
+		name := name1[:n1]
+		if name2 != "" {
+			name += "_" + name2[:n2]
+		}
+		name += "_" + suffix
+
+		if !exists(name) {
+			return name
+		}
+	}
+}
+
+// relationExists returns true if a table or index schema.name exists.
+func (p *ddlParser) relationExists(schema, name string) bool {
+	return p.tablePtr(schema, name) != nil || p.indexTable(schema, name) != nil
+}
+
+// indexTable returns the table of the index schema.name or nil if there is no
+// such index.
+func (p *ddlParser) indexTable(schema, name string) *Table {
+	for i := range p.snapshot.Tables {
+		t := &p.snapshot.Tables[i]
+		if t.Schema != schema {
+			continue
+		}
+		for _, index := range t.Indexes {
+			if index.Name == name {
+				return t
+			}
+		}
+	}
+	return nil
+}
+
+// addForeignKey adds a foreign key on columnNames to table. The referenced
+// table and optional column list follow REFERENCES at stmt[i]. An omitted
+// column list references the primary key, which is resolved once all
+// statements are parsed. A name is chosen like PostgreSQL does when name is
+// empty. It returns the index of the token following the column list.
+func (p *ddlParser) addForeignKey(table *Table, name string, columnNames []string, stmt []ddlToken, i int) (int, error) {
+	schema, tableName, next, err := ddlQualifiedName(stmt, i)
+	if err != nil {
+		return 0, err
+	}
+
+	fk := ForeignKey{ColumnNames: columnNames, ReferencedSchema: schema, ReferencedTableName: tableName}
+	if next < len(stmt) && stmt[next].is("(") {
+		var columns [][]ddlToken
+		columns, next, err = ddlParenList(stmt, next)
+		if err != nil {
+			return 0, err
+		}
+		for _, c := range columns {
+			if len(c) != 1 {
+				return 0, fmt.Errorf("line %d: expected referenced column name", stmt[i].line)
+			}
+			fk.ReferencedColumnNames = append(fk.ReferencedColumnNames, c[0].ident())
+		}
+		if len(fk.ReferencedColumnNames) != len(columnNames) {
+			return 0, fmt.Errorf("line %d: number of referencing and referenced columns for foreign key disagree", stmt[i].line)
+		}
+	}
+
+	// Skip MATCH and the referential actions so a column definition does not
+	// mistake SET NULL or SET DEFAULT for a column constraint.
+actions:
+	for next < len(stmt) {
+		switch {
+		case stmt[next].is("match"):
+			next += 2
+		case stmt[next].is("on"):
+			next += 2
+			if next < len(stmt) && (stmt[next].is("set") || stmt[next].is("no")) {
+				next++
+			}
+			next++
+			if next < len(stmt) && stmt[next].is("(") {
+				if _, next, err = ddlParenList(stmt, next); err != nil {
+					return 0, err
+				}
+			}
+		default:
+			break actions
+		}
+	}
+
+	if name == "" {
+		name = ddlChooseName(table.TableName, strings.Join(columnNames, "_"), "fkey", func(name string) bool {
+			for _, fk := range table.ForeignKeys {
+				if fk.Name == name {
+					return true
+				}
+			}
+			return false
+		})
+	}
+	fk.Name = name
+
+	table.ForeignKeys = append(table.ForeignKeys, fk)
+	return next, nil
+}
+
+// resolveForeignKeys fills in the referenced columns of foreign keys declared
+// without a column list with the primary key of the referenced table.
+func (p *ddlParser) resolveForeignKeys() error {
+	for i := range p.snapshot.Tables {
+		for j := range p.snapshot.Tables[i].ForeignKeys {
+			fk := &p.snapshot.Tables[i].ForeignKeys[j]
+			if len(fk.ReferencedColumnNames) > 0 {
+				continue
+			}
+			referenced := p.tablePtr(fk.ReferencedSchema, fk.ReferencedTableName)
+			if referenced == nil || len(referenced.PrimaryKeyColumnNames) != len(fk.ColumnNames) {
+				return fmt.Errorf("foreign key %s of table %s.%s does not match the primary key of %s.%s", fk.Name, p.snapshot.Tables[i].Schema, p.snapshot.Tables[i].TableName, fk.ReferencedSchema, fk.ReferencedTableName)
+			}
+			fk.ReferencedColumnNames = append([]string{}, referenced.PrimaryKeyColumnNames...)
+		}
+	}
+	return nil
+}
+
+// eachReferencingForeignKey calls fn with each foreign key that references
+// table.
+func (p *ddlParser) eachReferencingForeignKey(table *Table, fn func(*ForeignKey)) {
+	for i := range p.snapshot.Tables {
+		for j := range p.snapshot.Tables[i].ForeignKeys {
+			fk := &p.snapshot.Tables[i].ForeignKeys[j]
+			if fk.ReferencedSchema == table.Schema && fk.ReferencedTableName == table.TableName {
+				fn(fk)
+			}
+		}
+	}
+}
+
+func (p *ddlParser) removeForeignKey(table *Table, name string) {
+	for j, fk := range table.ForeignKeys {
+		if fk.Name == name {
+			table.ForeignKeys = append(table.ForeignKeys[:j], table.ForeignKeys[j+1:]...)
+			return
+		}
+	}
+}
+
+func (p *ddlParser) removeIndex(table *Table, name string) {
+	for j, index := range table.Indexes {
+		if index.Name == name {
+			table.Indexes = append(table.Indexes[:j], table.Indexes[j+1:]...)
+			return
+		}
+	}
+}
+
+func (p *ddlParser) alterIndex(stmt []ddlToken) error {
+	i := 2
+	if i+1 < len(stmt) && stmt[i].is("if") && stmt[i+1].is("exists") {
+		i += 2
+	}
+
+	schema, name, i, err := ddlQualifiedName(stmt, i)
+	if err != nil {
+		return err
+	}
+
+	action := stmt[i:]
+	if len(action) != 3 || !action[0].is("rename") || !action[1].is("to") {
+		return nil
+	}
+
+	table := p.indexTable(schema, name)
+	if table == nil {
+		return nil
+	}
+	for j := range table.Indexes {
+		if table.Indexes[j].Name == name {
+			table.Indexes[j].Name = action[2].ident()
+		}
+	}
+
+	return nil
+}
+
+func (p *ddlParser) dropIndex(stmt []ddlToken) error {
+	i := 2
+	if i < len(stmt) && stmt[i].is("concurrently") {
+		i++
+	}
+	if i+1 < len(stmt) && stmt[i].is("if") && stmt[i+1].is("exists") {
+		i += 2
+	}
+
+	for i < len(stmt) {
+		schema, name, next, err := ddlQualifiedName(stmt, i)
+		if err != nil {
+			return err
+		}
+
+		if table := p.indexTable(schema, name); table != nil {
+			p.removeIndex(table, name)
+		}
+
+		if next >= len(stmt) || !stmt[next].is(",") {
+			break
+		}
+		i = next + 1
+	}
+
+	return nil
+}
+
+func (p *ddlParser) createType(stmt []ddlToken, i int) error {
+	schema, name, i, err := ddlQualifiedName(stmt, i)
+	if err != nil {
+		return err
+	}
+
+	// Only enums and composite types are needed. Range and base types are
+	// ignored.
+	if i+1 >= len(stmt) || !stmt[i].is("as") {
+		return nil
+	}
+	if stmt[i+1].is("(") {
+		return p.createCompositeType(stmt, schema, name, i+1)
+	}
+	if i+2 >= len(stmt) || !stmt[i+1].is("enum") {
+		return nil
+	}
+
+	elements, _, err := ddlParenList(stmt, i+2)
+	if err != nil {
+		return err
+	}
+
+	if p.typeExists(schema, name) {
+		return fmt.Errorf("line %d: type %s.%s already exists", stmt[0].line, schema, name)
+	}
+
+	e := Enum{Schema: schema, Name: name, Labels: []string{}}
+	for _, element := range elements {
+		if len(element) == 0 {
+			continue
+		}
+		if len(element) != 1 || element[0].kind != ddlString {
+			return fmt.Errorf("line %d: expected enum label, got %s", element[0].line, element[0].text)
+		}
+		e.Labels = append(e.Labels, element[0].text)
+	}
+	p.snapshot.Enums = append(p.snapshot.Enums, e)
+
+	return nil
+}
+
+func (p *ddlParser) createCompositeType(stmt []ddlToken, schema, name string, i int) error {
+	elements, _, err := ddlParenList(stmt, i)
+	if err != nil {
+		return err
+	}
+
+	if p.typeExists(schema, name) {
+		return fmt.Errorf("line %d: type %s.%s already exists", stmt[0].line, schema, name)
+	}
+
+	p.snapshot.CompositeTypes = append(p.snapshot.CompositeTypes, CompositeType{Schema: schema, Name: name, Attributes: []Column{}})
+	ct := &p.snapshot.CompositeTypes[len(p.snapshot.CompositeTypes)-1]
+	delete(p.lastOrdinalPositions, schema+"."+name)
+
+	for _, element := range elements {
+		if len(element) == 0 {
+			continue
+		}
+		if err := p.addAttribute(ct, element); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// addAttribute adds an attribute to ct. Unlike columns, attributes of a domain
+// type are not resolved to the base type as information_schema.attributes
+// does not do so either.
+func (p *ddlParser) addAttribute(ct *CompositeType, def []ddlToken) error {
+	if def[0].kind != ddlWord && def[0].kind != ddlQuotedIdent {
+		return fmt.Errorf("line %d: expected attribute name, got %s", def[0].line, def[0].text)
+	}
+
+	attribute := Column{ColumnName: def[0].ident()}
+	for _, a := range ct.Attributes {
+		if a.ColumnName == attribute.ColumnName {
+			return fmt.Errorf("line %d: attribute %s of type %s.%s already exists", def[0].line, attribute.ColumnName, ct.Schema, ct.Name)
+		}
+	}
+
+	i := ddlTypeEnd(def, 1)
+	if i == 1 {
+		return fmt.Errorf("line %d: attribute %s has no type", def[0].line, attribute.ColumnName)
+	}
+	attribute.DataType, attribute.UDTSchema, attribute.UDTName = ddlDataType(def[1:i])
+
+	key := ct.Schema + "." + ct.Name
+	p.lastOrdinalPositions[key]++
+	attribute.OrdinalPosition = p.lastOrdinalPositions[key]
+	ct.Attributes = append(ct.Attributes, attribute)
+
+	return nil
+}
+
+func (p *ddlParser) typeExists(schema, name string) bool {
+	if _, ok := p.domains[schema+"."+name]; ok {
+		return true
+	}
+	return p.snapshot.enumPtr(schema, name) != nil || p.snapshot.compositeTypePtr(schema, name) != nil
+}
+
+func (p *ddlParser) alterType(stmt []ddlToken) error {
+	schema, name, i, err := ddlQualifiedName(stmt, 2)
+	if err != nil {
+		return err
+	}
+
+	if ct := p.snapshot.compositeTypePtr(schema, name); ct != nil {
+		return p.alterCompositeType(ct, stmt[i:])
+	}
+
+	e := p.snapshot.enumPtr(schema, name)
+	if e == nil {
+		return nil
+	}
+
+	action := stmt[i:]
+	switch {
+	case len(action) > 2 && action[0].is("add") && action[1].is("value"):
+		action = action[2:]
+		ifNotExists := false
+		if len(action) > 3 && action[0].is("if") && action[1].is("not") && action[2].is("exists") {
+			action = action[3:]
+			ifNotExists = true
+		}
+		if action[0].kind != ddlString {
+			return fmt.Errorf("line %d: expected enum label, got %s", action[0].line, action[0].text)
+		}
+		label := action[0].text
+		if stringIndex(e.Labels, label) >= 0 {
+			if ifNotExists {
+				return nil
+			}
+			return fmt.Errorf("line %d: enum label %q of type %s.%s already exists", action[0].line, label, schema, name)
+		}
+
+		position := len(e.Labels)
+		if len(action) == 3 && (action[1].is("before") || action[1].is("after")) {
+			position = stringIndex(e.Labels, action[2].text)
+			if position < 0 {
+				return fmt.Errorf("line %d: enum label %q of type %s.%s does not exist", action[2].line, action[2].text, schema, name)
+			}
+			if action[1].is("after") {
+				position++
+			}
+		}
+		e.Labels = append(e.Labels, "")
+		copy(e.Labels[position+1:], e.Labels[position:])
+		e.Labels[position] = label
+	case len(action) == 5 && action[0].is("rename") && action[1].is("value") && action[3].is("to"):
+		j := stringIndex(e.Labels, action[2].text)
+		if j < 0 {
+			return fmt.Errorf("line %d: enum label %q of type %s.%s does not exist", action[2].line, action[2].text, schema, name)
+		}
+		e.Labels[j] = action[4].text
+	case len(action) == 3 && action[0].is("rename") && action[1].is("to"):
+		p.renameType(e.Schema, e.Name, schema, action[2].ident())
+		e.Name = action[2].ident()
+	case len(action) == 3 && action[0].is("set") && action[1].is("schema"):
+		p.renameType(e.Schema, e.Name, action[2].ident(), name)
+		e.Schema = action[2].ident()
+	}
+
+	return nil
+}
+
+func (p *ddlParser) alterCompositeType(ct *CompositeType, action []ddlToken) error {
+	switch {
+	case len(action) == 3 && action[0].is("rename") && action[1].is("to"):
+		p.renameComposite(ct, ct.Schema, action[2].ident())
+		return nil
+	case len(action) == 3 && action[0].is("set") && action[1].is("schema"):
+		p.renameComposite(ct, action[2].ident(), ct.Name)
+		return nil
+	case len(action) > 4 && action[0].is("rename") && action[1].is("attribute") && action[3].is("to"):
+		from, to := action[2].ident(), action[4].ident()
+		for j := range ct.Attributes {
+			if ct.Attributes[j].ColumnName == from {
+				ct.Attributes[j].ColumnName = to
+				return nil
+			}
+		}
+		return fmt.Errorf("line %d: attribute %s of type %s.%s does not exist", action[2].line, from, ct.Schema, ct.Name)
+	}
+
+	// ADD and DROP ATTRIBUTE actions may be combined in one statement.
+	var actions [][]ddlToken
+	var a []ddlToken
+	depth := 0
+	for _, t := range action {
+		switch {
+		case t.is("("):
+			depth++
+		case t.is(")"):
+			depth--
+		case t.is(",") && depth == 0:
+			actions = append(actions, a)
+			a = nil
+			continue
+		}
+		a = append(a, t)
+	}
+	actions = append(actions, a)
+
+	for _, a := range actions {
+		switch {
+		case len(a) > 2 && a[0].is("add") && a[1].is("attribute"):
+			if err := p.addAttribute(ct, a[2:]); err != nil {
+				return err
+			}
+		case len(a) > 2 && a[0].is("drop") && a[1].is("attribute"):
+			a = a[2:]
+			ifExists := false
+			if len(a) > 2 && a[0].is("if") && a[1].is("exists") {
+				a = a[2:]
+				ifExists = true
+			}
+			if err := p.dropAttribute(ct, a[0], ifExists); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func (p *ddlParser) dropAttribute(ct *CompositeType, t ddlToken, ifExists bool) error {
+	name := t.ident()
+	for j, a := range ct.Attributes {
+		if a.ColumnName == name {
+			ct.Attributes = append(ct.Attributes[:j], ct.Attributes[j+1:]...)
+			return nil
+		}
+	}
+	if !ifExists {
+		return fmt.Errorf("line %d: attribute %s of type %s.%s does not exist", t.line, name, ct.Schema, ct.Name)
+	}
+	return nil
+}
+
+// renameComposite renames ct and updates the columns and attributes that use
+// it.
+func (p *ddlParser) renameComposite(ct *CompositeType, schema, name string) {
+	key := ct.Schema + "." + ct.Name
+	p.lastOrdinalPositions[schema+"."+name] = p.lastOrdinalPositions[key]
+	delete(p.lastOrdinalPositions, key)
+
+	p.renameType(ct.Schema, ct.Name, schema, name)
+	ct.Schema, ct.Name = schema, name
+}
+
+// renameType updates the columns and composite type attributes that use the
+// type fromSchema.fromName to use schema.name instead.
+func (p *ddlParser) renameType(fromSchema, fromName, schema, name string) {
+	rename := func(columns []Column) {
+		for j := range columns {
+			if columns[j].UDTSchema != fromSchema {
+				continue
+			}
+			switch columns[j].UDTName {
+			case fromName:
+				columns[j].UDTSchema, columns[j].UDTName = schema, name
+			case "_" + fromName:
+				columns[j].UDTSchema, columns[j].UDTName = schema, "_"+name
+			}
+		}
+	}
+
+	for i := range p.snapshot.Tables {
+		rename(p.snapshot.Tables[i].Columns)
+	}
+	for i := range p.snapshot.CompositeTypes {
+		rename(p.snapshot.CompositeTypes[i].Attributes)
+	}
+}
+
+func (p *ddlParser) dropType(stmt []ddlToken) error {
+	i := 2
+	if i+1 < len(stmt) && stmt[i].is("if") && stmt[i+1].is("exists") {
+		i += 2
+	}
+
+	for i < len(stmt) {
+		schema, name, next, err := ddlQualifiedName(stmt, i)
+		if err != nil {
+			return err
+		}
+
+		for j := range p.snapshot.Enums {
+			if p.snapshot.Enums[j].Schema == schema && p.snapshot.Enums[j].Name == name {
+				p.snapshot.Enums = append(p.snapshot.Enums[:j], p.snapshot.Enums[j+1:]...)
+				break
+			}
+		}
+		for j := range p.snapshot.CompositeTypes {
+			if p.snapshot.CompositeTypes[j].Schema == schema && p.snapshot.CompositeTypes[j].Name == name {
+				p.snapshot.CompositeTypes = append(p.snapshot.CompositeTypes[:j], p.snapshot.CompositeTypes[j+1:]...)
+				break
+			}
+		}
+
+		if next >= len(stmt) || !stmt[next].is(",") {
+			break
+		}
+		i = next + 1
+	}
+
+	return nil
+}
+
+func (p *ddlParser) createDomain(stmt []ddlToken, i int) error {
+	schema, name, i, err := ddlQualifiedName(stmt, i)
+	if err != nil {
+		return err
+	}
+	if i < len(stmt) && stmt[i].is("as") {
+		i++
+	}
+
+	end := ddlTypeEnd(stmt, i)
+	if end == i {
+		return fmt.Errorf("line %d: domain %s.%s has no type", stmt[0].line, schema, name)
+	}
+
+	if p.typeExists(schema, name) {
+		return fmt.Errorf("line %d: type %s.%s already exists", stmt[0].line, schema, name)
+	}
+
+	var base Column
+	base.DataType, base.UDTSchema, base.UDTName = ddlDataType(stmt[i:end])
+	ddlTypeModifiers(&base, stmt[i:end])
+	if domainBase, ok := p.domains[base.UDTSchema+"."+base.UDTName]; ok && base.DataType == "USER-DEFINED" {
+		base = domainBase
+	}
+	p.domains[schema+"."+name] = base
+
+	return nil
+}
+
+func (p *ddlParser) dropDomain(stmt []ddlToken) error {
+	i := 2
+	if i+1 < len(stmt) && stmt[i].is("if") && stmt[i+1].is("exists") {
+		i += 2
+	}
+
+	for i < len(stmt) {
+		schema, name, next, err := ddlQualifiedName(stmt, i)
+		if err != nil {
+			return err
+		}
+		delete(p.domains, schema+"."+name)
+
+		if next >= len(stmt) || !stmt[next].is(",") {
+			break
+		}
+		i = next + 1
+	}
+
+	return nil
+}
+
+func stringIndex(ss []string, s string) int {
+	for i := range ss {
+		if ss[i] == s {
+			return i
+		}
+	}
+	return -1
+}
+
+func (p *ddlParser) tablePtr(schema, tableName string) *Table {
+	for i := range p.snapshot.Tables {
+		if p.snapshot.Tables[i].Schema == schema && p.snapshot.Tables[i].TableName == tableName {
+			return &p.snapshot.Tables[i]
+		}
+	}
+	return nil
+}
+
+// ddlQualifiedName parses a possibly schema-qualified name starting at
+// stmt[i]. It returns the index of the token following the name.
+func ddlQualifiedName(stmt []ddlToken, i int) (schema, name string, next int, err error) {
+	if i >= len(stmt) || (stmt[i].kind != ddlWord && stmt[i].kind != ddlQuotedIdent) {
+		line := 0
+		if len(stmt) > 0 {
+			line = stmt[0].line
+		}
+		return "", "", 0, fmt.Errorf("line %d: expected table name", line)
+	}
+
+	name = stmt[i].ident()
+	i++
+	if i+1 < len(stmt) && stmt[i].is(".") && (stmt[i+1].kind == ddlWord || stmt[i+1].kind == ddlQuotedIdent) {
+		return name, stmt[i+1].ident(), i + 2, nil
+	}
+
+	return "public", name, i, nil
+}
+
+// ddlParenList splits the comma separated list in the parentheses opened at
+// stmt[i]. It returns the index of the token following the closing
+// parenthesis.
+func ddlParenList(stmt []ddlToken, i int) ([][]ddlToken, int, error) {
+	var elements [][]ddlToken
+	var element []ddlToken
+	depth := 0
+
+	for j := i + 1; j < len(stmt); j++ {
+		t := stmt[j]
+		switch {
+		case t.is("("):
+			depth++
+		case t.is(")") && depth == 0:
+			elements = append(elements, element)
+			return elements, j + 1, nil
+		case t.is(")"):
+			depth--
+		case t.is(",") && depth == 0:
+			elements = append(elements, element)
+			element = nil
+			continue
+		}
+		element = append(element, t)
+	}
+
+	return nil, 0, fmt.Errorf("line %d: unterminated parenthesis", stmt[i].line)
+}
+
+// ddlDataType converts the type of a column definition to the names used by
+// information_schema.columns.data_type, udt_schema and udt_name.
+func ddlDataType(tokens []ddlToken) (dataType, udtSchema, udtName string) {
+	var words []string
+	depth := 0
+	array := false
+	qualifier := ""
+	for i := 0; i < len(tokens); i++ {
+		t := tokens[i]
+		switch {
+		case t.is("(") || t.is("["):
+			array = array || t.is("[")
+			depth++
+		case t.is(")") || t.is("]"):
+			depth--
+		case depth > 0:
+		case t.is("array"):
+			array = true
+		case t.is("."):
+			qualifier = strings.Join(words, " ")
+			words = words[:0]
+		case t.kind == ddlWord || t.kind == ddlQuotedIdent:
+			words = append(words, t.ident())
+		}
+	}
+
+	name := strings.Join(words, " ")
+	if len(words) > 0 && words[0] == "interval" {
+		name = "interval"
+	}
+
+	// Only types in pg_catalog are known.
+	dataType, ok := ddlTypeNames[name]
+	if (qualifier != "" && qualifier != "pg_catalog") || !ok {
+		if qualifier == "" {
+			qualifier = "public"
+		}
+		dataType, udtSchema, udtName = "USER-DEFINED", qualifier, name
+	} else if udtSchema, udtName = "pg_catalog", ddlUDTNames[dataType]; udtName == "" {
+		udtName = dataType
+	}
+
+	if array {
+		return "ARRAY", udtSchema, "_" + udtName
+	}
+	return dataType, udtSchema, udtName
+}
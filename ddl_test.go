@@ -0,0 +1,498 @@
+package main
+
+import (
+	"context"
+	"io/ioutil"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestParseDDL(t *testing.T) {
+	t.Parallel()
+
+	src := `
+-- A pg_dump style schema
+SET search_path = '';
+
+CREATE FUNCTION public.touch() RETURNS trigger LANGUAGE plpgsql AS $$
+begin
+  new.updated_at := now(); -- a comment; with a semicolon
+  return new;
+end;
+$$;
+
+CREATE TABLE public.account (
+    id bigint NOT NULL,
+    "Name" character varying(100) DEFAULT 'unnamed;' NOT NULL,
+    balance numeric(10,2),
+    tags text[],
+    created_at timestamp(6) with time zone DEFAULT now(),
+    status public.account_status,
+    CONSTRAINT balance_check CHECK ((balance >= (0)::numeric))
+);
+
+CREATE SEQUENCE public.account_id_seq;
+ALTER TABLE ONLY public.account ALTER COLUMN id SET DEFAULT nextval('public.account_id_seq'::regclass);
+ALTER TABLE ONLY public.account ADD CONSTRAINT account_pkey PRIMARY KEY (id);
+
+/* migration style statements /* nested */ follow */
+create table if not exists billing.invoice (
+  account_id int8 references public.account,
+  number int,
+  legacy_code char(4),
+  primary key (account_id, number)
+);
+alter table billing.invoice add column paid bool not null default false, drop column legacy_code;
+alter table billing.invoice add note text;
+alter table public.account alter column balance set not null, alter tags set not null;
+alter table public.account alter tags drop not null;
+
+create table temp_data(id serial primary key);
+drop table if exists temp_data;
+`
+
+	snapshot, err := parseDDL(src)
+	if err != nil {
+		t.Fatalf("parseDDL unexpectedly failed: %v", err)
+	}
+
+	expected := []Table{
+		{
+			Schema:                "public",
+			TableName:             "account",
+			PrimaryKeyColumnNames: []string{"id"},
+			Columns: []Column{
+				{ColumnName: "id", DataType: "bigint", UDTSchema: "pg_catalog", UDTName: "int8", NotNull: true, Default: "nextval('public.account_id_seq'::regclass)", OrdinalPosition: 1},
+				{ColumnName: "Name", DataType: "character varying", UDTSchema: "pg_catalog", UDTName: "varchar", NotNull: true, Default: "'unnamed;'", CharacterMaxLength: 100, OrdinalPosition: 2},
+				{ColumnName: "balance", DataType: "numeric", UDTSchema: "pg_catalog", UDTName: "numeric", NotNull: true, NumericPrecision: 10, NumericScale: 2, OrdinalPosition: 3},
+				{ColumnName: "tags", DataType: "ARRAY", UDTSchema: "pg_catalog", UDTName: "_text", OrdinalPosition: 4},
+				{ColumnName: "created_at", DataType: "timestamp with time zone", UDTSchema: "pg_catalog", UDTName: "timestamptz", Default: "now()", OrdinalPosition: 5},
+				{ColumnName: "status", DataType: "USER-DEFINED", UDTSchema: "public", UDTName: "account_status", OrdinalPosition: 6},
+			},
+		},
+		{
+			Schema:                "billing",
+			TableName:             "invoice",
+			PrimaryKeyColumnNames: []string{"account_id", "number"},
+			Columns: []Column{
+				{ColumnName: "account_id", DataType: "bigint", UDTSchema: "pg_catalog", UDTName: "int8", NotNull: true, OrdinalPosition: 1},
+				{ColumnName: "number", DataType: "integer", UDTSchema: "pg_catalog", UDTName: "int4", NotNull: true, OrdinalPosition: 2},
+				{ColumnName: "paid", DataType: "boolean", UDTSchema: "pg_catalog", UDTName: "bool", NotNull: true, Default: "false", OrdinalPosition: 4},
+				{ColumnName: "note", DataType: "text", UDTSchema: "pg_catalog", UDTName: "text", OrdinalPosition: 5},
+			},
+			ForeignKeys: []ForeignKey{
+				{Name: "invoice_account_id_fkey", ColumnNames: []string{"account_id"}, ReferencedSchema: "public", ReferencedTableName: "account", ReferencedColumnNames: []string{"id"}},
+			},
+		},
+	}
+
+	if !reflect.DeepEqual(snapshot.Tables, expected) {
+		t.Errorf("Expected tables to be %v, got %v", expected, snapshot.Tables)
+	}
+}
+
+func TestParseDDLForeignKeys(t *testing.T) {
+	t.Parallel()
+
+	snapshot, err := parseDDL(`
+create table customer (id serial primary key, email text unique);
+create table semester (year int, season text, primary key (year, season));
+create table purchase_order (
+  id serial primary key,
+  customer_id int not null references customer on delete set default on update cascade,
+  customer_email text references customer (email) match simple,
+  year int,
+  season text,
+  note text default 'none',
+  constraint order_semester foreign key (year, season) references semester
+);
+create table note (id serial primary key, order_id int, author_id int);
+alter table note add foreign key (order_id) references purchase_order (id) on delete cascade;
+alter table note add constraint note_author foreign key (author_id) references customer;
+alter table note drop constraint note_author;
+alter table purchase_order rename column customer_id to buyer_id;
+alter table customer rename to client;
+alter table client rename column email to email_address;
+`)
+	if err != nil {
+		t.Fatalf("parseDDL unexpectedly failed: %v", err)
+	}
+
+	expected := map[string][]ForeignKey{
+		"purchase_order": {
+			{Name: "order_semester", ColumnNames: []string{"year", "season"}, ReferencedSchema: "public", ReferencedTableName: "semester", ReferencedColumnNames: []string{"year", "season"}},
+			{Name: "purchase_order_customer_email_fkey", ColumnNames: []string{"customer_email"}, ReferencedSchema: "public", ReferencedTableName: "client", ReferencedColumnNames: []string{"email_address"}},
+			{Name: "purchase_order_customer_id_fkey", ColumnNames: []string{"buyer_id"}, ReferencedSchema: "public", ReferencedTableName: "client", ReferencedColumnNames: []string{"id"}},
+		},
+		"note": {
+			{Name: "note_order_id_fkey", ColumnNames: []string{"order_id"}, ReferencedSchema: "public", ReferencedTableName: "purchase_order", ReferencedColumnNames: []string{"id"}},
+		},
+	}
+
+	for tableName, foreignKeys := range expected {
+		table, err := snapshot.table("public", tableName)
+		if err != nil || table == nil {
+			t.Fatalf("table %s not found: %v", tableName, err)
+		}
+		if !reflect.DeepEqual(table.ForeignKeys, foreignKeys) {
+			t.Errorf("Expected %s foreign keys to be %v, got %v", tableName, foreignKeys, table.ForeignKeys)
+		}
+	}
+
+	table, _ := snapshot.table("public", "purchase_order")
+	if c := table.column("note"); c == nil || c.Default != "'none'" {
+		t.Errorf("Expected note default to be 'none', got %v", c)
+	}
+	if c := table.column("buyer_id"); c == nil || c.Default != "" {
+		t.Errorf("Expected buyer_id to have no default, got %v", c)
+	}
+
+	_, err = parseDDL(`
+create table a (x int, y int, primary key (x, y));
+create table b (x int references a);
+`)
+	if err == nil {
+		t.Error("Expected a foreign key that does not match the referenced primary key to be an error, but it was not")
+	}
+
+	snapshot, err = parseDDL(`
+create table a (id int primary key);
+create table b (a_id int references a);
+drop table a cascade;
+`)
+	if err != nil {
+		t.Fatalf("parseDDL unexpectedly failed: %v", err)
+	}
+	if table, _ := snapshot.table("public", "b"); len(table.ForeignKeys) != 0 {
+		t.Errorf("Expected foreign keys referencing a dropped table to be dropped, got %v", table.ForeignKeys)
+	}
+}
+
+func TestDDLDataType(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		input     string
+		dataType  string
+		udtSchema string
+		udtName   string
+	}{
+		{"int", "integer", "pg_catalog", "int4"},
+		{"timestamp(3) without time zone", "timestamp without time zone", "pg_catalog", "timestamp"},
+		{"pg_catalog.bool", "boolean", "pg_catalog", "bool"},
+		{"bigint[]", "ARRAY", "pg_catalog", "_int8"},
+		{"character varying(10)[][]", "ARRAY", "pg_catalog", "_varchar"},
+		{"uuid array", "ARRAY", "pg_catalog", "_uuid"},
+		{"billing.order_status", "USER-DEFINED", "billing", "order_status"},
+		{"order_status[]", "ARRAY", "public", "_order_status"},
+	}
+
+	for i, tt := range tests {
+		tokens, err := tokenizeDDL(tt.input)
+		if err != nil {
+			t.Errorf("%d. Unexpected error: %v", i, err)
+			continue
+		}
+		dataType, udtSchema, udtName := ddlDataType(tokens)
+		if dataType != tt.dataType || udtSchema != tt.udtSchema || udtName != tt.udtName {
+			t.Errorf("%d. Given %s, expected %s %s.%s, but got %s %s.%s", i, tt.input, tt.dataType, tt.udtSchema, tt.udtName, dataType, udtSchema, udtName)
+		}
+	}
+}
+
+func TestParseDDLEnums(t *testing.T) {
+	t.Parallel()
+
+	src := `
+CREATE TYPE public.order_status AS ENUM ('pending', 'shipped');
+ALTER TYPE public.order_status ADD VALUE 'canceled';
+ALTER TYPE order_status ADD VALUE IF NOT EXISTS 'paid' BEFORE 'shipped';
+ALTER TYPE order_status ADD VALUE IF NOT EXISTS 'pending';
+ALTER TYPE order_status RENAME VALUE 'canceled' TO 'cancelled';
+
+create type billing.priority as enum ('low', 'high');
+alter type billing.priority rename to urgency;
+create type billing.point as (x int, y int);
+create type unused as enum ();
+drop type if exists unused, missing;
+
+create table purchase_order (
+  id serial primary key,
+  status order_status not null,
+  urgency billing.urgency,
+  history order_status[]
+);
+alter type order_status set schema sales;
+`
+
+	snapshot, err := parseDDL(src)
+	if err != nil {
+		t.Fatalf("parseDDL unexpectedly failed: %v", err)
+	}
+
+	expectedEnums := []Enum{
+		{Schema: "sales", Name: "order_status", Labels: []string{"pending", "paid", "shipped", "cancelled"}},
+		{Schema: "billing", Name: "urgency", Labels: []string{"low", "high"}},
+	}
+	if !reflect.DeepEqual(snapshot.Enums, expectedEnums) {
+		t.Errorf("Expected enums to be %v, got %v", expectedEnums, snapshot.Enums)
+	}
+
+	expectedColumns := []Column{
+		{ColumnName: "id", DataType: "integer", UDTSchema: "pg_catalog", UDTName: "int4", NotNull: true, Default: "nextval('purchase_order_id_seq'::regclass)", OrdinalPosition: 1},
+		{ColumnName: "status", DataType: "USER-DEFINED", UDTSchema: "sales", UDTName: "order_status", NotNull: true, OrdinalPosition: 2},
+		{ColumnName: "urgency", DataType: "USER-DEFINED", UDTSchema: "billing", UDTName: "urgency", OrdinalPosition: 3},
+		{ColumnName: "history", DataType: "ARRAY", UDTSchema: "sales", UDTName: "_order_status", OrdinalPosition: 4},
+	}
+	if len(snapshot.Tables) != 1 || !reflect.DeepEqual(snapshot.Tables[0].Columns, expectedColumns) {
+		t.Errorf("Expected columns to be %v, got %v", expectedColumns, snapshot.Tables)
+	}
+}
+
+func TestParseDDLCompositeTypesAndDomains(t *testing.T) {
+	t.Parallel()
+
+	src := `
+create domain email_address as text check (value like '%@%');
+create domain billing.cents bigint not null;
+create domain work_email email_address;
+create domain unused int;
+drop domain if exists unused, missing;
+
+create type address as (street text, city varchar(100), zip text);
+alter type address add attribute country char(2), drop attribute zip;
+alter type address rename attribute city to locality;
+create type billing.line as (amount billing.cents, home address);
+alter type address set schema geo;
+
+create table contact (
+  id serial primary key,
+  email email_address not null,
+  work work_email,
+  balance billing.cents,
+  home geo.address,
+  line billing.line,
+  unused int
+);
+`
+
+	snapshot, err := parseDDL(src)
+	if err != nil {
+		t.Fatalf("parseDDL unexpectedly failed: %v", err)
+	}
+
+	expectedTypes := []CompositeType{
+		{Schema: "geo", Name: "address", Attributes: []Column{
+			{ColumnName: "street", DataType: "text", UDTSchema: "pg_catalog", UDTName: "text", OrdinalPosition: 1},
+			{ColumnName: "locality", DataType: "character varying", UDTSchema: "pg_catalog", UDTName: "varchar", OrdinalPosition: 2},
+			{ColumnName: "country", DataType: "character", UDTSchema: "pg_catalog", UDTName: "bpchar", OrdinalPosition: 4},
+		}},
+		{Schema: "billing", Name: "line", Attributes: []Column{
+			{ColumnName: "amount", DataType: "USER-DEFINED", UDTSchema: "billing", UDTName: "cents", OrdinalPosition: 1},
+			{ColumnName: "home", DataType: "USER-DEFINED", UDTSchema: "geo", UDTName: "address", OrdinalPosition: 2},
+		}},
+	}
+	if !reflect.DeepEqual(snapshot.CompositeTypes, expectedTypes) {
+		t.Errorf("Expected composite types to be %v, got %v", expectedTypes, snapshot.CompositeTypes)
+	}
+
+	expectedColumns := []Column{
+		{ColumnName: "id", DataType: "integer", UDTSchema: "pg_catalog", UDTName: "int4", NotNull: true, Default: "nextval('contact_id_seq'::regclass)", OrdinalPosition: 1},
+		{ColumnName: "email", DataType: "text", UDTSchema: "pg_catalog", UDTName: "text", DomainSchema: "public", DomainName: "email_address", NotNull: true, OrdinalPosition: 2},
+		{ColumnName: "work", DataType: "text", UDTSchema: "pg_catalog", UDTName: "text", DomainSchema: "public", DomainName: "work_email", OrdinalPosition: 3},
+		{ColumnName: "balance", DataType: "bigint", UDTSchema: "pg_catalog", UDTName: "int8", DomainSchema: "billing", DomainName: "cents", OrdinalPosition: 4},
+		{ColumnName: "home", DataType: "USER-DEFINED", UDTSchema: "geo", UDTName: "address", OrdinalPosition: 5},
+		{ColumnName: "line", DataType: "USER-DEFINED", UDTSchema: "billing", UDTName: "line", OrdinalPosition: 6},
+		{ColumnName: "unused", DataType: "integer", UDTSchema: "pg_catalog", UDTName: "int4", OrdinalPosition: 7},
+	}
+	if len(snapshot.Tables) != 1 || !reflect.DeepEqual(snapshot.Tables[0].Columns, expectedColumns) {
+		t.Errorf("Expected columns to be %v, got %v", expectedColumns, snapshot.Tables)
+	}
+}
+
+func TestParseDDLColumnDetails(t *testing.T) {
+	t.Parallel()
+
+	src := `
+create domain short_code as varchar(20);
+create table billing.ledger (
+  id bigint generated always as identity (start with 100) primary key,
+  entry_number bigserial,
+  code short_code,
+  flag char,
+  amount numeric(12, 2) not null default 0,
+  amount_cents bigint generated always as ((amount * 100)::bigint) stored,
+  memo text default null,
+  posted_on date default current_date not null,
+  external_id int generated by default as identity
+);
+alter table billing.ledger alter column external_id drop identity if exists;
+alter table billing.ledger alter memo set default 'none', alter posted_on drop default;
+alter table billing.ledger alter column entry_number add generated by default as identity;
+alter table billing.ledger alter amount_cents drop expression;
+`
+
+	snapshot, err := parseDDL(src)
+	if err != nil {
+		t.Fatalf("parseDDL unexpectedly failed: %v", err)
+	}
+
+	expectedColumns := []Column{
+		{ColumnName: "id", DataType: "bigint", UDTSchema: "pg_catalog", UDTName: "int8", NotNull: true, Identity: "ALWAYS", OrdinalPosition: 1},
+		{ColumnName: "entry_number", DataType: "bigint", UDTSchema: "pg_catalog", UDTName: "int8", NotNull: true, Default: "nextval('billing.ledger_entry_number_seq'::regclass)", Identity: "BY DEFAULT", OrdinalPosition: 2},
+		{ColumnName: "code", DataType: "character varying", UDTSchema: "pg_catalog", UDTName: "varchar", DomainSchema: "public", DomainName: "short_code", CharacterMaxLength: 20, OrdinalPosition: 3},
+		{ColumnName: "flag", DataType: "character", UDTSchema: "pg_catalog", UDTName: "bpchar", CharacterMaxLength: 1, OrdinalPosition: 4},
+		{ColumnName: "amount", DataType: "numeric", UDTSchema: "pg_catalog", UDTName: "numeric", NotNull: true, Default: "0", NumericPrecision: 12, NumericScale: 2, OrdinalPosition: 5},
+		{ColumnName: "amount_cents", DataType: "bigint", UDTSchema: "pg_catalog", UDTName: "int8", OrdinalPosition: 6},
+		{ColumnName: "memo", DataType: "text", UDTSchema: "pg_catalog", UDTName: "text", Default: "'none'", OrdinalPosition: 7},
+		{ColumnName: "posted_on", DataType: "date", UDTSchema: "pg_catalog", UDTName: "date", NotNull: true, OrdinalPosition: 8},
+		{ColumnName: "external_id", DataType: "integer", UDTSchema: "pg_catalog", UDTName: "int4", NotNull: true, OrdinalPosition: 9},
+	}
+	if len(snapshot.Tables) != 1 || !reflect.DeepEqual(snapshot.Tables[0].Columns, expectedColumns) {
+		t.Errorf("Expected columns to be %v, got %v", expectedColumns, snapshot.Tables)
+	}
+
+	snapshot, err = parseDDL(`create table ledger (total numeric generated always as (1 + 2) stored, note text default lower('A' || 'b'))`)
+	if err != nil {
+		t.Fatalf("parseDDL unexpectedly failed: %v", err)
+	}
+	columns := snapshot.Tables[0].Columns
+	if !columns[0].Generated || !columns[0].GeneratedAlways() {
+		t.Errorf("Expected total to be generated, but it was not")
+	}
+	if columns[1].Default != "lower('A' || 'b')" {
+		t.Errorf("Expected default of note to be %q, got %q", "lower('A' || 'b')", columns[1].Default)
+	}
+}
+
+func TestParseDDLIndexes(t *testing.T) {
+	t.Parallel()
+
+	src := `
+create table booking (
+  id serial primary key,
+  code text unique,
+  room int constraint booking_room_unique unique,
+  during tstzrange,
+  note text,
+  exclude using gist (during with &&),
+  unique nulls not distinct (room, code)
+);
+create index on booking using gist (during);
+create unique index concurrently if not exists booking_note on booking (note desc nulls last);
+create unique index if not exists booking_note on booking (code);
+create index booking_lower_note on booking (lower(note));
+create index on booking (note) where note is not null;
+create index on booking (room);
+create index on booking (room);
+alter index booking_room_idx1 rename to booking_room_again;
+alter table booking add constraint booking_during_key exclude using gist (during with &&, room with =);
+alter table booking drop constraint booking_during_key;
+alter table booking rename column room to room_number;
+alter table booking drop column code;
+drop index if exists booking_room_again, missing;
+`
+
+	snapshot, err := parseDDL(src)
+	if err != nil {
+		t.Fatalf("parseDDL unexpectedly failed: %v", err)
+	}
+
+	expected := []Index{
+		{Name: "booking_during_excl", Method: "gist", ColumnNames: []string{"during"}},
+		{Name: "booking_during_idx", Method: "gist", ColumnNames: []string{"during"}},
+		{Name: "booking_note", Method: "btree", Unique: true, ColumnNames: []string{"note"}},
+		{Name: "booking_room_idx", Method: "btree", ColumnNames: []string{"room_number"}},
+		{Name: "booking_room_unique", Method: "btree", Unique: true, ColumnNames: []string{"room_number"}},
+	}
+	if len(snapshot.Tables) != 1 || !reflect.DeepEqual(snapshot.Tables[0].Indexes, expected) {
+		t.Errorf("Expected indexes to be %v, got %v", expected, snapshot.Tables)
+	}
+}
+
+func TestDDLChooseRelationName(t *testing.T) {
+	t.Parallel()
+
+	p := &ddlParser{snapshot: &Snapshot{}}
+	long := strings.Repeat("a", 40)
+	tests := []struct {
+		name1, name2, label string
+		expected            string
+	}{
+		{"widget", "name", "idx", "widget_name_idx"},
+		{long, "name", "key", long + "_name_key"},
+		{long, long, "key", strings.Repeat("a", 29) + "_" + strings.Repeat("a", 29) + "_key"},
+	}
+
+	for i, tt := range tests {
+		if actual := p.chooseRelationName("public", tt.name1, tt.name2, tt.label); actual != tt.expected {
+			t.Errorf("%d. Expected %q, got %q", i, tt.expected, actual)
+		}
+	}
+}
+
+func TestParseDDLErrors(t *testing.T) {
+	t.Parallel()
+
+	tests := []string{
+		`create table widget (id int primary key, name text primary key)`,
+		`create table widget (id int, id text)`,
+		`create table widget (id int`,
+		`create table widget (id int); create table widget (id int)`,
+		`create table widget (id int, name text, primary key (name, id), primary key (id))`,
+		`create table widget (id int); alter table widget drop column name`,
+		`create table widget (id int); alter table widget add column`,
+		`create table widget (name text default 'unterminated)`,
+		`create table widget (id int generated as identity)`,
+		`create type mood as enum ('happy'); create type mood as enum ('sad')`,
+		`create type mood as enum ('happy'); alter type mood add value 'happy'`,
+		`create type mood as enum ('happy'); alter type mood add value 'sad' after 'angry'`,
+		`create type point as (x int, x int)`,
+		`create type point as (x int); alter type point drop attribute y`,
+		`create type point as (x int); alter type point rename attribute y to z`,
+		`create domain email text; create type email as (address text)`,
+		`create domain email`,
+		`create table widget (id int); create index widget on widget (id)`,
+		`create table widget (id int); create index widget_id on widget (id); create index widget_id on widget (id)`,
+	}
+
+	for i, tt := range tests {
+		if _, err := parseDDL(tt); err == nil {
+			t.Errorf("%d. Expected parseDDL to fail for %s, but it did not", i, tt)
+		}
+	}
+}
+
+func TestDDLMatchesDatabase(t *testing.T) {
+	t.Parallel()
+
+	tx := begin(t)
+	defer tx.Rollback(context.Background())
+
+	src, err := ioutil.ReadFile("test/structure.sql")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	snapshot, err := parseDDL(string(src))
+	if err != nil {
+		t.Fatalf("parseDDL unexpectedly failed: %v", err)
+	}
+
+	for _, st := range snapshot.Tables {
+		dt, err := dbCatalog{tx}.table(st.Schema, st.TableName)
+		if err != nil {
+			t.Errorf("%s.%s: table unexpectedly failed: %v", st.Schema, st.TableName, err)
+			continue
+		}
+		if dt == nil {
+			t.Errorf("%s.%s: table not found in database", st.Schema, st.TableName)
+			continue
+		}
+
+		if !reflect.DeepEqual(st, *dt) {
+			t.Errorf("%s.%s: Expected %v, got %v", st.Schema, st.TableName, *dt, st)
+		}
+	}
+}
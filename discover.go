@@ -0,0 +1,114 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"path"
+)
+
+// DiscoverConfig is the [discover] section of config.toml. When present, every
+// table in Schemas whose name matches Include and does not match Exclude is
+// generated. Patterns are path.Match globs and are matched against both the
+// table name and the schema-qualified table name.
+type DiscoverConfig struct {
+	Schemas []string `toml:"schemas"`
+	Include []string `toml:"include"`
+	Exclude []string `toml:"exclude"`
+}
+
+// discoverTables returns tables with a Table appended for each discovered table
+// that is not already configured. A configured table without a schema matches
+// the table of that name found through the search_path.
+func discoverTables(cat catalog, dc DiscoverConfig, defaultSchema string, tables []Table) ([]Table, error) {
+	schemas := dc.Schemas
+	qualify := true
+	if len(schemas) == 0 {
+		if defaultSchema != "" {
+			schemas = []string{defaultSchema}
+		} else {
+			searchPath, err := cat.searchPath()
+			if err != nil {
+				return nil, err
+			}
+			if len(searchPath) == 0 {
+				return nil, errors.New("discover requires schemas when the search_path is empty")
+			}
+			schemas = searchPath[:1]
+			qualify = false
+		}
+	}
+
+	for _, pattern := range append(append([]string{}, dc.Include...), dc.Exclude...) {
+		if _, err := path.Match(pattern, ""); err != nil {
+			return nil, fmt.Errorf("discover pattern %s: %v", pattern, err)
+		}
+	}
+
+	candidates, err := cat.tableNames(schemas)
+	if err != nil {
+		return nil, err
+	}
+
+	configured := make(map[string]bool, len(tables))
+	for _, t := range tables {
+		schema := t.Schema
+		if schema == "" {
+			schema, err = cat.searchPathSchema(t.TableName)
+			if err != nil {
+				return nil, err
+			}
+		}
+		configured[schema+"."+t.TableName] = true
+	}
+
+	var discovered []Table
+	for _, c := range candidates {
+		if !dc.match(c.Schema, c.TableName) || configured[c.Schema+"."+c.TableName] {
+			continue
+		}
+
+		t := Table{
+			TableName:  c.TableName,
+			StructName: pgCaseToGoPublicCase(c.TableName),
+		}
+		if qualify {
+			t.Schema = c.Schema
+		}
+		discovered = append(discovered, t)
+	}
+
+	structNames := make(map[string]Table, len(tables)+len(discovered))
+	for _, t := range tables {
+		structNames[t.StructName] = t
+	}
+	for _, t := range discovered {
+		if other, ok := structNames[t.StructName]; ok {
+			return nil, fmt.Errorf("discovered table %s and table %s both map to struct %s; add a [[tables]] entry with a struct_name for one of them", t.qualifiedName(), other.qualifiedName(), t.StructName)
+		}
+		structNames[t.StructName] = t
+	}
+
+	return append(tables, discovered...), nil
+}
+
+func (dc DiscoverConfig) match(schema, tableName string) bool {
+	qualifiedName := schema + "." + tableName
+
+	matchAny := func(patterns []string) bool {
+		for _, p := range patterns {
+			if ok, _ := path.Match(p, tableName); ok {
+				return true
+			}
+			if ok, _ := path.Match(p, qualifiedName); ok {
+				return true
+			}
+		}
+		return false
+	}
+
+	if len(dc.Include) > 0 && !matchAny(dc.Include) {
+		return false
+	}
+
+	return !matchAny(dc.Exclude)
+}
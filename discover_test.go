@@ -0,0 +1,115 @@
+package main
+
+import (
+	"context"
+	"testing"
+)
+
+func TestDiscoverTables(t *testing.T) {
+	t.Parallel()
+
+	tx := begin(t)
+	defer tx.Rollback(context.Background())
+
+	dc := DiscoverConfig{
+		Schemas: []string{"public", "billing"},
+		Exclude: []string{"ip_*", "public.semester", "billing.customer"},
+	}
+	configured := []Table{
+		{TableName: "customer", StructName: "Customer"},
+		{TableName: "part", StructName: "Component"},
+	}
+
+	tables, err := discoverTables(dbCatalog{tx}, dc, "", configured)
+	if err != nil {
+		t.Fatalf("discoverTables unexpectedly failed: %v", err)
+	}
+
+	expected := []Table{
+		{TableName: "customer", StructName: "Customer"},
+		{TableName: "part", StructName: "Component"},
+		{Schema: "public", TableName: "blob", StructName: "Blob"},
+		{Schema: "public", TableName: "widget", StructName: "Widget"},
+	}
+
+	if len(tables) != len(expected) {
+		t.Fatalf("Expected %d tables, got %d: %v", len(expected), len(tables), tables)
+	}
+
+	for i := range expected {
+		if tables[i].Schema != expected[i].Schema || tables[i].TableName != expected[i].TableName || tables[i].StructName != expected[i].StructName {
+			t.Errorf("%d. Expected %s.%s (%s), got %s.%s (%s)", i, expected[i].Schema, expected[i].TableName, expected[i].StructName, tables[i].Schema, tables[i].TableName, tables[i].StructName)
+		}
+	}
+}
+
+func TestDiscoverTablesUnqualifiedConfiguredTable(t *testing.T) {
+	t.Parallel()
+
+	tx := begin(t)
+	defer tx.Rollback(context.Background())
+
+	dc := DiscoverConfig{
+		Schemas: []string{"public", "billing"},
+		Include: []string{"customer"},
+	}
+	configured := []Table{{TableName: "customer", StructName: "PublicCustomer"}}
+
+	// The configured table is public.customer through the search_path, so
+	// billing.customer is still discovered.
+	tables, err := discoverTables(dbCatalog{tx}, dc, "", configured)
+	if err != nil {
+		t.Fatalf("discoverTables unexpectedly failed: %v", err)
+	}
+
+	if len(tables) != 2 {
+		t.Fatalf("Expected 2 tables, got %d: %v", len(tables), tables)
+	}
+	if tables[1].Schema != "billing" || tables[1].TableName != "customer" || tables[1].StructName != "Customer" {
+		t.Errorf("Expected billing.customer (Customer), got %s.%s (%s)", tables[1].Schema, tables[1].TableName, tables[1].StructName)
+	}
+}
+
+func TestDiscoverTablesStructNameConflict(t *testing.T) {
+	t.Parallel()
+
+	tx := begin(t)
+	defer tx.Rollback(context.Background())
+
+	dc := DiscoverConfig{
+		Schemas: []string{"public", "billing"},
+		Include: []string{"customer"},
+	}
+
+	_, err := discoverTables(dbCatalog{tx}, dc, "", nil)
+	if err == nil {
+		t.Fatal("Expected discoverTables to fail when two tables map to the same struct, but it did not")
+	}
+}
+
+func TestDiscoverConfigMatch(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		dc        DiscoverConfig
+		schema    string
+		tableName string
+		expected  bool
+	}{
+		{DiscoverConfig{}, "public", "customer", true},
+		{DiscoverConfig{Include: []string{"cust*"}}, "public", "customer", true},
+		{DiscoverConfig{Include: []string{"cust*"}}, "public", "widget", false},
+		{DiscoverConfig{Include: []string{"billing.*"}}, "billing", "customer", true},
+		{DiscoverConfig{Include: []string{"billing.*"}}, "public", "customer", false},
+		{DiscoverConfig{Exclude: []string{"schema_migrations"}}, "public", "schema_migrations", false},
+		{DiscoverConfig{Exclude: []string{"*_archive"}}, "public", "order_archive", false},
+		{DiscoverConfig{Include: []string{"order*"}, Exclude: []string{"*_archive"}}, "public", "order", true},
+	}
+
+	for i, tt := range tests {
+		actual := tt.dc.match(tt.schema, tt.tableName)
+		if actual != tt.expected {
+			t.Errorf("%d. Given %v and %s.%s, expected %v, but got %v", i, tt.dc, tt.schema, tt.tableName, tt.expected, actual)
+		}
+	}
+}
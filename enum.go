@@ -0,0 +1,73 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"text/template"
+	"unicode"
+)
+
+// Enum is a PostgreSQL enum type. Labels are in sort order.
+type Enum struct {
+	Schema string   `json:"schema"`
+	Name   string   `json:"name"`
+	Labels []string `json:"labels"`
+
+	GoName string `json:"-"`
+}
+
+type enumLabel struct {
+	ConstName string
+	Literal   string
+}
+
+func (e *Enum) boxTypeName() string {
+	return e.GoName + "Box"
+}
+
+// labelConstName returns the name of the Go constant for label. Characters
+// that cannot be used in an identifier separate words and upper case words
+// are folded to lower case before converting to Go case.
+func (e *Enum) labelConstName(label string) string {
+	words := strings.FieldsFunc(label, func(r rune) bool {
+		return !(unicode.IsLetter(r) || unicode.IsDigit(r))
+	})
+	for i, w := range words {
+		if strings.ToUpper(w) == w {
+			words[i] = strings.ToLower(w)
+		}
+	}
+	return e.GoName + pgCaseToGoPublicCase(strings.Join(words, "_"))
+}
+
+func writeEnum(w io.Writer, templates *template.Template, pkgName string, e *Enum) error {
+	labels := make([]enumLabel, len(e.Labels))
+	seen := make(map[string]string, len(e.Labels))
+	for i, l := range e.Labels {
+		constName := e.labelConstName(l)
+		if constName == e.GoName {
+			return fmt.Errorf("enum %s.%s label %q cannot be used as a Go identifier", e.Schema, e.Name, l)
+		}
+		if other, ok := seen[constName]; ok {
+			return fmt.Errorf("enum %s.%s labels %q and %q both generate constant %s", e.Schema, e.Name, other, l, constName)
+		}
+		seen[constName] = l
+		labels[i] = enumLabel{ConstName: constName, Literal: strconv.Quote(l)}
+	}
+
+	return templates.ExecuteTemplate(w, "enum", struct {
+		PkgName       string
+		QualifiedName string
+		GoName        string
+		BoxTypeName   string
+		Labels        []enumLabel
+	}{
+		PkgName:       pkgName,
+		QualifiedName: e.Schema + "." + e.Name,
+		GoName:        e.GoName,
+		BoxTypeName:   e.boxTypeName(),
+		Labels:        labels,
+	})
+}
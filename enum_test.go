@@ -0,0 +1,48 @@
+package main
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestEnumLabelConstName(t *testing.T) {
+	t.Parallel()
+
+	e := &Enum{Name: "order_status", GoName: "OrderStatus"}
+
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"pending", "OrderStatusPending"},
+		{"in_progress", "OrderStatusInProgress"},
+		{"in progress", "OrderStatusInProgress"},
+		{"on-hold", "OrderStatusOnHold"},
+		{"SHIPPED", "OrderStatusShipped"},
+		{"partiallyShipped", "OrderStatusPartiallyShipped"},
+		{"awaiting_id", "OrderStatusAwaitingID"},
+		{"2nd_attempt", "OrderStatus2ndAttempt"},
+	}
+
+	for i, tt := range tests {
+		actual := e.labelConstName(tt.input)
+		if actual != tt.expected {
+			t.Errorf(`%d. Given "%s", expected "%s", but got "%s"`, i, tt.input, tt.expected, actual)
+		}
+	}
+}
+
+func TestWriteEnumErrors(t *testing.T) {
+	t.Parallel()
+
+	tests := []Enum{
+		{Schema: "public", Name: "order_status", GoName: "OrderStatus", Labels: []string{"on hold", "on_hold"}},
+		{Schema: "public", Name: "order_status", GoName: "OrderStatus", Labels: []string{"pending", "?"}},
+	}
+
+	for i, tt := range tests {
+		if err := writeEnum(&bytes.Buffer{}, loadTemplates(), "data", &tt); err == nil {
+			t.Errorf("%d. Expected writeEnum to fail for labels %v, but it did not", i, tt.Labels)
+		}
+	}
+}
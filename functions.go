@@ -0,0 +1,238 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"text/template"
+)
+
+// Function is a [[functions]] entry of config.toml. Its signature is read from
+// pg_proc.
+type Function struct {
+	Schema       string `toml:"schema"`
+	FunctionName string `toml:"function_name"`
+	GoName       string `toml:"go_name"`
+	FieldStyle   string `toml:"field_style"`
+
+	// Procedure is true for procedures, which are run with CALL.
+	Procedure  bool `toml:"-"`
+	ReturnsSet bool `toml:"-"`
+
+	Params []Column `toml:"-"`
+
+	// Columns are the OUT parameters, the columns of RETURNS TABLE or the
+	// attributes of a composite return type.
+	Columns []Column `toml:"-"`
+
+	// Result is the return type of functions returning a single value per row.
+	Result *Column `toml:"-"`
+
+	// RowType is the struct of a configured table when the function returns
+	// the row type of that table.
+	RowType string `toml:"-"`
+
+	// args are the arguments in the call of the function, e.g. $1 or
+	// variadic $2.
+	args []string
+
+	returnSchema    string
+	returnTableName string
+}
+
+// SQLConstName returns the name of the constant holding the SQL calling f.
+func (f Function) SQLConstName() string {
+	return strings.ToLower(f.GoName[:1]) + f.GoName[1:] + "SQL"
+}
+
+// RowName returns the type of the rows returned by f.
+func (f Function) RowName() string {
+	if f.RowType != "" {
+		return f.RowType
+	}
+	return f.GoName + "Row"
+}
+
+// Void returns true if f returns nothing.
+func (f Function) Void() bool {
+	return f.Result == nil && len(f.Columns) == 0
+}
+
+// QualifiedName returns the quoted function name for use in SQL. Like
+// Table.qualifiedName it only includes the schema when one was configured.
+func (f Function) QualifiedName() string {
+	if f.Schema == "" {
+		return quoteIdentifier(f.FunctionName)
+	}
+	return quoteIdentifier(f.Schema) + "." + quoteIdentifier(f.FunctionName)
+}
+
+// SQL returns the statement calling f. Results are selected with the casts of
+// their types.
+func (f Function) SQL() string {
+	call := f.QualifiedName() + "(" + strings.Join(f.args, ", ") + ")"
+
+	switch {
+	case f.Procedure:
+		return "call " + call
+	case f.Result != nil && f.Result.SelectCast != "":
+		return "select " + call + "::" + f.Result.SelectCast
+	case len(f.Columns) == 0:
+		return "select " + call
+	}
+
+	exprs := make([]string, len(f.Columns))
+	for i, c := range f.Columns {
+		exprs[i] = c.SelectExpr()
+	}
+	return "select " + strings.Join(exprs, ", ") + " from " + call
+}
+
+// ResultFormats returns the result formats argument of f. Only procedures need
+// one as the results of functions are selected with casts. See resultFormats.
+func (f Function) ResultFormats() string {
+	if !f.Procedure {
+		return ""
+	}
+	return resultFormats(f.Columns)
+}
+
+// SQLLiteral returns the SQL calling f as a Go string literal.
+func (f Function) SQLLiteral() string {
+	return sqlLiteral(f.SQL())
+}
+
+// describeFunctions reads the signatures of functions from dc. The schema of a
+// function found through the search_path is left empty so the function is
+// called through the search_path at runtime.
+func describeFunctions(dc dbCatalog, functions []Function) error {
+	for i := range functions {
+		f := &functions[i]
+
+		sig, err := dc.function(f.Schema, f.FunctionName)
+		if err != nil {
+			return err
+		}
+		if sig == nil {
+			return fmt.Errorf("function %s not found", f.FunctionName)
+		}
+
+		f.Procedure = sig.Procedure
+		f.ReturnsSet = sig.ReturnsSet
+		f.Params = sig.Params
+		f.Columns = sig.Columns
+		f.Result = sig.Result
+		f.args = sig.args
+		f.returnSchema = sig.returnSchema
+		f.returnTableName = sig.returnTableName
+	}
+
+	return nil
+}
+
+// resolveFunctionTypes sets the Go types of the parameters and results of
+// functions. Functions returning the row type of a configured table return its
+// struct.
+func resolveFunctionTypes(cat catalog, tables []Table, functions []Function, types []TypeConfig, ut *userTypes) error {
+	var unsupported []string
+
+	for i := range functions {
+		f := &functions[i]
+
+		if !isGoIdentifier(f.GoName) || strings.ToUpper(f.GoName[:1]) != f.GoName[:1] {
+			return fmt.Errorf("function %s go_name %s must be an exported Go identifier", f.FunctionName, f.GoName)
+		}
+		if f.FieldStyle != "" && f.FieldStyle != "pgtype" && f.FieldStyle != "go" {
+			return fmt.Errorf("function %s field_style must be pgtype or go, not %s", f.FunctionName, f.FieldStyle)
+		}
+
+		if f.returnTableName != "" {
+			for _, t := range tables {
+				schema := t.Schema
+				if schema == "" {
+					var err error
+					schema, err = cat.searchPathSchema(t.TableName)
+					if err != nil {
+						return err
+					}
+				}
+				if schema == f.returnSchema && t.TableName == f.returnTableName {
+					f.RowType = t.StructName
+					f.Columns = t.Columns
+					break
+				}
+			}
+		}
+
+		if err := ut.load(cat, f.Params); err != nil {
+			return err
+		}
+		what := "function " + f.FunctionName
+		unsupported = append(unsupported, resolveParamTypes(what, f.Params, types, ut)...)
+
+		if f.RowType == "" {
+			if err := ut.load(cat, f.Columns); err != nil {
+				return err
+			}
+			u, err := resolveResultTypes(what, f.Columns, f.FieldStyle, !f.Procedure, types, ut)
+			if err != nil {
+				return err
+			}
+			unsupported = append(unsupported, u...)
+		}
+
+		if f.Result != nil {
+			if err := ut.load(cat, []Column{*f.Result}); err != nil {
+				return err
+			}
+			result := []Column{*f.Result}
+			u, err := resolveResultTypes(what, result, f.FieldStyle, !f.Procedure, types, ut)
+			if err != nil {
+				return err
+			}
+			unsupported = append(unsupported, u...)
+			f.Result = &result[0]
+		}
+	}
+
+	if len(unsupported) > 0 {
+		return fmt.Errorf("functions with unsupported types (add [[types]] entries to config.toml to map them):\n  %s", strings.Join(unsupported, "\n  "))
+	}
+
+	return nil
+}
+
+// functionImports returns the imports needed by the generated code for f other
+// than those always imported by the function template.
+func functionImports(f Function) []string {
+	set := make(map[string]struct{})
+	if f.RowType == "" {
+		addResultImports(set, f.Params, f.Columns)
+	} else {
+		addResultImports(set, f.Params, nil)
+	}
+	if f.Result != nil {
+		addResultImports(set, nil, []Column{*f.Result})
+	}
+
+	imports := make([]string, 0, len(set))
+	for i := range set {
+		imports = append(imports, i)
+	}
+	sort.Strings(imports)
+
+	return imports
+}
+
+func writeFunction(w io.Writer, templates *template.Template, pkgName string, f Function) error {
+	return templates.ExecuteTemplate(w, "function", struct {
+		PkgName  string
+		Imports  []string
+		Function Function
+	}{
+		PkgName:  pkgName,
+		Imports:  functionImports(f),
+		Function: f,
+	})
+}
@@ -0,0 +1,157 @@
+package main
+
+import (
+	"bytes"
+	"go/parser"
+	"go/token"
+	"strings"
+	"testing"
+)
+
+func TestFunctionSQL(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		function Function
+		expected string
+	}{
+		{
+			function: Function{FunctionName: "touch_widget", args: []string{"$1"}},
+			expected: `select "touch_widget"($1)`,
+		},
+		{
+			function: Function{Schema: "billing", FunctionName: "balance", args: []string{"$1"}, Result: &Column{ColumnName: "balance"}},
+			expected: `select "billing"."balance"($1)`,
+		},
+		{
+			function: Function{FunctionName: "status_of", args: []string{"$1"}, Result: &Column{ColumnName: "status_of", SelectCast: "text"}},
+			expected: `select "status_of"($1)::text`,
+		},
+		{
+			function: Function{
+				FunctionName: "tagged",
+				args:         []string{"variadic $1"},
+				Columns:      []Column{{ColumnName: "id"}, {ColumnName: "status", SelectCast: "text"}},
+			},
+			expected: `select "id", "status"::text from "tagged"(variadic $1)`,
+		},
+		{
+			function: Function{FunctionName: "close_account", Procedure: true, args: []string{"$1", "null"}, Columns: []Column{{ColumnName: "closed_at"}}},
+			expected: `call "close_account"($1, null)`,
+		},
+	}
+
+	for i, tt := range tests {
+		if sql := tt.function.SQL(); sql != tt.expected {
+			t.Errorf("%d. Expected %q, got %q", i, tt.expected, sql)
+		}
+	}
+}
+
+func TestFunctionResultFormats(t *testing.T) {
+	t.Parallel()
+
+	columns := []Column{
+		{ColumnName: "id", DataType: "integer"},
+		{ColumnName: "status", DataType: "USER-DEFINED", UDTSchema: "public", UDTName: "order_status", SelectCast: "text"},
+	}
+
+	if formats := (Function{Columns: columns}).ResultFormats(); formats != "" {
+		t.Errorf("Expected a function to have no result formats as its SQL casts status, got %q", formats)
+	}
+
+	expected := "pgx.QueryResultFormats{pgx.BinaryFormatCode, pgx.TextFormatCode}"
+	if formats := (Function{Procedure: true, Columns: columns}).ResultFormats(); formats != expected {
+		t.Errorf("Expected a procedure to have result formats %q, got %q", expected, formats)
+	}
+}
+
+func TestResolveFunctionTypesProcedureCasts(t *testing.T) {
+	t.Parallel()
+
+	functions := []Function{
+		{FunctionName: "account_balance", GoName: "AccountBalance", FieldStyle: "go", Columns: []Column{{ColumnName: "balance", DataType: "numeric"}}},
+		{FunctionName: "close_account", GoName: "CloseAccount", FieldStyle: "go", Procedure: true, Columns: []Column{{ColumnName: "balance", DataType: "numeric"}}},
+	}
+	if err := resolveFunctionTypes(&Snapshot{}, nil, functions, nil, newUserTypes()); err != nil {
+		t.Fatalf("resolveFunctionTypes unexpectedly failed: %v", err)
+	}
+
+	if c := functions[0].Columns[0]; c.FieldType() != "*string" || c.SelectCast != "text" {
+		t.Errorf("Expected the function balance to be *string cast to text, got %s cast to %q", c.FieldType(), c.SelectCast)
+	}
+	if c := functions[1].Columns[0]; c.FieldType() != "pgtype.Numeric" || c.SelectCast != "" {
+		t.Errorf("Expected the procedure balance to be pgtype.Numeric without a cast, got %s cast to %q", c.FieldType(), c.SelectCast)
+	}
+}
+
+func TestWriteFunction(t *testing.T) {
+	t.Parallel()
+
+	id := Column{ColumnName: "id", FieldName: "ID", VarName: "id", GoBoxType: "pgtype.Int4", GoType: "int32"}
+	since := Column{ColumnName: "since", VarName: "since", GoType: "time.Time", GoTypeImport: "time"}
+	balance := Column{ColumnName: "balance", FieldName: "Balance", GoBoxType: "money.Money", BoxTypeImport: "example.com/money", GoType: "decimal.Decimal"}
+
+	tests := []struct {
+		function  Function
+		signature string
+		imports   []string
+	}{
+		{
+			function:  Function{FunctionName: "touch_customer", GoName: "TouchCustomer", Params: []Column{id}, args: []string{"$1"}},
+			signature: "func TouchCustomer(ctx context.Context, db Queryer, id int32) error",
+		},
+		{
+			function:  Function{FunctionName: "customer_balance", GoName: "CustomerBalance", Params: []Column{id}, args: []string{"$1"}, Result: &balance},
+			signature: "func CustomerBalance(ctx context.Context, db Queryer, id int32) (money.Money, error)",
+			imports:   []string{"golang.org/x/xerrors", "github.com/jackc/pgx/v4", "example.com/money"},
+		},
+		{
+			function:  Function{FunctionName: "customer_ids", GoName: "CustomerIDs", ReturnsSet: true, Params: []Column{since}, args: []string{"$1"}, Result: &id},
+			signature: "func CustomerIDs(ctx context.Context, db Queryer, since time.Time) ([]pgtype.Int4, error)",
+			imports:   []string{"time", "github.com/jackc/pgtype"},
+		},
+		{
+			function:  Function{FunctionName: "customer_balances", GoName: "CustomerBalances", ReturnsSet: true, Columns: []Column{id, balance}},
+			signature: "func CustomerBalances(ctx context.Context, db Queryer) ([]CustomerBalancesRow, error)",
+			imports:   []string{"github.com/jackc/pgtype", "example.com/money"},
+		},
+		{
+			function:  Function{FunctionName: "newest_customer", GoName: "NewestCustomer", RowType: "Customer", Columns: []Column{id, balance}},
+			signature: "func NewestCustomer(ctx context.Context, db Queryer) (*Customer, error)",
+			imports:   []string{"golang.org/x/xerrors", "github.com/jackc/pgx/v4"},
+		},
+	}
+
+	for i, tt := range tests {
+		buf := &bytes.Buffer{}
+		err := writeFunction(buf, loadTemplates(), "data", tt.function)
+		if err != nil {
+			t.Errorf("%d. writeFunction unexpectedly failed: %v", i, err)
+			continue
+		}
+
+		file, err := parser.ParseFile(token.NewFileSet(), "function.go", buf.Bytes(), 0)
+		if err != nil {
+			t.Errorf("%d. generated code does not parse: %v\n%s", i, err, buf.String())
+			continue
+		}
+
+		if !strings.Contains(buf.String(), tt.signature) {
+			t.Errorf("%d. Expected generated code to contain %q, but it did not", i, tt.signature)
+		}
+
+		imports := make(map[string]bool)
+		for _, spec := range file.Imports {
+			imports[strings.Trim(spec.Path.Value, `"`)] = true
+		}
+		if len(imports) != len(tt.imports)+1 {
+			t.Errorf("%d. Expected imports %v and context, got %v", i, tt.imports, imports)
+		}
+		for _, path := range tt.imports {
+			if !imports[path] {
+				t.Errorf("%d. Expected import %s, but it was missing", i, path)
+			}
+		}
+	}
+}
@@ -3,15 +3,19 @@ package main
 import (
 	"bytes"
 	"context"
+	"errors"
 	"fmt"
 	"io"
+	"net/url"
 	"os"
+	"regexp"
+	"sort"
+	"strconv"
 	"strings"
 	"text/template"
 	"unicode"
 
 	"github.com/BurntSushi/toml"
-	"github.com/jackc/pgconn"
 	"github.com/jackc/pgx/v4"
 	"github.com/spf13/cobra"
 )
@@ -33,27 +37,158 @@ type initData struct {
 }
 
 var pgToBoxTypeMap = map[string]string{
-	"bigint":                   "pgtype.Int8",
-	"integer":                  "pgtype.Int4",
-	"smallint":                 "pgtype.Int2",
-	"character varying":        "pgtype.Varchar",
-	"text":                     "pgtype.Text",
-	"date":                     "pgtype.Date",
-	"timestamp with time zone": "pgtype.Timestamptz",
-	"inet":                     "pgtype.Inet",
-	"cidr":                     "pgtype.Cidr",
-	"bytea":                    "pgtype.Bytea",
+	"bigint":                      "pgtype.Int8",
+	"integer":                     "pgtype.Int4",
+	"smallint":                    "pgtype.Int2",
+	"real":                        "pgtype.Float4",
+	"double precision":            "pgtype.Float8",
+	"numeric":                     "pgtype.Numeric",
+	"boolean":                     "pgtype.Bool",
+	"character varying":           "pgtype.Varchar",
+	"character":                   "pgtype.BPChar",
+	"text":                        "pgtype.Text",
+	"date":                        "pgtype.Date",
+	"timestamp without time zone": "pgtype.Timestamp",
+	"timestamp with time zone":    "pgtype.Timestamptz",
+	"time without time zone":      "pgtype.GenericText",
+	"time with time zone":         "pgtype.GenericText",
+	"interval":                    "pgtype.Interval",
+	"uuid":                        "pgtype.UUID",
+	"json":                        "pgtype.JSON",
+	"jsonb":                       "pgtype.JSONB",
+	"inet":                        "pgtype.Inet",
+	"cidr":                        "pgtype.Cidr",
+	"bytea":                       "pgtype.Bytea",
+	"int4range":                   "pgtype.Int4range",
+	"int8range":                   "pgtype.Int8range",
+	"numrange":                    "pgtype.Numrange",
+	"daterange":                   "pgtype.Daterange",
+	"tsrange":                     "pgtype.Tsrange",
+	"tstzrange":                   "pgtype.Tstzrange",
+
+	"_bool":        "pgtype.BoolArray",
+	"_bpchar":      "pgtype.BPCharArray",
+	"_bytea":       "pgtype.ByteaArray",
+	"_cidr":        "pgtype.CIDRArray",
+	"_date":        "pgtype.DateArray",
+	"_float4":      "pgtype.Float4Array",
+	"_float8":      "pgtype.Float8Array",
+	"_inet":        "pgtype.InetArray",
+	"_int2":        "pgtype.Int2Array",
+	"_int4":        "pgtype.Int4Array",
+	"_int8":        "pgtype.Int8Array",
+	"_numeric":     "pgtype.NumericArray",
+	"_text":        "pgtype.TextArray",
+	"_timestamp":   "pgtype.TimestampArray",
+	"_timestamptz": "pgtype.TimestamptzArray",
+	"_uuid":        "pgtype.UUIDArray",
+	"_varchar":     "pgtype.VarcharArray",
+}
+
+// pgTypeOIDs are the pgtype constants of the OIDs of the types in
+// pgToBoxTypeMap that have one. Batched statements are not prepared so they
+// must give the types of their parameters.
+var pgTypeOIDs = map[string]string{
+	"bigint":                      "pgtype.Int8OID",
+	"integer":                     "pgtype.Int4OID",
+	"smallint":                    "pgtype.Int2OID",
+	"real":                        "pgtype.Float4OID",
+	"double precision":            "pgtype.Float8OID",
+	"numeric":                     "pgtype.NumericOID",
+	"boolean":                     "pgtype.BoolOID",
+	"character varying":           "pgtype.VarcharOID",
+	"character":                   "pgtype.BPCharOID",
+	"text":                        "pgtype.TextOID",
+	"date":                        "pgtype.DateOID",
+	"timestamp without time zone": "pgtype.TimestampOID",
+	"timestamp with time zone":    "pgtype.TimestamptzOID",
+	"interval":                    "pgtype.IntervalOID",
+	"uuid":                        "pgtype.UUIDOID",
+	"json":                        "pgtype.JSONOID",
+	"jsonb":                       "pgtype.JSONBOID",
+	"inet":                        "pgtype.InetOID",
+	"cidr":                        "pgtype.CIDROID",
+	"bytea":                       "pgtype.ByteaOID",
+	"int4range":                   "pgtype.Int4rangeOID",
+	"int8range":                   "pgtype.Int8rangeOID",
+	"numrange":                    "pgtype.NumrangeOID",
+	"daterange":                   "pgtype.DaterangeOID",
+	"tsrange":                     "pgtype.TsrangeOID",
+	"tstzrange":                   "pgtype.TstzrangeOID",
+
+	"_bool":        "pgtype.BoolArrayOID",
+	"_bpchar":      "pgtype.BPCharArrayOID",
+	"_bytea":       "pgtype.ByteaArrayOID",
+	"_cidr":        "pgtype.CIDRArrayOID",
+	"_date":        "pgtype.DateArrayOID",
+	"_float4":      "pgtype.Float4ArrayOID",
+	"_float8":      "pgtype.Float8ArrayOID",
+	"_inet":        "pgtype.InetArrayOID",
+	"_int2":        "pgtype.Int2ArrayOID",
+	"_int4":        "pgtype.Int4ArrayOID",
+	"_int8":        "pgtype.Int8ArrayOID",
+	"_numeric":     "pgtype.NumericArrayOID",
+	"_text":        "pgtype.TextArrayOID",
+	"_timestamp":   "pgtype.TimestampArrayOID",
+	"_timestamptz": "pgtype.TimestamptzArrayOID",
+	"_uuid":        "pgtype.UUIDArrayOID",
+	"_varchar":     "pgtype.VarcharArrayOID",
 }
 
+// numeric and character values are passed as strings which pgx sends in the
+// text format so no precision or padding is lost.
 var pgToGoTypeMap = map[string]string{
-	"bigint":                   "int64",
-	"integer":                  "int32",
-	"smallint":                 "int16",
-	"character varying":        "string",
-	"text":                     "string",
-	"date":                     "time.Time",
-	"timestamp with time zone": "time.Time",
-	"bytea":                    "[]byte",
+	"bigint":                      "int64",
+	"integer":                     "int32",
+	"smallint":                    "int16",
+	"real":                        "float32",
+	"double precision":            "float64",
+	"numeric":                     "string",
+	"boolean":                     "bool",
+	"character varying":           "string",
+	"character":                   "string",
+	"text":                        "string",
+	"date":                        "time.Time",
+	"timestamp without time zone": "time.Time",
+	"timestamp with time zone":    "time.Time",
+	"time without time zone":      "string",
+	"time with time zone":         "string",
+	"uuid":                        "[16]byte",
+	"bytea":                       "[]byte",
+
+	"_bool":        "[]bool",
+	"_bpchar":      "[]string",
+	"_bytea":       "[][]byte",
+	"_date":        "[]time.Time",
+	"_float4":      "[]float32",
+	"_float8":      "[]float64",
+	"_int2":        "[]int16",
+	"_int4":        "[]int32",
+	"_int8":        "[]int64",
+	"_text":        "[]string",
+	"_timestamp":   "[]time.Time",
+	"_timestamptz": "[]time.Time",
+	"_uuid":        "[][16]byte",
+	"_varchar":     "[]string",
+}
+
+// pgSelectCasts are casts applied when selecting columns of types pgx does not
+// know. pgx only requests result formats for known types so selecting such a
+// column as is makes the number of result formats disagree with the number of
+// columns.
+var pgSelectCasts = map[string]string{
+	"time without time zone": "text",
+	"time with time zone":    "text",
+}
+
+// pgRangeTypes are the range types Select<Struct>Overlapping is generated for.
+var pgRangeTypes = map[string]bool{
+	"int4range": true,
+	"int8range": true,
+	"numrange":  true,
+	"daterange": true,
+	"tsrange":   true,
+	"tstzrange": true,
 }
 
 var acronyms = map[string]bool{
@@ -63,20 +198,312 @@ var acronyms = map[string]bool{
 }
 
 type Config struct {
-	Package string
-	Tables  []Table
+	Package    string
+	Schema     string
+	FieldStyle string `toml:"field_style"`
+	Database   DatabaseConfig
+	Discover   *DiscoverConfig
+	Types      []TypeConfig
+	Tables     []Table
+	Queries    string `toml:"queries"`
+	Functions  []Function
+}
+
+// DatabaseConfig is the [database] section of config.toml. Any values not
+// specified are taken from the PG* environment variables. String values may
+// reference environment variables with ${NAME}. In a key/value
+// connection_string the reference is replaced by a quoted value, so it must not
+// itself be quoted.
+type DatabaseConfig struct {
+	ConnectionString string `toml:"connection_string"`
+	Host             string `toml:"host"`
+	Port             uint16 `toml:"port"`
+	Database         string `toml:"database"`
+	User             string `toml:"user"`
+	Password         string `toml:"password"`
 }
 
 type Column struct {
-	ColumnName      string
-	DataType        string
-	OrdinalPosition int32
+	ColumnName   string `json:"column_name"`
+	DataType     string `json:"data_type"`
+	UDTSchema    string `json:"udt_schema"`
+	UDTName      string `json:"udt_name"`
+	DomainSchema string `json:"domain_schema,omitempty"`
+	DomainName   string `json:"domain_name,omitempty"`
+	NotNull      bool   `json:"not_null,omitempty"`
+	Default      string `json:"default,omitempty"`
+
+	// Identity is ALWAYS or BY DEFAULT for identity columns.
+	Identity string `json:"identity,omitempty"`
+
+	// Generated is true for generated columns.
+	Generated bool `json:"generated,omitempty"`
+
+	CharacterMaxLength int32 `json:"character_maximum_length,omitempty"`
+
+	// NumericPrecision and NumericScale are only set for numeric columns with
+	// a declared precision.
+	NumericPrecision int32 `json:"numeric_precision,omitempty"`
+	NumericScale     int32 `json:"numeric_scale,omitempty"`
+
+	OrdinalPosition int32 `json:"ordinal_position"`
+
+	FieldName     string `json:"-"`
+	GoBoxType     string `json:"-"`
+	BoxTypeImport string `json:"-"`
+
+	VarName      string `json:"-"`
+	GoType       string `json:"-"`
+	GoTypeImport string `json:"-"`
+
+	SelectCast string `json:"-"`
+
+	// GoField is true when the row struct field uses GoType instead of
+	// GoBoxType.
+	GoField bool `json:"-"`
+
+	// customType is true when GoBoxType comes from a [[types]] entry.
+	customType bool
+}
+
+// pgTypeName returns the name of the PostgreSQL type of c used to find its Go
+// types. Arrays are named by the udt_name of the array type, e.g. _int4, and
+// user-defined types by their own name.
+func (c Column) pgTypeName() string {
+	if c.DataType == "ARRAY" || c.DataType == "USER-DEFINED" {
+		return c.UDTName
+	}
+	return c.DataType
+}
+
+// pgxKnowsType returns true if the default pgx ConnInfo has a data type for
+// the type of c, so pgx requests the binary format for it.
+func (c Column) pgxKnowsType() bool {
+	pgType := c.pgTypeName()
+	return c.DataType != "USER-DEFINED" && pgSelectCasts[pgType] == "" && pgToBoxTypeMap[pgType] != ""
+}
+
+// GeneratedAlways returns true if the value of c is always generated by
+// PostgreSQL and cannot be inserted or updated.
+func (c Column) GeneratedAlways() bool {
+	return c.Generated || c.Identity == "ALWAYS"
+}
+
+// PgType returns the PostgreSQL type of c as it would be declared, e.g.
+// character varying(50) or text[].
+func (c Column) PgType() string {
+	switch {
+	case c.DomainName != "":
+		return c.DomainName
+	case c.DataType == "ARRAY":
+		elem := strings.TrimPrefix(c.UDTName, "_")
+		for dataType, udtName := range ddlUDTNames {
+			if udtName == elem {
+				elem = dataType
+			}
+		}
+		return elem + "[]"
+	case c.DataType == "USER-DEFINED":
+		return c.UDTName
+	case c.CharacterMaxLength > 0:
+		return fmt.Sprintf("%s(%d)", c.DataType, c.CharacterMaxLength)
+	case c.NumericPrecision > 0:
+		return fmt.Sprintf("%s(%d,%d)", c.DataType, c.NumericPrecision, c.NumericScale)
+	}
+	return c.DataType
+}
+
+// Description describes the PostgreSQL type and constraints of c for the doc
+// comment of its row struct field.
+func (c Column) Description() string {
+	parts := []string{c.PgType()}
+	if c.NotNull {
+		parts = append(parts, "not null")
+	}
+	if c.Default != "" {
+		parts = append(parts, "default "+strings.Join(strings.Fields(c.Default), " "))
+	}
+	switch {
+	case c.Identity != "":
+		parts = append(parts, "generated "+strings.ToLower(c.Identity)+" as identity")
+	case c.Generated:
+		parts = append(parts, "generated always")
+	}
+	return strings.Join(parts, " ")
+}
+
+// ParamOID returns the OID of the type of c for a parameter of a batched
+// statement. It is 0 for types without a pgtype constant, such as enums and
+// composite types, which lets PostgreSQL infer the type.
+func (c Column) ParamOID() string {
+	if oid, ok := pgTypeOIDs[c.pgTypeName()]; ok {
+		return oid
+	}
+	return "0"
+}
+
+// FieldType returns the type of the row struct field for c. Nullable columns
+// with a Go type use a pointer unless the Go type is a slice where nil is NULL.
+func (c Column) FieldType() string {
+	if !c.GoField {
+		return c.GoBoxType
+	}
+	if c.NotNull || strings.HasPrefix(c.GoType, "[]") {
+		return c.GoType
+	}
+	return "*" + c.GoType
+}
 
-	FieldName string
-	GoBoxType string
+// FieldArg returns the expression passing the row struct field for c as a
+// query argument. Box types implement pgtype encoders on their pointer.
+func (c Column) FieldArg() string {
+	if c.GoField {
+		return "row." + c.FieldName
+	}
+	return "&row." + c.FieldName
+}
+
+// fieldTypeImport returns the import needed by FieldType.
+func (c Column) fieldTypeImport() string {
+	if c.GoField {
+		return c.GoTypeImport
+	}
+	return c.BoxTypeImport
+}
+
+// InParam returns how the values of an In condition on c are sent as the
+// single array parameter of = ANY: "slice" for a slice of GoType, "literal"
+// for the text of an array literal or "" when c has no In condition. pgx has
+// no array type for enums, numeric strings and time strings, array columns
+// would compare their elements and custom types have no known array type.
+func (c Column) InParam() string {
+	switch {
+	case c.GoType == "" || c.customType || c.DataType == "ARRAY":
+		return ""
+	case c.DataType == "USER-DEFINED" || c.pgTypeName() == "numeric" || pgSelectCasts[c.pgTypeName()] != "":
+		return "literal"
+	}
+	return "slice"
+}
+
+// CopyValue returns how the value of c is sent by the copy protocol, which
+// uses the binary format: "label" for enums, whose binary format is their
+// label, "numeric" for numeric strings that are sent as pgtype.Numeric,
+// "unsupported" for composite and time types that only have a text encoder
+// and "" for values sent as they are.
+func (c Column) CopyValue() string {
+	switch {
+	case c.customType:
+		return ""
+	case c.DataType == "USER-DEFINED" && c.GoType != "":
+		return "label"
+	case c.DataType == "USER-DEFINED" || pgSelectCasts[c.pgTypeName()] != "":
+		return "unsupported"
+	case c.GoField && c.pgTypeName() == "numeric":
+		return "numeric"
+	}
+	return ""
+}
+
+// SQLType returns the qualified name of the type of c for casts. Domains are
+// cast to their base type, which is checked against the domain when assigned
+// to the column, and the type modifier is left to the assignment too.
+func (c Column) SQLType() string {
+	if c.UDTSchema == "" {
+		return quoteIdentifier(c.UDTName)
+	}
+	return quoteIdentifier(c.UDTSchema) + "." + quoteIdentifier(c.UDTName)
+}
+
+// QuotedName returns the quoted name of c for use in SQL.
+func (c Column) QuotedName() string {
+	return quoteIdentifier(c.ColumnName)
+}
+
+// SelectExpr returns the expression used to select c.
+func (c Column) SelectExpr() string {
+	if c.SelectCast != "" {
+		return c.QuotedName() + "::" + c.SelectCast
+	}
+	return c.QuotedName()
+}
+
+// Index is an index of a table on plain columns. Primary key, partial and
+// expression indexes are not included.
+type Index struct {
+	Name        string   `json:"name"`
+	Method      string   `json:"method"`
+	Unique      bool     `json:"unique,omitempty"`
+	ColumnNames []string `json:"columns"`
+}
+
+// ForeignKey is a foreign key constraint of a table on the columns of a
+// referenced table.
+type ForeignKey struct {
+	Name                  string   `json:"name"`
+	ColumnNames           []string `json:"columns"`
+	ReferencedSchema      string   `json:"referenced_schema"`
+	ReferencedTableName   string   `json:"referenced_table"`
+	ReferencedColumnNames []string `json:"referenced_columns"`
+}
 
-	VarName string
-	GoType  string
+// Reference is a foreign key of a table on columns with Go types that rows can
+// be selected by.
+type Reference struct {
+	Name    string
+	Columns []*Column
+
+	// Unique is true when the columns are also the primary key or a unique
+	// index so Select<Struct>By<Name> is already generated for them.
+	Unique bool
+
+	// Loader is true when a single column foreign key can be loaded for many
+	// keys at once with = ANY($1).
+	Loader bool
+}
+
+// ConflictTarget is the primary key or the columns of a unique index that an
+// upsert can use to detect a conflicting row.
+type ConflictTarget struct {
+	Name    string
+	Columns []*Column
+}
+
+// PageOrdering is an order of the rows of a table for keyset pagination. The
+// primary key columns that are not already part of the order are appended to
+// make it unique.
+type PageOrdering struct {
+	Name    string
+	Columns []*Column
+}
+
+// OrderBySQL returns the columns of the order for an ORDER BY clause.
+func (po PageOrdering) OrderBySQL() string {
+	names := make([]string, len(po.Columns))
+	for i, c := range po.Columns {
+		names[i] = quoteIdentifier(c.ColumnName)
+	}
+	return strings.Join(names, ", ")
+}
+
+// AfterSQL returns the condition that selects the rows after a cursor given as
+// parameters $1 to $n. Multiple columns use a row comparison so an index on the
+// columns can be used.
+func (po PageOrdering) AfterSQL() string {
+	if len(po.Columns) == 1 {
+		return quoteIdentifier(po.Columns[0].ColumnName) + " > $1"
+	}
+	placeholders := make([]string, len(po.Columns))
+	for i := range po.Columns {
+		placeholders[i] = fmt.Sprintf("$%d", i+1)
+	}
+	return "(" + po.OrderBySQL() + ") > (" + strings.Join(placeholders, ", ") + ")"
+}
+
+// LimitPlaceholder returns the parameter placeholder for the limit of a page
+// after a cursor.
+func (po PageOrdering) LimitPlaceholder() string {
+	return fmt.Sprintf("$%d", len(po.Columns)+1)
 }
 
 type ColumnConfig struct {
@@ -85,12 +512,23 @@ type ColumnConfig struct {
 }
 
 type Table struct {
-	TableName             string         `toml:"table_name"`
-	StructName            string         `toml:"struct_name"`
-	PrimaryKeyColumnNames []string       `toml:"primary_key"`
-	ColumnConfigs         []ColumnConfig `toml:"columns"`
-	Columns               []Column
-	PrimaryKeyColumns     []*Column
+	Schema                string           `toml:"schema" json:"schema"`
+	TableName             string           `toml:"table_name" json:"table_name"`
+	StructName            string           `toml:"struct_name" json:"-"`
+	FieldStyle            string           `toml:"field_style" json:"-"`
+	PrimaryKeyColumnNames []string         `toml:"primary_key" json:"primary_key,omitempty"`
+	ColumnConfigs         []ColumnConfig   `toml:"columns" json:"-"`
+	Returning             []string         `toml:"returning" json:"-"`
+	PageOrderingConfigs   [][]string       `toml:"page_orderings" json:"-"`
+	Columns               []Column         `toml:"-" json:"columns"`
+	Indexes               []Index          `toml:"-" json:"indexes,omitempty"`
+	ForeignKeys           []ForeignKey     `toml:"-" json:"foreign_keys,omitempty"`
+	PrimaryKeyColumns     []*Column        `toml:"-" json:"-"`
+	OverlapColumns        []*Column        `toml:"-" json:"-"`
+	ReturningColumns      []*Column        `toml:"-" json:"-"`
+	ConflictTargets       []ConflictTarget `toml:"-" json:"-"`
+	PageOrderings         []PageOrdering   `toml:"-" json:"-"`
+	References            []Reference      `toml:"-" json:"-"`
 }
 
 func generateCmd(cmd *cobra.Command, args []string) {
@@ -99,20 +537,89 @@ func generateCmd(cmd *cobra.Command, args []string) {
 		os.Exit(1)
 	}
 
-	var c Config
-	_, err := toml.DecodeFile("config.toml", &c)
+	c, err := loadConfig("config.toml")
 	if err != nil {
 		fmt.Fprintln(os.Stderr, err)
 		os.Exit(1)
 	}
 
-	conn, err := pgx.Connect(context.Background(), "")
-	if err != nil {
-		fmt.Fprintln(os.Stderr, err)
+	schemaPath, _ := cmd.Flags().GetString("schema")
+	ddlPath, _ := cmd.Flags().GetString("ddl")
+
+	var cat catalog
+	if schemaPath != "" && ddlPath != "" {
+		fmt.Fprintln(os.Stderr, "generate cannot use both --schema and --ddl")
 		os.Exit(1)
+	} else if schemaPath != "" {
+		cat, err = loadSnapshot(schemaPath)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+	} else if ddlPath != "" {
+		cat, err = loadDDL(ddlPath)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+	} else {
+		conn, err := connectDatabase(c.Database)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		defer conn.Close(context.Background())
+		cat = dbCatalog{db: conn}
+	}
+
+	if c.Discover != nil {
+		c.Tables, err = discoverTables(cat, *c.Discover, c.Schema, c.Tables)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+	}
+
+	for i := range c.Tables {
+		if c.Tables[i].FieldStyle == "" {
+			c.Tables[i].FieldStyle = c.FieldStyle
+		}
+	}
+
+	for i := range c.Functions {
+		if c.Functions[i].FieldStyle == "" {
+			c.Functions[i].FieldStyle = c.FieldStyle
+		}
+	}
+
+	var queryFiles []QueryFile
+	if c.Queries != "" {
+		queryFiles, err = loadQueries(c.Queries, c.FieldStyle)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
 	}
 
-	err = inspectDatabase(conn, c.Tables)
+	if c.Queries != "" || len(c.Functions) > 0 {
+		dc, ok := cat.(dbCatalog)
+		if !ok {
+			fmt.Fprintln(os.Stderr, "queries and functions can only be generated with a database connection, not with --schema or --ddl")
+			os.Exit(1)
+		}
+		err = describeQueries(dc.db, queryFiles)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		err = describeFunctions(dc, c.Functions)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+	}
+
+	gt, err := inspectAll(cat, c.Tables, queryFiles, c.Functions, c.Types)
 	if err != nil {
 		fmt.Fprintln(os.Stderr, err)
 		os.Exit(1)
@@ -154,57 +661,530 @@ func generateCmd(cmd *cobra.Command, args []string) {
 
 		file.Close()
 	}
+
+	for _, f := range queryFiles {
+		file, err := os.Create("pgxdata_" + goCaseToFileCase(pgCaseToGoPublicCase(f.Name)) + "_queries.go")
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+
+		err = writeQueries(file, templates, c.Package, f)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+
+		file.Close()
+	}
+
+	for _, f := range c.Functions {
+		file, err := os.Create("pgxdata_" + goCaseToFileCase(f.GoName) + "_function.go")
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+
+		err = writeFunction(file, templates, c.Package, f)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+
+		file.Close()
+	}
+
+	for _, e := range gt.Enums {
+		file, err := os.Create("pgxdata_" + goCaseToFileCase(e.GoName) + ".go")
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+
+		err = writeEnum(file, templates, c.Package, e)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+
+		file.Close()
+	}
+
+	for _, ct := range gt.Composites {
+		file, err := os.Create("pgxdata_" + goCaseToFileCase(ct.GoName) + ".go")
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+
+		err = writeCompositeType(file, templates, c.Package, ct)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+
+		file.Close()
+	}
+}
+
+// loadConfig reads the config file at path and applies the package-level
+// defaults to each table and function.
+func loadConfig(path string) (Config, error) {
+	var c Config
+	_, err := toml.DecodeFile(path, &c)
+	if err != nil {
+		return c, err
+	}
+
+	for i := range c.Tables {
+		if c.Tables[i].Schema == "" {
+			c.Tables[i].Schema = c.Schema
+		}
+	}
+
+	for i := range c.Functions {
+		if c.Functions[i].Schema == "" {
+			c.Functions[i].Schema = c.Schema
+		}
+		if c.Functions[i].GoName == "" {
+			c.Functions[i].GoName = pgCaseToGoPublicCase(c.Functions[i].FunctionName)
+		}
+	}
+
+	return c, nil
+}
+
+func connectDatabase(dc DatabaseConfig) (*pgx.Conn, error) {
+	connString, err := dc.connString()
+	if err != nil {
+		return nil, err
+	}
+
+	return pgx.Connect(context.Background(), connString)
+}
+
+// connString builds a connection string suitable for pgx.Connect. Settings
+// that are not present fall back to the PG* environment variables.
+//
+// The settings are passed as the query parameters of a URL rather than as
+// key/value pairs so values containing spaces, quotes or backslashes survive
+// parsing.
+func (dc DatabaseConfig) connString() (string, error) {
+	if dc.ConnectionString != "" {
+		if dc.Host != "" || dc.Port != 0 || dc.Database != "" || dc.User != "" || dc.Password != "" {
+			return "", errors.New("database connection_string cannot be combined with host, port, database, user, or password")
+		}
+		if strings.HasPrefix(dc.ConnectionString, "postgres://") || strings.HasPrefix(dc.ConnectionString, "postgresql://") {
+			return interpolateEnv(dc.ConnectionString, urlEscape)
+		}
+		return interpolateEnv(dc.ConnectionString, dsnQuote)
+	}
+
+	settings := []struct {
+		key   string
+		value string
+	}{
+		{"host", dc.Host},
+		{"database", dc.Database},
+		{"user", dc.User},
+		{"password", dc.Password},
+	}
+
+	params := url.Values{}
+	for _, s := range settings {
+		if s.value == "" {
+			continue
+		}
+		v, err := interpolateEnv(s.value, nil)
+		if err != nil {
+			return "", err
+		}
+		params.Set(s.key, v)
+	}
+	if dc.Port != 0 {
+		params.Set("port", strconv.Itoa(int(dc.Port)))
+	}
+
+	if len(params) == 0 {
+		return "", nil
+	}
+
+	return "postgres://?" + params.Encode(), nil
 }
 
-type Queryer interface {
-	Query(ctx context.Context, sql string, args ...interface{}) (pgx.Rows, error)
-	QueryRow(ctx context.Context, sql string, args ...interface{}) pgx.Row
-	Exec(ctx context.Context, sql string, arguments ...interface{}) (pgconn.CommandTag, error)
+// urlEscape percent-encodes every byte of s other than unreserved characters so
+// it can be used in any part of a URL.
+func urlEscape(s string) string {
+	return strings.Replace(url.QueryEscape(s), "+", "%20", -1)
+}
+
+// dsnQuote quotes s as a value in a key/value connection string following the
+// libpq rules: the value is wrapped in single quotes and any single quote or
+// backslash in it is escaped with a backslash.
+func dsnQuote(s string) string {
+	s = strings.Replace(s, `\`, `\\`, -1)
+	s = strings.Replace(s, `'`, `\'`, -1)
+	return "'" + s + "'"
+}
+
+var envReferenceRegexp = regexp.MustCompile(`\$\{([^}]*)\}`)
+
+// interpolateEnv replaces ${NAME} references in s with the value of the
+// environment variable NAME, passed through escape if it is not nil. It is an
+// error to reference an unset variable.
+func interpolateEnv(s string, escape func(string) string) (string, error) {
+	var err error
+	result := envReferenceRegexp.ReplaceAllStringFunc(s, func(ref string) string {
+		name := envReferenceRegexp.FindStringSubmatch(ref)[1]
+		value, ok := os.LookupEnv(name)
+		if !ok && err == nil {
+			err = fmt.Errorf("environment variable %s is not set", name)
+		}
+		if escape != nil {
+			value = escape(value)
+		}
+		return value
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return result, nil
 }
 
 func writeTableCrud(w io.Writer, templates *template.Template, pkgName string, table Table) error {
 	return templates.ExecuteTemplate(w, "row", struct {
-		PkgName           string
-		TableName         string
-		StructName        string
-		Columns           []Column
-		PrimaryKeyColumns []*Column
+		PkgName            string
+		Imports            []string
+		TableName          string
+		QualifiedTableName string
+		TableIdentifier    string
+		StructName         string
+		Columns            []Column
+		PrimaryKeyColumns  []*Column
+		OverlapColumns     []*Column
+		ReturningColumns   []*Column
+		ConflictTargets    []ConflictTarget
+		PageOrderings      []PageOrdering
+		UniqueKeys         []ConflictTarget
+		References         []Reference
+		GoStyle            bool
+
+		CopyConvertColumns     []*Column
+		CopyUnsupportedColumns []*Column
 	}{
-		PkgName:           pkgName,
-		TableName:         table.TableName,
-		StructName:        table.StructName,
-		Columns:           table.Columns,
-		PrimaryKeyColumns: table.PrimaryKeyColumns,
+		PkgName:            pkgName,
+		Imports:            tableImports(table),
+		TableName:          table.TableName,
+		QualifiedTableName: table.qualifiedName(),
+		TableIdentifier:    table.identifier(),
+		StructName:         table.StructName,
+		Columns:            table.Columns,
+		PrimaryKeyColumns:  table.PrimaryKeyColumns,
+		OverlapColumns:     table.OverlapColumns,
+		ReturningColumns:   table.ReturningColumns,
+		ConflictTargets:    table.ConflictTargets,
+		PageOrderings:      table.PageOrderings,
+		UniqueKeys:         table.uniqueKeys(),
+		References:         table.References,
+		GoStyle:            table.FieldStyle == "go",
+
+		CopyConvertColumns:     table.copyColumns("label", "numeric"),
+		CopyUnsupportedColumns: table.copyColumns("unsupported"),
 	})
 }
 
-func inspectDatabase(db Queryer, tables []Table) error {
+// copyColumns returns the columns of t that can be inserted and whose
+// CopyValue is one of copyValues.
+func (t Table) copyColumns(copyValues ...string) []*Column {
+	var columns []*Column
+	for i := range t.Columns {
+		c := &t.Columns[i]
+		if c.GeneratedAlways() {
+			continue
+		}
+		for _, v := range copyValues {
+			if c.CopyValue() == v {
+				columns = append(columns, c)
+			}
+		}
+	}
+	return columns
+}
+
+// qualifiedName returns the quoted table name for use in SQL. The name is only
+// schema-qualified when a schema was configured so tables without a configured
+// schema continue to be resolved through the search_path at runtime.
+func (t Table) qualifiedName() string {
+	if t.Schema == "" {
+		return quoteIdentifier(t.TableName)
+	}
+	return quoteIdentifier(t.Schema) + "." + quoteIdentifier(t.TableName)
+}
+
+// conflictTargets returns the primary key of t followed by its unique indexes
+// on other sets of columns.
+func conflictTargets(t Table) []ConflictTarget {
+	targets := []ConflictTarget{{Name: "PK", Columns: t.PrimaryKeyColumns}}
+	seen := map[string]bool{strings.Join(sortedStrings(t.PrimaryKeyColumnNames), ","): true}
+
+	for _, index := range t.Indexes {
+		key := strings.Join(sortedStrings(index.ColumnNames), ",")
+		if !index.Unique || seen[key] {
+			continue
+		}
+		seen[key] = true
+
+		var target ConflictTarget
+		for _, columnName := range index.ColumnNames {
+			c := t.column(columnName)
+			target.Name += c.FieldName
+			target.Columns = append(target.Columns, c)
+		}
+		targets = append(targets, target)
+	}
+
+	return targets
+}
+
+// pageOrderings returns the primary key order of t followed by the orders
+// configured with page_orderings. A configured order must be on not null
+// columns that lead a btree index so a page can be read from the index.
+func pageOrderings(t Table) ([]PageOrdering, error) {
+	orderings := []PageOrdering{{Columns: t.PrimaryKeyColumns}}
+
+	for _, columnNames := range t.PageOrderingConfigs {
+		if len(columnNames) == 0 {
+			return nil, fmt.Errorf("table %s page_orderings cannot contain an empty ordering", t.TableName)
+		}
+
+		var po PageOrdering
+		for _, columnName := range columnNames {
+			c := t.column(columnName)
+			if c == nil {
+				return nil, fmt.Errorf("table %s page_orderings column %s not found", t.TableName, columnName)
+			}
+			if !c.NotNull {
+				return nil, fmt.Errorf("table %s page_orderings column %s must be not null", t.TableName, columnName)
+			}
+			if c.GoType == "" {
+				return nil, fmt.Errorf("table %s page_orderings column %s has type %s which has no Go type; add a [[types]] entry with go_type", t.TableName, columnName, c.pgTypeName())
+			}
+			po.Name += c.FieldName
+			po.Columns = append(po.Columns, c)
+		}
+
+		if !stringSlicesHasPrefix(t.PrimaryKeyColumnNames, columnNames) && !t.hasIndexPrefix("btree", columnNames) {
+			return nil, fmt.Errorf("table %s page_orderings %v are not the leading columns of a btree index", t.TableName, columnNames)
+		}
+
+		for _, c := range t.PrimaryKeyColumns {
+			if stringIndex(columnNames, c.ColumnName) < 0 {
+				po.Columns = append(po.Columns, c)
+			}
+		}
+
+		orderings = append(orderings, po)
+	}
+
+	return orderings, nil
+}
+
+// uniqueKeys returns the unique indexes of t other than the primary key that
+// can be looked up by Go values. Indexes with a column without a Go type are
+// skipped.
+func (t Table) uniqueKeys() []ConflictTarget {
+	if len(t.ConflictTargets) == 0 {
+		return nil
+	}
+
+	var keys []ConflictTarget
+targets:
+	for _, target := range t.ConflictTargets[1:] {
+		for _, c := range target.Columns {
+			if c.GoType == "" {
+				continue targets
+			}
+		}
+		keys = append(keys, target)
+	}
+	return keys
+}
+
+// references returns the foreign keys of t on distinct sets of columns that
+// all have a Go type.
+func references(t Table) []Reference {
+	seen := map[string]bool{}
+	unique := map[string]bool{}
+	for _, target := range t.ConflictTargets {
+		var columnNames []string
+		for _, c := range target.Columns {
+			columnNames = append(columnNames, c.ColumnName)
+		}
+		unique[strings.Join(sortedStrings(columnNames), ",")] = true
+	}
+
+	var refs []Reference
+foreignKeys:
+	for _, fk := range t.ForeignKeys {
+		key := strings.Join(sortedStrings(fk.ColumnNames), ",")
+		if seen[key] {
+			continue
+		}
+
+		ref := Reference{Unique: unique[key]}
+		for _, columnName := range fk.ColumnNames {
+			c := t.column(columnName)
+			if c == nil || c.GoType == "" {
+				continue foreignKeys
+			}
+			ref.Name += c.FieldName
+			ref.Columns = append(ref.Columns, c)
+		}
+		seen[key] = true
+
+		// Arrays of user-defined and custom types cannot be sent as = ANY($1)
+		// parameters, pgtype.Numeric cannot be scanned into a string key and
+		// slices such as the []byte of bytea cannot be map keys.
+		c := ref.Columns[0]
+		ref.Loader = len(ref.Columns) == 1 && !c.customType && c.DataType != "USER-DEFINED" && c.pgTypeName() != "numeric" && !strings.HasPrefix(c.GoType, "[]")
+
+		refs = append(refs, ref)
+	}
+
+	return refs
+}
+
+// sortedStrings returns a sorted copy of ss.
+func sortedStrings(ss []string) []string {
+	sorted := append([]string{}, ss...)
+	sort.Strings(sorted)
+	return sorted
+}
+
+// column returns the column of t named columnName or nil if there is none.
+func (t Table) column(columnName string) *Column {
+	for i := range t.Columns {
+		if t.Columns[i].ColumnName == columnName {
+			return &t.Columns[i]
+		}
+	}
+	return nil
+}
+
+// identifier returns the elements of a pgx.Identifier literal for the table
+// name, e.g. {"billing", "customer"}. Like qualifiedName it only includes the
+// schema when one was configured.
+func (t Table) identifier() string {
+	if t.Schema == "" {
+		return fmt.Sprintf("{%q}", t.TableName)
+	}
+	return fmt.Sprintf("{%q, %q}", t.Schema, t.TableName)
+}
+
+// hasIndexOn returns true if columnName is one of the columns of an index of t
+// using method.
+func (t Table) hasIndexOn(method, columnName string) bool {
+	for _, index := range t.Indexes {
+		if index.Method == method && stringIndex(index.ColumnNames, columnName) >= 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// hasIndexPrefix returns true if columnNames are the leading columns of an
+// index of t using method.
+func (t Table) hasIndexPrefix(method string, columnNames []string) bool {
+	for _, index := range t.Indexes {
+		if index.Method == method && stringSlicesHasPrefix(index.ColumnNames, columnNames) {
+			return true
+		}
+	}
+	return false
+}
+
+func quoteIdentifier(s string) string {
+	return `"` + strings.Replace(s, `"`, `""`, -1) + `"`
+}
+
+// inspectTables fills in the columns and primary key of each table from cat
+// and applies the table configuration. It returns the user-defined types Go
+// types need to be generated for.
+func inspectTables(cat catalog, tables []Table, types []TypeConfig) (*generatedTypes, error) {
+	return inspectAll(cat, tables, nil, nil, types)
+}
+
+// inspectAll is inspectTables that also resolves the Go types of the
+// parameters and results of described queries and functions.
+func inspectAll(cat catalog, tables []Table, queryFiles []QueryFile, functions []Function, types []TypeConfig) (*generatedTypes, error) {
+	if err := validateTypeConfigs(types); err != nil {
+		return nil, err
+	}
+
+	var unsupported []string
+	ut := newUserTypes()
+
 	for i := range tables {
-		rows, err := db.Query(context.Background(), `select column_name, data_type, ordinal_position from information_schema.columns where table_name=$1`, tables[i].TableName)
-		if err != nil {
-			return err
+		if fs := tables[i].FieldStyle; fs != "" && fs != "pgtype" && fs != "go" {
+			return nil, fmt.Errorf("table %s field_style must be pgtype or go, not %s", tables[i].TableName, fs)
 		}
 
-		var columns []Column
-		for rows.Next() {
-			var c Column
-			rows.Scan(&c.ColumnName, &c.DataType, &c.OrdinalPosition)
-			c.FieldName = pgCaseToGoPublicCase(c.ColumnName)
-			c.GoBoxType = pgTypeToGoBoxType(c.DataType)
-			c.VarName = pgCaseToGoPrivateCase(c.ColumnName)
-			c.GoType = pgTypeToGoType(c.DataType)
+		schema := tables[i].Schema
+		if schema == "" {
+			var err error
+			schema, err = cat.searchPathSchema(tables[i].TableName)
+			if err != nil {
+				return nil, err
+			}
+		}
 
-			columns = append(columns, c)
+		catalogTable, err := cat.table(schema, tables[i].TableName)
+		if err != nil {
+			return nil, err
+		}
+		if catalogTable == nil {
+			return nil, fmt.Errorf("table %s.%s not found", schema, tables[i].TableName)
 		}
 
-		if rows.Err() != nil {
-			return err
+		if err := ut.load(cat, catalogTable.Columns); err != nil {
+			return nil, err
+		}
+
+		columns := make([]Column, len(catalogTable.Columns))
+		for j, c := range catalogTable.Columns {
+			c.FieldName = pgCaseToGoPublicCase(c.ColumnName)
+			c.VarName = pgCaseToGoPrivateCase(c.ColumnName)
+			if err := resolveColumnType(&c, schema, tables[i].TableName, types, ut); err != nil {
+				unsupported = append(unsupported, err.Error())
+			}
+			if tables[i].FieldStyle == "go" {
+				useGoField(&c)
+			}
+			columns[j] = c
 		}
 
 		tables[i].Columns = columns
+		tables[i].Indexes = catalogTable.Indexes
+		tables[i].ForeignKeys = catalogTable.ForeignKeys
+
+		// Range columns covered by a GiST index can be searched efficiently with
+		// the && operator.
+		for j := range tables[i].Columns {
+			c := &tables[i].Columns[j]
+			if pgRangeTypes[c.pgTypeName()] && tables[i].hasIndexOn("gist", c.ColumnName) {
+				tables[i].OverlapColumns = append(tables[i].OverlapColumns, c)
+			}
+		}
 
-		if len(tables[i].PrimaryKeyColumnNames) == 0 {
-			tables[i].PrimaryKeyColumnNames = []string{"id"}
+		pkColumnNames := catalogTable.PrimaryKeyColumnNames
+		if len(pkColumnNames) > 0 {
+			if len(tables[i].PrimaryKeyColumnNames) > 0 && !stringSlicesEqual(tables[i].PrimaryKeyColumnNames, pkColumnNames) {
+				fmt.Fprintf(os.Stderr, "warning: table %s primary_key %v does not match database primary key %v; using database primary key\n", tables[i].TableName, tables[i].PrimaryKeyColumnNames, pkColumnNames)
+			}
+			tables[i].PrimaryKeyColumnNames = pkColumnNames
+		} else if len(tables[i].PrimaryKeyColumnNames) == 0 {
+			return nil, fmt.Errorf("table %s has no primary key and primary_key is not configured", tables[i].TableName)
 		}
 
 		for _, columnName := range tables[i].PrimaryKeyColumnNames {
@@ -217,7 +1197,13 @@ func inspectDatabase(db Queryer, tables []Table) error {
 				}
 			}
 			if !found {
-				return fmt.Errorf("table %s primary_key column %s not found", tables[i].TableName, columnName)
+				return nil, fmt.Errorf("table %s primary_key column %s not found", tables[i].TableName, columnName)
+			}
+		}
+
+		for _, c := range tables[i].PrimaryKeyColumns {
+			if c.GoType == "" && c.GoBoxType != "" {
+				return nil, fmt.Errorf("table %s primary_key column %s has type %s which has no Go type; add a [[types]] entry with go_type", tables[i].TableName, c.ColumnName, c.pgTypeName())
 			}
 		}
 
@@ -233,12 +1219,66 @@ func inspectDatabase(db Queryer, tables []Table) error {
 				}
 			}
 			if !found {
-				return fmt.Errorf("table %s column %s not found", tables[i].TableName, cc.ColumnName)
+				return nil, fmt.Errorf("table %s column %s not found", tables[i].TableName, cc.ColumnName)
 			}
 		}
+
+		// Insert and Update return all columns unless returning limits them. The
+		// primary key is always returned.
+		for _, columnName := range tables[i].Returning {
+			if tables[i].column(columnName) == nil {
+				return nil, fmt.Errorf("table %s returning column %s not found", tables[i].TableName, columnName)
+			}
+		}
+		for j := range tables[i].Columns {
+			c := &tables[i].Columns[j]
+			if len(tables[i].Returning) == 0 || stringIndex(tables[i].Returning, c.ColumnName) >= 0 || stringIndex(tables[i].PrimaryKeyColumnNames, c.ColumnName) >= 0 {
+				tables[i].ReturningColumns = append(tables[i].ReturningColumns, c)
+			}
+		}
+
+		tables[i].ConflictTargets = conflictTargets(tables[i])
+
+		tables[i].PageOrderings, err = pageOrderings(tables[i])
+		if err != nil {
+			return nil, err
+		}
+
+		tables[i].References = references(tables[i])
 	}
 
-	return nil
+	if err := resolveQueryTypes(cat, queryFiles, types, ut); err != nil {
+		return nil, err
+	}
+
+	if err := resolveFunctionTypes(cat, tables, functions, types, ut); err != nil {
+		return nil, err
+	}
+
+	unsupported = append(unsupported, ut.resolveAttributes(types)...)
+
+	if len(unsupported) > 0 {
+		return nil, fmt.Errorf("columns with unsupported types (add [[types]] entries to config.toml to map them):\n  %s", strings.Join(unsupported, "\n  "))
+	}
+
+	return ut.used(tables, queryFiles, functions)
+}
+
+func stringSlicesEqual(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// stringSlicesHasPrefix returns true if s begins with prefix.
+func stringSlicesHasPrefix(s, prefix []string) bool {
+	return len(prefix) <= len(s) && stringSlicesEqual(s[:len(prefix)], prefix)
 }
 
 func pgCaseToGoPublicCase(pg string) string {
@@ -286,22 +1326,6 @@ func goCaseToFileCase(g string) string {
 	return buf.String()
 }
 
-func pgTypeToGoBoxType(pg string) string {
-	if t, ok := pgToBoxTypeMap[pg]; ok {
-		return t
-	} else {
-		return "pgtype.Varchar"
-	}
-}
-
-func pgTypeToGoType(pg string) string {
-	if t, ok := pgToGoTypeMap[pg]; ok {
-		return t
-	} else {
-		return "string"
-	}
-}
-
 func writeSupportFile(path string, tmpl *template.Template, data initData) error {
 	file, err := os.Create(path)
 	if err != nil {
@@ -21,6 +21,15 @@ func main() {
 		Short: "Build",
 		Run:   generateCmd,
 	}
+	cmdGenerate.Flags().String("schema", "", "generate from a schema snapshot file instead of the database")
+	cmdGenerate.Flags().String("ddl", "", "generate from a SQL file of CREATE TABLE statements instead of the database")
+
+	cmdInspect := &cobra.Command{
+		Use:   "inspect",
+		Short: "Write a schema snapshot of the database",
+		Run:   inspectCmd,
+	}
+	cmdInspect.Flags().String("out", "", "path to write the schema snapshot to (default stdout)")
 
 	cmdVersion := &cobra.Command{
 		Use:   "version",
@@ -31,6 +40,7 @@ func main() {
 	var rootCmd = &cobra.Command{Use: "pgxdata"}
 	rootCmd.AddCommand(cmdInit)
 	rootCmd.AddCommand(cmdGenerate)
+	rootCmd.AddCommand(cmdInspect)
 	rootCmd.AddCommand(cmdVersion)
 	rootCmd.Execute()
 }
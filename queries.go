@@ -0,0 +1,440 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"text/template"
+	"unicode"
+
+	"github.com/jackc/pgtype"
+	"github.com/jackc/pgx/v4"
+)
+
+// Query is an annotated query from a file in the queries directory. Its
+// parameters and result columns are learned by preparing it.
+type Query struct {
+	Name string
+
+	// Command is one, many or exec. It determines whether the generated
+	// function returns a row, a slice of rows or the number of rows affected.
+	Command string
+
+	SQL        string
+	ParamNames []string
+	Params     []Column
+	Columns    []Column
+}
+
+// SQLConstName returns the name of the constant holding the SQL of q.
+func (q Query) SQLConstName() string {
+	return strings.ToLower(q.Name[:1]) + q.Name[1:] + "SQL"
+}
+
+// SQLLiteral returns the SQL of q as a Go string literal.
+func (q Query) SQLLiteral() string {
+	return sqlLiteral(q.SQL)
+}
+
+// sqlLiteral returns sql as a raw Go string literal unless it contains a
+// backquote.
+func sqlLiteral(sql string) string {
+	if strings.Contains(sql, "`") {
+		return strconv.Quote(sql)
+	}
+	return "`" + sql + "`"
+}
+
+// ResultFormats returns the result formats argument of q. See resultFormats.
+func (q Query) ResultFormats() string {
+	return resultFormats(q.Columns)
+}
+
+// resultFormats returns a pgx.QueryResultFormats literal requesting the text
+// format for the columns of types pgx does not know and the binary format for
+// the others. It returns "" when pgx knows all of them. pgx only requests
+// result formats for known types, so SQL that does not cast such columns needs
+// the formats of all columns to be given.
+func resultFormats(columns []Column) string {
+	formats := make([]string, len(columns))
+	var unknown bool
+	for i, c := range columns {
+		formats[i] = "pgx.BinaryFormatCode"
+		if !c.pgxKnowsType() {
+			formats[i] = "pgx.TextFormatCode"
+			unknown = true
+		}
+	}
+	if !unknown {
+		return ""
+	}
+	return "pgx.QueryResultFormats{" + strings.Join(formats, ", ") + "}"
+}
+
+// QueryFile is the queries of a single .sql file. Each file generates one Go
+// file.
+type QueryFile struct {
+	Name       string
+	FieldStyle string
+	Queries    []Query
+}
+
+var queryNameRegexp = regexp.MustCompile(`^--\s*name:\s*(\S+)\s+:(\S+)\s*$`)
+var queryParamsRegexp = regexp.MustCompile(`^--\s*params:(.*)$`)
+
+// loadQueries reads the .sql files in dir in name order. The result rows of
+// the queries use fieldStyle.
+func loadQueries(dir, fieldStyle string) ([]QueryFile, error) {
+	paths, err := filepath.Glob(filepath.Join(dir, "*.sql"))
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(paths)
+
+	var files []QueryFile
+	names := make(map[string]string)
+	for _, path := range paths {
+		buf, err := ioutil.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+
+		queries, err := parseQueries(string(buf))
+		if err != nil {
+			return nil, fmt.Errorf("%s: %v", path, err)
+		}
+		for _, q := range queries {
+			if other, ok := names[q.Name]; ok {
+				return nil, fmt.Errorf("%s: query %s is already defined in %s", path, q.Name, other)
+			}
+			names[q.Name] = path
+		}
+
+		files = append(files, QueryFile{
+			Name:       strings.TrimSuffix(filepath.Base(path), ".sql"),
+			FieldStyle: fieldStyle,
+			Queries:    queries,
+		})
+	}
+
+	return files, nil
+}
+
+// parseQueries splits src into queries. Each query starts with a
+// "-- name: Name :command" line and may be followed by a
+// "-- params: a, b" line naming its parameters in order.
+func parseQueries(src string) ([]Query, error) {
+	var queries []Query
+	var sql []string
+
+	finish := func() error {
+		if len(queries) == 0 {
+			if strings.TrimSpace(strings.Join(sql, "\n")) != "" {
+				return fmt.Errorf("SQL before the first -- name: annotation")
+			}
+			return nil
+		}
+		q := &queries[len(queries)-1]
+		q.SQL = strings.TrimRight(strings.TrimSpace(strings.Join(sql, "\n")), ";")
+		q.SQL = strings.TrimSpace(q.SQL)
+		if q.SQL == "" {
+			return fmt.Errorf("query %s has no SQL", q.Name)
+		}
+		return nil
+	}
+
+	for i, line := range strings.Split(src, "\n") {
+		trimmed := strings.TrimSpace(line)
+
+		if m := queryNameRegexp.FindStringSubmatch(trimmed); m != nil {
+			if err := finish(); err != nil {
+				return nil, err
+			}
+			sql = nil
+
+			if !isGoIdentifier(m[1]) || !unicode.IsUpper(rune(m[1][0])) {
+				return nil, fmt.Errorf("line %d: query name %s must be an exported Go identifier", i+1, m[1])
+			}
+			if m[2] != "one" && m[2] != "many" && m[2] != "exec" {
+				return nil, fmt.Errorf("line %d: query %s command must be :one, :many or :exec, not :%s", i+1, m[1], m[2])
+			}
+			queries = append(queries, Query{Name: m[1], Command: m[2]})
+			continue
+		}
+
+		if m := queryParamsRegexp.FindStringSubmatch(trimmed); m != nil && len(queries) > 0 && len(sql) == 0 {
+			q := &queries[len(queries)-1]
+			for _, name := range strings.Split(m[1], ",") {
+				name = strings.TrimSpace(name)
+				if !isGoIdentifier(name) {
+					return nil, fmt.Errorf("line %d: query %s parameter name %q is not a Go identifier", i+1, q.Name, name)
+				}
+				q.ParamNames = append(q.ParamNames, name)
+			}
+			continue
+		}
+
+		sql = append(sql, line)
+	}
+
+	if err := finish(); err != nil {
+		return nil, err
+	}
+
+	return queries, nil
+}
+
+var goIdentifierRegexp = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+func isGoIdentifier(s string) bool {
+	return goIdentifierRegexp.MatchString(s)
+}
+
+// describeQueries prepares each query on db and sets its parameters and
+// result columns from the statement description.
+func describeQueries(db Queryer, files []QueryFile) error {
+	preparer, ok := db.(interface {
+		Prepare(ctx context.Context, name, sql string) (*pgx.PreparedStatement, error)
+	})
+	if !ok {
+		return fmt.Errorf("queries can only be described by a database connection")
+	}
+
+	types := make(map[pgtype.OID]Column)
+	typeColumn := func(oid pgtype.OID) (Column, error) {
+		if c, ok := types[oid]; ok {
+			return c, nil
+		}
+		c, err := describeType(db, oid)
+		if err != nil {
+			return Column{}, err
+		}
+		types[oid] = c
+		return c, nil
+	}
+
+	for i := range files {
+		for j := range files[i].Queries {
+			q := &files[i].Queries[j]
+
+			ps, err := preparer.Prepare(context.Background(), "", q.SQL)
+			if err != nil {
+				return fmt.Errorf("query %s: %v", q.Name, err)
+			}
+
+			if len(q.ParamNames) > 0 && len(q.ParamNames) != len(ps.ParameterOIDs) {
+				return fmt.Errorf("query %s names %d parameters but has %d", q.Name, len(q.ParamNames), len(ps.ParameterOIDs))
+			}
+			q.Params = nil
+			for k, oid := range ps.ParameterOIDs {
+				c, err := typeColumn(oid)
+				if err != nil {
+					return err
+				}
+				c.ColumnName = fmt.Sprintf("arg%d", k+1)
+				if len(q.ParamNames) > 0 {
+					c.ColumnName = q.ParamNames[k]
+				}
+				q.Params = append(q.Params, c)
+			}
+
+			if q.Command == "exec" {
+				continue
+			}
+			if len(ps.FieldDescriptions) == 0 {
+				return fmt.Errorf("query %s is :%s but does not return rows", q.Name, q.Command)
+			}
+			q.Columns = nil
+			for _, fd := range ps.FieldDescriptions {
+				c, err := typeColumn(pgtype.OID(fd.DataTypeOID))
+				if err != nil {
+					return err
+				}
+				c.ColumnName = string(fd.Name)
+				q.Columns = append(q.Columns, c)
+			}
+		}
+	}
+
+	return nil
+}
+
+// describeType returns a Column describing the type oid like
+// information_schema.columns does.
+func describeType(db Queryer, oid pgtype.OID) (Column, error) {
+	var c Column
+	var typtype string
+	var baseOID pgtype.OID
+	err := db.QueryRow(context.Background(), `select
+  case
+    when t.typelem <> 0 and t.typlen = -1 then 'ARRAY'
+    when n.nspname = 'pg_catalog' then format_type(t.oid, null)
+    else 'USER-DEFINED'
+  end,
+  n.nspname::text, t.typname::text, t.typtype::text, t.typbasetype
+from pg_catalog.pg_type t
+  join pg_catalog.pg_namespace n on n.oid=t.typnamespace
+where t.oid=$1`, oid).Scan(&c.DataType, &c.UDTSchema, &c.UDTName, &typtype, &baseOID)
+	if err == pgx.ErrNoRows {
+		return Column{}, fmt.Errorf("type %d not found", oid)
+	} else if err != nil {
+		return Column{}, err
+	}
+
+	if typtype == "d" {
+		base, err := describeType(db, baseOID)
+		if err != nil {
+			return Column{}, err
+		}
+		base.DomainSchema, base.DomainName = c.UDTSchema, c.UDTName
+		return base, nil
+	}
+
+	return c, nil
+}
+
+// resolveQueryTypes sets the Go types of the parameters and result columns of
+// the queries.
+func resolveQueryTypes(cat catalog, files []QueryFile, types []TypeConfig, ut *userTypes) error {
+	var unsupported []string
+
+	for i := range files {
+		for j := range files[i].Queries {
+			q := &files[i].Queries[j]
+
+			if err := ut.load(cat, q.Params); err != nil {
+				return err
+			}
+			if err := ut.load(cat, q.Columns); err != nil {
+				return err
+			}
+
+			what := "query " + q.Name
+			unsupported = append(unsupported, resolveParamTypes(what, q.Params, types, ut)...)
+			u, err := resolveResultTypes(what, q.Columns, files[i].FieldStyle, false, types, ut)
+			if err != nil {
+				return err
+			}
+			unsupported = append(unsupported, u...)
+		}
+	}
+
+	if len(unsupported) > 0 {
+		return fmt.Errorf("queries with unsupported types (add [[types]] entries to config.toml to map them):\n  %s", strings.Join(unsupported, "\n  "))
+	}
+
+	return nil
+}
+
+// resolveParamTypes sets the Go types of the parameters of a query or function
+// described by what. It returns the parameters without a Go type.
+func resolveParamTypes(what string, params []Column, types []TypeConfig, ut *userTypes) []string {
+	var unsupported []string
+	for i := range params {
+		c := &params[i]
+		c.VarName = pgCaseToGoPrivateCase(c.ColumnName)
+		if err := resolveColumnType(c, "", "", types, ut); err != nil {
+			unsupported = append(unsupported, fmt.Sprintf("%s parameter %s: %v", what, c.ColumnName, err))
+		} else if c.GoType == "" {
+			unsupported = append(unsupported, fmt.Sprintf("%s parameter %s has type %s which has no Go type", what, c.ColumnName, c.pgTypeName()))
+		}
+	}
+	return unsupported
+}
+
+// resolveResultTypes sets the field names and types of the result columns of a
+// query or function described by what. It returns the columns with unsupported
+// types. Described columns are nullable as the nullability of an expression is
+// not known. selectCasts is false when the columns are selected by SQL written
+// by the user, which does not apply SelectCast, so columns whose Go type needs
+// a cast keep their box types.
+func resolveResultTypes(what string, columns []Column, fieldStyle string, selectCasts bool, types []TypeConfig, ut *userTypes) ([]string, error) {
+	var unsupported []string
+	fieldNames := make(map[string]bool)
+	for i := range columns {
+		c := &columns[i]
+		c.FieldName = pgCaseToGoPublicCase(c.ColumnName)
+		if fieldNames[c.FieldName] {
+			return nil, fmt.Errorf("%s has more than one column named %s", what, c.ColumnName)
+		}
+		fieldNames[c.FieldName] = true
+		if err := resolveColumnType(c, "", "", types, ut); err != nil {
+			unsupported = append(unsupported, fmt.Sprintf("%s column %s: %v", what, c.ColumnName, err))
+		}
+		if fieldStyle == "go" {
+			goField := *c
+			useGoField(&goField)
+			if selectCasts || goField.SelectCast == "" {
+				*c = goField
+			}
+		}
+	}
+	return unsupported, nil
+}
+
+// queryImports returns the imports needed by the generated code for f other
+// than those always imported by the query template.
+func queryImports(f QueryFile) []string {
+	set := make(map[string]struct{})
+	for _, q := range f.Queries {
+		addResultImports(set, q.Params, q.Columns)
+	}
+
+	imports := make([]string, 0, len(set))
+	for i := range set {
+		imports = append(imports, i)
+	}
+	sort.Strings(imports)
+
+	return imports
+}
+
+// addResultImports adds the imports needed by the Go types of params and the
+// field types of columns to set.
+func addResultImports(set map[string]struct{}, params, columns []Column) {
+	for _, c := range params {
+		if c.GoTypeImport != "" {
+			set[c.GoTypeImport] = struct{}{}
+		}
+	}
+	for _, c := range columns {
+		if i := c.fieldTypeImport(); i != "" {
+			set[i] = struct{}{}
+		}
+		if typeQualifier(c.FieldType()) == "pgtype" {
+			set["github.com/jackc/pgtype"] = struct{}{}
+		}
+	}
+}
+
+func writeQueries(w io.Writer, templates *template.Template, pkgName string, f QueryFile) error {
+	var hasOne, hasResultFormats bool
+	for _, q := range f.Queries {
+		if q.Command == "one" {
+			hasOne = true
+		}
+		if q.ResultFormats() != "" {
+			hasResultFormats = true
+		}
+	}
+
+	return templates.ExecuteTemplate(w, "query", struct {
+		PkgName          string
+		Imports          []string
+		HasOne           bool
+		HasResultFormats bool
+		Queries          []Query
+	}{
+		PkgName:          pkgName,
+		Imports:          queryImports(f),
+		HasOne:           hasOne,
+		HasResultFormats: hasResultFormats,
+		Queries:          f.Queries,
+	})
+}
@@ -0,0 +1,201 @@
+package main
+
+import (
+	"bytes"
+	"go/parser"
+	"go/token"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestParseQueries(t *testing.T) {
+	t.Parallel()
+
+	src := `-- name: CustomerByEmail :one
+-- params: email
+select id, first_name, last_name
+from customer
+where email = $1;
+
+-- name: CustomersCreatedSince :many
+select id, created_at from customer where created_at >= $1;
+
+-- name: DeleteCustomer :exec
+delete from customer where id=$1
+`
+
+	queries, err := parseQueries(src)
+	if err != nil {
+		t.Fatalf("parseQueries unexpectedly failed: %v", err)
+	}
+
+	expected := []Query{
+		{
+			Name:       "CustomerByEmail",
+			Command:    "one",
+			SQL:        "select id, first_name, last_name\nfrom customer\nwhere email = $1",
+			ParamNames: []string{"email"},
+		},
+		{
+			Name:    "CustomersCreatedSince",
+			Command: "many",
+			SQL:     "select id, created_at from customer where created_at >= $1",
+		},
+		{
+			Name:    "DeleteCustomer",
+			Command: "exec",
+			SQL:     "delete from customer where id=$1",
+		},
+	}
+
+	if !reflect.DeepEqual(queries, expected) {
+		t.Errorf("Expected %#v, but got %#v", expected, queries)
+	}
+}
+
+func TestParseQueriesErrors(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		src string
+		err string
+	}{
+		{src: "select 1;\n-- name: One :one\nselect 1", err: "before the first"},
+		{src: "-- name: one :one\nselect 1", err: "exported Go identifier"},
+		{src: "-- name: One :first\nselect 1", err: "must be :one, :many or :exec"},
+		{src: "-- name: One :one\n-- params: a, b-c\nselect $1, $2", err: "not a Go identifier"},
+		{src: "-- name: One :one\n-- name: Two :one\nselect 1", err: "has no SQL"},
+	}
+
+	for i, tt := range tests {
+		_, err := parseQueries(tt.src)
+		if err == nil {
+			t.Errorf("%d. Expected error containing %q, but there was none", i, tt.err)
+		} else if !strings.Contains(err.Error(), tt.err) {
+			t.Errorf("%d. Expected error containing %q, but got %v", i, tt.err, err)
+		}
+	}
+}
+
+func TestResolveResultTypesSelectCasts(t *testing.T) {
+	t.Parallel()
+
+	for _, selectCasts := range []bool{false, true} {
+		columns := []Column{
+			{ColumnName: "id", DataType: "integer"},
+			{ColumnName: "balance", DataType: "numeric"},
+		}
+		unsupported, err := resolveResultTypes("query Balances", columns, "go", selectCasts, nil, &userTypes{})
+		if err != nil || len(unsupported) > 0 {
+			t.Fatalf("resolveResultTypes unexpectedly failed: %v %v", err, unsupported)
+		}
+
+		if columns[0].FieldType() != "*int32" {
+			t.Errorf("selectCasts %v: Expected id to be *int32, got %s", selectCasts, columns[0].FieldType())
+		}
+		expectedType, expectedCast := "pgtype.Numeric", ""
+		if selectCasts {
+			expectedType, expectedCast = "*string", "text"
+		}
+		if columns[1].FieldType() != expectedType || columns[1].SelectCast != expectedCast {
+			t.Errorf("selectCasts %v: Expected balance to be %s cast to %q, got %s cast to %q", selectCasts, expectedType, expectedCast, columns[1].FieldType(), columns[1].SelectCast)
+		}
+	}
+}
+
+func TestQueryResultFormats(t *testing.T) {
+	t.Parallel()
+
+	id := Column{ColumnName: "id", DataType: "integer"}
+	tags := Column{ColumnName: "tags", DataType: "ARRAY", UDTName: "_text"}
+	opensAt := Column{ColumnName: "opens_at", DataType: "time without time zone"}
+	status := Column{ColumnName: "status", DataType: "USER-DEFINED", UDTSchema: "public", UDTName: "order_status"}
+	statuses := Column{ColumnName: "statuses", DataType: "ARRAY", UDTSchema: "public", UDTName: "_order_status"}
+
+	tests := []struct {
+		columns  []Column
+		expected string
+	}{
+		{nil, ""},
+		{[]Column{id, tags}, ""},
+		{[]Column{id, opensAt}, "pgx.QueryResultFormats{pgx.BinaryFormatCode, pgx.TextFormatCode}"},
+		{[]Column{status, id, statuses}, "pgx.QueryResultFormats{pgx.TextFormatCode, pgx.BinaryFormatCode, pgx.TextFormatCode}"},
+	}
+
+	for i, tt := range tests {
+		if formats := (Query{Columns: tt.columns}).ResultFormats(); formats != tt.expected {
+			t.Errorf("%d. Expected %q, got %q", i, tt.expected, formats)
+		}
+	}
+}
+
+func TestWriteQueries(t *testing.T) {
+	t.Parallel()
+
+	f := QueryFile{
+		Name: "customer",
+		Queries: []Query{
+			{
+				Name:    "CustomerByEmail",
+				Command: "one",
+				SQL:     "select id, balance from customer where email = $1",
+				Params: []Column{
+					{ColumnName: "email", VarName: "email", GoType: "string"},
+				},
+				Columns: []Column{
+					{ColumnName: "id", FieldName: "ID", GoBoxType: "pgtype.Int4", GoType: "int32"},
+					{ColumnName: "balance", FieldName: "Balance", GoBoxType: "money.Money", BoxTypeImport: "example.com/money", GoType: "decimal.Decimal"},
+				},
+			},
+			{
+				Name:    "CustomerIDs",
+				Command: "many",
+				SQL:     "select `id` from customer",
+				Columns: []Column{
+					{ColumnName: "id", FieldName: "ID", GoBoxType: "pgtype.Int4", GoType: "int32"},
+				},
+			},
+			{
+				Name:    "DeleteCustomer",
+				Command: "exec",
+				SQL:     "delete from customer where created_at < $1",
+				Params: []Column{
+					{ColumnName: "created_at", VarName: "createdAt", GoType: "time.Time", GoTypeImport: "time"},
+				},
+			},
+		},
+	}
+
+	buf := &bytes.Buffer{}
+	err := writeQueries(buf, loadTemplates(), "data", f)
+	if err != nil {
+		t.Fatalf("writeQueries unexpectedly failed: %v", err)
+	}
+
+	file, err := parser.ParseFile(token.NewFileSet(), "customer_queries.go", buf.Bytes(), 0)
+	if err != nil {
+		t.Fatalf("generated code does not parse: %v\n%s", err, buf.String())
+	}
+
+	imports := make(map[string]bool)
+	for _, spec := range file.Imports {
+		imports[strings.Trim(spec.Path.Value, `"`)] = true
+	}
+	for _, path := range []string{"context", "github.com/jackc/pgx/v4", "github.com/jackc/pgtype", "example.com/money", "time"} {
+		if !imports[path] {
+			t.Errorf("Expected import %s, but it was missing", path)
+		}
+	}
+
+	for _, s := range []string{
+		"func CustomerByEmail(ctx context.Context, db Queryer, email string) (*CustomerByEmailRow, error)",
+		"func CustomerIDs(ctx context.Context, db Queryer) ([]CustomerIDsRow, error)",
+		"func DeleteCustomer(ctx context.Context, db Queryer, createdAt time.Time) (int64, error)",
+		"const customerIDsSQL = \"select `id` from customer\"",
+	} {
+		if !strings.Contains(buf.String(), s) {
+			t.Errorf("Expected generated code to contain %q, but it did not", s)
+		}
+	}
+}
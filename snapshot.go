@@ -0,0 +1,305 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+
+	"github.com/spf13/cobra"
+)
+
+// Snapshot is a serialized description of the database that generate can use
+// instead of a live connection.
+type Snapshot struct {
+	SearchPath     []string        `json:"search_path"`
+	Tables         []Table         `json:"tables"`
+	Enums          []Enum          `json:"enums,omitempty"`
+	CompositeTypes []CompositeType `json:"composite_types,omitempty"`
+}
+
+func inspectCmd(cmd *cobra.Command, args []string) {
+	if len(args) != 0 {
+		fmt.Fprintln(os.Stderr, "inspect does not take any arguments")
+		os.Exit(1)
+	}
+
+	c, err := loadConfig("config.toml")
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+
+	conn, err := connectDatabase(c.Database)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+
+	snapshot, err := takeSnapshot(dbCatalog{db: conn}, c)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+
+	var w io.Writer = os.Stdout
+	if outPath, _ := cmd.Flags().GetString("out"); outPath != "" {
+		file, err := os.Create(outPath)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		defer file.Close()
+		w = file
+	}
+
+	err = writeSnapshot(w, snapshot)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+// takeSnapshot reads every table in the schemas referenced by c or the
+// search_path as well as any configured table outside of those schemas and the
+// enum and composite types used by their columns.
+func takeSnapshot(cat catalog, c Config) (*Snapshot, error) {
+	searchPath, err := cat.searchPath()
+	if err != nil {
+		return nil, err
+	}
+
+	schemaSet := make(map[string]struct{})
+	for _, s := range searchPath {
+		schemaSet[s] = struct{}{}
+	}
+	if c.Schema != "" {
+		schemaSet[c.Schema] = struct{}{}
+	}
+	if c.Discover != nil {
+		for _, s := range c.Discover.Schemas {
+			schemaSet[s] = struct{}{}
+		}
+	}
+	for _, t := range c.Tables {
+		if t.Schema != "" {
+			schemaSet[t.Schema] = struct{}{}
+		}
+	}
+
+	schemas := make([]string, 0, len(schemaSet))
+	for s := range schemaSet {
+		schemas = append(schemas, s)
+	}
+	sort.Strings(schemas)
+
+	tableNames, err := cat.tableNames(schemas)
+	if err != nil {
+		return nil, err
+	}
+
+	// Configured tables may be views which are not included in tableNames.
+	for _, t := range c.Tables {
+		schema := t.Schema
+		if schema == "" {
+			schema, err = cat.searchPathSchema(t.TableName)
+			if err != nil {
+				return nil, err
+			}
+		}
+		if !containsTable(tableNames, schema, t.TableName) {
+			tableNames = append(tableNames, Table{Schema: schema, TableName: t.TableName})
+		}
+	}
+
+	snapshot := &Snapshot{SearchPath: searchPath}
+	for _, tn := range tableNames {
+		t, err := cat.table(tn.Schema, tn.TableName)
+		if err != nil {
+			return nil, err
+		}
+		if t == nil {
+			return nil, fmt.Errorf("table %s.%s not found", tn.Schema, tn.TableName)
+		}
+		snapshot.Tables = append(snapshot.Tables, *t)
+
+		if err := snapshot.addUserTypes(cat, t.Columns); err != nil {
+			return nil, err
+		}
+	}
+
+	sort.Slice(snapshot.Tables, func(i, j int) bool {
+		if snapshot.Tables[i].Schema != snapshot.Tables[j].Schema {
+			return snapshot.Tables[i].Schema < snapshot.Tables[j].Schema
+		}
+		return snapshot.Tables[i].TableName < snapshot.Tables[j].TableName
+	})
+	sort.Slice(snapshot.Enums, func(i, j int) bool {
+		if snapshot.Enums[i].Schema != snapshot.Enums[j].Schema {
+			return snapshot.Enums[i].Schema < snapshot.Enums[j].Schema
+		}
+		return snapshot.Enums[i].Name < snapshot.Enums[j].Name
+	})
+	sort.Slice(snapshot.CompositeTypes, func(i, j int) bool {
+		if snapshot.CompositeTypes[i].Schema != snapshot.CompositeTypes[j].Schema {
+			return snapshot.CompositeTypes[i].Schema < snapshot.CompositeTypes[j].Schema
+		}
+		return snapshot.CompositeTypes[i].Name < snapshot.CompositeTypes[j].Name
+	})
+
+	return snapshot, nil
+}
+
+// addUserTypes adds the enum and composite types used by columns that are not
+// already in s.
+func (s *Snapshot) addUserTypes(cat catalog, columns []Column) error {
+	for _, c := range columns {
+		if c.DataType != "USER-DEFINED" || s.enumPtr(c.UDTSchema, c.UDTName) != nil || s.compositeTypePtr(c.UDTSchema, c.UDTName) != nil {
+			continue
+		}
+
+		e, err := cat.enum(c.UDTSchema, c.UDTName)
+		if err != nil {
+			return err
+		}
+		if e != nil {
+			s.Enums = append(s.Enums, *e)
+			continue
+		}
+
+		ct, err := cat.compositeType(c.UDTSchema, c.UDTName)
+		if err != nil {
+			return err
+		}
+		if ct != nil {
+			s.CompositeTypes = append(s.CompositeTypes, *ct)
+			if err := s.addUserTypes(cat, ct.Attributes); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func containsTable(tables []Table, schema, tableName string) bool {
+	for _, t := range tables {
+		if t.Schema == schema && t.TableName == tableName {
+			return true
+		}
+	}
+	return false
+}
+
+func writeSnapshot(w io.Writer, snapshot *Snapshot) error {
+	buf, err := json.MarshalIndent(snapshot, "", "  ")
+	if err != nil {
+		return err
+	}
+	buf = append(buf, '\n')
+
+	_, err = w.Write(buf)
+	return err
+}
+
+func loadSnapshot(path string) (*Snapshot, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	snapshot, err := readSnapshot(file)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %v", path, err)
+	}
+
+	return snapshot, nil
+}
+
+func readSnapshot(r io.Reader) (*Snapshot, error) {
+	var snapshot Snapshot
+	err := json.NewDecoder(r).Decode(&snapshot)
+	if err != nil {
+		return nil, err
+	}
+
+	return &snapshot, nil
+}
+
+func (s *Snapshot) searchPath() ([]string, error) {
+	return s.SearchPath, nil
+}
+
+func (s *Snapshot) searchPathSchema(tableName string) (string, error) {
+	for _, schema := range s.SearchPath {
+		if containsTable(s.Tables, schema, tableName) {
+			return schema, nil
+		}
+	}
+
+	return "", fmt.Errorf("table %s not found in search_path", tableName)
+}
+
+func (s *Snapshot) tableNames(schemas []string) ([]Table, error) {
+	var tables []Table
+	for _, t := range s.Tables {
+		for _, schema := range schemas {
+			if t.Schema == schema {
+				tables = append(tables, Table{Schema: t.Schema, TableName: t.TableName})
+				break
+			}
+		}
+	}
+
+	return tables, nil
+}
+
+func (s *Snapshot) table(schema, tableName string) (*Table, error) {
+	for i := range s.Tables {
+		if s.Tables[i].Schema == schema && s.Tables[i].TableName == tableName {
+			t := s.Tables[i]
+			return &t, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func (s *Snapshot) enum(schema, name string) (*Enum, error) {
+	if e := s.enumPtr(schema, name); e != nil {
+		result := *e
+		return &result, nil
+	}
+
+	return nil, nil
+}
+
+func (s *Snapshot) enumPtr(schema, name string) *Enum {
+	for i := range s.Enums {
+		if s.Enums[i].Schema == schema && s.Enums[i].Name == name {
+			return &s.Enums[i]
+		}
+	}
+	return nil
+}
+
+func (s *Snapshot) compositeType(schema, name string) (*CompositeType, error) {
+	if ct := s.compositeTypePtr(schema, name); ct != nil {
+		result := *ct
+		result.Attributes = append([]Column{}, ct.Attributes...)
+		return &result, nil
+	}
+
+	return nil, nil
+}
+
+func (s *Snapshot) compositeTypePtr(schema, name string) *CompositeType {
+	for i := range s.CompositeTypes {
+		if s.CompositeTypes[i].Schema == schema && s.CompositeTypes[i].Name == name {
+			return &s.CompositeTypes[i]
+		}
+	}
+	return nil
+}
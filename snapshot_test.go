@@ -0,0 +1,108 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+)
+
+func TestSnapshotRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	tx := begin(t)
+	defer tx.Rollback(context.Background())
+
+	c := Config{
+		Tables: []Table{
+			{TableName: "customer", StructName: "Customer"},
+			{TableName: "semester", StructName: "Semester"},
+			{Schema: "billing", TableName: "customer", StructName: "BillingCustomer"},
+			{TableName: "purchase_order", StructName: "PurchaseOrder"},
+		},
+	}
+
+	snapshot, err := takeSnapshot(dbCatalog{tx}, c)
+	if err != nil {
+		t.Fatalf("takeSnapshot unexpectedly failed: %v", err)
+	}
+
+	buf := &bytes.Buffer{}
+	err = writeSnapshot(buf, snapshot)
+	if err != nil {
+		t.Fatalf("writeSnapshot unexpectedly failed: %v", err)
+	}
+
+	loadedSnapshot, err := readSnapshot(buf)
+	if err != nil {
+		t.Fatalf("readSnapshot unexpectedly failed: %v", err)
+	}
+
+	fromDatabase := append([]Table{}, c.Tables...)
+	typesFromDatabase, err := inspectTables(dbCatalog{tx}, fromDatabase, nil)
+	if err != nil {
+		t.Fatalf("inspectTables with database unexpectedly failed: %v", err)
+	}
+
+	fromSnapshot := append([]Table{}, c.Tables...)
+	typesFromSnapshot, err := inspectTables(loadedSnapshot, fromSnapshot, nil)
+	if err != nil {
+		t.Fatalf("inspectTables with snapshot unexpectedly failed: %v", err)
+	}
+
+	if !reflect.DeepEqual(typesFromDatabase, typesFromSnapshot) {
+		t.Errorf("Expected types to be %v, got %v", typesFromDatabase, typesFromSnapshot)
+	}
+	if len(typesFromDatabase.Enums) != 1 || !reflect.DeepEqual(typesFromDatabase.Enums[0].Labels, []string{"pending", "shipped", "delivered", "cancelled"}) {
+		t.Errorf("Expected order_status enum, got %v", typesFromDatabase.Enums)
+	}
+	if len(typesFromDatabase.Composites) != 1 || typesFromDatabase.Composites[0].Name != "address" {
+		t.Errorf("Expected address composite type, got %v", typesFromDatabase.Composites)
+	}
+
+	for i := range fromDatabase {
+		if !reflect.DeepEqual(fromDatabase[i].PrimaryKeyColumnNames, fromSnapshot[i].PrimaryKeyColumnNames) {
+			t.Errorf("%d. Expected PrimaryKeyColumnNames to be %v, got %v", i, fromDatabase[i].PrimaryKeyColumnNames, fromSnapshot[i].PrimaryKeyColumnNames)
+		}
+		if !reflect.DeepEqual(fromDatabase[i].Columns, fromSnapshot[i].Columns) {
+			t.Errorf("%d. Expected Columns to be %v, got %v", i, fromDatabase[i].Columns, fromSnapshot[i].Columns)
+		}
+	}
+}
+
+func TestSnapshotSearchPathSchema(t *testing.T) {
+	t.Parallel()
+
+	snapshot := &Snapshot{
+		SearchPath: []string{"app", "public"},
+		Tables: []Table{
+			{Schema: "public", TableName: "customer"},
+			{Schema: "app", TableName: "customer"},
+			{Schema: "public", TableName: "widget"},
+			{Schema: "billing", TableName: "invoice"},
+		},
+	}
+
+	tests := []struct {
+		tableName string
+		expected  string
+	}{
+		{"customer", "app"},
+		{"widget", "public"},
+	}
+
+	for i, tt := range tests {
+		actual, err := snapshot.searchPathSchema(tt.tableName)
+		if err != nil {
+			t.Errorf("%d. Unexpected error: %v", i, err)
+			continue
+		}
+		if actual != tt.expected {
+			t.Errorf(`%d. Given "%s", expected "%s", but got "%s"`, i, tt.tableName, tt.expected, actual)
+		}
+	}
+
+	if _, err := snapshot.searchPathSchema("invoice"); err == nil {
+		t.Error("Expected table outside of search_path to not be found, but it was")
+	}
+}
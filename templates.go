@@ -18,7 +18,18 @@ func loadTemplates() *template.Template {
 	var decoded []byte
 	var err error
 
-	decoded, err = base64.StdEncoding.DecodeString(`cGFja2FnZSA9ICJ7ey5Qa2dOYW1lfX0iCgojIERhdGFiYXNlIGNvbm5lY3Rpb24gaW5mb3JtYXRpb24gY2FuIGJlIHNwZWNpZmllZCBoZXJlIG9yIGluIFBHKiBlbnZpcm9ubWVudCB2YXJpYWJsZXMKIwojIFtkYXRhYmFzZV0KIyBob3N0ID0gIjEyNy4wLjAuMSIKIyBwb3J0ID0gNTQzMgojIGRhdGFiYXNlID0gIm15YXBwX2RldmVsb3BtZW50IgojIHVzZXIgPSAibXl1c2VyIgojIHBhc3N3b3JkID0gInNlY3JldCIKCltbdGFibGVzXV0KdGFibGVfbmFtZSA9ICJjdXN0b21lciIKIyBzdHJ1Y3RfbmFtZSA9ICJDdXN0b21lciIK`)
+	decoded, err = base64.StdEncoding.DecodeString(`cGFja2FnZSB7ey5Qa2dOYW1lfX0KCi8vIFRoaXMgZmlsZSBpcyBhdXRvbWF0aWNhbGx5IGdlbmVyYXRlZCBieSBwZ3hkYXRhLgoKaW1wb3J0ICgKICAiZ2l0aHViLmNvbS9qYWNrYy9wZ3R5cGUiCiAgZXJyb3JzICJnb2xhbmcub3JnL3gveGVycm9ycyJ7e3JhbmdlIC5JbXBvcnRzfX0KICAie3sufX0ie3tlbmR9fQopCgovLyB7ey5Hb05hbWV9fSBpcyBhIHZhbHVlIG9mIHRoZSBQb3N0Z3JlU1FMIGNvbXBvc2l0ZSB0eXBlIHt7LlF1YWxpZmllZE5hbWV9fS4KdHlwZSB7ey5Hb05hbWV9fSBzdHJ1Y3Qgewp7e3JhbmdlIC5BdHRyaWJ1dGVzfX0gIHt7LkZpZWxkTmFtZX19IHt7LkdvQm94VHlwZX19Cnt7ZW5kfX19CgovLyB7ey5Cb3hUeXBlTmFtZX19IGlzIHRoZSBwZ3R5cGUgY29tcGF0aWJsZSBib3ggZm9yIHt7LkdvTmFtZX19IHVzZWQgaW4gcm93Ci8vIHN0cnVjdHMuIFZhbHVlcyBhcmUgdHJhbnNmZXJyZWQgaW4gdGhlIHRleHQgZm9ybWF0IG9mIGNvbXBvc2l0ZSB0eXBlcyBzbwovLyBhbGwgYXR0cmlidXRlcyBtdXN0IGJlIFByZXNlbnQgb3IgTnVsbCB3aGVuIGVuY29kaW5nLgp0eXBlIHt7LkJveFR5cGVOYW1lfX0gc3RydWN0IHsKICBWYWx1ZSAge3suR29OYW1lfX0KICBTdGF0dXMgcGd0eXBlLlN0YXR1cwp9CgpmdW5jIChkc3QgKnt7LkJveFR5cGVOYW1lfX0pIFNldChzcmMgaW50ZXJmYWNle30pIGVycm9yIHsKICBpZiBzcmMgPT0gbmlsIHsKICAgICpkc3QgPSB7ey5Cb3hUeXBlTmFtZX19e1N0YXR1czogcGd0eXBlLk51bGx9CiAgICByZXR1cm4gbmlsCiAgfQoKICBzd2l0Y2ggdmFsdWUgOj0gc3JjLih0eXBlKSB7CiAgY2FzZSB7ey5Hb05hbWV9fToKICAgICpkc3QgPSB7ey5Cb3hUeXBlTmFtZX19e1ZhbHVlOiB2YWx1ZSwgU3RhdHVzOiBwZ3R5cGUuUHJlc2VudH0KICBjYXNlICp7ey5Hb05hbWV9fToKICAgIGlmIHZhbHVlID09IG5pbCB7CiAgICAgICpkc3QgPSB7ey5Cb3hUeXBlTmFtZX19e1N0YXR1czogcGd0eXBlLk51bGx9CiAgICB9IGVsc2UgewogICAgICAqZHN0ID0ge3suQm94VHlwZU5hbWV9fXtWYWx1ZTogKnZhbHVlLCBTdGF0dXM6IHBndHlwZS5QcmVzZW50fQogICAgfQogIGRlZmF1bHQ6CiAgICByZXR1cm4gZXJyb3JzLkVycm9yZigiY2Fubm90IGNvbnZlcnQgJXYgdG8ge3suR29OYW1lfX0iLCB2YWx1ZSkKICB9CgogIHJldHVybiBuaWwKfQoKZnVuYyAoZHN0ICp7ey5Cb3hUeXBlTmFtZX19KSBHZXQoKSBpbnRlcmZhY2V7fSB7CiAgc3dpdGNoIGRzdC5TdGF0dXMgewogIGNhc2UgcGd0eXBlLlByZXNlbnQ6CiAgICByZXR1cm4gZHN0LlZhbHVlCiAgY2FzZSBwZ3R5cGUuTnVsbDoKICAgIHJldHVybiBuaWwKICBkZWZhdWx0OgogICAgcmV0dXJuIGRzdC5TdGF0dXMKICB9Cn0KCmZ1bmMgKHNyYyAqe3suQm94VHlwZU5hbWV9fSkgQXNzaWduVG8oZHN0IGludGVyZmFjZXt9KSBlcnJvciB7CiAgc3dpdGNoIHNyYy5TdGF0dXMgewogIGNhc2UgcGd0eXBlLlByZXNlbnQ6CiAgICBzd2l0Y2ggdiA6PSBkc3QuKHR5cGUpIHsKICAgIGNhc2UgKnt7LkdvTmFtZX19OgogICAgICAqdiA9IHNyYy5WYWx1ZQogICAgZGVmYXVsdDoKICAgICAgcmV0dXJuIGVycm9ycy5FcnJvcmYoInVuYWJsZSB0byBhc3NpZ24gdG8gJVQiLCBkc3QpCiAgICB9CiAgICByZXR1cm4gbmlsCiAgY2FzZSBwZ3R5cGUuTnVsbDoKICAgIHJldHVybiBwZ3R5cGUuTnVsbEFzc2lnblRvKGRzdCkKICB9CgogIHJldHVybiBlcnJvcnMuRXJyb3JmKCJjYW5ub3QgYXNzaWduICV2IGludG8gJVQiLCBzcmMsIGRzdCkKfQoKZnVuYyAoZHN0ICp7ey5Cb3hUeXBlTmFtZX19KSBEZWNvZGVUZXh0KGNpICpwZ3R5cGUuQ29ubkluZm8sIHNyYyBbXWJ5dGUpIGVycm9yIHsKICBpZiBzcmMgPT0gbmlsIHsKICAgICpkc3QgPSB7ey5Cb3hUeXBlTmFtZX19e1N0YXR1czogcGd0eXBlLk51bGx9CiAgICByZXR1cm4gbmlsCiAgfQoKICBmaWVsZHMsIGVyciA6PSBwYXJzZUNvbXBvc2l0ZVRleHQoc3JjKQogIGlmIGVyciAhPSBuaWwgewogICAgcmV0dXJuIGVycgogIH0KICBpZiBsZW4oZmllbGRzKSAhPSB7e2xlbiAuQXR0cmlidXRlc319IHsKICAgIHJldHVybiBlcnJvcnMuRXJyb3JmKCJleHBlY3RlZCB7e2xlbiAuQXR0cmlidXRlc319IGZpZWxkcyBmb3Ige3suUXVhbGlmaWVkTmFtZX19LCBnb3QgJWQiLCBsZW4oZmllbGRzKSkKICB9CgogIHZhciB2IHt7LkdvTmFtZX19Cnt7cmFuZ2UgJGksICRhIDo9IC5BdHRyaWJ1dGVzfX0gIGlmIGVyciA6PSB2Lnt7JGEuRmllbGROYW1lfX0uRGVjb2RlVGV4dChjaSwgZmllbGRzW3t7JGl9fV0pOyBlcnIgIT0gbmlsIHsKICAgIHJldHVybiBlcnIKICB9Cnt7ZW5kfX0KICAqZHN0ID0ge3suQm94VHlwZU5hbWV9fXtWYWx1ZTogdiwgU3RhdHVzOiBwZ3R5cGUuUHJlc2VudH0KICByZXR1cm4gbmlsCn0KCi8vIERlY29kZUJpbmFyeSBpcyB0aGUgc2FtZSBhcyBEZWNvZGVUZXh0IGFzIGNvbXBvc2l0ZSB0eXBlcyBhcmUgc2VsZWN0ZWQgYXMKLy8gdGV4dCB3aGljaCBoYXMgdGhlIHNhbWUgdGV4dCBhbmQgYmluYXJ5IGZvcm1hdC4KZnVuYyAoZHN0ICp7ey5Cb3hUeXBlTmFtZX19KSBEZWNvZGVCaW5hcnkoY2kgKnBndHlwZS5Db25uSW5mbywgc3JjIFtdYnl0ZSkgZXJyb3IgewogIHJldHVybiBkc3QuRGVjb2RlVGV4dChjaSwgc3JjKQp9CgpmdW5jIChzcmMgKnt7LkJveFR5cGVOYW1lfX0pIEVuY29kZVRleHQoY2kgKnBndHlwZS5Db25uSW5mbywgYnVmIFtdYnl0ZSkgKFtdYnl0ZSwgZXJyb3IpIHsKICBzd2l0Y2ggc3JjLlN0YXR1cyB7CiAgY2FzZSBwZ3R5cGUuTnVsbDoKICAgIHJldHVybiBuaWwsIG5pbAogIGNhc2UgcGd0eXBlLlVuZGVmaW5lZDoKICAgIHJldHVybiBuaWwsIGVycm9ycy5OZXcoImNhbm5vdCBlbmNvZGUgc3RhdHVzIHVuZGVmaW5lZCIpCiAgfQoKICByZXR1cm4gYXBwZW5kQ29tcG9zaXRlVGV4dChjaSwgYnVmLHt7cmFuZ2UgLkF0dHJpYnV0ZXN9fQogICAgJnNyYy5WYWx1ZS57ey5GaWVsZE5hbWV9fSx7e2VuZH19CiAgKQp9Cg==`)
+	if err != nil {
+		panic("Unable to decode template")
+	}
+
+	_, err = templates.New(`composite`).Parse(string(decoded))
+	if err != nil {
+		fmt.Println(string(decoded))
+		panic("Unable to parse template")
+	}
+
+	decoded, err = base64.StdEncoding.DecodeString(`cGFja2FnZSA9ICJ7ey5Qa2dOYW1lfX0iCgojIFRhYmxlcyBhcmUgZm91bmQgdGhyb3VnaCB0aGUgc2VhcmNoX3BhdGggdW5sZXNzIGEgc2NoZW1hIGlzIHNwZWNpZmllZC4gU1FMIGZvciB0YWJsZXMgd2l0aCBhCiMgc2NoZW1hIGlzIGdlbmVyYXRlZCB3aXRoIHNjaGVtYS1xdWFsaWZpZWQgdGFibGUgbmFtZXMuIHNjaGVtYSBtYXkgYWxzbyBiZSBzZXQgcGVyIHRhYmxlLgojCiMgc2NoZW1hID0gInB1YmxpYyIKCiMgUm93IHN0cnVjdCBmaWVsZHMgdXNlIHBndHlwZSBib3ggdHlwZXMgYnkgZGVmYXVsdC4gV2l0aCBmaWVsZF9zdHlsZSA9ICJnbyIsIGNvbHVtbnMgd2l0aCBhIEdvCiMgdHlwZSB1c2UgaXQgZGlyZWN0bHkgZm9yIE5PVCBOVUxMIGNvbHVtbnMgYW5kIGEgcG9pbnRlciBmb3IgbnVsbGFibGUgY29sdW1ucy4gSW5zZXJ0IGFuZAojIFVwZGF0ZSB0aGVuIHRha2UgdGhlIGZpZWxkcyB0byB3cml0ZS4gZmllbGRfc3R5bGUgbWF5IGFsc28gYmUgc2V0IHBlciB0YWJsZS4KIwojIGZpZWxkX3N0eWxlID0gImdvIgoKIyBEYXRhYmFzZSBjb25uZWN0aW9uIGluZm9ybWF0aW9uIGNhbiBiZSBzcGVjaWZpZWQgaGVyZSBvciBpbiBQRyogZW52aXJvbm1lbnQgdmFyaWFibGVzLgojIEFueSB2YWx1ZXMgbm90IHNwZWNpZmllZCBoZXJlIGFyZSB0YWtlbiBmcm9tIHRoZSBQRyogZW52aXJvbm1lbnQgdmFyaWFibGVzLiBTdHJpbmcgdmFsdWVzCiMgbWF5IHJlZmVyZW5jZSBlbnZpcm9ubWVudCB2YXJpYWJsZXMgd2l0aCAke05BTUV9LgojCiMgW2RhdGFiYXNlXQojIGhvc3QgPSAiMTI3LjAuMC4xIgojIHBvcnQgPSA1NDMyCiMgZGF0YWJhc2UgPSAibXlhcHBfZGV2ZWxvcG1lbnQiCiMgdXNlciA9ICJteXVzZXIiCiMgcGFzc3dvcmQgPSAiJHtNWUFQUF9EQVRBQkFTRV9QQVNTV09SRH0iCiMKIyBBbHRlcm5hdGl2ZWx5LCBhIGNvbXBsZXRlIGNvbm5lY3Rpb24gc3RyaW5nIG1heSBiZSB1c2VkIGluc3RlYWQgb2YgdGhlIGluZGl2aWR1YWwgdmFsdWVzLgojCiMgW2RhdGFiYXNlXQojIGNvbm5lY3Rpb25fc3RyaW5nID0gInBvc3RncmVzOi8vbXl1c2VyOiR7TVlBUFBfREFUQUJBU0VfUEFTU1dPUkR9QDEyNy4wLjAuMTo1NDMyL215YXBwX2RldmVsb3BtZW50IgoKIyBUYWJsZXMgY2FuIGJlIGRpc2NvdmVyZWQgZnJvbSB0aGUgZGF0YWJhc2UgaW5zdGVhZCBvZiBsaXN0aW5nIGVhY2ggb25lLiBQYXR0ZXJucyBhcmUgZ2xvYnMKIyBtYXRjaGVkIGFnYWluc3QgdGhlIHRhYmxlIG5hbWUgb3IgdGhlIHNjaGVtYS1xdWFsaWZpZWQgdGFibGUgbmFtZS4gW1t0YWJsZXNdXSBlbnRyaWVzCiMgb3ZlcnJpZGUgdGhlIHNldHRpbmdzIGZvciBkaXNjb3ZlcmVkIHRhYmxlcyB3aXRoIHRoZSBzYW1lIG5hbWUuCiMKIyBbZGlzY292ZXJdCiMgc2NoZW1hcyA9IFsicHVibGljIl0KIyBpbmNsdWRlID0gWyIqIl0KIyBleGNsdWRlID0gWyJzY2hlbWFfbWlncmF0aW9ucyIsICIqX2FyY2hpdmUiXQoKIyBQb3N0Z3JlU1FMIHR5cGVzIGNhbiBiZSBtYXBwZWQgdG8gY3VzdG9tIEdvIHR5cGVzLiBUaGUgbWFwcGluZyBjYW4gYXBwbHkgdG8gYWxsIGNvbHVtbnMgb2YgYQojIFBvc3RncmVTUUwgdHlwZSBvciB0byBhIHNpbmdsZSBjb2x1bW4gZ2l2ZW4gYXMgdGFibGUuY29sdW1uIG9yIHNjaGVtYS50YWJsZS5jb2x1bW4uCiMgZ29fYm94X3R5cGUgaXMgdXNlZCBmb3Igcm93IHN0cnVjdCBmaWVsZHMgYW5kIG11c3QgaGF2ZSBhIFN0YXR1cyBmaWVsZCBsaWtlIHRoZSBwZ3R5cGUgdHlwZXMuCiMgZ29fdHlwZSBpcyB1c2VkIGZvciBwcmltYXJ5IGtleSBwYXJhbWV0ZXJzLiBpbXBvcnQgaXMgdGhlIGltcG9ydCBwYXRoIG9mIHRoZSBnb19ib3hfdHlwZSBwYWNrYWdlLgojIGdvX3R5cGVfaW1wb3J0IGlzIG9ubHkgbmVlZGVkIHdoZW4gZ29fdHlwZSBpcyBmcm9tIGEgZGlmZmVyZW50IHBhY2thZ2UuIEFycmF5IHR5cGVzIGFyZQojIG5hbWVkIGJ5IHRoZWlyIGVsZW1lbnQgdHlwZSBwcmVmaXhlZCB3aXRoIGFuIHVuZGVyc2NvcmUsIGUuZy4gIl9pbnQ0Ii4gQ29sdW1ucyBvZiBhIGRvbWFpbiB1c2UKIyB0aGUgbWFwcGluZyBvZiB0aGUgZG9tYWluIG5hbWUgaWYgdGhlcmUgaXMgb25lIGFuZCBvdGhlcndpc2UgdGhhdCBvZiB0aGUgZG9tYWluJ3MgYmFzZSB0eXBlLgojCiMgW1t0eXBlc11dCiMgcGdfdHlwZSA9ICJudW1lcmljIgojIGdvX2JveF90eXBlID0gInNob3BzcHJpbmcuTnVtZXJpYyIKIyBpbXBvcnQgPSAiZ2l0aHViLmNvbS9qYWNrYy9wZ3gtc2hvcHNwcmluZy1kZWNpbWFsIgojIGdvX3R5cGUgPSAiZGVjaW1hbC5EZWNpbWFsIgojIGdvX3R5cGVfaW1wb3J0ID0gImdpdGh1Yi5jb20vc2hvcHNwcmluZy9kZWNpbWFsIgojCiMgW1t0eXBlc11dCiMgY29sdW1uID0gImludm9pY2UudG90YWwiCiMgZ29fYm94X3R5cGUgPSAibW9uZXkuTW9uZXkiCiMgaW1wb3J0ID0gImV4YW1wbGUuY29tL215YXBwL21vbmV5IgoKIyBFYWNoIC5zcWwgZmlsZSBpbiB0aGUgcXVlcmllcyBkaXJlY3RvcnkgZ2VuZXJhdGVzIGEgR28gZmlsZSB3aXRoIGEgdHlwZWQgZnVuY3Rpb24gZm9yIGVhY2gKIyBxdWVyeSBhbm5vdGF0ZWQgd2l0aCAiLS0gbmFtZTogRmluZEN1c3RvbWVycyA6bWFueSIuIFRoZSBjb21tYW5kIGlzIDpvbmUsIDptYW55IG9yIDpleGVjLiBBbgojIG9wdGlvbmFsICItLSBwYXJhbXM6IGVtYWlsLCBjcmVhdGVkX2F0IiBsaW5lIGFmdGVyIHRoZSBuYW1lIG5hbWVzIHRoZSBwYXJhbWV0ZXJzLiBRdWVyaWVzIGFyZQojIHByZXBhcmVkIHRvIGxlYXJuIHRoZWlyIHR5cGVzLCBzbyB0aGV5IG5lZWQgYSBkYXRhYmFzZSBjb25uZWN0aW9uLgojCiMgcXVlcmllcyA9ICJxdWVyaWVzIgoKIyBGdW5jdGlvbnMgYW5kIHByb2NlZHVyZXMgZ2V0IHR5cGVkIHdyYXBwZXJzLiBUaGUgYXJndW1lbnRzIGFuZCByZXN1bHRzIGFyZSByZWFkIGZyb20gcGdfcHJvYy4KIyBGdW5jdGlvbnMgcmV0dXJuaW5nIHRoZSByb3cgdHlwZSBvZiBhIFtbdGFibGVzXV0gZW50cnkgcmV0dXJuIGl0cyBzdHJ1Y3QsIGZ1bmN0aW9ucyB3aXRoIE9VVAojIHBhcmFtZXRlcnMgb3IgUkVUVVJOUyBUQUJMRSByZXR1cm4gYSBnZW5lcmF0ZWQgPEdvTmFtZT5Sb3cgc3RydWN0IGFuZCBvdGhlciBmdW5jdGlvbnMgcmV0dXJuCiMgdGhlaXIgdmFsdWUuIHNldG9mIGZ1bmN0aW9ucyByZXR1cm4gYSBzbGljZS4gZ29fbmFtZSBkZWZhdWx0cyB0byB0aGUgZnVuY3Rpb24gbmFtZSBpbiBHbyBjYXNlLgojIExpa2UgcXVlcmllcywgZnVuY3Rpb25zIG5lZWQgYSBkYXRhYmFzZSBjb25uZWN0aW9uLgojCiMgW1tmdW5jdGlvbnNdXQojIGZ1bmN0aW9uX25hbWUgPSAiY3VzdG9tZXJfYmFsYW5jZSIKIyBnb19uYW1lID0gIkN1c3RvbWVyQmFsYW5jZSIKCltbdGFibGVzXV0KdGFibGVfbmFtZSA9ICJjdXN0b21lciIKIyBzY2hlbWEgPSAicHVibGljIgojIHN0cnVjdF9uYW1lID0gIkN1c3RvbWVyIgojIGZpZWxkX3N0eWxlID0gImdvIgojCiMgVGhlIHByaW1hcnkga2V5IGlzIHJlYWQgZnJvbSB0aGUgZGF0YWJhc2UuIHByaW1hcnlfa2V5IG9ubHkgbmVlZHMgdG8gYmUgc3BlY2lmaWVkIGZvciB0YWJsZXMKIyBhbmQgdmlld3Mgd2l0aG91dCBhIHByaW1hcnkga2V5IGNvbnN0cmFpbnQuCiMgcHJpbWFyeV9rZXkgPSBbImlkIl0KIwojIEluc2VydCBhbmQgVXBkYXRlIHJldHVybiBldmVyeSBjb2x1bW4gYW5kIHNjYW4gaXQgaW50byB0aGUgcm93LiByZXR1cm5pbmcgbGltaXRzIHRoZW0gdG8gdGhlCiMgZ2l2ZW4gY29sdW1ucyBhbmQgdGhlIHByaW1hcnkga2V5LgojIHJldHVybmluZyA9IFsiY3JlYXRpb25fdGltZSJdCiMKIyBTZWxlY3Q8U3RydWN0PlBhZ2UgcGFnZXMgdGhyb3VnaCB0aGUgcm93cyBpbiBwcmltYXJ5IGtleSBvcmRlci4gcGFnZV9vcmRlcmluZ3MgYWRkcwojIFNlbGVjdDxTdHJ1Y3Q+UGFnZUJ5PEZpZWxkcz4gZnVuY3Rpb25zIGZvciBvdGhlciBvcmRlcnMuIEVhY2ggb3JkZXIgbXVzdCBiZSBvbiBub3QgbnVsbCBjb2x1bW5zCiMgdGhhdCBsZWFkIGEgYnRyZWUgaW5kZXguIFRoZSBwcmltYXJ5IGtleSBpcyBhcHBlbmRlZCB0byBtYWtlIHRoZSBvcmRlciB1bmlxdWUuCiMgcGFnZV9vcmRlcmluZ3MgPSBbWyJsYXN0X25hbWUiLCAiZmlyc3RfbmFtZSJdXQo=`)
 	if err != nil {
 		panic("Unable to decode template")
 	}
@@ -29,7 +40,18 @@ func loadTemplates() *template.Template {
 		panic("Unable to parse template")
 	}
 
-	decoded, err = base64.StdEncoding.DecodeString(`Y29uc3QgY291bnR7ey5TdHJ1Y3ROYW1lfX1TUUwgPSBgc2VsZWN0IGNvdW50KCopIGZyb20gInt7LlRhYmxlTmFtZX19ImAKCmZ1bmMgQ291bnR7ey5TdHJ1Y3ROYW1lfX0oY3R4IGNvbnRleHQuQ29udGV4dCwgZGIgUXVlcnllcikgKGludDY0LCBlcnJvcikgewogIHZhciBuIGludDY0CiAgZXJyIDo9IHByZXBhcmVRdWVyeVJvdyhjdHgsIGRiLCAicGd4ZGF0YUNvdW50e3suU3RydWN0TmFtZX19IiwgY291bnR7ey5TdHJ1Y3ROYW1lfX1TUUwpLlNjYW4oJm4pCiAgcmV0dXJuIG4sIGVycgp9Cg==`)
+	decoded, err = base64.StdEncoding.DecodeString(`Ly8gaW5zZXJ0e3suU3RydWN0TmFtZX19Q29sdW1ucyByZXR1cm5zIHRoZSBjb2x1bW5zIHt7aWYgLkdvU3R5bGV9fW9mIGZpZWxkc3t7ZWxzZX19b2Ygcm93IHRoYXQgYXJlIG5vdCBVbmRlZmluZWR7e2VuZH19IGFuZCB0aGVpcgovLyB2YWx1ZXMgZm9yIGluc2VydGluZyByb3cuCmZ1bmMgaW5zZXJ0e3suU3RydWN0TmFtZX19Q29sdW1ucyhyb3cgKnt7LlN0cnVjdE5hbWV9fXt7aWYgLkdvU3R5bGV9fSwgZmllbGRzIFtde3suU3RydWN0TmFtZX19RmllbGR7e2VuZH19KSAoW11zdHJpbmcsIFtdaW50ZXJmYWNle30sIGVycm9yKSB7CiAgdmFyIGNvbHVtbnMgW11zdHJpbmcKICB2YXIgdmFsdWVzIFtdaW50ZXJmYWNle30KCnt7aWYgLkdvU3R5bGV9fSAgZm9yIF8sIGYgOj0gcmFuZ2UgZmllbGRzIHsKICAgIHN3aXRjaCBmIHsKe3tyYW5nZSAuQ29sdW1uc319ICAgIGNhc2Uge3skLlN0cnVjdE5hbWV9fXt7LkZpZWxkTmFtZX19RmllbGQ6e3tpZiAuR2VuZXJhdGVkQWx3YXlzfX0KICAgICAgcmV0dXJuIG5pbCwgbmlsLCBlcnJvcnMuTmV3KCJ7ey5Db2x1bW5OYW1lfX0gaXMgZ2VuZXJhdGVkIGFuZCBjYW5ub3QgYmUgc2V0Iil7e2Vsc2V9fQogICAgICBjb2x1bW5zID0gYXBwZW5kKGNvbHVtbnMsIGB7ey5Db2x1bW5OYW1lfX1gKQogICAgICB2YWx1ZXMgPSBhcHBlbmQodmFsdWVzLCB7ey5GaWVsZEFyZ319KXt7ZW5kfX0Ke3tlbmR9fSAgICBkZWZhdWx0OgogICAgICByZXR1cm4gbmlsLCBuaWwsIGVycm9ycy5FcnJvcmYoInVua25vd24ge3suU3RydWN0TmFtZX19RmllbGQgJWQiLCBmKQogICAgfQogIH0Ke3tlbHNlfX17e3JhbmdlIC5Db2x1bW5zfX17e2lmIG5vdCAuR2VuZXJhdGVkQWx3YXlzfX0gIGlmIHJvdy57ey5GaWVsZE5hbWV9fS5TdGF0dXMgIT0gcGd0eXBlLlVuZGVmaW5lZCB7CiAgICBjb2x1bW5zID0gYXBwZW5kKGNvbHVtbnMsIGB7ey5Db2x1bW5OYW1lfX1gKQogICAgdmFsdWVzID0gYXBwZW5kKHZhbHVlcywgJnJvdy57ey5GaWVsZE5hbWV9fSkKICB9Cnt7ZW5kfX17e2VuZH19e3tlbmR9fQogIHJldHVybiBjb2x1bW5zLCB2YWx1ZXMsIG5pbAp9CgovLyBDb3B5SW5zZXJ0e3suU3RydWN0TmFtZX19IGluc2VydHMgcm93cyB3aXRoIHRoZSBQb3N0Z3JlU1FMIGNvcHkgcHJvdG9jb2wgYW5kCi8vIHJldHVybnMgdGhlIG51bWJlciBvZiByb3dzIGNvcGllZC4gVGhlIGNvbHVtbnMgY29waWVkIGFyZSB0aG9zZSB7e2lmIC5Hb1N0eWxlfX1vZiBmaWVsZHMue3tlbHNlfX1ub3QKLy8gVW5kZWZpbmVkIGluIHRoZSBmaXJzdCByb3cgYW5kIGV2ZXJ5IHJvdyBtdXN0IHNldCB0aGUgc2FtZSBmaWVsZHMue3tlbmR9fSBWYWx1ZXMKLy8gbXVzdCBzdXBwb3J0IHRoZSBiaW5hcnkgZm9ybWF0Lnt7aWYgLkNvcHlVbnN1cHBvcnRlZENvbHVtbnN9fSB7e3JhbmdlICRpLCAkYyA6PSAuQ29weVVuc3VwcG9ydGVkQ29sdW1uc319e3tpZiAkaX19LCB7e2VuZH19e3skYy5Db2x1bW5OYW1lfX17e2VuZH19IHt7aWYgZXEgKGxlbiAuQ29weVVuc3VwcG9ydGVkQ29sdW1ucykgMX19aGFze3tlbHNlfX1oYXZle3tlbmR9fSBubwovLyBiaW5hcnkgZm9ybWF0IGFuZCBtdXN0IGJlIGluc2VydGVkIHdpdGggSW5zZXJ0TWFueXt7LlN0cnVjdE5hbWV9fS57e2VuZH19CmZ1bmMgQ29weUluc2VydHt7LlN0cnVjdE5hbWV9fShjdHggY29udGV4dC5Db250ZXh0LCBkYiBDb3B5RnJvbWVyLCByb3dzIFtde3suU3RydWN0TmFtZX19e3tpZiAuR29TdHlsZX19LCBmaWVsZHMgLi4ue3suU3RydWN0TmFtZX19RmllbGR7e2VuZH19KSAoaW50NjQsIGVycm9yKSB7CiAgaWYgbGVuKHJvd3MpID09IDAgewogICAgcmV0dXJuIDAsIG5pbAogIH0KCiAgY29sdW1ucywgXywgZXJyIDo9IGluc2VydHt7LlN0cnVjdE5hbWV9fUNvbHVtbnMoJnJvd3NbMF17e2lmIC5Hb1N0eWxlfX0sIGZpZWxkc3t7ZW5kfX0pCiAgaWYgZXJyICE9IG5pbCB7CiAgICByZXR1cm4gMCwgZXJyCiAgfQp7e2lmIC5Db3B5VW5zdXBwb3J0ZWRDb2x1bW5zfX0KICBmb3IgXywgY29sdW1uIDo9IHJhbmdlIGNvbHVtbnMgewogICAgc3dpdGNoIGNvbHVtbiB7CiAgICBjYXNlIHt7cmFuZ2UgJGksICRjIDo9IC5Db3B5VW5zdXBwb3J0ZWRDb2x1bW5zfX17e2lmICRpfX0sIHt7ZW5kfX1ge3skYy5Db2x1bW5OYW1lfX1ge3tlbmR9fToKICAgICAgcmV0dXJuIDAsIGVycm9ycy5FcnJvcmYoImNvbHVtbiAlcyBoYXMgbm8gYmluYXJ5IGZvcm1hdCBmb3IgdGhlIGNvcHkgcHJvdG9jb2wsIHVzZSBJbnNlcnRNYW55e3suU3RydWN0TmFtZX19IiwgY29sdW1uKQogICAgfQogIH0Ke3tlbmR9fQogIHJldHVybiBkYi5Db3B5RnJvbShjdHgsIHBneC5JZGVudGlmaWVye3suVGFibGVJZGVudGlmaWVyfX0sIGNvbHVtbnMsICZjb3B5RnJvbXt7LlN0cnVjdE5hbWV9fVNvdXJjZXtyb3dzOiByb3dzLCBjb2x1bW5zOiBjb2x1bW5ze3tpZiAuR29TdHlsZX19LCBmaWVsZHM6IGZpZWxkc3t7ZW5kfX0sIGlkeDogLTF9KQp9CgovLyBjb3B5RnJvbXt7LlN0cnVjdE5hbWV9fVNvdXJjZSBpcyBhIHBneC5Db3B5RnJvbVNvdXJjZSBvZiB0aGUgY29sdW1ucyBvZiByb3dzLgp0eXBlIGNvcHlGcm9te3suU3RydWN0TmFtZX19U291cmNlIHN0cnVjdCB7CiAgcm93cyAgICBbXXt7LlN0cnVjdE5hbWV9fQogIGNvbHVtbnMgW11zdHJpbmd7e2lmIC5Hb1N0eWxlfX0KICBmaWVsZHMgIFtde3suU3RydWN0TmFtZX19RmllbGR7e2VuZH19CiAgaWR4ICAgICBpbnQKfQoKZnVuYyAocyAqY29weUZyb217ey5TdHJ1Y3ROYW1lfX1Tb3VyY2UpIE5leHQoKSBib29sIHsKICBzLmlkeCsrCiAgcmV0dXJuIHMuaWR4IDwgbGVuKHMucm93cykKfQoKZnVuYyAocyAqY29weUZyb217ey5TdHJ1Y3ROYW1lfX1Tb3VyY2UpIFZhbHVlcygpIChbXWludGVyZmFjZXt9LCBlcnJvcikgewogIGNvbHVtbnMsIHZhbHVlcywgZXJyIDo9IGluc2VydHt7LlN0cnVjdE5hbWV9fUNvbHVtbnMoJnMucm93c1tzLmlkeF17e2lmIC5Hb1N0eWxlfX0sIHMuZmllbGRze3tlbmR9fSkKICBpZiBlcnIgIT0gbmlsIHsKICAgIHJldHVybiBuaWwsIGVycgogIH0KICBpZiAhZXF1YWxDb2x1bW5zKGNvbHVtbnMsIHMuY29sdW1ucykgewogICAgcmV0dXJuIG5pbCwgZXJyb3JzLkVycm9yZigicm93ICVkIGRvZXMgbm90IHNldCB0aGUgc2FtZSBmaWVsZHMgYXMgcm93IDAiLCBzLmlkeCkKICB9Cnt7aWYgLkNvcHlDb252ZXJ0Q29sdW1uc319ICBmb3IgaSwgY29sdW1uIDo9IHJhbmdlIGNvbHVtbnMgewogICAgaWYgdmFsdWVzW2ldLCBlcnIgPSBjb3B5e3suU3RydWN0TmFtZX19VmFsdWUoY29sdW1uLCB2YWx1ZXNbaV0pOyBlcnIgIT0gbmlsIHsKICAgICAgcmV0dXJuIG5pbCwgZXJyCiAgICB9CiAgfQp7e2VuZH19ICByZXR1cm4gdmFsdWVzLCBuaWwKfQoKZnVuYyAocyAqY29weUZyb217ey5TdHJ1Y3ROYW1lfX1Tb3VyY2UpIEVycigpIGVycm9yIHsKICByZXR1cm4gbmlsCn0Ke3tpZiAuQ29weUNvbnZlcnRDb2x1bW5zfX0KLy8gY29weXt7LlN0cnVjdE5hbWV9fVZhbHVlIGNvbnZlcnRzIHZhbHVlLCB0aGUgdmFsdWUgb2YgY29sdW1uIHJldHVybmVkIGJ5Ci8vIGluc2VydHt7LlN0cnVjdE5hbWV9fUNvbHVtbnMsIHRvIG9uZSBwZ3ggc2VuZHMgaW4gdGhlIGJpbmFyeSBmb3JtYXQgb2YgdGhlCi8vIGNvcHkgcHJvdG9jb2wuCmZ1bmMgY29weXt7LlN0cnVjdE5hbWV9fVZhbHVlKGNvbHVtbiBzdHJpbmcsIHZhbHVlIGludGVyZmFjZXt9KSAoaW50ZXJmYWNle30sIGVycm9yKSB7CiAgc3dpdGNoIGNvbHVtbiB7Cnt7cmFuZ2UgLkNvcHlDb252ZXJ0Q29sdW1uc319ICBjYXNlIGB7ey5Db2x1bW5OYW1lfX1gOgp7e2lmIGVxIC5Db3B5VmFsdWUgImxhYmVsIn19e3tpZiBub3QgLkdvRmllbGR9fSAgICB2IDo9IHZhbHVlLigqe3suR29Cb3hUeXBlfX0pCiAgICBpZiB2LlN0YXR1cyAhPSBwZ3R5cGUuUHJlc2VudCB7CiAgICAgIHJldHVybiBuaWwsIG5pbAogICAgfQogICAgcmV0dXJuIHN0cmluZyh2LlZhbHVlKSwgbmlsCnt7ZWxzZSBpZiAuTm90TnVsbH19ICAgIHJldHVybiBzdHJpbmcodmFsdWUuKHt7LkdvVHlwZX19KSksIG5pbAp7e2Vsc2V9fSAgICB2IDo9IHZhbHVlLigqe3suR29UeXBlfX0pCiAgICBpZiB2ID09IG5pbCB7CiAgICAgIHJldHVybiBuaWwsIG5pbAogICAgfQogICAgcmV0dXJuIHN0cmluZygqdiksIG5pbAp7e2VuZH19e3tlbHNlfX17e2lmIC5Ob3ROdWxsfX0gICAgdiA6PSB2YWx1ZS4oc3RyaW5nKQp7e2Vsc2V9fSAgICBwIDo9IHZhbHVlLigqc3RyaW5nKQogICAgaWYgcCA9PSBuaWwgewogICAgICByZXR1cm4gbmlsLCBuaWwKICAgIH0KICAgIHYgOj0gKnAKe3tlbmR9fSAgICBuIDo9ICZwZ3R5cGUuTnVtZXJpY3t9CiAgICBlcnIgOj0gbi5TZXQodikKICAgIHJldHVybiBuLCBlcnIKe3tlbmR9fXt7ZW5kfX0gIH0KICByZXR1cm4gdmFsdWUsIG5pbAp9Cnt7ZW5kfX0KLy8gaW5zZXJ0TWFueXt7LlN0cnVjdE5hbWV9fVR5cGVzIGFyZSB0aGUgdHlwZXMgdGhlIHBhcmFtZXRlcnMgb2YKLy8gSW5zZXJ0TWFueXt7LlN0cnVjdE5hbWV9fSBhcmUgY2FzdCB0by4gUG9zdGdyZVNRTCBvbmx5IGluZmVycyB0aGVtIGZvciB0aGUKLy8gVkFMVUVTIGxpc3Qgb2YgYW4gSU5TRVJULCBub3QgZm9yIG9uZSB0aGF0IGlzIHNlbGVjdGVkIGZyb20uCnZhciBpbnNlcnRNYW55e3suU3RydWN0TmFtZX19VHlwZXMgPSBtYXBbc3RyaW5nXXN0cmluZ3sKe3tyYW5nZSAuQ29sdW1uc319e3tpZiBub3QgLkdlbmVyYXRlZEFsd2F5c319ICBge3suQ29sdW1uTmFtZX19YDogYHt7LlNRTFR5cGV9fWAsCnt7ZW5kfX17e2VuZH19fQoKLy8gSW5zZXJ0TWFueXt7LlN0cnVjdE5hbWV9fSBpbnNlcnRzIHJvd3Mgd2l0aCBtdWx0aS1yb3cgSU5TRVJUIHN0YXRlbWVudHMgZm9yCi8vIGEgUXVlcnllciB0aGF0IGRvZXMgbm90IHN1cHBvcnQgdGhlIGNvcHkgcHJvdG9jb2wgb3IgZm9yIGNvbHVtbnMgd2l0aG91dCBhCi8vIGJpbmFyeSBmb3JtYXQuIFRoZSBjb2x1bW5zIGluc2VydGVkIGFyZSB0aG9zZSB7e2lmIC5Hb1N0eWxlfX1vZiBmaWVsZHMue3tlbHNlfX1ub3QgVW5kZWZpbmVkIGluIHRoZQovLyBmaXJzdCByb3cgYW5kIGV2ZXJ5IHJvdyBtdXN0IHNldCB0aGUgc2FtZSBmaWVsZHMue3tlbmR9fSBMaWtlIEluc2VydHt7LlN0cnVjdE5hbWV9fQovLyB0aGUgcGVyc2lzdGVkIHJvd3MgYXJlIHNjYW5uZWQgaW50byByb3dzLgpmdW5jIEluc2VydE1hbnl7ey5TdHJ1Y3ROYW1lfX0oY3R4IGNvbnRleHQuQ29udGV4dCwgZGIgUXVlcnllciwgcm93cyBbXXt7LlN0cnVjdE5hbWV9fXt7aWYgLkdvU3R5bGV9fSwgZmllbGRzIC4uLnt7LlN0cnVjdE5hbWV9fUZpZWxke3tlbmR9fSkgZXJyb3IgewogIGlmIGxlbihyb3dzKSA9PSAwIHsKICAgIHJldHVybiBuaWwKICB9CgogIGNvbHVtbnMsIF8sIGVyciA6PSBpbnNlcnR7ey5TdHJ1Y3ROYW1lfX1Db2x1bW5zKCZyb3dzWzBde3tpZiAuR29TdHlsZX19LCBmaWVsZHN7e2VuZH19KQogIGlmIGVyciAhPSBuaWwgewogICAgcmV0dXJuIGVycgogIH0KCiAgcXVvdGVkQ29sdW1ucyA6PSBtYWtlKFtdc3RyaW5nLCBsZW4oY29sdW1ucykpCiAgZm9yIGksIGNvbHVtbiA6PSByYW5nZSBjb2x1bW5zIHsKICAgIHF1b3RlZENvbHVtbnNbaV0gPSBwZ3guSWRlbnRpZmllcntjb2x1bW59LlNhbml0aXplKCkKICB9CgogIC8vIEEgc3RhdGVtZW50IGNhbiBoYXZlIGF0IG1vc3QgNjU1MzUgcGFyYW1ldGVycyBpbmNsdWRpbmcgdGhlIG9yZGluYWxpdHkKICAvLyBvZiBlYWNoIHJvdy4KICBiYXRjaFNpemUgOj0gbGVuKHJvd3MpCiAgaWYgYmF0Y2hTaXplID4gNjU1MzUvKGxlbihjb2x1bW5zKSsxKSB7CiAgICBiYXRjaFNpemUgPSA2NTUzNSAvIChsZW4oY29sdW1ucykgKyAxKQogIH0KCiAgZm9yIHN0YXJ0IDo9IDA7IHN0YXJ0IDwgbGVuKHJvd3MpOyBzdGFydCArPSBiYXRjaFNpemUgewogICAgZW5kIDo9IHN0YXJ0ICsgYmF0Y2hTaXplCiAgICBpZiBlbmQgPiBsZW4ocm93cykgewogICAgICBlbmQgPSBsZW4ocm93cykKICAgIH0KICAgIGJhdGNoIDo9IHJvd3Nbc3RhcnQ6ZW5kXQoKICAgIGFyZ3MgOj0gcGd4LlF1ZXJ5QXJncyhtYWtlKFtdaW50ZXJmYWNle30sIDAsIGxlbihiYXRjaCkqKGxlbihjb2x1bW5zKSsxKSkpCiAgICB2YWx1ZUxpc3RzIDo9IG1ha2UoW11zdHJpbmcsIGxlbihiYXRjaCkpCiAgICBmb3IgaSA6PSByYW5nZSBiYXRjaCB7CiAgICAgIHJvd0NvbHVtbnMsIHZhbHVlcywgZXJyIDo9IGluc2VydHt7LlN0cnVjdE5hbWV9fUNvbHVtbnMoJmJhdGNoW2lde3tpZiAuR29TdHlsZX19LCBmaWVsZHN7e2VuZH19KQogICAgICBpZiBlcnIgIT0gbmlsIHsKICAgICAgICByZXR1cm4gZXJyCiAgICAgIH0KICAgICAgaWYgIWVxdWFsQ29sdW1ucyhyb3dDb2x1bW5zLCBjb2x1bW5zKSB7CiAgICAgICAgcmV0dXJuIGVycm9ycy5FcnJvcmYoInJvdyAlZCBkb2VzIG5vdCBzZXQgdGhlIHNhbWUgZmllbGRzIGFzIHJvdyAwIiwgc3RhcnQraSkKICAgICAgfQoKICAgICAgcGxhY2Vob2xkZXJzIDo9IG1ha2UoW11zdHJpbmcsIGxlbih2YWx1ZXMpKzEpCiAgICAgIGZvciBqLCB2IDo9IHJhbmdlIHZhbHVlcyB7CiAgICAgICAgcGxhY2Vob2xkZXJzW2pdID0gYXJncy5BcHBlbmQodikgKyAiOjoiICsgaW5zZXJ0TWFueXt7LlN0cnVjdE5hbWV9fVR5cGVzW2NvbHVtbnNbal1dCiAgICAgIH0KICAgICAgcGxhY2Vob2xkZXJzW2xlbih2YWx1ZXMpXSA9IGFyZ3MuQXBwZW5kKGludDMyKGkpKSArICI6OmludDQiCiAgICAgIHZhbHVlTGlzdHNbaV0gPSAiKCIgKyBzdHJpbmdzLkpvaW4ocGxhY2Vob2xkZXJzLCAiLCIpICsgIikiCiAgICB9CgogICAgLy8gVGhlIHJvd3MgYXJlIGluc2VydGVkIGluIHRoZSBvcmRlciBvZiB0aGVpciBvcmRpbmFsaXR5IHNvIHRoZXkgYXJlCiAgICAvLyByZXR1cm5lZCBpbiB0aGUgb3JkZXIgb2YgYmF0Y2guCiAgICBzcWwgOj0gYGluc2VydCBpbnRvIHt7LlF1YWxpZmllZFRhYmxlTmFtZX19KGAgKyBzdHJpbmdzLkpvaW4ocXVvdGVkQ29sdW1ucywgIiwgIikgKyBgKQpzZWxlY3QgYCArIHN0cmluZ3MuSm9pbihxdW90ZWRDb2x1bW5zLCAiLCAiKSArIGAKZnJvbSAodmFsdWVzYCArIHN0cmluZ3MuSm9pbih2YWx1ZUxpc3RzLCAiLCIpICsgYCkgYXMgaW5wdXQoYCArIHN0cmluZ3MuSm9pbihhcHBlbmQocXVvdGVkQ29sdW1ucywgInBneGRhdGFfb3JkaW5hbGl0eSIpLCAiLCAiKSArIGApCm9yZGVyIGJ5IHBneGRhdGFfb3JkaW5hbGl0eQpyZXR1cm5pbmcge3sgcmFuZ2UgJGksICRjb2x1bW4gOj0gLlJldHVybmluZ0NvbHVtbnN9fXt7aWYgJGl9fSwge3tlbmR9fXt7JGNvbHVtbi5TZWxlY3RFeHByfX17e2VuZH19YAoKICAgIC8vIFRoZSBTUUwgZGVwZW5kcyBvbiB0aGUgbnVtYmVyIG9mIHJvd3Mgc28gaXQgaXMgbm90IHByZXBhcmVkLCB3aGljaAogICAgLy8gd291bGQgbGVhdmUgYSBwcmVwYXJlZCBzdGF0ZW1lbnQgZm9yIGV2ZXJ5IGJhdGNoIHNpemUuCiAgICBkYlJvd3MsIGVyciA6PSBkYi5RdWVyeShjdHgsIHNxbCwgYXJncy4uLikKICAgIGlmIGVyciAhPSBuaWwgewogICAgICByZXR1cm4gZXJyCiAgICB9CgogICAgLy8gUG9zdGdyZVNRTCBkb2VzIG5vdCBndWFyYW50ZWUgdGhlIG9yZGVyIG9mIHRoZSByZXR1cm5lZCByb3dzLCBidXQgaXQKICAgIC8vIHJldHVybnMgdGhlbSBpbiB0aGUgb3JkZXIgdGhleSB3ZXJlIGluc2VydGVkLiBBIGRpZmZlcmVudCBudW1iZXIgb2Ygcm93cwogICAgLy8gbWVhbnMgdGhleSBjYW5ub3QgYmUgbWF0Y2hlZCB0byBiYXRjaC4KICAgIG4gOj0gMAogICAgZm9yIGRiUm93cy5OZXh0KCkgewogICAgICBpZiBuIDwgbGVuKGJhdGNoKSB7CiAgICAgICAgcm93IDo9ICZiYXRjaFtuXQogICAgICAgIGlmIGVyciA6PSBkYlJvd3MuU2Nhbih7eyByYW5nZSAkaSwgJGNvbHVtbiA6PSAuUmV0dXJuaW5nQ29sdW1uc319e3tpZiAkaX19LCB7e2VuZH19JnJvdy57eyRjb2x1bW4uRmllbGROYW1lfX17e2VuZH19KTsgZXJyICE9IG5pbCB7CiAgICAgICAgICBkYlJvd3MuQ2xvc2UoKQogICAgICAgICAgcmV0dXJuIGVycgogICAgICAgIH0KICAgICAgfQogICAgICBuKysKICAgIH0KCiAgICBpZiBkYlJvd3MuRXJyKCkgIT0gbmlsIHsKICAgICAgcmV0dXJuIGRiUm93cy5FcnIoKQogICAgfQogICAgaWYgbiAhPSBsZW4oYmF0Y2gpIHsKICAgICAgcmV0dXJuIGVycm9ycy5FcnJvcmYoImluc2VydGVkICVkIHJvd3MgYnV0ICVkIHJvd3Mgd2VyZSByZXR1cm5lZCIsIGxlbihiYXRjaCksIG4pCiAgICB9CiAgfQoKICByZXR1cm4gbmlsCn0K`)
+	if err != nil {
+		panic("Unable to decode template")
+	}
+
+	_, err = templates.New(`copy_insert_func`).Parse(string(decoded))
+	if err != nil {
+		fmt.Println(string(decoded))
+		panic("Unable to parse template")
+	}
+
+	decoded, err = base64.StdEncoding.DecodeString(`Y29uc3QgY291bnR7ey5TdHJ1Y3ROYW1lfX1TUUwgPSBgc2VsZWN0IGNvdW50KCopIGZyb20ge3suUXVhbGlmaWVkVGFibGVOYW1lfX1gCgpmdW5jIENvdW50e3suU3RydWN0TmFtZX19KGN0eCBjb250ZXh0LkNvbnRleHQsIGRiIFF1ZXJ5ZXIpIChpbnQ2NCwgZXJyb3IpIHsKICB2YXIgbiBpbnQ2NAogIGVyciA6PSBwcmVwYXJlUXVlcnlSb3coY3R4LCBkYiwgInBneGRhdGFDb3VudHt7LlN0cnVjdE5hbWV9fSIsIGNvdW50e3suU3RydWN0TmFtZX19U1FMKS5TY2FuKCZuKQogIHJldHVybiBuLCBlcnIKfQo=`)
 	if err != nil {
 		panic("Unable to decode template")
 	}
@@ -40,7 +62,7 @@ func loadTemplates() *template.Template {
 		panic("Unable to parse template")
 	}
 
-	decoded, err = base64.StdEncoding.DecodeString(`cGFja2FnZSB7ey5Qa2dOYW1lfX0KLy8gVGhpcyBmaWxlIGlzIGF1dG9tYXRpY2FsbHkgZ2VuZXJhdGVkIGJ5IHBneGRhdGEuCgppbXBvcnQgKAoJImZtdCIKCSJoYXNoL2ZudiIKCSJpbyIKCSJjb250ZXh0IgoKCWVycm9ycyAiZ29sYW5nLm9yZy94L3hlcnJvcnMiCgkiZ2l0aHViLmNvbS9qYWNrYy9wZ3gvdjQiCgkiZ2l0aHViLmNvbS9qYWNrYy9wZ2Nvbm4iCikKCmNvbnN0IFBHWERBVEFfVkVSU0lPTiA9ICJ7ey5WZXJzaW9ufX0iCgp2YXIgRXJyTm90Rm91bmQgPSBlcnJvcnMuTmV3KCJub3QgZm91bmQiKQoKdHlwZSBRdWVyeWVyIGludGVyZmFjZSB7CglRdWVyeShjdHggY29udGV4dC5Db250ZXh0LCBzcWwgc3RyaW5nLCBhcmdzIC4uLmludGVyZmFjZXt9KSAocGd4LlJvd3MsIGVycm9yKQoJUXVlcnlSb3coY3R4IGNvbnRleHQuQ29udGV4dCwgc3FsIHN0cmluZywgYXJncyAuLi5pbnRlcmZhY2V7fSkgcGd4LlJvdwoJRXhlYyhjdHggY29udGV4dC5Db250ZXh0LCBzcWwgc3RyaW5nLCBhcmd1bWVudHMgLi4uaW50ZXJmYWNle30pIChwZ2Nvbm4uQ29tbWFuZFRhZywgZXJyb3IpCn0KCnR5cGUgcHJlcGFyZXIgaW50ZXJmYWNlIHsKCVByZXBhcmUoY3R4IGNvbnRleHQuQ29udGV4dCwgbmFtZSwgc3FsIHN0cmluZykgKCpwZ3guUHJlcGFyZWRTdGF0ZW1lbnQsIGVycm9yKQoJRGVhbGxvY2F0ZShjdHggY29udGV4dC5Db250ZXh0LCBuYW1lIHN0cmluZykgZXJyb3IKfQoKZnVuYyBwcmVwYXJlUXVlcnkoY3R4IGNvbnRleHQuQ29udGV4dCwgZGIgUXVlcnllciwgbmFtZSwgc3FsIHN0cmluZywgYXJncyAuLi5pbnRlcmZhY2V7fSkgKHBneC5Sb3dzLCBlcnJvcikgewoJaWYgcHJlcGFyZXIsIG9rIDo9IGRiLihwcmVwYXJlcik7IG9rIHsKCQlpZiBfLCBlcnIgOj0gcHJlcGFyZXIuUHJlcGFyZShjdHgsIG5hbWUsIHNxbCk7IGVyciAhPSBuaWwgewoJCQlyZXR1cm4gbmlsLCBlcnIKCQl9CgkJc3FsID0gbmFtZQoJfQoKCXJldHVybiBkYi5RdWVyeShjdHgsIHNxbCwgYXJncy4uLikKfQoKZnVuYyBwcmVwYXJlUXVlcnlSb3coY3R4IGNvbnRleHQuQ29udGV4dCwgZGIgUXVlcnllciwgbmFtZSwgc3FsIHN0cmluZywgYXJncyAuLi5pbnRlcmZhY2V7fSkgcGd4LlJvdyB7CglpZiBwcmVwYXJlciwgb2sgOj0gZGIuKHByZXBhcmVyKTsgb2sgewoJCS8vIFF1ZXJ5Um93IGRvZXNuJ3QgcmV0dXJuIGFuIGVycm9yLCB0aGUgZXJyb3IgaXMgZW5jb2RlZCBpbiB0aGUgcGd4LlJvdy4KCQkvLyBTaW5jZSB0aGF0IGlzIHByaXZhdGUsIElnbm9yZSB0aGUgZXJyb3IgZnJvbSBQcmVwYXJlIGFuZCBydW4gdGhlIHF1ZXJ5CgkJLy8gd2l0aG91dCB0aGUgcHJlcGFyZWQgc3RhdGVtZW50LiBJdCBzaG91bGQgZmFpbCB3aXRoIHRoZSBzYW1lIGVycm9yLgoJCWlmIF8sIGVyciA6PSBwcmVwYXJlci5QcmVwYXJlKGN0eCwgbmFtZSwgc3FsKTsgZXJyID09IG5pbCB7CgkJCXNxbCA9IG5hbWUKCQl9Cgl9CglyZXR1cm4gZGIuUXVlcnlSb3coY3R4LCBzcWwsIGFyZ3MuLi4pCn0KCmZ1bmMgcHJlcGFyZUV4ZWMoY3R4IGNvbnRleHQuQ29udGV4dCwgZGIgUXVlcnllciwgbmFtZSwgc3FsIHN0cmluZywgYXJncyAuLi5pbnRlcmZhY2V7fSkgKHBnY29ubi5Db21tYW5kVGFnLCBlcnJvcikgewoJaWYgcHJlcGFyZXIsIG9rIDo9IGRiLihwcmVwYXJlcik7IG9rIHsKCQlpZiBfLCBlcnIgOj0gcHJlcGFyZXIuUHJlcGFyZShjdHgsIG5hbWUsIHNxbCk7IGVyciAhPSBuaWwgewoJCQlyZXR1cm4gbmlsLCBlcnIKCQl9CgkJc3FsID0gbmFtZQoJfQoKCXJldHVybiBkYi5FeGVjKGN0eCwgc3FsLCBhcmdzLi4uKQp9CgpmdW5jIHByZXBhcmVkTmFtZShiYXNlTmFtZSwgc3FsIHN0cmluZykgc3RyaW5nIHsKCWggOj0gZm52Lk5ldzMyYSgpCglpZiBfLCBlcnIgOj0gaW8uV3JpdGVTdHJpbmcoaCwgc3FsKTsgZXJyICE9IG5pbCB7CgkJLy8gaGFzaC5IYXNoLldyaXRlIG5ldmVyIHJldHVybnMgYW4gZXJyb3Igc28gdGhpcyBjYW4ndCBoYXBwZW4KCSAgcGFuaWMoImZhaWxlZCB3cml0aW5nIHRvIGhhc2giKQoJfQoKCXJldHVybiBmbXQuU3ByaW50ZigiJXMlZCIsIGJhc2VOYW1lLCBoLlN1bTMyKCkpCn0K`)
+	decoded, err = base64.StdEncoding.DecodeString(`cGFja2FnZSB7ey5Qa2dOYW1lfX0KLy8gVGhpcyBmaWxlIGlzIGF1dG9tYXRpY2FsbHkgZ2VuZXJhdGVkIGJ5IHBneGRhdGEuCgppbXBvcnQgKAoJImZtdCIKCSJoYXNoL2ZudiIKCSJpbyIKCSJjb250ZXh0IgoJInN0cmluZ3MiCgoJZXJyb3JzICJnb2xhbmcub3JnL3gveGVycm9ycyIKCSJnaXRodWIuY29tL2phY2tjL3BneC92NCIKCSJnaXRodWIuY29tL2phY2tjL3BnY29ubiIKCSJnaXRodWIuY29tL2phY2tjL3BndHlwZSIKKQoKY29uc3QgUEdYREFUQV9WRVJTSU9OID0gInt7LlZlcnNpb259fSIKCnZhciBFcnJOb3RGb3VuZCA9IGVycm9ycy5OZXcoIm5vdCBmb3VuZCIpCgovLyBVcHNlcnRSZXN1bHQgcmVwb3J0cyB3aGF0IGFuIHVwc2VydCBkaWQuCnR5cGUgVXBzZXJ0UmVzdWx0IGludAoKY29uc3QgKAoJLy8gVXBzZXJ0VW5jaGFuZ2VkIG1lYW5zIGEgY29uZmxpY3Rpbmcgcm93IHdhcyBsZWZ0IHVuY2hhbmdlZC4KCVVwc2VydFVuY2hhbmdlZCBVcHNlcnRSZXN1bHQgPSBpb3RhCgkvLyBVcHNlcnRJbnNlcnRlZCBtZWFucyBhIG5ldyByb3cgd2FzIGluc2VydGVkLgoJVXBzZXJ0SW5zZXJ0ZWQKCS8vIFVwc2VydFVwZGF0ZWQgbWVhbnMgYSBjb25mbGljdGluZyByb3cgd2FzIHVwZGF0ZWQuCglVcHNlcnRVcGRhdGVkCikKCnR5cGUgUXVlcnllciBpbnRlcmZhY2UgewoJUXVlcnkoY3R4IGNvbnRleHQuQ29udGV4dCwgc3FsIHN0cmluZywgYXJncyAuLi5pbnRlcmZhY2V7fSkgKHBneC5Sb3dzLCBlcnJvcikKCVF1ZXJ5Um93KGN0eCBjb250ZXh0LkNvbnRleHQsIHNxbCBzdHJpbmcsIGFyZ3MgLi4uaW50ZXJmYWNle30pIHBneC5Sb3cKCUV4ZWMoY3R4IGNvbnRleHQuQ29udGV4dCwgc3FsIHN0cmluZywgYXJndW1lbnRzIC4uLmludGVyZmFjZXt9KSAocGdjb25uLkNvbW1hbmRUYWcsIGVycm9yKQp9CgovLyBDb3B5RnJvbWVyIGlzIGEgUXVlcnllciB0aGF0IHN1cHBvcnRzIHRoZSBQb3N0Z3JlU1FMIGNvcHkgcHJvdG9jb2wgc3VjaCBhcwovLyAqcGd4LkNvbm4gYW5kIHRoZSBwb29scyBhbmQgdHJhbnNhY3Rpb25zIG9mIHBneC4KdHlwZSBDb3B5RnJvbWVyIGludGVyZmFjZSB7CglDb3B5RnJvbShjdHggY29udGV4dC5Db250ZXh0LCB0YWJsZU5hbWUgcGd4LklkZW50aWZpZXIsIGNvbHVtbk5hbWVzIFtdc3RyaW5nLCByb3dTcmMgcGd4LkNvcHlGcm9tU291cmNlKSAoaW50NjQsIGVycm9yKQp9CgovLyBCYXRjaFJlYWRlciByZWFkcyB0aGUgcmVzdWx0IG9mIGEgc3RhdGVtZW50IHF1ZXVlZCBpbiBhIHBneC5CYXRjaCBieSBhCi8vIGdlbmVyYXRlZCBRdWV1ZSBmdW5jdGlvbi4gVGhlIHJlYWRlcnMgb2YgYSBiYXRjaCBtdXN0IGJlIGNhbGxlZCB3aXRoIGl0cwovLyBwZ3guQmF0Y2hSZXN1bHRzIGluIHRoZSBvcmRlciB0aGUgc3RhdGVtZW50cyB3ZXJlIHF1ZXVlZC4KdHlwZSBCYXRjaFJlYWRlciBmdW5jKHBneC5CYXRjaFJlc3VsdHMpIGVycm9yCgovLyBSZWFkQmF0Y2ggY2FsbHMgcmVhZGVycyB3aXRoIHJlc3VsdHMgaW4gb3JkZXIgYW5kIGNsb3NlcyByZXN1bHRzLiBBbGwKLy8gcmVzdWx0cyBhcmUgcmVhZCBhbmQgdGhlIGZpcnN0IGVycm9yIGlzIHJldHVybmVkLCBlLmcuIEVyck5vdEZvdW5kIHdoZW4gYQovLyByb3cgdG8gc2VsZWN0LCB1cGRhdGUgb3IgZGVsZXRlIGRvZXMgbm90IGV4aXN0LgpmdW5jIFJlYWRCYXRjaChyZXN1bHRzIHBneC5CYXRjaFJlc3VsdHMsIHJlYWRlcnMgLi4uQmF0Y2hSZWFkZXIpIGVycm9yIHsKCXZhciBlcnIgZXJyb3IKCWZvciBfLCByZWFkIDo9IHJhbmdlIHJlYWRlcnMgewoJCWlmIHJlYWRFcnIgOj0gcmVhZChyZXN1bHRzKTsgcmVhZEVyciAhPSBuaWwgJiYgZXJyID09IG5pbCB7CgkJCWVyciA9IHJlYWRFcnIKCQl9Cgl9CglpZiBjbG9zZUVyciA6PSByZXN1bHRzLkNsb3NlKCk7IGNsb3NlRXJyICE9IG5pbCAmJiBlcnIgPT0gbmlsIHsKCQllcnIgPSBjbG9zZUVycgoJfQoKCXJldHVybiBlcnIKfQoKLy8gcXVldWUgYWRkcyBzcWwgdG8gYiBhbmQgcmV0dXJucyByZWFkLiBCYXRjaGVkIHN0YXRlbWVudHMgYXJlIG5vdCBwcmVwYXJlZCBzbwovLyBwYXJhbWV0ZXJPSURzIGdpdmVzIHRoZSB0eXBlcyBvZiBhcmdzLiBSZXN1bHRzIGFyZSByZWFkIGluIHRoZSB0ZXh0IGZvcm1hdAovLyB3aGljaCBhbGwgdHlwZXMgY2FuIGRlY29kZS4KZnVuYyBxdWV1ZShiICpwZ3guQmF0Y2gsIHNxbCBzdHJpbmcsIGFyZ3MgW11pbnRlcmZhY2V7fSwgcGFyYW1ldGVyT0lEcyBbXXBndHlwZS5PSUQsIHJlYWQgQmF0Y2hSZWFkZXIpIEJhdGNoUmVhZGVyIHsKCWIuUXVldWUoc3FsLCBhcmdzLCBwYXJhbWV0ZXJPSURzLCBbXWludDE2e3BneC5UZXh0Rm9ybWF0Q29kZX0pCglyZXR1cm4gcmVhZAp9CgovLyBxdWV1ZUZhaWxlZCByZXR1cm5zIHRoZSByZWFkZXIgb2YgYSBzdGF0ZW1lbnQgdGhhdCBjb3VsZCBub3QgYmUgcXVldWVkLiBJdAovLyByZXR1cm5zIGVyciB3aXRob3V0IHJlYWRpbmcgYSByZXN1bHQuCmZ1bmMgcXVldWVGYWlsZWQoZXJyIGVycm9yKSBCYXRjaFJlYWRlciB7CglyZXR1cm4gZnVuYyhwZ3guQmF0Y2hSZXN1bHRzKSBlcnJvciB7CgkJcmV0dXJuIGVycgoJfQp9Cgp0eXBlIHByZXBhcmVyIGludGVyZmFjZSB7CglQcmVwYXJlKGN0eCBjb250ZXh0LkNvbnRleHQsIG5hbWUsIHNxbCBzdHJpbmcpICgqcGd4LlByZXBhcmVkU3RhdGVtZW50LCBlcnJvcikKCURlYWxsb2NhdGUoY3R4IGNvbnRleHQuQ29udGV4dCwgbmFtZSBzdHJpbmcpIGVycm9yCn0KCmZ1bmMgcHJlcGFyZVF1ZXJ5KGN0eCBjb250ZXh0LkNvbnRleHQsIGRiIFF1ZXJ5ZXIsIG5hbWUsIHNxbCBzdHJpbmcsIGFyZ3MgLi4uaW50ZXJmYWNle30pIChwZ3guUm93cywgZXJyb3IpIHsKCWlmIHByZXBhcmVyLCBvayA6PSBkYi4ocHJlcGFyZXIpOyBvayB7CgkJaWYgXywgZXJyIDo9IHByZXBhcmVyLlByZXBhcmUoY3R4LCBuYW1lLCBzcWwpOyBlcnIgIT0gbmlsIHsKCQkJcmV0dXJuIG5pbCwgZXJyCgkJfQoJCXNxbCA9IG5hbWUKCX0KCglyZXR1cm4gZGIuUXVlcnkoY3R4LCBzcWwsIGFyZ3MuLi4pCn0KCmZ1bmMgcHJlcGFyZVF1ZXJ5Um93KGN0eCBjb250ZXh0LkNvbnRleHQsIGRiIFF1ZXJ5ZXIsIG5hbWUsIHNxbCBzdHJpbmcsIGFyZ3MgLi4uaW50ZXJmYWNle30pIHBneC5Sb3cgewoJaWYgcHJlcGFyZXIsIG9rIDo9IGRiLihwcmVwYXJlcik7IG9rIHsKCQkvLyBRdWVyeVJvdyBkb2Vzbid0IHJldHVybiBhbiBlcnJvciwgdGhlIGVycm9yIGlzIGVuY29kZWQgaW4gdGhlIHBneC5Sb3cuCgkJLy8gU2luY2UgdGhhdCBpcyBwcml2YXRlLCBJZ25vcmUgdGhlIGVycm9yIGZyb20gUHJlcGFyZSBhbmQgcnVuIHRoZSBxdWVyeQoJCS8vIHdpdGhvdXQgdGhlIHByZXBhcmVkIHN0YXRlbWVudC4gSXQgc2hvdWxkIGZhaWwgd2l0aCB0aGUgc2FtZSBlcnJvci4KCQlpZiBfLCBlcnIgOj0gcHJlcGFyZXIuUHJlcGFyZShjdHgsIG5hbWUsIHNxbCk7IGVyciA9PSBuaWwgewoJCQlzcWwgPSBuYW1lCgkJfQoJfQoJcmV0dXJuIGRiLlF1ZXJ5Um93KGN0eCwgc3FsLCBhcmdzLi4uKQp9CgpmdW5jIHByZXBhcmVFeGVjKGN0eCBjb250ZXh0LkNvbnRleHQsIGRiIFF1ZXJ5ZXIsIG5hbWUsIHNxbCBzdHJpbmcsIGFyZ3MgLi4uaW50ZXJmYWNle30pIChwZ2Nvbm4uQ29tbWFuZFRhZywgZXJyb3IpIHsKCWlmIHByZXBhcmVyLCBvayA6PSBkYi4ocHJlcGFyZXIpOyBvayB7CgkJaWYgXywgZXJyIDo9IHByZXBhcmVyLlByZXBhcmUoY3R4LCBuYW1lLCBzcWwpOyBlcnIgIT0gbmlsIHsKCQkJcmV0dXJuIG5pbCwgZXJyCgkJfQoJCXNxbCA9IG5hbWUKCX0KCglyZXR1cm4gZGIuRXhlYyhjdHgsIHNxbCwgYXJncy4uLikKfQoKLy8gZXF1YWxDb2x1bW5zIHJldHVybnMgdHJ1ZSBpZiBhIGFuZCBiIGFyZSB0aGUgc2FtZSBjb2x1bW5zIGluIHRoZSBzYW1lIG9yZGVyLgpmdW5jIGVxdWFsQ29sdW1ucyhhLCBiIFtdc3RyaW5nKSBib29sIHsKCWlmIGxlbihhKSAhPSBsZW4oYikgewoJCXJldHVybiBmYWxzZQoJfQoJZm9yIGkgOj0gcmFuZ2UgYSB7CgkJaWYgYVtpXSAhPSBiW2ldIHsKCQkJcmV0dXJuIGZhbHNlCgkJfQoJfQoJcmV0dXJuIHRydWUKfQoKLy8gQ29uZGl0aW9uIGlzIGEgU1FMIGNvbmRpdGlvbiBvbiB0aGUgY29sdW1ucyBvZiBhIHRhYmxlIGJ1aWx0IHdpdGggdGhlCi8vIGdlbmVyYXRlZCA8U3RydWN0PldoZXJlIGZpbHRlcnMuIFRoZSB6ZXJvIENvbmRpdGlvbiBtYXRjaGVzIGV2ZXJ5IHJvdy4gSXQgaGFzCi8vIG5vIHByZWRpY2F0ZSwgYW5kIG5laXRoZXIgaGFzIGFueSBjb25kaXRpb24gY29tYmluZWQgb25seSBmcm9tIHplcm8KLy8gQ29uZGl0aW9ucy4KdHlwZSBDb25kaXRpb24gc3RydWN0IHsKCXdyaXRlIGZ1bmMoYXJncyAqcGd4LlF1ZXJ5QXJncykgc3RyaW5nCn0KCi8vIEFuZCByZXR1cm5zIGEgY29uZGl0aW9uIHRoYXQgbWF0Y2hlcyB3aGVuIGMgYW5kIGFsbCBvdGhlcnMgbWF0Y2guIFplcm8KLy8gQ29uZGl0aW9ucyBhcmUgbGVmdCBvdXQuCmZ1bmMgKGMgQ29uZGl0aW9uKSBBbmQob3RoZXJzIC4uLkNvbmRpdGlvbikgQ29uZGl0aW9uIHsKCXJldHVybiBjLmpvaW4oIiBhbmQgIiwgb3RoZXJzKQp9CgovLyBPciByZXR1cm5zIGEgY29uZGl0aW9uIHRoYXQgbWF0Y2hlcyB3aGVuIGMgb3IgYW55IG9mIG90aGVycyBtYXRjaC4gSXQgaXMgdGhlCi8vIHplcm8gQ29uZGl0aW9uIHdoZW4gYW55IG9mIHRoZW0gaXMuCmZ1bmMgKGMgQ29uZGl0aW9uKSBPcihvdGhlcnMgLi4uQ29uZGl0aW9uKSBDb25kaXRpb24gewoJaWYgYy53cml0ZSA9PSBuaWwgewoJCXJldHVybiBDb25kaXRpb257fQoJfQoJZm9yIF8sIG8gOj0gcmFuZ2Ugb3RoZXJzIHsKCQlpZiBvLndyaXRlID09IG5pbCB7CgkJCXJldHVybiBDb25kaXRpb257fQoJCX0KCX0KCXJldHVybiBjLmpvaW4oIiBvciAiLCBvdGhlcnMpCn0KCi8vIE5vdCByZXR1cm5zIGEgY29uZGl0aW9uIHRoYXQgbWF0Y2hlcyB3aGVuIGMgZG9lcyBub3QgbWF0Y2guCmZ1bmMgKGMgQ29uZGl0aW9uKSBOb3QoKSBDb25kaXRpb24gewoJcmV0dXJuIENvbmRpdGlvbnt3cml0ZTogZnVuYyhhcmdzICpwZ3guUXVlcnlBcmdzKSBzdHJpbmcgewoJCXJldHVybiAibm90ICgiICsgYy5zcWwoYXJncykgKyAiKSIKCX19Cn0KCi8vIGpvaW4gY29tYmluZXMgdGhlIGNvbmRpdGlvbnMgd2l0aCBwcmVkaWNhdGVzIG9mIGMgYW5kIG90aGVycyB3aXRoIG9wLgpmdW5jIChjIENvbmRpdGlvbikgam9pbihvcCBzdHJpbmcsIG90aGVycyBbXUNvbmRpdGlvbikgQ29uZGl0aW9uIHsKCXZhciBjb25kaXRpb25zIFtdQ29uZGl0aW9uCglmb3IgXywgYyA6PSByYW5nZSBhcHBlbmQoW11Db25kaXRpb257Y30sIG90aGVycy4uLikgewoJCWlmIGMud3JpdGUgIT0gbmlsIHsKCQkJY29uZGl0aW9ucyA9IGFwcGVuZChjb25kaXRpb25zLCBjKQoJCX0KCX0KCXN3aXRjaCBsZW4oY29uZGl0aW9ucykgewoJY2FzZSAwOgoJCXJldHVybiBDb25kaXRpb257fQoJY2FzZSAxOgoJCXJldHVybiBjb25kaXRpb25zWzBdCgl9CglyZXR1cm4gQ29uZGl0aW9ue3dyaXRlOiBmdW5jKGFyZ3MgKnBneC5RdWVyeUFyZ3MpIHN0cmluZyB7CgkJcGFydHMgOj0gbWFrZShbXXN0cmluZywgbGVuKGNvbmRpdGlvbnMpKQoJCWZvciBpLCBjIDo9IHJhbmdlIGNvbmRpdGlvbnMgewoJCQlwYXJ0c1tpXSA9ICIoIiArIGMuc3FsKGFyZ3MpICsgIikiCgkJfQoJCXJldHVybiBzdHJpbmdzLkpvaW4ocGFydHMsIG9wKQoJfX0KfQoKLy8gc3FsIHJldHVybnMgdGhlIFNRTCBvZiBjIGFuZCBhcHBlbmRzIGl0cyBhcmd1bWVudHMgdG8gYXJncy4KZnVuYyAoYyBDb25kaXRpb24pIHNxbChhcmdzICpwZ3guUXVlcnlBcmdzKSBzdHJpbmcgewoJaWYgYy53cml0ZSA9PSBuaWwgewoJCXJldHVybiAidHJ1ZSIKCX0KCXJldHVybiBjLndyaXRlKGFyZ3MpCn0KCi8vIGNvbHVtbkZpbHRlciBidWlsZHMgdGhlIGNvbmRpdGlvbnMgb2YgYSBjb2x1bW4uIFRoZSBnZW5lcmF0ZWQgZmlsdGVycyBlbWJlZAovLyBpdCBhbmQgYWRkIHRoZSBjb21wYXJpc29ucyB0eXBlZCBmb3IgdGhlIGNvbHVtbi4KdHlwZSBjb2x1bW5GaWx0ZXIgc3RydWN0IHsKCWNvbHVtbiBzdHJpbmcKfQoKLy8gSXNOdWxsIHJldHVybnMgYSBjb25kaXRpb24gdGhhdCBtYXRjaGVzIHdoZW4gdGhlIGNvbHVtbiBpcyBOVUxMLgpmdW5jIChmIGNvbHVtbkZpbHRlcikgSXNOdWxsKCkgQ29uZGl0aW9uIHsKCXJldHVybiBDb25kaXRpb257d3JpdGU6IGZ1bmMoYXJncyAqcGd4LlF1ZXJ5QXJncykgc3RyaW5nIHsKCQlyZXR1cm4gZi5jb2x1bW4gKyAiIGlzIG51bGwiCgl9fQp9CgovLyBJc05vdE51bGwgcmV0dXJucyBhIGNvbmRpdGlvbiB0aGF0IG1hdGNoZXMgd2hlbiB0aGUgY29sdW1uIGlzIG5vdCBOVUxMLgpmdW5jIChmIGNvbHVtbkZpbHRlcikgSXNOb3ROdWxsKCkgQ29uZGl0aW9uIHsKCXJldHVybiBDb25kaXRpb257d3JpdGU6IGZ1bmMoYXJncyAqcGd4LlF1ZXJ5QXJncykgc3RyaW5nIHsKCQlyZXR1cm4gZi5jb2x1bW4gKyAiIGlzIG5vdCBudWxsIgoJfX0KfQoKZnVuYyAoZiBjb2x1bW5GaWx0ZXIpIGNvbXBhcmUob3Agc3RyaW5nLCB2YWx1ZSBpbnRlcmZhY2V7fSkgQ29uZGl0aW9uIHsKCXJldHVybiBDb25kaXRpb257d3JpdGU6IGZ1bmMoYXJncyAqcGd4LlF1ZXJ5QXJncykgc3RyaW5nIHsKCQlyZXR1cm4gZi5jb2x1bW4gKyBvcCArIGFyZ3MuQXBwZW5kKHZhbHVlKQoJfX0KfQoKLy8gaW4gcmV0dXJucyBhIGNvbmRpdGlvbiB0aGF0IG1hdGNoZXMgd2hlbiB0aGUgY29sdW1uIGVxdWFscyBhbnkgb2YgdGhlIG4KLy8gdmFsdWVzIG9mIHRoZSBhcnJheSBwYXJhbWV0ZXIgdmFsdWVzLiBUaGUgU1FMIGRvZXMgbm90IGRlcGVuZCBvbiBuIHNvIG9uZQovLyBwcmVwYXJlZCBzdGF0ZW1lbnQgc2VydmVzIGV2ZXJ5IG51bWJlciBvZiB2YWx1ZXMuCmZ1bmMgKGYgY29sdW1uRmlsdGVyKSBpbihuIGludCwgdmFsdWVzIGludGVyZmFjZXt9KSBDb25kaXRpb24gewoJaWYgbiA9PSAwIHsKCQlyZXR1cm4gQ29uZGl0aW9ue3dyaXRlOiBmdW5jKGFyZ3MgKnBneC5RdWVyeUFyZ3MpIHN0cmluZyB7CgkJCXJldHVybiAiZmFsc2UiCgkJfX0KCX0KCXJldHVybiBDb25kaXRpb257d3JpdGU6IGZ1bmMoYXJncyAqcGd4LlF1ZXJ5QXJncykgc3RyaW5nIHsKCQlyZXR1cm4gZi5jb2x1bW4gKyAiID0gYW55KCIgKyBhcmdzLkFwcGVuZCh2YWx1ZXMpICsgIikiCgl9fQp9CgovLyBhcnJheUxpdGVyYWwgcmV0dXJucyB0aGUgdGV4dCBmb3JtYXQgb2YgYSBvbmUgZGltZW5zaW9uYWwgYXJyYXkgb2YgdmFsdWVzLgovLyBwZ3ggc2VuZHMgc3RyaW5ncyBpbiB0aGUgdGV4dCBmb3JtYXQgc28gUG9zdGdyZVNRTCBwYXJzZXMgaXQgYXMgYW4gYXJyYXkgb2YKLy8gdGhlIHR5cGUgb2YgdGhlIHBhcmFtZXRlci4KZnVuYyBhcnJheUxpdGVyYWwodmFsdWVzIFtdc3RyaW5nKSBzdHJpbmcgewoJdmFyIHNiIHN0cmluZ3MuQnVpbGRlcgoJc2IuV3JpdGVCeXRlKCd7JykKCWZvciBpLCB2IDo9IHJhbmdlIHZhbHVlcyB7CgkJaWYgaSA+IDAgewoJCQlzYi5Xcml0ZUJ5dGUoJywnKQoJCX0KCQlzYi5Xcml0ZUJ5dGUoJyInKQoJCWZvciBfLCByIDo9IHJhbmdlIHYgewoJCQlpZiByID09ICciJyB8fCByID09ICdcXCcgewoJCQkJc2IuV3JpdGVCeXRlKCdcXCcpCgkJCX0KCQkJc2IuV3JpdGVSdW5lKHIpCgkJfQoJCXNiLldyaXRlQnl0ZSgnIicpCgl9CglzYi5Xcml0ZUJ5dGUoJ30nKQoJcmV0dXJuIHNiLlN0cmluZygpCn0KCmZ1bmMgcHJlcGFyZWROYW1lKGJhc2VOYW1lLCBzcWwgc3RyaW5nKSBzdHJpbmcgewoJaCA6PSBmbnYuTmV3MzJhKCkKCWlmIF8sIGVyciA6PSBpby5Xcml0ZVN0cmluZyhoLCBzcWwpOyBlcnIgIT0gbmlsIHsKCQkvLyBoYXNoLkhhc2guV3JpdGUgbmV2ZXIgcmV0dXJucyBhbiBlcnJvciBzbyB0aGlzIGNhbid0IGhhcHBlbgoJICBwYW5pYygiZmFpbGVkIHdyaXRpbmcgdG8gaGFzaCIpCgl9CgoJcmV0dXJuIGZtdC5TcHJpbnRmKCIlcyVkIiwgYmFzZU5hbWUsIGguU3VtMzIoKSkKfQoKLy8gcGFyc2VDb21wb3NpdGVUZXh0IHNwbGl0cyB0aGUgdGV4dCBmb3JtYXQgb2YgYSBjb21wb3NpdGUgdmFsdWUgaW50byB0aGUgdGV4dAovLyBvZiBpdHMgZmllbGRzLiBOVUxMIGZpZWxkcyBhcmUgbmlsLgpmdW5jIHBhcnNlQ29tcG9zaXRlVGV4dChzcmMgW11ieXRlKSAoW11bXWJ5dGUsIGVycm9yKSB7CglpZiBsZW4oc3JjKSA8IDIgfHwgc3JjWzBdICE9ICcoJyB8fCBzcmNbbGVuKHNyYyktMV0gIT0gJyknIHsKCQlyZXR1cm4gbmlsLCBlcnJvcnMuRXJyb3JmKCJpbnZhbGlkIGNvbXBvc2l0ZSB2YWx1ZTogJXMiLCBzcmMpCgl9CglzcmMgPSBzcmNbMSA6IGxlbihzcmMpLTFdCgoJdmFyIGZpZWxkcyBbXVtdYnl0ZQoJZm9yIGkgOj0gMDsgOyBpKysgewoJCXZhciBmaWVsZCBbXWJ5dGUKCQludWxsIDo9IHRydWUKCQlxdW90ZWQgOj0gZmFsc2UKCQlmb3IgOyBpIDwgbGVuKHNyYykgJiYgKHF1b3RlZCB8fCBzcmNbaV0gIT0gJywnKTsgaSsrIHsKCQkJc3dpdGNoIHsKCQkJY2FzZSBzcmNbaV0gPT0gJyInICYmIHF1b3RlZCAmJiBpKzEgPCBsZW4oc3JjKSAmJiBzcmNbaSsxXSA9PSAnIic6CgkJCQlmaWVsZCA9IGFwcGVuZChmaWVsZCwgJyInKQoJCQkJaSsrCgkJCWNhc2Ugc3JjW2ldID09ICciJzoKCQkJCXF1b3RlZCA9ICFxdW90ZWQKCQkJY2FzZSBzcmNbaV0gPT0gJ1xcJyAmJiBpKzEgPCBsZW4oc3JjKToKCQkJCWZpZWxkID0gYXBwZW5kKGZpZWxkLCBzcmNbaSsxXSkKCQkJCWkrKwoJCQlkZWZhdWx0OgoJCQkJZmllbGQgPSBhcHBlbmQoZmllbGQsIHNyY1tpXSkKCQkJfQoJCQludWxsID0gZmFsc2UKCQl9CgkJaWYgcXVvdGVkIHsKCQkJcmV0dXJuIG5pbCwgZXJyb3JzLkVycm9yZigiaW52YWxpZCBjb21wb3NpdGUgdmFsdWU6IHVudGVybWluYXRlZCBxdW90ZSIpCgkJfQoKCQlpZiBudWxsIHsKCQkJZmllbGRzID0gYXBwZW5kKGZpZWxkcywgbmlsKQoJCX0gZWxzZSB7CgkJCWZpZWxkcyA9IGFwcGVuZChmaWVsZHMsIGFwcGVuZChbXWJ5dGV7fSwgZmllbGQuLi4pKQoJCX0KCgkJaWYgaSA+PSBsZW4oc3JjKSB7CgkJCXJldHVybiBmaWVsZHMsIG5pbAoJCX0KCX0KfQoKLy8gYXBwZW5kQ29tcG9zaXRlVGV4dCBhcHBlbmRzIHRoZSB0ZXh0IGZvcm1hdCBvZiBhIGNvbXBvc2l0ZSB2YWx1ZSB3aXRoCi8vIGZpZWxkcyB0byBidWYuCmZ1bmMgYXBwZW5kQ29tcG9zaXRlVGV4dChjaSAqcGd0eXBlLkNvbm5JbmZvLCBidWYgW11ieXRlLCBmaWVsZHMgLi4ucGd0eXBlLlRleHRFbmNvZGVyKSAoW11ieXRlLCBlcnJvcikgewoJYnVmID0gYXBwZW5kKGJ1ZiwgJygnKQoJZm9yIGksIGYgOj0gcmFuZ2UgZmllbGRzIHsKCQlpZiBpID4gMCB7CgkJCWJ1ZiA9IGFwcGVuZChidWYsICcsJykKCQl9CgoJCWZpZWxkQnVmLCBlcnIgOj0gZi5FbmNvZGVUZXh0KGNpLCBuaWwpCgkJaWYgZXJyICE9IG5pbCB7CgkJCXJldHVybiBuaWwsIGVycgoJCX0KCQlpZiBmaWVsZEJ1ZiA9PSBuaWwgewoJCQljb250aW51ZQoJCX0KCgkJYnVmID0gYXBwZW5kKGJ1ZiwgJyInKQoJCWZvciBfLCBiIDo9IHJhbmdlIGZpZWxkQnVmIHsKCQkJaWYgYiA9PSAnIicgfHwgYiA9PSAnXFwnIHsKCQkJCWJ1ZiA9IGFwcGVuZChidWYsIGIpCgkJCX0KCQkJYnVmID0gYXBwZW5kKGJ1ZiwgYikKCQl9CgkJYnVmID0gYXBwZW5kKGJ1ZiwgJyInKQoJfQoJYnVmID0gYXBwZW5kKGJ1ZiwgJyknKQoKCXJldHVybiBidWYsIG5pbAp9Cg==`)
 	if err != nil {
 		panic("Unable to decode template")
 	}
@@ -51,7 +73,7 @@ func loadTemplates() *template.Template {
 		panic("Unable to parse template")
 	}
 
-	decoded, err = base64.StdEncoding.DecodeString(`ZnVuYyBEZWxldGV7ey5TdHJ1Y3ROYW1lfX0oY3R4IGNvbnRleHQuQ29udGV4dCwgZGIgUXVlcnllcnt7cmFuZ2UgLlByaW1hcnlLZXlDb2x1bW5zfX0sCiAge3suVmFyTmFtZX19IHt7LkdvVHlwZX19e3tlbmR9fSwKKSBlcnJvciB7CiAgYXJncyA6PSBwZ3guUXVlcnlBcmdzKG1ha2UoW11pbnRlcmZhY2V7fSwgMCwge3tsZW4gLlByaW1hcnlLZXlDb2x1bW5zfX0pKQoKICBzcWwgOj0gYGRlbGV0ZSBmcm9tICJ7ey5UYWJsZU5hbWV9fSIgd2hlcmUgYCB7eyByYW5nZSAkaSwgJGNvbHVtbiA6PSAuUHJpbWFyeUtleUNvbHVtbnN9fSArIGB7e2lmICRpfX0gYW5kIHt7ZW5kfX0ie3skY29sdW1uLkNvbHVtbk5hbWV9fSI9YCArIGFyZ3MuQXBwZW5kKHt7JGNvbHVtbi5WYXJOYW1lfX0pe3tlbmR9fQoKICBjb21tYW5kVGFnLCBlcnIgOj0gcHJlcGFyZUV4ZWMoY3R4LCBkYiwgInBneGRhdGFEZWxldGV7ey5TdHJ1Y3ROYW1lfX0iLCBzcWwsIGFyZ3MuLi4pCiAgaWYgZXJyICE9IG5pbCB7CiAgICByZXR1cm4gZXJyCiAgfQogIGlmIGNvbW1hbmRUYWcuUm93c0FmZmVjdGVkKCkgIT0gMSB7CiAgICByZXR1cm4gRXJyTm90Rm91bmQKICB9CiAgcmV0dXJuIG5pbAp9Cg==`)
+	decoded, err = base64.StdEncoding.DecodeString(`ZnVuYyBEZWxldGV7ey5TdHJ1Y3ROYW1lfX0oY3R4IGNvbnRleHQuQ29udGV4dCwgZGIgUXVlcnllcnt7cmFuZ2UgLlByaW1hcnlLZXlDb2x1bW5zfX0sCiAge3suVmFyTmFtZX19IHt7LkdvVHlwZX19e3tlbmR9fSwKKSBlcnJvciB7CiAgYXJncyA6PSBwZ3guUXVlcnlBcmdzKG1ha2UoW11pbnRlcmZhY2V7fSwgMCwge3tsZW4gLlByaW1hcnlLZXlDb2x1bW5zfX0pKQoKICBzcWwgOj0gYGRlbGV0ZSBmcm9tIHt7LlF1YWxpZmllZFRhYmxlTmFtZX19IHdoZXJlIGAge3sgcmFuZ2UgJGksICRjb2x1bW4gOj0gLlByaW1hcnlLZXlDb2x1bW5zfX0gKyBge3tpZiAkaX19IGFuZCB7e2VuZH19Int7JGNvbHVtbi5Db2x1bW5OYW1lfX0iPWAgKyBhcmdzLkFwcGVuZCh7eyRjb2x1bW4uVmFyTmFtZX19KXt7ZW5kfX0KCiAgY29tbWFuZFRhZywgZXJyIDo9IHByZXBhcmVFeGVjKGN0eCwgZGIsICJwZ3hkYXRhRGVsZXRle3suU3RydWN0TmFtZX19Iiwgc3FsLCBhcmdzLi4uKQogIGlmIGVyciAhPSBuaWwgewogICAgcmV0dXJuIGVycgogIH0KICBpZiBjb21tYW5kVGFnLlJvd3NBZmZlY3RlZCgpICE9IDEgewogICAgcmV0dXJuIEVyck5vdEZvdW5kCiAgfQogIHJldHVybiBuaWwKfQo=`)
 	if err != nil {
 		panic("Unable to decode template")
 	}
@@ -62,7 +84,29 @@ func loadTemplates() *template.Template {
 		panic("Unable to parse template")
 	}
 
-	decoded, err = base64.StdEncoding.DecodeString(`ZnVuYyBJbnNlcnR7ey5TdHJ1Y3ROYW1lfX0oY3R4IGNvbnRleHQuQ29udGV4dCwgZGIgUXVlcnllciwgcm93ICp7ey5TdHJ1Y3ROYW1lfX0pIGVycm9yIHsKICBhcmdzIDo9IHBneC5RdWVyeUFyZ3MobWFrZShbXWludGVyZmFjZXt9LCAwLCB7e2xlbiAuQ29sdW1uc319KSkKCiAgdmFyIGNvbHVtbnMsIHZhbHVlcyBbXXN0cmluZwoKe3tyYW5nZSAuQ29sdW1uc319ICBpZiByb3cue3suRmllbGROYW1lfX0uU3RhdHVzICE9IHBndHlwZS5VbmRlZmluZWQgewogICAgY29sdW1ucyA9IGFwcGVuZChjb2x1bW5zLCBge3suQ29sdW1uTmFtZX19YCkKICAgIHZhbHVlcyA9IGFwcGVuZCh2YWx1ZXMsIGFyZ3MuQXBwZW5kKCZyb3cue3suRmllbGROYW1lfX0pKQogIH0Ke3tlbmR9fQoKICBzcWwgOj0gYGluc2VydCBpbnRvICJ7ey5UYWJsZU5hbWV9fSIoYCArIHN0cmluZ3MuSm9pbihjb2x1bW5zLCAiLCAiKSArIGApCnZhbHVlcyhgICsgc3RyaW5ncy5Kb2luKHZhbHVlcywgIiwiKSArIGApCnJldHVybmluZyB7eyByYW5nZSAkaSwgJGNvbHVtbiA6PSAuUHJpbWFyeUtleUNvbHVtbnN9fXt7aWYgJGl9fSwge3tlbmR9fSJ7eyRjb2x1bW4uQ29sdW1uTmFtZX19Int7ZW5kfX0KICBgCgogIHBzTmFtZSA6PSBwcmVwYXJlZE5hbWUoInBneGRhdGFJbnNlcnR7ey5TdHJ1Y3ROYW1lfX0iLCBzcWwpCgogIHJldHVybiBwcmVwYXJlUXVlcnlSb3coY3R4LCBkYiwgcHNOYW1lLCBzcWwsIGFyZ3MuLi4pLlNjYW4oe3sgcmFuZ2UgJGksICRjb2x1bW4gOj0gLlByaW1hcnlLZXlDb2x1bW5zfX17e2lmICRpfX0sIHt7ZW5kfX0mcm93Lnt7JGNvbHVtbi5GaWVsZE5hbWV9fXt7ZW5kfX0pCn0K`)
+	decoded, err = base64.StdEncoding.DecodeString(`cGFja2FnZSB7ey5Qa2dOYW1lfX0KCi8vIFRoaXMgZmlsZSBpcyBhdXRvbWF0aWNhbGx5IGdlbmVyYXRlZCBieSBwZ3hkYXRhLgoKaW1wb3J0ICgKICAiZ2l0aHViLmNvbS9qYWNrYy9wZ3R5cGUiCiAgZXJyb3JzICJnb2xhbmcub3JnL3gveGVycm9ycyIKKQoKLy8ge3suR29OYW1lfX0gaXMgYSBsYWJlbCBvZiB0aGUgUG9zdGdyZVNRTCBlbnVtIHt7LlF1YWxpZmllZE5hbWV9fS4KdHlwZSB7ey5Hb05hbWV9fSBzdHJpbmcKCmNvbnN0ICgKe3tyYW5nZSAuTGFiZWxzfX0gIHt7LkNvbnN0TmFtZX19IHt7JC5Hb05hbWV9fSA9IHt7LkxpdGVyYWx9fQp7e2VuZH19KQoKLy8gVmFsaWQgcmV0dXJucyB0cnVlIGlmIHYgaXMgYSBsYWJlbCBvZiB7ey5RdWFsaWZpZWROYW1lfX0uCmZ1bmMgKHYge3suR29OYW1lfX0pIFZhbGlkKCkgYm9vbCB7Cnt7LSBpZiAuTGFiZWxzfX0KICBzd2l0Y2ggdiB7CiAgY2FzZSB7e3JhbmdlICRpLCAkbGFiZWwgOj0gLkxhYmVsc319e3tpZiAkaX19LCB7e2VuZH19e3skbGFiZWwuQ29uc3ROYW1lfX17e2VuZH19OgogICAgcmV0dXJuIHRydWUKICB9Cnt7LSBlbmR9fQogIHJldHVybiBmYWxzZQp9CgovLyB7ey5Cb3hUeXBlTmFtZX19IGlzIHRoZSBwZ3R5cGUgY29tcGF0aWJsZSBib3ggZm9yIHt7LkdvTmFtZX19IHVzZWQgaW4gcm93Ci8vIHN0cnVjdHMuCnR5cGUge3suQm94VHlwZU5hbWV9fSBzdHJ1Y3QgewogIFZhbHVlICB7ey5Hb05hbWV9fQogIFN0YXR1cyBwZ3R5cGUuU3RhdHVzCn0KCmZ1bmMgKGRzdCAqe3suQm94VHlwZU5hbWV9fSkgU2V0KHNyYyBpbnRlcmZhY2V7fSkgZXJyb3IgewogIGlmIHNyYyA9PSBuaWwgewogICAgKmRzdCA9IHt7LkJveFR5cGVOYW1lfX17U3RhdHVzOiBwZ3R5cGUuTnVsbH0KICAgIHJldHVybiBuaWwKICB9CgogIHZhciB2IHt7LkdvTmFtZX19CiAgc3dpdGNoIHZhbHVlIDo9IHNyYy4odHlwZSkgewogIGNhc2Uge3suR29OYW1lfX06CiAgICB2ID0gdmFsdWUKICBjYXNlIHN0cmluZzoKICAgIHYgPSB7ey5Hb05hbWV9fSh2YWx1ZSkKICBjYXNlICp7ey5Hb05hbWV9fToKICAgIGlmIHZhbHVlID09IG5pbCB7CiAgICAgICpkc3QgPSB7ey5Cb3hUeXBlTmFtZX19e1N0YXR1czogcGd0eXBlLk51bGx9CiAgICAgIHJldHVybiBuaWwKICAgIH0KICAgIHYgPSAqdmFsdWUKICBjYXNlICpzdHJpbmc6CiAgICBpZiB2YWx1ZSA9PSBuaWwgewogICAgICAqZHN0ID0ge3suQm94VHlwZU5hbWV9fXtTdGF0dXM6IHBndHlwZS5OdWxsfQogICAgICByZXR1cm4gbmlsCiAgICB9CiAgICB2ID0ge3suR29OYW1lfX0oKnZhbHVlKQogIGRlZmF1bHQ6CiAgICByZXR1cm4gZXJyb3JzLkVycm9yZigiY2Fubm90IGNvbnZlcnQgJXYgdG8ge3suR29OYW1lfX0iLCB2YWx1ZSkKICB9CgogIGlmICF2LlZhbGlkKCkgewogICAgcmV0dXJuIGVycm9ycy5FcnJvcmYoIiVxIGlzIG5vdCBhIGxhYmVsIG9mIHt7LlF1YWxpZmllZE5hbWV9fSIsIHN0cmluZyh2KSkKICB9CiAgKmRzdCA9IHt7LkJveFR5cGVOYW1lfX17VmFsdWU6IHYsIFN0YXR1czogcGd0eXBlLlByZXNlbnR9CiAgcmV0dXJuIG5pbAp9CgpmdW5jIChkc3QgKnt7LkJveFR5cGVOYW1lfX0pIEdldCgpIGludGVyZmFjZXt9IHsKICBzd2l0Y2ggZHN0LlN0YXR1cyB7CiAgY2FzZSBwZ3R5cGUuUHJlc2VudDoKICAgIHJldHVybiBkc3QuVmFsdWUKICBjYXNlIHBndHlwZS5OdWxsOgogICAgcmV0dXJuIG5pbAogIGRlZmF1bHQ6CiAgICByZXR1cm4gZHN0LlN0YXR1cwogIH0KfQoKZnVuYyAoc3JjICp7ey5Cb3hUeXBlTmFtZX19KSBBc3NpZ25Ubyhkc3QgaW50ZXJmYWNle30pIGVycm9yIHsKICBzd2l0Y2ggc3JjLlN0YXR1cyB7CiAgY2FzZSBwZ3R5cGUuUHJlc2VudDoKICAgIHN3aXRjaCB2IDo9IGRzdC4odHlwZSkgewogICAgY2FzZSAqe3suR29OYW1lfX06CiAgICAgICp2ID0gc3JjLlZhbHVlCiAgICBjYXNlICpzdHJpbmc6CiAgICAgICp2ID0gc3RyaW5nKHNyYy5WYWx1ZSkKICAgIGRlZmF1bHQ6CiAgICAgIHJldHVybiBlcnJvcnMuRXJyb3JmKCJ1bmFibGUgdG8gYXNzaWduIHRvICVUIiwgZHN0KQogICAgfQogICAgcmV0dXJuIG5pbAogIGNhc2UgcGd0eXBlLk51bGw6CiAgICByZXR1cm4gcGd0eXBlLk51bGxBc3NpZ25Ubyhkc3QpCiAgfQoKICByZXR1cm4gZXJyb3JzLkVycm9yZigiY2Fubm90IGFzc2lnbiAldiBpbnRvICVUIiwgc3JjLCBkc3QpCn0KCmZ1bmMgKGRzdCAqe3suQm94VHlwZU5hbWV9fSkgRGVjb2RlVGV4dChjaSAqcGd0eXBlLkNvbm5JbmZvLCBzcmMgW11ieXRlKSBlcnJvciB7CiAgaWYgc3JjID09IG5pbCB7CiAgICAqZHN0ID0ge3suQm94VHlwZU5hbWV9fXtTdGF0dXM6IHBndHlwZS5OdWxsfQogICAgcmV0dXJuIG5pbAogIH0KCiAgKmRzdCA9IHt7LkJveFR5cGVOYW1lfX17VmFsdWU6IHt7LkdvTmFtZX19KHNyYyksIFN0YXR1czogcGd0eXBlLlByZXNlbnR9CiAgcmV0dXJuIG5pbAp9CgovLyBEZWNvZGVCaW5hcnkgaXMgdGhlIHNhbWUgYXMgRGVjb2RlVGV4dCBhcyBlbnVtcyBhcmUgc2VsZWN0ZWQgYXMgdGV4dCB3aGljaAovLyBoYXMgdGhlIHNhbWUgdGV4dCBhbmQgYmluYXJ5IGZvcm1hdC4KZnVuYyAoZHN0ICp7ey5Cb3hUeXBlTmFtZX19KSBEZWNvZGVCaW5hcnkoY2kgKnBndHlwZS5Db25uSW5mbywgc3JjIFtdYnl0ZSkgZXJyb3IgewogIHJldHVybiBkc3QuRGVjb2RlVGV4dChjaSwgc3JjKQp9CgpmdW5jIChzcmMgKnt7LkJveFR5cGVOYW1lfX0pIEVuY29kZVRleHQoY2kgKnBndHlwZS5Db25uSW5mbywgYnVmIFtdYnl0ZSkgKFtdYnl0ZSwgZXJyb3IpIHsKICBzd2l0Y2ggc3JjLlN0YXR1cyB7CiAgY2FzZSBwZ3R5cGUuTnVsbDoKICAgIHJldHVybiBuaWwsIG5pbAogIGNhc2UgcGd0eXBlLlVuZGVmaW5lZDoKICAgIHJldHVybiBuaWwsIGVycm9ycy5OZXcoImNhbm5vdCBlbmNvZGUgc3RhdHVzIHVuZGVmaW5lZCIpCiAgfQoKICByZXR1cm4gYXBwZW5kKGJ1Ziwgc3JjLlZhbHVlLi4uKSwgbmlsCn0K`)
+	if err != nil {
+		panic("Unable to decode template")
+	}
+
+	_, err = templates.New(`enum`).Parse(string(decoded))
+	if err != nil {
+		fmt.Println(string(decoded))
+		panic("Unable to parse template")
+	}
+
+	decoded, err = base64.StdEncoding.DecodeString(`cGFja2FnZSB7ey5Qa2dOYW1lfX0KLy8gVGhpcyBmaWxlIGlzIGF1dG9tYXRpY2FsbHkgZ2VuZXJhdGVkIGJ5IHBneGRhdGEuCgppbXBvcnQgKAogICJjb250ZXh0Int7aWYgYW5kIChub3QgLkZ1bmN0aW9uLlZvaWQpIChub3QgLkZ1bmN0aW9uLlJldHVybnNTZXQpfX0KCiAgZXJyb3JzICJnb2xhbmcub3JnL3gveGVycm9ycyIKICAiZ2l0aHViLmNvbS9qYWNrYy9wZ3gvdjQie3tlbmR9fXt7cmFuZ2UgLkltcG9ydHN9fQogICJ7ey59fSJ7e2VuZH19CikKe3t3aXRoIC5GdW5jdGlvbn19CmNvbnN0IHt7LlNRTENvbnN0TmFtZX19ID0ge3suU1FMTGl0ZXJhbH19Cnt7aWYgLlZvaWR9fQovLyB7ey5Hb05hbWV9fSBjYWxscyB7ey5GdW5jdGlvbk5hbWV9fS4KZnVuYyB7ey5Hb05hbWV9fShjdHggY29udGV4dC5Db250ZXh0LCBkYiBRdWVyeWVye3tyYW5nZSAuUGFyYW1zfX0sIHt7LlZhck5hbWV9fSB7ey5Hb1R5cGV9fXt7ZW5kfX0pIGVycm9yIHsKICBfLCBlcnIgOj0gcHJlcGFyZUV4ZWMoY3R4LCBkYiwgcHJlcGFyZWROYW1lKCJwZ3hkYXRhe3suR29OYW1lfX0iLCB7ey5TUUxDb25zdE5hbWV9fSksIHt7LlNRTENvbnN0TmFtZX19e3tyYW5nZSAuUGFyYW1zfX0sIHt7LlZhck5hbWV9fXt7ZW5kfX0pCiAgcmV0dXJuIGVycgp9Cnt7ZWxzZSBpZiAuUmVzdWx0fX17eyRyZXN1bHRUeXBlIDo9IC5SZXN1bHQuRmllbGRUeXBlfX17e2lmIC5SZXR1cm5zU2V0fX0KLy8ge3suR29OYW1lfX0gY2FsbHMge3suRnVuY3Rpb25OYW1lfX0gYW5kIHJldHVybnMgaXRzIHJlc3VsdHMuCmZ1bmMge3suR29OYW1lfX0oY3R4IGNvbnRleHQuQ29udGV4dCwgZGIgUXVlcnllcnt7cmFuZ2UgLlBhcmFtc319LCB7ey5WYXJOYW1lfX0ge3suR29UeXBlfX17e2VuZH19KSAoW117eyRyZXN1bHRUeXBlfX0sIGVycm9yKSB7CiAgcm93cywgZXJyIDo9IHByZXBhcmVRdWVyeShjdHgsIGRiLCBwcmVwYXJlZE5hbWUoInBneGRhdGF7ey5Hb05hbWV9fSIsIHt7LlNRTENvbnN0TmFtZX19KSwge3suU1FMQ29uc3ROYW1lfX17e3JhbmdlIC5QYXJhbXN9fSwge3suVmFyTmFtZX19e3tlbmR9fSkKICBpZiBlcnIgIT0gbmlsIHsKICAgIHJldHVybiBuaWwsIGVycgogIH0KICBkZWZlciByb3dzLkNsb3NlKCkKCiAgdmFyIHJlc3VsdHMgW117eyRyZXN1bHRUeXBlfX0KICBmb3Igcm93cy5OZXh0KCkgewogICAgdmFyIHJlc3VsdCB7eyRyZXN1bHRUeXBlfX0KICAgIGlmIGVyciA6PSByb3dzLlNjYW4oJnJlc3VsdCk7IGVyciAhPSBuaWwgewogICAgICByZXR1cm4gbmlsLCBlcnIKICAgIH0KICAgIHJlc3VsdHMgPSBhcHBlbmQocmVzdWx0cywgcmVzdWx0KQogIH0KCiAgaWYgcm93cy5FcnIoKSAhPSBuaWwgewogICAgcmV0dXJuIG5pbCwgcm93cy5FcnIoKQogIH0KCiAgcmV0dXJuIHJlc3VsdHMsIG5pbAp9Cnt7ZWxzZX19Ci8vIHt7LkdvTmFtZX19IGNhbGxzIHt7LkZ1bmN0aW9uTmFtZX19IGFuZCByZXR1cm5zIGl0cyByZXN1bHQuIEl0IHJldHVybnMKLy8gRXJyTm90Rm91bmQgd2hlbiB0aGVyZSBpcyBubyByZXN1bHQuCmZ1bmMge3suR29OYW1lfX0oY3R4IGNvbnRleHQuQ29udGV4dCwgZGIgUXVlcnllcnt7cmFuZ2UgLlBhcmFtc319LCB7ey5WYXJOYW1lfX0ge3suR29UeXBlfX17e2VuZH19KSAoe3skcmVzdWx0VHlwZX19LCBlcnJvcikgewogIHZhciByZXN1bHQge3skcmVzdWx0VHlwZX19CiAgZXJyIDo9IHByZXBhcmVRdWVyeVJvdyhjdHgsIGRiLCBwcmVwYXJlZE5hbWUoInBneGRhdGF7ey5Hb05hbWV9fSIsIHt7LlNRTENvbnN0TmFtZX19KSwge3suU1FMQ29uc3ROYW1lfX17e3JhbmdlIC5QYXJhbXN9fSwge3suVmFyTmFtZX19e3tlbmR9fSkuU2NhbigmcmVzdWx0KQogIGlmIGVycm9ycy5JcyhlcnIsIHBneC5FcnJOb1Jvd3MpIHsKICAgIHJldHVybiByZXN1bHQsIEVyck5vdEZvdW5kCiAgfQogIHJldHVybiByZXN1bHQsIGVycgp9Cnt7ZW5kfX17e2Vsc2V9fXt7aWYgbm90IC5Sb3dUeXBlfX0KLy8ge3suUm93TmFtZX19IGlzIGEgcm93IHJldHVybmVkIGJ5IHt7LkdvTmFtZX19Lgp0eXBlIHt7LlJvd05hbWV9fSBzdHJ1Y3Qgewp7e3JhbmdlIC5Db2x1bW5zfX0gIHt7LkZpZWxkTmFtZX19IHt7LkZpZWxkVHlwZX19Cnt7ZW5kfX19Cnt7ZW5kfX17e2lmIC5SZXR1cm5zU2V0fX0KLy8ge3suR29OYW1lfX0gY2FsbHMge3suRnVuY3Rpb25OYW1lfX0gYW5kIHJldHVybnMgaXRzIHJvd3MuCmZ1bmMge3suR29OYW1lfX0oY3R4IGNvbnRleHQuQ29udGV4dCwgZGIgUXVlcnllcnt7cmFuZ2UgLlBhcmFtc319LCB7ey5WYXJOYW1lfX0ge3suR29UeXBlfX17e2VuZH19KSAoW117ey5Sb3dOYW1lfX0sIGVycm9yKSB7CiAgZGJSb3dzLCBlcnIgOj0gcHJlcGFyZVF1ZXJ5KGN0eCwgZGIsIHByZXBhcmVkTmFtZSgicGd4ZGF0YXt7LkdvTmFtZX19Iiwge3suU1FMQ29uc3ROYW1lfX0pLCB7ey5TUUxDb25zdE5hbWV9fXt7d2l0aCAuUmVzdWx0Rm9ybWF0c319LCB7ey59fXt7ZW5kfX17e3JhbmdlIC5QYXJhbXN9fSwge3suVmFyTmFtZX19e3tlbmR9fSkKICBpZiBlcnIgIT0gbmlsIHsKICAgIHJldHVybiBuaWwsIGVycgogIH0KICBkZWZlciBkYlJvd3MuQ2xvc2UoKQoKICB2YXIgcm93cyBbXXt7LlJvd05hbWV9fQogIGZvciBkYlJvd3MuTmV4dCgpIHsKICAgIHZhciByb3cge3suUm93TmFtZX19CiAgICBlcnIgOj0gZGJSb3dzLlNjYW4oCnt7cmFuZ2UgLkNvbHVtbnN9fSZyb3cue3suRmllbGROYW1lfX0sCiAgICB7e2VuZH19KQogICAgaWYgZXJyICE9IG5pbCB7CiAgICAgIHJldHVybiBuaWwsIGVycgogICAgfQogICAgcm93cyA9IGFwcGVuZChyb3dzLCByb3cpCiAgfQoKICBpZiBkYlJvd3MuRXJyKCkgIT0gbmlsIHsKICAgIHJldHVybiBuaWwsIGRiUm93cy5FcnIoKQogIH0KCiAgcmV0dXJuIHJvd3MsIG5pbAp9Cnt7ZWxzZX19Ci8vIHt7LkdvTmFtZX19IGNhbGxzIHt7LkZ1bmN0aW9uTmFtZX19IGFuZCByZXR1cm5zIGl0cyByb3cuIEl0IHJldHVybnMKLy8gRXJyTm90Rm91bmQgd2hlbiB0aGVyZSBpcyBubyByb3cuCmZ1bmMge3suR29OYW1lfX0oY3R4IGNvbnRleHQuQ29udGV4dCwgZGIgUXVlcnllcnt7cmFuZ2UgLlBhcmFtc319LCB7ey5WYXJOYW1lfX0ge3suR29UeXBlfX17e2VuZH19KSAoKnt7LlJvd05hbWV9fSwgZXJyb3IpIHsKICB2YXIgcm93IHt7LlJvd05hbWV9fQogIGVyciA6PSBwcmVwYXJlUXVlcnlSb3coY3R4LCBkYiwgcHJlcGFyZWROYW1lKCJwZ3hkYXRhe3suR29OYW1lfX0iLCB7ey5TUUxDb25zdE5hbWV9fSksIHt7LlNRTENvbnN0TmFtZX19e3t3aXRoIC5SZXN1bHRGb3JtYXRzfX0sIHt7Ln19e3tlbmR9fXt7cmFuZ2UgLlBhcmFtc319LCB7ey5WYXJOYW1lfX17e2VuZH19KS5TY2FuKAp7e3JhbmdlIC5Db2x1bW5zfX0mcm93Lnt7LkZpZWxkTmFtZX19LAogICAge3tlbmR9fSkKICBpZiBlcnJvcnMuSXMoZXJyLCBwZ3guRXJyTm9Sb3dzKSB7CiAgICByZXR1cm4gbmlsLCBFcnJOb3RGb3VuZAogIH0gZWxzZSBpZiBlcnIgIT0gbmlsIHsKICAgIHJldHVybiBuaWwsIGVycgogIH0KCiAgcmV0dXJuICZyb3csIG5pbAp9Cnt7ZW5kfX17e2VuZH19e3tlbmR9fQo=`)
+	if err != nil {
+		panic("Unable to decode template")
+	}
+
+	_, err = templates.New(`function`).Parse(string(decoded))
+	if err != nil {
+		fmt.Println(string(decoded))
+		panic("Unable to parse template")
+	}
+
+	decoded, err = base64.StdEncoding.DecodeString(`ZnVuYyBJbnNlcnR7ey5TdHJ1Y3ROYW1lfX0oY3R4IGNvbnRleHQuQ29udGV4dCwgZGIgUXVlcnllciwgcm93ICp7ey5TdHJ1Y3ROYW1lfX17e2lmIC5Hb1N0eWxlfX0sIGZpZWxkcyAuLi57ey5TdHJ1Y3ROYW1lfX1GaWVsZHt7ZW5kfX0pIGVycm9yIHsKICBhcmdzIDo9IHBneC5RdWVyeUFyZ3MobWFrZShbXWludGVyZmFjZXt9LCAwLCB7e2xlbiAuQ29sdW1uc319KSkKCiAgdmFyIGNvbHVtbnMsIHZhbHVlcyBbXXN0cmluZwoKe3tpZiAuR29TdHlsZX19ICBmb3IgXywgZiA6PSByYW5nZSBmaWVsZHMgewogICAgc3dpdGNoIGYgewp7e3JhbmdlIC5Db2x1bW5zfX0gICAgY2FzZSB7eyQuU3RydWN0TmFtZX19e3suRmllbGROYW1lfX1GaWVsZDp7e2lmIC5HZW5lcmF0ZWRBbHdheXN9fQogICAgICByZXR1cm4gZXJyb3JzLk5ldygie3suQ29sdW1uTmFtZX19IGlzIGdlbmVyYXRlZCBhbmQgY2Fubm90IGJlIHNldCIpe3tlbHNlfX0KICAgICAgY29sdW1ucyA9IGFwcGVuZChjb2x1bW5zLCBge3suQ29sdW1uTmFtZX19YCkKICAgICAgdmFsdWVzID0gYXBwZW5kKHZhbHVlcywgYXJncy5BcHBlbmQoe3suRmllbGRBcmd9fSkpe3tlbmR9fQp7e2VuZH19ICAgIGRlZmF1bHQ6CiAgICAgIHJldHVybiBlcnJvcnMuRXJyb3JmKCJ1bmtub3duIHt7LlN0cnVjdE5hbWV9fUZpZWxkICVkIiwgZikKICAgIH0KICB9Cnt7ZWxzZX19e3tyYW5nZSAuQ29sdW1uc319e3tpZiBub3QgLkdlbmVyYXRlZEFsd2F5c319ICBpZiByb3cue3suRmllbGROYW1lfX0uU3RhdHVzICE9IHBndHlwZS5VbmRlZmluZWQgewogICAgY29sdW1ucyA9IGFwcGVuZChjb2x1bW5zLCBge3suQ29sdW1uTmFtZX19YCkKICAgIHZhbHVlcyA9IGFwcGVuZCh2YWx1ZXMsIGFyZ3MuQXBwZW5kKCZyb3cue3suRmllbGROYW1lfX0pKQogIH0Ke3tlbmR9fXt7ZW5kfX17e2VuZH19CgogIGluc2VydCA6PSBgIGRlZmF1bHQgdmFsdWVzYAogIGlmIGxlbihjb2x1bW5zKSA+IDAgewogICAgaW5zZXJ0ID0gYChgICsgc3RyaW5ncy5Kb2luKGNvbHVtbnMsICIsICIpICsgYCkKdmFsdWVzKGAgKyBzdHJpbmdzLkpvaW4odmFsdWVzLCAiLCIpICsgYClgCiAgfQoKICBzcWwgOj0gYGluc2VydCBpbnRvIHt7LlF1YWxpZmllZFRhYmxlTmFtZX19YCArIGluc2VydCArIGAKcmV0dXJuaW5nIHt7IHJhbmdlICRpLCAkY29sdW1uIDo9IC5SZXR1cm5pbmdDb2x1bW5zfX17e2lmICRpfX0sIHt7ZW5kfX17eyRjb2x1bW4uU2VsZWN0RXhwcn19e3tlbmR9fQogIGAKCiAgcHNOYW1lIDo9IHByZXBhcmVkTmFtZSgicGd4ZGF0YUluc2VydHt7LlN0cnVjdE5hbWV9fSIsIHNxbCkKCiAgcmV0dXJuIHByZXBhcmVRdWVyeVJvdyhjdHgsIGRiLCBwc05hbWUsIHNxbCwgYXJncy4uLikuU2Nhbih7eyByYW5nZSAkaSwgJGNvbHVtbiA6PSAuUmV0dXJuaW5nQ29sdW1uc319e3tpZiAkaX19LCB7e2VuZH19JnJvdy57eyRjb2x1bW4uRmllbGROYW1lfX17e2VuZH19KQp9Cg==`)
 	if err != nil {
 		panic("Unable to decode template")
 	}
@@ -73,7 +117,40 @@ func loadTemplates() *template.Template {
 		panic("Unable to parse template")
 	}
 
-	decoded, err = base64.StdEncoding.DecodeString(`cGFja2FnZSB7ey5Qa2dOYW1lfX0KLy8gVGhpcyBmaWxlIGlzIGF1dG9tYXRpY2FsbHkgZ2VuZXJhdGVkIGJ5IHBneGRhdGEuCgppbXBvcnQgKAogICJjb250ZXh0IgogICJzdHJpbmdzIgoKICBlcnJvcnMgImdvbGFuZy5vcmcveC94ZXJyb3JzIgogICJnaXRodWIuY29tL2phY2tjL3BneC92NCIKICAiZ2l0aHViLmNvbS9qYWNrYy9wZ3R5cGUiCikKCnR5cGUge3suU3RydWN0TmFtZX19IHN0cnVjdCB7Cnt7cmFuZ2UgLkNvbHVtbnN9fSAge3suRmllbGROYW1lfX0ge3suR29Cb3hUeXBlfX0Ke3tlbmR9fX0KCnt7dGVtcGxhdGUgImNvdW50X2Z1bmMiIC59fQp7e3RlbXBsYXRlICJzZWxlY3RfYWxsX2Z1bmMiIC59fQp7e3RlbXBsYXRlICJzZWxlY3RfYnlfcGtfZnVuYyIgLn19Cnt7dGVtcGxhdGUgImluc2VydF9mdW5jIiAufX0Ke3t0ZW1wbGF0ZSAidXBkYXRlX2Z1bmMiIC59fQp7e3RlbXBsYXRlICJkZWxldGVfZnVuYyIgLn19Cg==`)
+	decoded, err = base64.StdEncoding.DecodeString(`cGFja2FnZSB7ey5Qa2dOYW1lfX0KLy8gVGhpcyBmaWxlIGlzIGF1dG9tYXRpY2FsbHkgZ2VuZXJhdGVkIGJ5IHBneGRhdGEuCgppbXBvcnQgKAogICJjb250ZXh0Int7aWYgb3IgLkhhc09uZSAuSGFzUmVzdWx0Rm9ybWF0c319Cnt7aWYgLkhhc09uZX19CiAgZXJyb3JzICJnb2xhbmcub3JnL3gveGVycm9ycyJ7e2VuZH19CiAgImdpdGh1Yi5jb20vamFja2MvcGd4L3Y0Int7ZW5kfX17e3JhbmdlIC5JbXBvcnRzfX0KICAie3sufX0ie3tlbmR9fQopCnt7cmFuZ2UgLlF1ZXJpZXN9fQpjb25zdCB7ey5TUUxDb25zdE5hbWV9fSA9IHt7LlNRTExpdGVyYWx9fQp7e2lmIGVxIC5Db21tYW5kICJleGVjIn19Ci8vIHt7Lk5hbWV9fSBydW5zIHt7LlNRTENvbnN0TmFtZX19IGFuZCByZXR1cm5zIHRoZSBudW1iZXIgb2Ygcm93cyBhZmZlY3RlZC4KZnVuYyB7ey5OYW1lfX0oY3R4IGNvbnRleHQuQ29udGV4dCwgZGIgUXVlcnllcnt7cmFuZ2UgLlBhcmFtc319LCB7ey5WYXJOYW1lfX0ge3suR29UeXBlfX17e2VuZH19KSAoaW50NjQsIGVycm9yKSB7CiAgY29tbWFuZFRhZywgZXJyIDo9IHByZXBhcmVFeGVjKGN0eCwgZGIsIHByZXBhcmVkTmFtZSgicGd4ZGF0YXt7Lk5hbWV9fSIsIHt7LlNRTENvbnN0TmFtZX19KSwge3suU1FMQ29uc3ROYW1lfX17e3JhbmdlIC5QYXJhbXN9fSwge3suVmFyTmFtZX19e3tlbmR9fSkKICBpZiBlcnIgIT0gbmlsIHsKICAgIHJldHVybiAwLCBlcnIKICB9CiAgcmV0dXJuIGNvbW1hbmRUYWcuUm93c0FmZmVjdGVkKCksIG5pbAp9Cnt7ZWxzZX19Ci8vIHt7Lk5hbWV9fVJvdyBpcyBhIHJvdyByZXR1cm5lZCBieSB7ey5OYW1lfX0uCnR5cGUge3suTmFtZX19Um93IHN0cnVjdCB7Cnt7cmFuZ2UgLkNvbHVtbnN9fSAge3suRmllbGROYW1lfX0ge3suRmllbGRUeXBlfX0Ke3tlbmR9fX0Ke3tpZiBlcSAuQ29tbWFuZCAib25lIn19Ci8vIHt7Lk5hbWV9fSByZXR1cm5zIHRoZSBmaXJzdCByb3cgb2Yge3suU1FMQ29uc3ROYW1lfX0uIEl0IHJldHVybnMgRXJyTm90Rm91bmQgd2hlbiB0aGVyZSBhcmUgbm8gcm93cy4KZnVuYyB7ey5OYW1lfX0oY3R4IGNvbnRleHQuQ29udGV4dCwgZGIgUXVlcnllcnt7cmFuZ2UgLlBhcmFtc319LCB7ey5WYXJOYW1lfX0ge3suR29UeXBlfX17e2VuZH19KSAoKnt7Lk5hbWV9fVJvdywgZXJyb3IpIHsKICB2YXIgcm93IHt7Lk5hbWV9fVJvdwogIGVyciA6PSBwcmVwYXJlUXVlcnlSb3coY3R4LCBkYiwgcHJlcGFyZWROYW1lKCJwZ3hkYXRhe3suTmFtZX19Iiwge3suU1FMQ29uc3ROYW1lfX0pLCB7ey5TUUxDb25zdE5hbWV9fXt7d2l0aCAuUmVzdWx0Rm9ybWF0c319LCB7ey59fXt7ZW5kfX17e3JhbmdlIC5QYXJhbXN9fSwge3suVmFyTmFtZX19e3tlbmR9fSkuU2NhbigKe3tyYW5nZSAuQ29sdW1uc319JnJvdy57ey5GaWVsZE5hbWV9fSwKICAgIHt7ZW5kfX0pCiAgaWYgZXJyb3JzLklzKGVyciwgcGd4LkVyck5vUm93cykgewogICAgcmV0dXJuIG5pbCwgRXJyTm90Rm91bmQKICB9IGVsc2UgaWYgZXJyICE9IG5pbCB7CiAgICByZXR1cm4gbmlsLCBlcnIKICB9CgogIHJldHVybiAmcm93LCBuaWwKfQp7e2Vsc2V9fQovLyB7ey5OYW1lfX0gcmV0dXJucyB0aGUgcm93cyBvZiB7ey5TUUxDb25zdE5hbWV9fS4KZnVuYyB7ey5OYW1lfX0oY3R4IGNvbnRleHQuQ29udGV4dCwgZGIgUXVlcnllcnt7cmFuZ2UgLlBhcmFtc319LCB7ey5WYXJOYW1lfX0ge3suR29UeXBlfX17e2VuZH19KSAoW117ey5OYW1lfX1Sb3csIGVycm9yKSB7CiAgZGJSb3dzLCBlcnIgOj0gcHJlcGFyZVF1ZXJ5KGN0eCwgZGIsIHByZXBhcmVkTmFtZSgicGd4ZGF0YXt7Lk5hbWV9fSIsIHt7LlNRTENvbnN0TmFtZX19KSwge3suU1FMQ29uc3ROYW1lfX17e3dpdGggLlJlc3VsdEZvcm1hdHN9fSwge3sufX17e2VuZH19e3tyYW5nZSAuUGFyYW1zfX0sIHt7LlZhck5hbWV9fXt7ZW5kfX0pCiAgaWYgZXJyICE9IG5pbCB7CiAgICByZXR1cm4gbmlsLCBlcnIKICB9CiAgZGVmZXIgZGJSb3dzLkNsb3NlKCkKCiAgdmFyIHJvd3MgW117ey5OYW1lfX1Sb3cKICBmb3IgZGJSb3dzLk5leHQoKSB7CiAgICB2YXIgcm93IHt7Lk5hbWV9fVJvdwogICAgZXJyIDo9IGRiUm93cy5TY2FuKAp7e3JhbmdlIC5Db2x1bW5zfX0mcm93Lnt7LkZpZWxkTmFtZX19LAogICAge3tlbmR9fSkKICAgIGlmIGVyciAhPSBuaWwgewogICAgICByZXR1cm4gbmlsLCBlcnIKICAgIH0KICAgIHJvd3MgPSBhcHBlbmQocm93cywgcm93KQogIH0KCiAgaWYgZGJSb3dzLkVycigpICE9IG5pbCB7CiAgICByZXR1cm4gbmlsLCBkYlJvd3MuRXJyKCkKICB9CgogIHJldHVybiByb3dzLCBuaWwKfQp7e2VuZH19e3tlbmR9fXt7ZW5kfX0K`)
+	if err != nil {
+		panic("Unable to decode template")
+	}
+
+	_, err = templates.New(`query`).Parse(string(decoded))
+	if err != nil {
+		fmt.Println(string(decoded))
+		panic("Unable to parse template")
+	}
+
+	decoded, err = base64.StdEncoding.DecodeString(`Ly8gUXVldWVTZWxlY3R7ey5TdHJ1Y3ROYW1lfX1CeVBLIHF1ZXVlcyBzZWxlY3RpbmcgdGhlIHJvdyBieSBwcmltYXJ5IGtleSBpbiBiLgovLyBUaGUgcmV0dXJuZWQgcmVhZGVyIHNjYW5zIHRoZSByb3cgaW50byBkc3Qgb3IgcmV0dXJucyBFcnJOb3RGb3VuZCBpZiB0aGVyZQovLyBpcyBubyBzdWNoIHJvdy4KZnVuYyBRdWV1ZVNlbGVjdHt7LlN0cnVjdE5hbWV9fUJ5UEsoYiAqcGd4LkJhdGNoe3tyYW5nZSAuUHJpbWFyeUtleUNvbHVtbnN9fSwge3suVmFyTmFtZX19IHt7LkdvVHlwZX19e3tlbmR9fSwgZHN0ICp7ey5TdHJ1Y3ROYW1lfX0pIEJhdGNoUmVhZGVyIHsKICBhcmdzIDo9IFtdaW50ZXJmYWNle317IHt7LSByYW5nZSAkaSwgJGNvbHVtbiA6PSAuUHJpbWFyeUtleUNvbHVtbnN9fXt7aWYgJGl9fSwge3tlbmR9fXt7JGNvbHVtbi5WYXJOYW1lfX17e2VuZCAtfX0gfQogIG9pZHMgOj0gW11wZ3R5cGUuT0lEeyB7ey0gcmFuZ2UgJGksICRjb2x1bW4gOj0gLlByaW1hcnlLZXlDb2x1bW5zfX17e2lmICRpfX0sIHt7ZW5kfX17eyRjb2x1bW4uUGFyYW1PSUR9fXt7ZW5kIC19fSB9CgogIHJldHVybiBxdWV1ZShiLCBzZWxlY3R7ey5TdHJ1Y3ROYW1lfX1CeVBLU1FMLCBhcmdzLCBvaWRzLCBmdW5jKHJlc3VsdHMgcGd4LkJhdGNoUmVzdWx0cykgZXJyb3IgewogICAgZXJyIDo9IHJlc3VsdHMuUXVlcnlSb3dSZXN1bHRzKCkuU2NhbigKe3tyYW5nZSAuQ29sdW1uc319JmRzdC57ey5GaWVsZE5hbWV9fSwKICAgIHt7ZW5kfX0pCiAgICBpZiBlcnJvcnMuSXMoZXJyLCBwZ3guRXJyTm9Sb3dzKSB7CiAgICAgIHJldHVybiBFcnJOb3RGb3VuZAogICAgfQogICAgcmV0dXJuIGVycgogIH0pCn0KCi8vIFF1ZXVlSW5zZXJ0e3suU3RydWN0TmFtZX19IHF1ZXVlcyBpbnNlcnRpbmcgcm93IGluIGIuIExpa2UgSW5zZXJ0e3suU3RydWN0TmFtZX19Ci8vIHRoZSByZXR1cm5lZCByZWFkZXIgc2NhbnMgdGhlIHBlcnNpc3RlZCByb3cgaW50byByb3cuCmZ1bmMgUXVldWVJbnNlcnR7ey5TdHJ1Y3ROYW1lfX0oYiAqcGd4LkJhdGNoLCByb3cgKnt7LlN0cnVjdE5hbWV9fXt7aWYgLkdvU3R5bGV9fSwgZmllbGRzIC4uLnt7LlN0cnVjdE5hbWV9fUZpZWxke3tlbmR9fSkgQmF0Y2hSZWFkZXIgewogIGFyZ3MgOj0gcGd4LlF1ZXJ5QXJncyhtYWtlKFtdaW50ZXJmYWNle30sIDAsIHt7bGVuIC5Db2x1bW5zfX0pKQogIG9pZHMgOj0gbWFrZShbXXBndHlwZS5PSUQsIDAsIHt7bGVuIC5Db2x1bW5zfX0pCgogIHZhciBjb2x1bW5zLCB2YWx1ZXMgW11zdHJpbmcKCnt7aWYgLkdvU3R5bGV9fSAgZm9yIF8sIGYgOj0gcmFuZ2UgZmllbGRzIHsKICAgIHN3aXRjaCBmIHsKe3tyYW5nZSAuQ29sdW1uc319ICAgIGNhc2Uge3skLlN0cnVjdE5hbWV9fXt7LkZpZWxkTmFtZX19RmllbGQ6e3tpZiAuR2VuZXJhdGVkQWx3YXlzfX0KICAgICAgcmV0dXJuIHF1ZXVlRmFpbGVkKGVycm9ycy5OZXcoInt7LkNvbHVtbk5hbWV9fSBpcyBnZW5lcmF0ZWQgYW5kIGNhbm5vdCBiZSBzZXQiKSl7e2Vsc2V9fQogICAgICBjb2x1bW5zID0gYXBwZW5kKGNvbHVtbnMsIGB7ey5Db2x1bW5OYW1lfX1gKQogICAgICB2YWx1ZXMgPSBhcHBlbmQodmFsdWVzLCBhcmdzLkFwcGVuZCh7ey5GaWVsZEFyZ319KSkKICAgICAgb2lkcyA9IGFwcGVuZChvaWRzLCB7ey5QYXJhbU9JRH19KXt7ZW5kfX0Ke3tlbmR9fSAgICBkZWZhdWx0OgogICAgICByZXR1cm4gcXVldWVGYWlsZWQoZXJyb3JzLkVycm9yZigidW5rbm93biB7ey5TdHJ1Y3ROYW1lfX1GaWVsZCAlZCIsIGYpKQogICAgfQogIH0Ke3tlbHNlfX17e3JhbmdlIC5Db2x1bW5zfX17e2lmIG5vdCAuR2VuZXJhdGVkQWx3YXlzfX0gIGlmIHJvdy57ey5GaWVsZE5hbWV9fS5TdGF0dXMgIT0gcGd0eXBlLlVuZGVmaW5lZCB7CiAgICBjb2x1bW5zID0gYXBwZW5kKGNvbHVtbnMsIGB7ey5Db2x1bW5OYW1lfX1gKQogICAgdmFsdWVzID0gYXBwZW5kKHZhbHVlcywgYXJncy5BcHBlbmQoJnJvdy57ey5GaWVsZE5hbWV9fSkpCiAgICBvaWRzID0gYXBwZW5kKG9pZHMsIHt7LlBhcmFtT0lEfX0pCiAgfQp7e2VuZH19e3tlbmR9fXt7ZW5kfX0KICBpbnNlcnQgOj0gYCBkZWZhdWx0IHZhbHVlc2AKICBpZiBsZW4oY29sdW1ucykgPiAwIHsKICAgIGluc2VydCA9IGAoYCArIHN0cmluZ3MuSm9pbihjb2x1bW5zLCAiLCAiKSArIGApCnZhbHVlcyhgICsgc3RyaW5ncy5Kb2luKHZhbHVlcywgIiwiKSArIGApYAogIH0KCiAgc3FsIDo9IGBpbnNlcnQgaW50byB7ey5RdWFsaWZpZWRUYWJsZU5hbWV9fWAgKyBpbnNlcnQgKyBgCnJldHVybmluZyB7eyByYW5nZSAkaSwgJGNvbHVtbiA6PSAuUmV0dXJuaW5nQ29sdW1uc319e3tpZiAkaX19LCB7e2VuZH19e3skY29sdW1uLlNlbGVjdEV4cHJ9fXt7ZW5kfX1gCgogIHJldHVybiBxdWV1ZShiLCBzcWwsIGFyZ3MsIG9pZHMsIGZ1bmMocmVzdWx0cyBwZ3guQmF0Y2hSZXN1bHRzKSBlcnJvciB7CiAgICByZXR1cm4gcmVzdWx0cy5RdWVyeVJvd1Jlc3VsdHMoKS5TY2FuKHt7IHJhbmdlICRpLCAkY29sdW1uIDo9IC5SZXR1cm5pbmdDb2x1bW5zfX17e2lmICRpfX0sIHt7ZW5kfX0mcm93Lnt7JGNvbHVtbi5GaWVsZE5hbWV9fXt7ZW5kfX0pCiAgfSkKfQoKLy8gUXVldWVVcGRhdGV7ey5TdHJ1Y3ROYW1lfX0gcXVldWVzIHVwZGF0aW5nIHRoZSByb3cgYnkgcHJpbWFyeSBrZXkgaW4gYi4gTGlrZQovLyBVcGRhdGV7ey5TdHJ1Y3ROYW1lfX0gdGhlIHJldHVybmVkIHJlYWRlciBzY2FucyB0aGUgcGVyc2lzdGVkIHJvdyBpbnRvIHJvdwovLyBvciByZXR1cm5zIEVyck5vdEZvdW5kIGlmIHRoZXJlIGlzIG5vIHN1Y2ggcm93LiBOb3RoaW5nIGlzIHF1ZXVlZCB3aGVuCi8vIHRoZXJlIGlzIG5vdGhpbmcgdG8gdXBkYXRlLgpmdW5jIFF1ZXVlVXBkYXRle3suU3RydWN0TmFtZX19KGIgKnBneC5CYXRjaHt7cmFuZ2UgLlByaW1hcnlLZXlDb2x1bW5zfX0sIHt7LlZhck5hbWV9fSB7ey5Hb1R5cGV9fXt7ZW5kfX0sIHJvdyAqe3suU3RydWN0TmFtZX19e3tpZiAuR29TdHlsZX19LCBmaWVsZHMgLi4ue3suU3RydWN0TmFtZX19RmllbGR7e2VuZH19KSBCYXRjaFJlYWRlciB7CiAgc2V0cyA6PSBtYWtlKFtdc3RyaW5nLCAwLCB7e2xlbiAuQ29sdW1uc319KQogIGFyZ3MgOj0gcGd4LlF1ZXJ5QXJncyhtYWtlKFtdaW50ZXJmYWNle30sIDAsIHt7bGVuIC5Db2x1bW5zfX0pKQogIG9pZHMgOj0gbWFrZShbXXBndHlwZS5PSUQsIDAsIHt7bGVuIC5Db2x1bW5zfX0pCgp7e2lmIC5Hb1N0eWxlfX0gIGZvciBfLCBmIDo9IHJhbmdlIGZpZWxkcyB7CiAgICBzd2l0Y2ggZiB7Cnt7cmFuZ2UgLkNvbHVtbnN9fSAgICBjYXNlIHt7JC5TdHJ1Y3ROYW1lfX17ey5GaWVsZE5hbWV9fUZpZWxkOnt7aWYgLkdlbmVyYXRlZEFsd2F5c319CiAgICAgIHJldHVybiBxdWV1ZUZhaWxlZChlcnJvcnMuTmV3KCJ7ey5Db2x1bW5OYW1lfX0gaXMgZ2VuZXJhdGVkIGFuZCBjYW5ub3QgYmUgc2V0Iikpe3tlbHNlfX0KICAgICAgc2V0cyA9IGFwcGVuZChzZXRzLCBge3suQ29sdW1uTmFtZX19YCsiPSIrYXJncy5BcHBlbmQoe3suRmllbGRBcmd9fSkpCiAgICAgIG9pZHMgPSBhcHBlbmQob2lkcywge3suUGFyYW1PSUR9fSl7e2VuZH19Cnt7ZW5kfX0gICAgZGVmYXVsdDoKICAgICAgcmV0dXJuIHF1ZXVlRmFpbGVkKGVycm9ycy5FcnJvcmYoInVua25vd24ge3suU3RydWN0TmFtZX19RmllbGQgJWQiLCBmKSkKICAgIH0KICB9Cnt7ZWxzZX19e3tyYW5nZSAuQ29sdW1uc319e3tpZiBub3QgLkdlbmVyYXRlZEFsd2F5c319ICBpZiByb3cue3suRmllbGROYW1lfX0uU3RhdHVzICE9IHBndHlwZS5VbmRlZmluZWQgewogICAgc2V0cyA9IGFwcGVuZChzZXRzLCBge3suQ29sdW1uTmFtZX19YCsiPSIrYXJncy5BcHBlbmQoJnJvdy57ey5GaWVsZE5hbWV9fSkpCiAgICBvaWRzID0gYXBwZW5kKG9pZHMsIHt7LlBhcmFtT0lEfX0pCiAgfQp7e2VuZH19e3tlbmR9fXt7ZW5kfX0KICBpZiBsZW4oc2V0cykgPT0gMCB7CiAgICByZXR1cm4gZnVuYyhwZ3guQmF0Y2hSZXN1bHRzKSBlcnJvciB7IHJldHVybiBuaWwgfQogIH0KCiAgc3FsIDo9IGB1cGRhdGUge3suUXVhbGlmaWVkVGFibGVOYW1lfX0gc2V0IGAgKyBzdHJpbmdzLkpvaW4oc2V0cywgIiwgIikgKyBgIHdoZXJlIGAge3sgcmFuZ2UgJGksICRjb2x1bW4gOj0gLlByaW1hcnlLZXlDb2x1bW5zfX0gKyBge3tpZiAkaX19IGFuZCB7e2VuZH19Int7JGNvbHVtbi5Db2x1bW5OYW1lfX0iPWAgKyBhcmdzLkFwcGVuZCh7eyRjb2x1bW4uVmFyTmFtZX19KXt7ZW5kfX0gKyBgCnJldHVybmluZyB7eyByYW5nZSAkaSwgJGNvbHVtbiA6PSAuUmV0dXJuaW5nQ29sdW1uc319e3tpZiAkaX19LCB7e2VuZH19e3skY29sdW1uLlNlbGVjdEV4cHJ9fXt7ZW5kfX1gCiAgb2lkcyA9IGFwcGVuZChvaWRze3tyYW5nZSAuUHJpbWFyeUtleUNvbHVtbnN9fSwge3suUGFyYW1PSUR9fXt7ZW5kfX0pCgogIHJldHVybiBxdWV1ZShiLCBzcWwsIGFyZ3MsIG9pZHMsIGZ1bmMocmVzdWx0cyBwZ3guQmF0Y2hSZXN1bHRzKSBlcnJvciB7CiAgICBlcnIgOj0gcmVzdWx0cy5RdWVyeVJvd1Jlc3VsdHMoKS5TY2FuKHt7IHJhbmdlICRpLCAkY29sdW1uIDo9IC5SZXR1cm5pbmdDb2x1bW5zfX17e2lmICRpfX0sIHt7ZW5kfX0mcm93Lnt7JGNvbHVtbi5GaWVsZE5hbWV9fXt7ZW5kfX0pCiAgICBpZiBlcnJvcnMuSXMoZXJyLCBwZ3guRXJyTm9Sb3dzKSB7CiAgICAgIHJldHVybiBFcnJOb3RGb3VuZAogICAgfQogICAgcmV0dXJuIGVycgogIH0pCn0KCi8vIFF1ZXVlRGVsZXRle3suU3RydWN0TmFtZX19IHF1ZXVlcyBkZWxldGluZyB0aGUgcm93IGJ5IHByaW1hcnkga2V5IGluIGIuIFRoZQovLyByZXR1cm5lZCByZWFkZXIgcmV0dXJucyBFcnJOb3RGb3VuZCBpZiB0aGVyZSBpcyBubyBzdWNoIHJvdy4KZnVuYyBRdWV1ZURlbGV0ZXt7LlN0cnVjdE5hbWV9fShiICpwZ3guQmF0Y2h7e3JhbmdlIC5QcmltYXJ5S2V5Q29sdW1uc319LCB7ey5WYXJOYW1lfX0ge3suR29UeXBlfX17e2VuZH19KSBCYXRjaFJlYWRlciB7CiAgYXJncyA6PSBwZ3guUXVlcnlBcmdzKG1ha2UoW11pbnRlcmZhY2V7fSwgMCwge3tsZW4gLlByaW1hcnlLZXlDb2x1bW5zfX0pKQoKICBzcWwgOj0gYGRlbGV0ZSBmcm9tIHt7LlF1YWxpZmllZFRhYmxlTmFtZX19IHdoZXJlIGAge3sgcmFuZ2UgJGksICRjb2x1bW4gOj0gLlByaW1hcnlLZXlDb2x1bW5zfX0gKyBge3tpZiAkaX19IGFuZCB7e2VuZH19Int7JGNvbHVtbi5Db2x1bW5OYW1lfX0iPWAgKyBhcmdzLkFwcGVuZCh7eyRjb2x1bW4uVmFyTmFtZX19KXt7ZW5kfX0KICBvaWRzIDo9IFtdcGd0eXBlLk9JRHsge3stIHJhbmdlICRpLCAkY29sdW1uIDo9IC5QcmltYXJ5S2V5Q29sdW1uc319e3tpZiAkaX19LCB7e2VuZH19e3skY29sdW1uLlBhcmFtT0lEfX17e2VuZCAtfX0gfQoKICByZXR1cm4gcXVldWUoYiwgc3FsLCBhcmdzLCBvaWRzLCBmdW5jKHJlc3VsdHMgcGd4LkJhdGNoUmVzdWx0cykgZXJyb3IgewogICAgY29tbWFuZFRhZywgZXJyIDo9IHJlc3VsdHMuRXhlY1Jlc3VsdHMoKQogICAgaWYgZXJyICE9IG5pbCB7CiAgICAgIHJldHVybiBlcnIKICAgIH0KICAgIGlmIGNvbW1hbmRUYWcuUm93c0FmZmVjdGVkKCkgIT0gMSB7CiAgICAgIHJldHVybiBFcnJOb3RGb3VuZAogICAgfQogICAgcmV0dXJuIG5pbAogIH0pCn0K`)
+	if err != nil {
+		panic("Unable to decode template")
+	}
+
+	_, err = templates.New(`queue_func`).Parse(string(decoded))
+	if err != nil {
+		fmt.Println(string(decoded))
+		panic("Unable to parse template")
+	}
+
+	decoded, err = base64.StdEncoding.DecodeString(`e3tyYW5nZSAuUmVmZXJlbmNlc319e3tpZiBub3QgLlVuaXF1ZX19CmNvbnN0IHNlbGVjdHt7JC5TdHJ1Y3ROYW1lfX1CeXt7Lk5hbWV9fVNRTCA9IGBzZWxlY3R7eyByYW5nZSAkaSwgJGNvbHVtbiA6PSAkLkNvbHVtbnN9fXt7aWYgJGl9fSx7e2VuZH19CiAge3skY29sdW1uLlNlbGVjdEV4cHJ9fXt7ZW5kfX0KZnJvbSB7eyQuUXVhbGlmaWVkVGFibGVOYW1lfX0Kd2hlcmUge3sgcmFuZ2UgJGksICRjb2x1bW4gOj0gLkNvbHVtbnN9fXt7aWYgJGl9fSBhbmQge3tlbmR9fSJ7eyRjb2x1bW4uQ29sdW1uTmFtZX19Ij17e3BrUGxhY2Vob2xkZXIgJGl9fXt7ZW5kfX0Kb3JkZXIgYnkge3sgcmFuZ2UgJGksICRjb2x1bW4gOj0gJC5QcmltYXJ5S2V5Q29sdW1uc319e3tpZiAkaX19LCB7e2VuZH19Int7JGNvbHVtbi5Db2x1bW5OYW1lfX0ie3tlbmR9fWAKCi8vIFNlbGVjdHt7JC5TdHJ1Y3ROYW1lfX1CeXt7Lk5hbWV9fSBzZWxlY3RzIHRoZSByb3dzIG9mIHt7JC5RdWFsaWZpZWRUYWJsZU5hbWV9fSB0aGF0IHJlZmVyZW5jZSB0aGUgZ2l2ZW4ga2V5LgpmdW5jIFNlbGVjdHt7JC5TdHJ1Y3ROYW1lfX1CeXt7Lk5hbWV9fSgKICBjdHggY29udGV4dC5Db250ZXh0LAogIGRiIFF1ZXJ5ZXJ7e3JhbmdlIC5Db2x1bW5zfX0sCiAge3suVmFyTmFtZX19IHt7LkdvVHlwZX19e3tlbmR9fSwKKSAoW117eyQuU3RydWN0TmFtZX19LCBlcnJvcikgewogIHZhciByb3dzIFtde3skLlN0cnVjdE5hbWV9fQoKICBkYlJvd3MsIGVyciA6PSBwcmVwYXJlUXVlcnkoY3R4LCBkYiwgInBneGRhdGFTZWxlY3R7eyQuU3RydWN0TmFtZX19Qnl7ey5OYW1lfX0iLCBzZWxlY3R7eyQuU3RydWN0TmFtZX19Qnl7ey5OYW1lfX1TUUx7e3JhbmdlIC5Db2x1bW5zfX0sIHt7LlZhck5hbWV9fXt7ZW5kfX0pCiAgaWYgZXJyICE9IG5pbCB7CiAgICByZXR1cm4gbmlsLCBlcnIKICB9CiAgZGVmZXIgZGJSb3dzLkNsb3NlKCkKCiAgZm9yIGRiUm93cy5OZXh0KCkgewogICAgdmFyIHJvdyB7eyQuU3RydWN0TmFtZX19CiAgICBlcnIgOj0gZGJSb3dzLlNjYW4oCnt7cmFuZ2UgJC5Db2x1bW5zfX0mcm93Lnt7LkZpZWxkTmFtZX19LAogICAge3tlbmR9fSkKICAgIGlmIGVyciAhPSBuaWwgewogICAgICByZXR1cm4gbmlsLCBlcnIKICAgIH0KICAgIHJvd3MgPSBhcHBlbmQocm93cywgcm93KQogIH0KCiAgaWYgZGJSb3dzLkVycigpICE9IG5pbCB7CiAgICByZXR1cm4gbmlsLCBkYlJvd3MuRXJyKCkKICB9CgogIHJldHVybiByb3dzLCBuaWwKfQp7e2VuZH19e3tpZiAuTG9hZGVyfX17eyRrZXkgOj0gaW5kZXggLkNvbHVtbnMgMH19CmNvbnN0IGxvYWR7eyQuU3RydWN0TmFtZX19Qnl7ey5OYW1lfX1TUUwgPSBgc2VsZWN0e3sgcmFuZ2UgJGksICRjb2x1bW4gOj0gJC5Db2x1bW5zfX17e2lmICRpfX0se3tlbmR9fQogIHt7JGNvbHVtbi5TZWxlY3RFeHByfX17e2VuZH19LAogICJ7eyRrZXkuQ29sdW1uTmFtZX19Igpmcm9tIHt7JC5RdWFsaWZpZWRUYWJsZU5hbWV9fQp3aGVyZSAie3ska2V5LkNvbHVtbk5hbWV9fSIgPSBhbnkoJDEpCm9yZGVyIGJ5IHt7IHJhbmdlICRpLCAkY29sdW1uIDo9ICQuUHJpbWFyeUtleUNvbHVtbnN9fXt7aWYgJGl9fSwge3tlbmR9fSJ7eyRjb2x1bW4uQ29sdW1uTmFtZX19Int7ZW5kfX1gCgovLyBMb2Fke3skLlN0cnVjdE5hbWV9fUJ5e3suTmFtZX19IHNlbGVjdHMgdGhlIHJvd3Mgb2Yge3skLlF1YWxpZmllZFRhYmxlTmFtZX19IHRoYXQgcmVmZXJlbmNlIGFueSBvZiBrZXlzIGluIG9uZSBxdWVyeSBhbmQKLy8gZ3JvdXBzIHRoZW0gYnkga2V5LiBLZXlzIHdpdGhvdXQgcm93cyBhcmUgbm90IGluIHRoZSBtYXAuCmZ1bmMgTG9hZHt7JC5TdHJ1Y3ROYW1lfX1CeXt7Lk5hbWV9fShjdHggY29udGV4dC5Db250ZXh0LCBkYiBRdWVyeWVyLCBrZXlzIFtde3ska2V5LkdvVHlwZX19KSAobWFwW3t7JGtleS5Hb1R5cGV9fV1bXXt7JC5TdHJ1Y3ROYW1lfX0sIGVycm9yKSB7CiAgcm93c0J5S2V5IDo9IG1ha2UobWFwW3t7JGtleS5Hb1R5cGV9fV1bXXt7JC5TdHJ1Y3ROYW1lfX0pCiAgaWYgbGVuKGtleXMpID09IDAgewogICAgcmV0dXJuIHJvd3NCeUtleSwgbmlsCiAgfQoKICBkYlJvd3MsIGVyciA6PSBwcmVwYXJlUXVlcnkoY3R4LCBkYiwgInBneGRhdGFMb2Fke3skLlN0cnVjdE5hbWV9fUJ5e3suTmFtZX19IiwgbG9hZHt7JC5TdHJ1Y3ROYW1lfX1CeXt7Lk5hbWV9fVNRTCwga2V5cykKICBpZiBlcnIgIT0gbmlsIHsKICAgIHJldHVybiBuaWwsIGVycgogIH0KICBkZWZlciBkYlJvd3MuQ2xvc2UoKQoKICBmb3IgZGJSb3dzLk5leHQoKSB7CiAgICB2YXIgcm93IHt7JC5TdHJ1Y3ROYW1lfX0KICAgIHZhciBrZXkge3ska2V5LkdvVHlwZX19CiAgICBlcnIgOj0gZGJSb3dzLlNjYW4oCnt7cmFuZ2UgJC5Db2x1bW5zfX0mcm93Lnt7LkZpZWxkTmFtZX19LAogICAge3tlbmR9fSZrZXksCiAgICApCiAgICBpZiBlcnIgIT0gbmlsIHsKICAgICAgcmV0dXJuIG5pbCwgZXJyCiAgICB9CiAgICByb3dzQnlLZXlba2V5XSA9IGFwcGVuZChyb3dzQnlLZXlba2V5XSwgcm93KQogIH0KCiAgaWYgZGJSb3dzLkVycigpICE9IG5pbCB7CiAgICByZXR1cm4gbmlsLCBkYlJvd3MuRXJyKCkKICB9CgogIHJldHVybiByb3dzQnlLZXksIG5pbAp9Cnt7ZW5kfX17e2VuZH19Cg==`)
+	if err != nil {
+		panic("Unable to decode template")
+	}
+
+	_, err = templates.New(`reference_func`).Parse(string(decoded))
+	if err != nil {
+		fmt.Println(string(decoded))
+		panic("Unable to parse template")
+	}
+
+	decoded, err = base64.StdEncoding.DecodeString(`cGFja2FnZSB7ey5Qa2dOYW1lfX0KLy8gVGhpcyBmaWxlIGlzIGF1dG9tYXRpY2FsbHkgZ2VuZXJhdGVkIGJ5IHBneGRhdGEuCgppbXBvcnQgKAogICJjb250ZXh0IgogICJzdHJpbmdzIgoKICBlcnJvcnMgImdvbGFuZy5vcmcveC94ZXJyb3JzIgogICJnaXRodWIuY29tL2phY2tjL3BneC92NCIKICAiZ2l0aHViLmNvbS9qYWNrYy9wZ3R5cGUie3tyYW5nZSAuSW1wb3J0c319CiAgInt7Ln19Int7ZW5kfX0KKQoKdHlwZSB7ey5TdHJ1Y3ROYW1lfX0gc3RydWN0IHsKe3tyYW5nZSAuQ29sdW1uc319ICAvLyB7ey5GaWVsZE5hbWV9fSBpcyB7ey5EZXNjcmlwdGlvbn19LgogIHt7LkZpZWxkTmFtZX19IHt7LkZpZWxkVHlwZX19Cnt7ZW5kfX19Cnt7aWYgLkdvU3R5bGV9fQovLyB7ey5TdHJ1Y3ROYW1lfX1GaWVsZCBpZGVudGlmaWVzIGEgZmllbGQgb2Yge3suU3RydWN0TmFtZX19IHRvIHNldCBpbiBJbnNlcnR7ey5TdHJ1Y3ROYW1lfX0gYW5kCi8vIFVwZGF0ZXt7LlN0cnVjdE5hbWV9fS4KdHlwZSB7ey5TdHJ1Y3ROYW1lfX1GaWVsZCBpbnQKCmNvbnN0ICh7e3JhbmdlICRpLCAkY29sdW1uIDo9IC5Db2x1bW5zfX0KICB7eyQuU3RydWN0TmFtZX19e3skY29sdW1uLkZpZWxkTmFtZX19RmllbGR7e2lmIG5vdCAkaX19IHt7JC5TdHJ1Y3ROYW1lfX1GaWVsZCA9IGlvdGF7e2VuZH19e3tlbmR9fQopCnt7ZW5kfX0Ke3t0ZW1wbGF0ZSAiY291bnRfZnVuYyIgLn19Cnt7dGVtcGxhdGUgInNlbGVjdF9hbGxfZnVuYyIgLn19Cnt7dGVtcGxhdGUgInNlbGVjdF9wYWdlX2Z1bmMiIC59fQp7e3RlbXBsYXRlICJzZWxlY3RfYnlfcGtfZnVuYyIgLn19Cnt7dGVtcGxhdGUgInNlbGVjdF9ieV91bmlxdWVfZnVuYyIgLn19Cnt7dGVtcGxhdGUgInJlZmVyZW5jZV9mdW5jIiAufX0Ke3t0ZW1wbGF0ZSAic2VsZWN0X292ZXJsYXBwaW5nX2Z1bmMiIC59fQp7e3RlbXBsYXRlICJpbnNlcnRfZnVuYyIgLn19Cnt7dGVtcGxhdGUgInVwZGF0ZV9mdW5jIiAufX0Ke3t0ZW1wbGF0ZSAidXBzZXJ0X2Z1bmMiIC59fQp7e3RlbXBsYXRlICJjb3B5X2luc2VydF9mdW5jIiAufX0Ke3t0ZW1wbGF0ZSAicXVldWVfZnVuYyIgLn19Cnt7dGVtcGxhdGUgImRlbGV0ZV9mdW5jIiAufX0Ke3t0ZW1wbGF0ZSAid2hlcmVfZnVuYyIgLn19Cg==`)
 	if err != nil {
 		panic("Unable to decode template")
 	}
@@ -84,7 +161,7 @@ func loadTemplates() *template.Template {
 		panic("Unable to parse template")
 	}
 
-	decoded, err = base64.StdEncoding.DecodeString(`Y29uc3QgU2VsZWN0QWxse3suU3RydWN0TmFtZX19U1FMID0gYHNlbGVjdHt7IHJhbmdlICRpLCAkY29sdW1uIDo9IC5Db2x1bW5zfX17e2lmICRpfX0se3tlbmR9fQogICJ7eyRjb2x1bW4uQ29sdW1uTmFtZX19Int7ZW5kfX0KZnJvbSAie3suVGFibGVOYW1lfX0iYAoKZnVuYyBTZWxlY3RBbGx7ey5TdHJ1Y3ROYW1lfX0oY3R4IGNvbnRleHQuQ29udGV4dCwgZGIgUXVlcnllcikgKFtde3suU3RydWN0TmFtZX19LCBlcnJvcikgewogIHZhciByb3dzIFtde3suU3RydWN0TmFtZX19CgogIGRiUm93cywgZXJyIDo9IHByZXBhcmVRdWVyeShjdHgsIGRiLCAicGd4ZGF0YVNlbGVjdEFsbHt7LlN0cnVjdE5hbWV9fSIsIFNlbGVjdEFsbHt7LlN0cnVjdE5hbWV9fVNRTCkKICBpZiBlcnIgIT0gbmlsIHsKICAgIHJldHVybiBuaWwsIGVycgogIH0KCiAgZm9yIGRiUm93cy5OZXh0KCkgewogICAgdmFyIHJvdyB7ey5TdHJ1Y3ROYW1lfX0KICAgIGRiUm93cy5TY2FuKAp7e3JhbmdlIC5Db2x1bW5zfX0mcm93Lnt7LkZpZWxkTmFtZX19LAogICAge3tlbmR9fSkKICAgIHJvd3MgPSBhcHBlbmQocm93cywgcm93KQogIH0KCiAgaWYgZGJSb3dzLkVycigpICE9IG5pbCB7CiAgICByZXR1cm4gbmlsLCBkYlJvd3MuRXJyKCkKICB9CgogIHJldHVybiByb3dzLCBuaWwKfQo=`)
+	decoded, err = base64.StdEncoding.DecodeString(`Y29uc3QgU2VsZWN0QWxse3suU3RydWN0TmFtZX19U1FMID0gYHNlbGVjdHt7IHJhbmdlICRpLCAkY29sdW1uIDo9IC5Db2x1bW5zfX17e2lmICRpfX0se3tlbmR9fQogIHt7JGNvbHVtbi5TZWxlY3RFeHByfX17e2VuZH19CmZyb20ge3suUXVhbGlmaWVkVGFibGVOYW1lfX1gCgpmdW5jIFNlbGVjdEFsbHt7LlN0cnVjdE5hbWV9fShjdHggY29udGV4dC5Db250ZXh0LCBkYiBRdWVyeWVyKSAoW117ey5TdHJ1Y3ROYW1lfX0sIGVycm9yKSB7CiAgdmFyIHJvd3MgW117ey5TdHJ1Y3ROYW1lfX0KCiAgZXJyIDo9IEZvckVhY2h7ey5TdHJ1Y3ROYW1lfX0oY3R4LCBkYiwgZnVuYyhyb3cgKnt7LlN0cnVjdE5hbWV9fSkgZXJyb3IgewogICAgcm93cyA9IGFwcGVuZChyb3dzLCAqcm93KQogICAgcmV0dXJuIG5pbAogIH0pCiAgaWYgZXJyICE9IG5pbCB7CiAgICByZXR1cm4gbmlsLCBlcnIKICB9CgogIHJldHVybiByb3dzLCBuaWwKfQoKLy8ge3suU3RydWN0TmFtZX19Um93cyBpcyBhIGN1cnNvciBvdmVyIHRoZSByb3dzIG9mIHt7LlF1YWxpZmllZFRhYmxlTmFtZX19IHRoYXQKLy8gcmVhZHMgb25lIHJvdyBhdCBhIHRpbWUuCnR5cGUge3suU3RydWN0TmFtZX19Um93cyBzdHJ1Y3QgewogIHJvd3MgcGd4LlJvd3MKICByb3cgIHt7LlN0cnVjdE5hbWV9fQogIGVyciAgZXJyb3IKfQoKLy8gUXVlcnl7ey5TdHJ1Y3ROYW1lfX1Sb3dzIHNlbGVjdHMgYWxsIHJvd3Mgb2Yge3suUXVhbGlmaWVkVGFibGVOYW1lfX0uIFRoZQovLyByZXR1cm5lZCByb3dzIG11c3QgYmUgY2xvc2VkLgpmdW5jIFF1ZXJ5e3suU3RydWN0TmFtZX19Um93cyhjdHggY29udGV4dC5Db250ZXh0LCBkYiBRdWVyeWVyKSAoKnt7LlN0cnVjdE5hbWV9fVJvd3MsIGVycm9yKSB7CiAgZGJSb3dzLCBlcnIgOj0gcHJlcGFyZVF1ZXJ5KGN0eCwgZGIsICJwZ3hkYXRhU2VsZWN0QWxse3suU3RydWN0TmFtZX19IiwgU2VsZWN0QWxse3suU3RydWN0TmFtZX19U1FMKQogIGlmIGVyciAhPSBuaWwgewogICAgcmV0dXJuIG5pbCwgZXJyCiAgfQoKICByZXR1cm4gJnt7LlN0cnVjdE5hbWV9fVJvd3N7cm93czogZGJSb3dzfSwgbmlsCn0KCi8vIE5leHQgcmVhZHMgdGhlIG5leHQgcm93LiBJdCByZXR1cm5zIGZhbHNlIHdoZW4gdGhlcmUgYXJlIG5vIG1vcmUgcm93cyBvciBhbgovLyBlcnJvciBvY2N1cnJlZC4KZnVuYyAociAqe3suU3RydWN0TmFtZX19Um93cykgTmV4dCgpIGJvb2wgewogIGlmIHIuZXJyICE9IG5pbCB8fCAhci5yb3dzLk5leHQoKSB7CiAgICByZXR1cm4gZmFsc2UKICB9CgogIHIucm93ID0ge3suU3RydWN0TmFtZX19e30KICByLmVyciA9IHIucm93cy5TY2FuKAp7e3JhbmdlIC5Db2x1bW5zfX0mci5yb3cue3suRmllbGROYW1lfX0sCiAgICB7e2VuZH19KQogIGlmIHIuZXJyICE9IG5pbCB7CiAgICByLnJvd3MuQ2xvc2UoKQogICAgcmV0dXJuIGZhbHNlCiAgfQoKICByZXR1cm4gdHJ1ZQp9CgovLyBSb3cgcmV0dXJucyB0aGUgY3VycmVudCByb3cuIEl0IGlzIG92ZXJ3cml0dGVuIGJ5IHRoZSBuZXh0IGNhbGwgdG8gTmV4dC4KZnVuYyAociAqe3suU3RydWN0TmFtZX19Um93cykgUm93KCkgKnt7LlN0cnVjdE5hbWV9fSB7CiAgcmV0dXJuICZyLnJvdwp9CgovLyBFcnIgcmV0dXJucyB0aGUgZXJyb3IsIGlmIGFueSwgdGhhdCBzdG9wcGVkIE5leHQuCmZ1bmMgKHIgKnt7LlN0cnVjdE5hbWV9fVJvd3MpIEVycigpIGVycm9yIHsKICBpZiByLmVyciAhPSBuaWwgewogICAgcmV0dXJuIHIuZXJyCiAgfQogIHJldHVybiByLnJvd3MuRXJyKCkKfQoKLy8gQ2xvc2UgY2xvc2VzIHRoZSByb3dzLiBJdCBpcyBzYWZlIHRvIGNhbGwgQ2xvc2UgYWZ0ZXIgYWxsIHJvd3MgaGF2ZSBiZWVuCi8vIHJlYWQuCmZ1bmMgKHIgKnt7LlN0cnVjdE5hbWV9fVJvd3MpIENsb3NlKCkgewogIHIucm93cy5DbG9zZSgpCn0KCi8vIEZvckVhY2h7ey5TdHJ1Y3ROYW1lfX0gY2FsbHMgZm4gd2l0aCBlYWNoIHJvdyBvZiB7ey5RdWFsaWZpZWRUYWJsZU5hbWV9fS4gVGhlCi8vIHJvdyBwYXNzZWQgdG8gZm4gaXMgcmV1c2VkIGZvciB0aGUgbmV4dCByb3cuIElmIGZuIHJldHVybnMgYW4gZXJyb3IgdGhlCi8vIHJlbWFpbmluZyByb3dzIGFyZSBza2lwcGVkIGFuZCB0aGUgZXJyb3IgaXMgcmV0dXJuZWQuCmZ1bmMgRm9yRWFjaHt7LlN0cnVjdE5hbWV9fShjdHggY29udGV4dC5Db250ZXh0LCBkYiBRdWVyeWVyLCBmbiBmdW5jKCp7ey5TdHJ1Y3ROYW1lfX0pIGVycm9yKSBlcnJvciB7CiAgcm93cywgZXJyIDo9IFF1ZXJ5e3suU3RydWN0TmFtZX19Um93cyhjdHgsIGRiKQogIGlmIGVyciAhPSBuaWwgewogICAgcmV0dXJuIGVycgogIH0KICBkZWZlciByb3dzLkNsb3NlKCkKCiAgZm9yIHJvd3MuTmV4dCgpIHsKICAgIGVyciA6PSBmbihyb3dzLlJvdygpKQogICAgaWYgZXJyICE9IG5pbCB7CiAgICAgIHJldHVybiBlcnIKICAgIH0KICB9CgogIHJldHVybiByb3dzLkVycigpCn0K`)
 	if err != nil {
 		panic("Unable to decode template")
 	}
@@ -95,7 +172,7 @@ func loadTemplates() *template.Template {
 		panic("Unable to parse template")
 	}
 
-	decoded, err = base64.StdEncoding.DecodeString(`Y29uc3Qgc2VsZWN0e3suU3RydWN0TmFtZX19QnlQS1NRTCA9IGBzZWxlY3R7eyByYW5nZSAkaSwgJGNvbHVtbiA6PSAuQ29sdW1uc319e3tpZiAkaX19LHt7ZW5kfX0KICAie3skY29sdW1uLkNvbHVtbk5hbWV9fSJ7e2VuZH19CmZyb20gInt7LlRhYmxlTmFtZX19Igp3aGVyZSB7eyByYW5nZSAkaSwgJGNvbHVtbiA6PSAuUHJpbWFyeUtleUNvbHVtbnN9fXt7aWYgJGl9fSBhbmQge3tlbmR9fSJ7eyRjb2x1bW4uQ29sdW1uTmFtZX19Ij17e3BrUGxhY2Vob2xkZXIgJGl9fXt7ZW5kfX1gCgpmdW5jIFNlbGVjdHt7LlN0cnVjdE5hbWV9fUJ5UEsoCiAgY3R4IGNvbnRleHQuQ29udGV4dCwKICBkYiBRdWVyeWVye3tyYW5nZSAuUHJpbWFyeUtleUNvbHVtbnN9fSwKICB7ey5WYXJOYW1lfX0ge3suR29UeXBlfX17e2VuZH19LAopICgqe3suU3RydWN0TmFtZX19LCBlcnJvcikgewogIHZhciByb3cge3suU3RydWN0TmFtZX19CiAgZXJyIDo9IHByZXBhcmVRdWVyeVJvdyhjdHgsIGRiLCAicGd4ZGF0YVNlbGVjdHt7LlN0cnVjdE5hbWV9fUJ5UEsiLCBzZWxlY3R7ey5TdHJ1Y3ROYW1lfX1CeVBLU1FMe3tyYW5nZSAuUHJpbWFyeUtleUNvbHVtbnN9fSwge3suVmFyTmFtZX19e3tlbmR9fSkuU2NhbigKe3tyYW5nZSAuQ29sdW1uc319JnJvdy57ey5GaWVsZE5hbWV9fSwKICAgIHt7ZW5kfX0pCiAgaWYgZXJyb3JzLklzKGVyciwgcGd4LkVyck5vUm93cykgewogICAgcmV0dXJuIG5pbCwgRXJyTm90Rm91bmQKICB9IGVsc2UgaWYgZXJyICE9IG5pbCB7CiAgICByZXR1cm4gbmlsLCBlcnIKICB9CgogIHJldHVybiAmcm93LCBuaWwKfQo=`)
+	decoded, err = base64.StdEncoding.DecodeString(`Y29uc3Qgc2VsZWN0e3suU3RydWN0TmFtZX19QnlQS1NRTCA9IGBzZWxlY3R7eyByYW5nZSAkaSwgJGNvbHVtbiA6PSAuQ29sdW1uc319e3tpZiAkaX19LHt7ZW5kfX0KICB7eyRjb2x1bW4uU2VsZWN0RXhwcn19e3tlbmR9fQpmcm9tIHt7LlF1YWxpZmllZFRhYmxlTmFtZX19CndoZXJlIHt7IHJhbmdlICRpLCAkY29sdW1uIDo9IC5QcmltYXJ5S2V5Q29sdW1uc319e3tpZiAkaX19IGFuZCB7e2VuZH19Int7JGNvbHVtbi5Db2x1bW5OYW1lfX0iPXt7cGtQbGFjZWhvbGRlciAkaX19e3tlbmR9fWAKCmZ1bmMgU2VsZWN0e3suU3RydWN0TmFtZX19QnlQSygKICBjdHggY29udGV4dC5Db250ZXh0LAogIGRiIFF1ZXJ5ZXJ7e3JhbmdlIC5QcmltYXJ5S2V5Q29sdW1uc319LAogIHt7LlZhck5hbWV9fSB7ey5Hb1R5cGV9fXt7ZW5kfX0sCikgKCp7ey5TdHJ1Y3ROYW1lfX0sIGVycm9yKSB7CiAgdmFyIHJvdyB7ey5TdHJ1Y3ROYW1lfX0KICBlcnIgOj0gcHJlcGFyZVF1ZXJ5Um93KGN0eCwgZGIsICJwZ3hkYXRhU2VsZWN0e3suU3RydWN0TmFtZX19QnlQSyIsIHNlbGVjdHt7LlN0cnVjdE5hbWV9fUJ5UEtTUUx7e3JhbmdlIC5QcmltYXJ5S2V5Q29sdW1uc319LCB7ey5WYXJOYW1lfX17e2VuZH19KS5TY2FuKAp7e3JhbmdlIC5Db2x1bW5zfX0mcm93Lnt7LkZpZWxkTmFtZX19LAogICAge3tlbmR9fSkKICBpZiBlcnJvcnMuSXMoZXJyLCBwZ3guRXJyTm9Sb3dzKSB7CiAgICByZXR1cm4gbmlsLCBFcnJOb3RGb3VuZAogIH0gZWxzZSBpZiBlcnIgIT0gbmlsIHsKICAgIHJldHVybiBuaWwsIGVycgogIH0KCiAgcmV0dXJuICZyb3csIG5pbAp9Cg==`)
 	if err != nil {
 		panic("Unable to decode template")
 	}
@@ -106,7 +183,40 @@ func loadTemplates() *template.Template {
 		panic("Unable to parse template")
 	}
 
-	decoded, err = base64.StdEncoding.DecodeString(`ZnVuYyBVcGRhdGV7ey5TdHJ1Y3ROYW1lfX0oY3R4IGNvbnRleHQuQ29udGV4dCwgZGIgUXVlcnllcnt7cmFuZ2UgLlByaW1hcnlLZXlDb2x1bW5zfX0sCiAge3suVmFyTmFtZX19IHt7LkdvVHlwZX19e3tlbmR9fSwKICByb3cgKnt7LlN0cnVjdE5hbWV9fSwKKSBlcnJvciB7CiAgc2V0cyA6PSBtYWtlKFtdc3RyaW5nLCAwLCB7e2xlbiAuQ29sdW1uc319KQogIGFyZ3MgOj0gcGd4LlF1ZXJ5QXJncyhtYWtlKFtdaW50ZXJmYWNle30sIDAsIHt7bGVuIC5Db2x1bW5zfX0pKQoKe3tyYW5nZSAuQ29sdW1uc319ICBpZiByb3cue3suRmllbGROYW1lfX0uU3RhdHVzICE9IHBndHlwZS5VbmRlZmluZWQgewogICAgc2V0cyA9IGFwcGVuZChzZXRzLCBge3suQ29sdW1uTmFtZX19YCsiPSIrYXJncy5BcHBlbmQoJnJvdy57ey5GaWVsZE5hbWV9fSkpCiAgfQp7e2VuZH19CgogIGlmIGxlbihzZXRzKSA9PSAwIHsKICAgIHJldHVybiBuaWwKICB9CgogIHNxbCA6PSBgdXBkYXRlICJ7ey5UYWJsZU5hbWV9fSIgc2V0IGAgKyBzdHJpbmdzLkpvaW4oc2V0cywgIiwgIikgKyBgIHdoZXJlIGAge3sgcmFuZ2UgJGksICRjb2x1bW4gOj0gLlByaW1hcnlLZXlDb2x1bW5zfX0gKyBge3tpZiAkaX19IGFuZCB7e2VuZH19Int7JGNvbHVtbi5Db2x1bW5OYW1lfX0iPWAgKyBhcmdzLkFwcGVuZCh7eyRjb2x1bW4uVmFyTmFtZX19KXt7ZW5kfX0KCiAgcHNOYW1lIDo9IHByZXBhcmVkTmFtZSgicGd4ZGF0YVVwZGF0ZXt7LlN0cnVjdE5hbWV9fSIsIHNxbCkKCiAgY29tbWFuZFRhZywgZXJyIDo9IHByZXBhcmVFeGVjKGN0eCwgZGIsIHBzTmFtZSwgc3FsLCBhcmdzLi4uKQogIGlmIGVyciAhPSBuaWwgewogICAgcmV0dXJuIGVycgogIH0KICBpZiBjb21tYW5kVGFnLlJvd3NBZmZlY3RlZCgpICE9IDEgewogICAgcmV0dXJuIEVyck5vdEZvdW5kCiAgfQogIHJldHVybiBuaWwKfQo=`)
+	decoded, err = base64.StdEncoding.DecodeString(`e3tyYW5nZSAuVW5pcXVlS2V5c319CmNvbnN0IHNlbGVjdHt7JC5TdHJ1Y3ROYW1lfX1CeXt7Lk5hbWV9fVNRTCA9IGBzZWxlY3R7eyByYW5nZSAkaSwgJGNvbHVtbiA6PSAkLkNvbHVtbnN9fXt7aWYgJGl9fSx7e2VuZH19CiAge3skY29sdW1uLlNlbGVjdEV4cHJ9fXt7ZW5kfX0KZnJvbSB7eyQuUXVhbGlmaWVkVGFibGVOYW1lfX0Kd2hlcmUge3sgcmFuZ2UgJGksICRjb2x1bW4gOj0gLkNvbHVtbnN9fXt7aWYgJGl9fSBhbmQge3tlbmR9fSJ7eyRjb2x1bW4uQ29sdW1uTmFtZX19Ij17e3BrUGxhY2Vob2xkZXIgJGl9fXt7ZW5kfX1gCgpmdW5jIFNlbGVjdHt7JC5TdHJ1Y3ROYW1lfX1CeXt7Lk5hbWV9fSgKICBjdHggY29udGV4dC5Db250ZXh0LAogIGRiIFF1ZXJ5ZXJ7e3JhbmdlIC5Db2x1bW5zfX0sCiAge3suVmFyTmFtZX19IHt7LkdvVHlwZX19e3tlbmR9fSwKKSAoKnt7JC5TdHJ1Y3ROYW1lfX0sIGVycm9yKSB7CiAgdmFyIHJvdyB7eyQuU3RydWN0TmFtZX19CiAgZXJyIDo9IHByZXBhcmVRdWVyeVJvdyhjdHgsIGRiLCAicGd4ZGF0YVNlbGVjdHt7JC5TdHJ1Y3ROYW1lfX1CeXt7Lk5hbWV9fSIsIHNlbGVjdHt7JC5TdHJ1Y3ROYW1lfX1CeXt7Lk5hbWV9fVNRTHt7cmFuZ2UgLkNvbHVtbnN9fSwge3suVmFyTmFtZX19e3tlbmR9fSkuU2NhbigKe3tyYW5nZSAkLkNvbHVtbnN9fSZyb3cue3suRmllbGROYW1lfX0sCiAgICB7e2VuZH19KQogIGlmIGVycm9ycy5JcyhlcnIsIHBneC5FcnJOb1Jvd3MpIHsKICAgIHJldHVybiBuaWwsIEVyck5vdEZvdW5kCiAgfSBlbHNlIGlmIGVyciAhPSBuaWwgewogICAgcmV0dXJuIG5pbCwgZXJyCiAgfQoKICByZXR1cm4gJnJvdywgbmlsCn0Ke3tlbmR9fQo=`)
+	if err != nil {
+		panic("Unable to decode template")
+	}
+
+	_, err = templates.New(`select_by_unique_func`).Parse(string(decoded))
+	if err != nil {
+		fmt.Println(string(decoded))
+		panic("Unable to parse template")
+	}
+
+	decoded, err = base64.StdEncoding.DecodeString(`e3tpZiAuT3ZlcmxhcENvbHVtbnN9fXt7JHN0cnVjdCA6PSAuU3RydWN0TmFtZX19Ly8ge3suU3RydWN0TmFtZX19UmFuZ2VDb2x1bW4gaXMgYSByYW5nZSBjb2x1bW4gb2Yge3suVGFibGVOYW1lfX0gY292ZXJlZCBieSBhIEdpU1QKLy8gaW5kZXggdGhhdCBTZWxlY3R7ey5TdHJ1Y3ROYW1lfX1PdmVybGFwcGluZyBjYW4gc2VhcmNoLgp0eXBlIHt7LlN0cnVjdE5hbWV9fVJhbmdlQ29sdW1uIHN0cmluZwoKY29uc3QgKHt7cmFuZ2UgLk92ZXJsYXBDb2x1bW5zfX0KICB7eyRzdHJ1Y3R9fXt7LkZpZWxkTmFtZX19Q29sdW1uIHt7JHN0cnVjdH19UmFuZ2VDb2x1bW4gPSAie3suQ29sdW1uTmFtZX19Int7ZW5kfX0KKQp7e3JhbmdlIC5PdmVybGFwQ29sdW1uc319CmNvbnN0IHNlbGVjdHt7JHN0cnVjdH19T3ZlcmxhcHBpbmd7ey5GaWVsZE5hbWV9fVNRTCA9IGBzZWxlY3R7eyByYW5nZSAkaSwgJGNvbHVtbiA6PSAkLkNvbHVtbnN9fXt7aWYgJGl9fSx7e2VuZH19CiAge3skY29sdW1uLlNlbGVjdEV4cHJ9fXt7ZW5kfX0KZnJvbSB7eyQuUXVhbGlmaWVkVGFibGVOYW1lfX0Kd2hlcmUgInt7LkNvbHVtbk5hbWV9fSIgJiYgJDFgCnt7ZW5kfX0KLy8gU2VsZWN0e3suU3RydWN0TmFtZX19T3ZlcmxhcHBpbmcgc2VsZWN0cyB0aGUgcm93cyB3aG9zZSBjb2x1bW4gb3ZlcmxhcHMgdmFsdWUKLy8gdXNpbmcgdGhlICYmIG9wZXJhdG9yLiB2YWx1ZSBtdXN0IGJlIG9mIHRoZSB0eXBlIG9mIGNvbHVtbiwgZS5nLiBhCi8vIHt7KGluZGV4IC5PdmVybGFwQ29sdW1ucyAwKS5Hb0JveFR5cGV9fSBmb3Ige3skc3RydWN0fX17eyhpbmRleCAuT3ZlcmxhcENvbHVtbnMgMCkuRmllbGROYW1lfX1Db2x1bW4uCmZ1bmMgU2VsZWN0e3suU3RydWN0TmFtZX19T3ZlcmxhcHBpbmcoY3R4IGNvbnRleHQuQ29udGV4dCwgZGIgUXVlcnllciwgY29sdW1uIHt7LlN0cnVjdE5hbWV9fVJhbmdlQ29sdW1uLCB2YWx1ZSBpbnRlcmZhY2V7fSkgKFtde3suU3RydWN0TmFtZX19LCBlcnJvcikgewogIHZhciBuYW1lLCBzcWwgc3RyaW5nCiAgc3dpdGNoIGNvbHVtbiB7Cnt7cmFuZ2UgLk92ZXJsYXBDb2x1bW5zfX0gIGNhc2Uge3skc3RydWN0fX17ey5GaWVsZE5hbWV9fUNvbHVtbjoKICAgIG5hbWUsIHNxbCA9ICJwZ3hkYXRhU2VsZWN0e3skc3RydWN0fX1PdmVybGFwcGluZ3t7LkZpZWxkTmFtZX19Iiwgc2VsZWN0e3skc3RydWN0fX1PdmVybGFwcGluZ3t7LkZpZWxkTmFtZX19U1FMCnt7ZW5kfX0gIGRlZmF1bHQ6CiAgICByZXR1cm4gbmlsLCBlcnJvcnMuRXJyb3JmKCIlcyBpcyBub3QgYSByYW5nZSBjb2x1bW4gb2Yge3suVGFibGVOYW1lfX0iLCBjb2x1bW4pCiAgfQoKICB2YXIgcm93cyBbXXt7LlN0cnVjdE5hbWV9fQoKICBkYlJvd3MsIGVyciA6PSBwcmVwYXJlUXVlcnkoY3R4LCBkYiwgbmFtZSwgc3FsLCB2YWx1ZSkKICBpZiBlcnIgIT0gbmlsIHsKICAgIHJldHVybiBuaWwsIGVycgogIH0KCiAgZm9yIGRiUm93cy5OZXh0KCkgewogICAgdmFyIHJvdyB7ey5TdHJ1Y3ROYW1lfX0KICAgIGRiUm93cy5TY2FuKAp7e3JhbmdlIC5Db2x1bW5zfX0mcm93Lnt7LkZpZWxkTmFtZX19LAogICAge3tlbmR9fSkKICAgIHJvd3MgPSBhcHBlbmQocm93cywgcm93KQogIH0KCiAgaWYgZGJSb3dzLkVycigpICE9IG5pbCB7CiAgICByZXR1cm4gbmlsLCBkYlJvd3MuRXJyKCkKICB9CgogIHJldHVybiByb3dzLCBuaWwKfQp7e2VuZH19Cg==`)
+	if err != nil {
+		panic("Unable to decode template")
+	}
+
+	_, err = templates.New(`select_overlapping_func`).Parse(string(decoded))
+	if err != nil {
+		fmt.Println(string(decoded))
+		panic("Unable to parse template")
+	}
+
+	decoded, err = base64.StdEncoding.DecodeString(`e3tyYW5nZSAuUGFnZU9yZGVyaW5nc319e3skcG8gOj0gLn19Ci8vIHt7JC5TdHJ1Y3ROYW1lfX1QYWdle3tpZiAuTmFtZX19Qnl7ey5OYW1lfX17e2VuZH19Q3Vyc29yIGlzIHRoZSBwb3NpdGlvbiBhZnRlciB0aGUgbGFzdCByb3cgb2YgYQovLyBwYWdlIHJldHVybmVkIGJ5IFNlbGVjdHt7JC5TdHJ1Y3ROYW1lfX1QYWdle3tpZiAuTmFtZX19Qnl7ey5OYW1lfX17e2VuZH19Lgp0eXBlIHt7JC5TdHJ1Y3ROYW1lfX1QYWdle3tpZiAuTmFtZX19Qnl7ey5OYW1lfX17e2VuZH19Q3Vyc29yIHN0cnVjdCB7Cnt7cmFuZ2UgLkNvbHVtbnN9fSAge3suRmllbGROYW1lfX0ge3suR29UeXBlfX0Ke3tlbmR9fX0KCmNvbnN0IHNlbGVjdHt7JC5TdHJ1Y3ROYW1lfX1QYWdle3suTmFtZX19U1FMID0gYHNlbGVjdHt7IHJhbmdlICRpLCAkY29sdW1uIDo9ICQuQ29sdW1uc319e3tpZiAkaX19LHt7ZW5kfX0KICB7eyRjb2x1bW4uU2VsZWN0RXhwcn19e3tlbmR9fQpmcm9tIHt7JC5RdWFsaWZpZWRUYWJsZU5hbWV9fQpvcmRlciBieSB7ey5PcmRlckJ5U1FMfX0KbGltaXQgJDFgCgpjb25zdCBzZWxlY3R7eyQuU3RydWN0TmFtZX19UGFnZXt7Lk5hbWV9fUFmdGVyU1FMID0gYHNlbGVjdHt7IHJhbmdlICRpLCAkY29sdW1uIDo9ICQuQ29sdW1uc319e3tpZiAkaX19LHt7ZW5kfX0KICB7eyRjb2x1bW4uU2VsZWN0RXhwcn19e3tlbmR9fQpmcm9tIHt7JC5RdWFsaWZpZWRUYWJsZU5hbWV9fQp3aGVyZSB7ey5BZnRlclNRTH19Cm9yZGVyIGJ5IHt7Lk9yZGVyQnlTUUx9fQpsaW1pdCB7ey5MaW1pdFBsYWNlaG9sZGVyfX1gCgovLyBTZWxlY3R7eyQuU3RydWN0TmFtZX19UGFnZXt7aWYgLk5hbWV9fUJ5e3suTmFtZX19e3tlbmR9fSBzZWxlY3RzIHVwIHRvIGxpbWl0IHJvd3MgdGhhdCBmb2xsb3cKLy8gYWZ0ZXIsIG9yIHRoZSBmaXJzdCByb3dzIHdoZW4gYWZ0ZXIgaXMgbmlsLCBvcmRlcmVkIGJ5Ci8vIHt7Lk9yZGVyQnlTUUx9fS4gVGhlIHJldHVybmVkIGN1cnNvciBzZWxlY3RzIHRoZSBuZXh0IHBhZ2UuIEl0IGlzIG5pbAovLyB3aGVuIHRoZXJlIGFyZSBubyBtb3JlIHJvd3MuCmZ1bmMgU2VsZWN0e3skLlN0cnVjdE5hbWV9fVBhZ2V7e2lmIC5OYW1lfX1CeXt7Lk5hbWV9fXt7ZW5kfX0oY3R4IGNvbnRleHQuQ29udGV4dCwgZGIgUXVlcnllciwgYWZ0ZXIgKnt7JC5TdHJ1Y3ROYW1lfX1QYWdle3tpZiAuTmFtZX19Qnl7ey5OYW1lfX17e2VuZH19Q3Vyc29yLCBsaW1pdCBpbnQpIChbXXt7JC5TdHJ1Y3ROYW1lfX0sICp7eyQuU3RydWN0TmFtZX19UGFnZXt7aWYgLk5hbWV9fUJ5e3suTmFtZX19e3tlbmR9fUN1cnNvciwgZXJyb3IpIHsKICB2YXIgZGJSb3dzIHBneC5Sb3dzCiAgdmFyIGVyciBlcnJvcgogIGlmIGFmdGVyID09IG5pbCB7CiAgICBkYlJvd3MsIGVyciA9IHByZXBhcmVRdWVyeShjdHgsIGRiLCAicGd4ZGF0YVNlbGVjdHt7JC5TdHJ1Y3ROYW1lfX1QYWdle3suTmFtZX19Iiwgc2VsZWN0e3skLlN0cnVjdE5hbWV9fVBhZ2V7ey5OYW1lfX1TUUwsIGxpbWl0KQogIH0gZWxzZSB7CiAgICBkYlJvd3MsIGVyciA9IHByZXBhcmVRdWVyeShjdHgsIGRiLCAicGd4ZGF0YVNlbGVjdHt7JC5TdHJ1Y3ROYW1lfX1QYWdle3suTmFtZX19QWZ0ZXIiLCBzZWxlY3R7eyQuU3RydWN0TmFtZX19UGFnZXt7Lk5hbWV9fUFmdGVyU1FMe3tyYW5nZSAuQ29sdW1uc319LCBhZnRlci57ey5GaWVsZE5hbWV9fXt7ZW5kfX0sIGxpbWl0KQogIH0KICBpZiBlcnIgIT0gbmlsIHsKICAgIHJldHVybiBuaWwsIG5pbCwgZXJyCiAgfQogIGRlZmVyIGRiUm93cy5DbG9zZSgpCgogIHZhciByb3dzIFtde3skLlN0cnVjdE5hbWV9fQogIGZvciBkYlJvd3MuTmV4dCgpIHsKICAgIHZhciByb3cge3skLlN0cnVjdE5hbWV9fQogICAgZXJyIDo9IGRiUm93cy5TY2FuKAp7e3JhbmdlICQuQ29sdW1uc319JnJvdy57ey5GaWVsZE5hbWV9fSwKICAgIHt7ZW5kfX0pCiAgICBpZiBlcnIgIT0gbmlsIHsKICAgICAgcmV0dXJuIG5pbCwgbmlsLCBlcnIKICAgIH0KICAgIHJvd3MgPSBhcHBlbmQocm93cywgcm93KQogIH0KCiAgaWYgZGJSb3dzLkVycigpICE9IG5pbCB7CiAgICByZXR1cm4gbmlsLCBuaWwsIGRiUm93cy5FcnIoKQogIH0KCiAgaWYgbGVuKHJvd3MpID09IDAgfHwgbGVuKHJvd3MpIDwgbGltaXQgewogICAgcmV0dXJuIHJvd3MsIG5pbCwgbmlsCiAgfQoKICBsYXN0IDo9ICZyb3dzW2xlbihyb3dzKS0xXQogIG5leHQgOj0gJnt7JC5TdHJ1Y3ROYW1lfX1QYWdle3tpZiAuTmFtZX19Qnl7ey5OYW1lfX17e2VuZH19Q3Vyc29ye30Ke3tyYW5nZSAuQ29sdW1uc319e3tpZiAuR29GaWVsZH19ICBuZXh0Lnt7LkZpZWxkTmFtZX19ID0gbGFzdC57ey5GaWVsZE5hbWV9fQp7e2Vsc2V9fSAgZXJyID0gbGFzdC57ey5GaWVsZE5hbWV9fS5Bc3NpZ25UbygmbmV4dC57ey5GaWVsZE5hbWV9fSkKICBpZiBlcnIgIT0gbmlsIHsKICAgIHJldHVybiBuaWwsIG5pbCwgZXJyCiAgfQp7e2VuZH19e3tlbmR9fQogIHJldHVybiByb3dzLCBuZXh0LCBuaWwKfQp7e2VuZH19Cg==`)
+	if err != nil {
+		panic("Unable to decode template")
+	}
+
+	_, err = templates.New(`select_page_func`).Parse(string(decoded))
+	if err != nil {
+		fmt.Println(string(decoded))
+		panic("Unable to parse template")
+	}
+
+	decoded, err = base64.StdEncoding.DecodeString(`Ly8gdXBkYXRle3suU3RydWN0TmFtZX19U2V0cyByZXR1cm5zIHRoZSBhc3NpZ25tZW50cyBvZiB0aGUgU0VUIGNsYXVzZSBmb3IgdGhlIGZpZWxkcyBvZiByb3cgdG8gdXBkYXRlIGFuZCB0aGVpcgovLyBhcmd1bWVudHMuCmZ1bmMgdXBkYXRle3suU3RydWN0TmFtZX19U2V0cyhyb3cgKnt7LlN0cnVjdE5hbWV9fXt7aWYgLkdvU3R5bGV9fSwgZmllbGRzIFtde3suU3RydWN0TmFtZX19RmllbGR7e2VuZH19KSAoW11zdHJpbmcsIHBneC5RdWVyeUFyZ3MsIGVycm9yKSB7CiAgc2V0cyA6PSBtYWtlKFtdc3RyaW5nLCAwLCB7e2xlbiAuQ29sdW1uc319KQogIGFyZ3MgOj0gcGd4LlF1ZXJ5QXJncyhtYWtlKFtdaW50ZXJmYWNle30sIDAsIHt7bGVuIC5Db2x1bW5zfX0pKQoKe3tpZiAuR29TdHlsZX19ICBmb3IgXywgZiA6PSByYW5nZSBmaWVsZHMgewogICAgc3dpdGNoIGYgewp7e3JhbmdlIC5Db2x1bW5zfX0gICAgY2FzZSB7eyQuU3RydWN0TmFtZX19e3suRmllbGROYW1lfX1GaWVsZDp7e2lmIC5HZW5lcmF0ZWRBbHdheXN9fQogICAgICByZXR1cm4gbmlsLCBuaWwsIGVycm9ycy5OZXcoInt7LkNvbHVtbk5hbWV9fSBpcyBnZW5lcmF0ZWQgYW5kIGNhbm5vdCBiZSBzZXQiKXt7ZWxzZX19CiAgICAgIHNldHMgPSBhcHBlbmQoc2V0cywgYHt7LkNvbHVtbk5hbWV9fWArIj0iK2FyZ3MuQXBwZW5kKHt7LkZpZWxkQXJnfX0pKXt7ZW5kfX0Ke3tlbmR9fSAgICBkZWZhdWx0OgogICAgICByZXR1cm4gbmlsLCBuaWwsIGVycm9ycy5FcnJvcmYoInVua25vd24ge3suU3RydWN0TmFtZX19RmllbGQgJWQiLCBmKQogICAgfQogIH0Ke3tlbHNlfX17e3JhbmdlIC5Db2x1bW5zfX17e2lmIG5vdCAuR2VuZXJhdGVkQWx3YXlzfX0gIGlmIHJvdy57ey5GaWVsZE5hbWV9fS5TdGF0dXMgIT0gcGd0eXBlLlVuZGVmaW5lZCB7CiAgICBzZXRzID0gYXBwZW5kKHNldHMsIGB7ey5Db2x1bW5OYW1lfX1gKyI9IithcmdzLkFwcGVuZCgmcm93Lnt7LkZpZWxkTmFtZX19KSkKICB9Cnt7ZW5kfX17e2VuZH19e3tlbmR9fQogIHJldHVybiBzZXRzLCBhcmdzLCBuaWwKfQoKZnVuYyBVcGRhdGV7ey5TdHJ1Y3ROYW1lfX0oY3R4IGNvbnRleHQuQ29udGV4dCwgZGIgUXVlcnllcnt7cmFuZ2UgLlByaW1hcnlLZXlDb2x1bW5zfX0sCiAge3suVmFyTmFtZX19IHt7LkdvVHlwZX19e3tlbmR9fSwKICByb3cgKnt7LlN0cnVjdE5hbWV9fSx7e2lmIC5Hb1N0eWxlfX0KICBmaWVsZHMgLi4ue3suU3RydWN0TmFtZX19RmllbGQse3tlbmR9fQopIGVycm9yIHsKICBzZXRzLCBhcmdzLCBlcnIgOj0gdXBkYXRle3suU3RydWN0TmFtZX19U2V0cyhyb3d7e2lmIC5Hb1N0eWxlfX0sIGZpZWxkc3t7ZW5kfX0pCiAgaWYgZXJyICE9IG5pbCB7CiAgICByZXR1cm4gZXJyCiAgfQogIGlmIGxlbihzZXRzKSA9PSAwIHsKICAgIHJldHVybiBuaWwKICB9CgogIHNxbCA6PSBgdXBkYXRlIHt7LlF1YWxpZmllZFRhYmxlTmFtZX19IHNldCBgICsgc3RyaW5ncy5Kb2luKHNldHMsICIsICIpICsgYCB3aGVyZSBgIHt7IHJhbmdlICRpLCAkY29sdW1uIDo9IC5QcmltYXJ5S2V5Q29sdW1uc319ICsgYHt7aWYgJGl9fSBhbmQge3tlbmR9fSJ7eyRjb2x1bW4uQ29sdW1uTmFtZX19Ij1gICsgYXJncy5BcHBlbmQoe3skY29sdW1uLlZhck5hbWV9fSl7e2VuZH19ICsgYApyZXR1cm5pbmcge3sgcmFuZ2UgJGksICRjb2x1bW4gOj0gLlJldHVybmluZ0NvbHVtbnN9fXt7aWYgJGl9fSwge3tlbmR9fXt7JGNvbHVtbi5TZWxlY3RFeHByfX17e2VuZH19YAoKICBwc05hbWUgOj0gcHJlcGFyZWROYW1lKCJwZ3hkYXRhVXBkYXRle3suU3RydWN0TmFtZX19Iiwgc3FsKQoKICBlcnIgPSBwcmVwYXJlUXVlcnlSb3coY3R4LCBkYiwgcHNOYW1lLCBzcWwsIGFyZ3MuLi4pLlNjYW4oe3sgcmFuZ2UgJGksICRjb2x1bW4gOj0gLlJldHVybmluZ0NvbHVtbnN9fXt7aWYgJGl9fSwge3tlbmR9fSZyb3cue3skY29sdW1uLkZpZWxkTmFtZX19e3tlbmR9fSkKICBpZiBlcnJvcnMuSXMoZXJyLCBwZ3guRXJyTm9Sb3dzKSB7CiAgICByZXR1cm4gRXJyTm90Rm91bmQKICB9CiAgcmV0dXJuIGVycgp9Cg==`)
 	if err != nil {
 		panic("Unable to decode template")
 	}
@@ -117,5 +227,27 @@ func loadTemplates() *template.Template {
 		panic("Unable to parse template")
 	}
 
+	decoded, err = base64.StdEncoding.DecodeString(`e3skc3RydWN0IDo9IC5TdHJ1Y3ROYW1lfX0vLyB7ey5TdHJ1Y3ROYW1lfX1Db25mbGljdCBpZGVudGlmaWVzIHRoZSBwcmltYXJ5IGtleSBvciB1bmlxdWUgaW5kZXggVXBzZXJ0e3suU3RydWN0TmFtZX19Ci8vIGRldGVjdHMgYSBjb25mbGljdGluZyByb3cgYnkuCnR5cGUge3suU3RydWN0TmFtZX19Q29uZmxpY3QgaW50Cgpjb25zdCAoe3tyYW5nZSAkaSwgJHRhcmdldCA6PSAuQ29uZmxpY3RUYXJnZXRzfX0KICB7eyRzdHJ1Y3R9fUNvbmZsaWN0T257eyR0YXJnZXQuTmFtZX19e3tpZiBub3QgJGl9fSB7eyRzdHJ1Y3R9fUNvbmZsaWN0ID0gaW90YXt7ZW5kfX17e2VuZH19CikKCi8vIHt7LlN0cnVjdE5hbWV9fVVwc2VydE9wdGlvbnMgY29uZmlndXJlcyBVcHNlcnR7ey5TdHJ1Y3ROYW1lfX0uCnR5cGUge3suU3RydWN0TmFtZX19VXBzZXJ0T3B0aW9ucyBzdHJ1Y3QgewogIC8vIENvbmZsaWN0IGlzIHRoZSBwcmltYXJ5IGtleSBvciB1bmlxdWUgaW5kZXggYSBjb25mbGljdGluZyByb3cgaXMgZGV0ZWN0ZWQKICAvLyBieS4gVGhlIHByaW1hcnkga2V5IGlzIHRoZSBkZWZhdWx0LgogIENvbmZsaWN0IHt7LlN0cnVjdE5hbWV9fUNvbmZsaWN0CgogIC8vIERvTm90aGluZyBsZWF2ZXMgYSBjb25mbGljdGluZyByb3cgdW5jaGFuZ2VkIGluc3RlYWQgb2YgdXBkYXRpbmcgaXQuCiAgRG9Ob3RoaW5nIGJvb2wKfQoKLy8gVXBzZXJ0e3suU3RydWN0TmFtZX19IGluc2VydHMgcm93IG9yLCB3aGVuIGl0IGNvbmZsaWN0cyB3aXRoIGFuIGV4aXN0aW5nIHJvdywKLy8gdXBkYXRlcyB0aGUgZXhpc3Rpbmcgcm93IHdpdGggdGhlIHt7aWYgLkdvU3R5bGV9fWdpdmVuIGZpZWxkc3t7ZWxzZX19ZmllbGRzIG9mIHJvdyB0aGF0IGFyZSBub3QgVW5kZWZpbmVke3tlbmR9fSBvdGhlciB0aGFuCi8vIHRob3NlIG9mIHRoZSBjb25mbGljdCB0YXJnZXQuCi8vIExpa2UgSW5zZXJ0e3suU3RydWN0TmFtZX19IHRoZSBwZXJzaXN0ZWQgcm93IGlzIHNjYW5uZWQgaW50byByb3cuIE5vdGhpbmcgaXMKLy8gc2Nhbm5lZCB3aGVuIGEgY29uZmxpY3Rpbmcgcm93IGlzIGxlZnQgdW5jaGFuZ2VkLgpmdW5jIFVwc2VydHt7LlN0cnVjdE5hbWV9fShjdHggY29udGV4dC5Db250ZXh0LCBkYiBRdWVyeWVyLCByb3cgKnt7LlN0cnVjdE5hbWV9fSwgb3B0cyB7ey5TdHJ1Y3ROYW1lfX1VcHNlcnRPcHRpb25ze3tpZiAuR29TdHlsZX19LCBmaWVsZHMgLi4ue3suU3RydWN0TmFtZX19RmllbGR7e2VuZH19KSAoVXBzZXJ0UmVzdWx0LCBlcnJvcikgewogIGFyZ3MgOj0gcGd4LlF1ZXJ5QXJncyhtYWtlKFtdaW50ZXJmYWNle30sIDAsIHt7bGVuIC5Db2x1bW5zfX0pKQoKICB2YXIgY29sdW1ucywgdmFsdWVzIFtdc3RyaW5nCgp7e2lmIC5Hb1N0eWxlfX0gIGZvciBfLCBmIDo9IHJhbmdlIGZpZWxkcyB7CiAgICBzd2l0Y2ggZiB7Cnt7cmFuZ2UgLkNvbHVtbnN9fSAgICBjYXNlIHt7JC5TdHJ1Y3ROYW1lfX17ey5GaWVsZE5hbWV9fUZpZWxkOnt7aWYgLkdlbmVyYXRlZEFsd2F5c319CiAgICAgIHJldHVybiBVcHNlcnRVbmNoYW5nZWQsIGVycm9ycy5OZXcoInt7LkNvbHVtbk5hbWV9fSBpcyBnZW5lcmF0ZWQgYW5kIGNhbm5vdCBiZSBzZXQiKXt7ZWxzZX19CiAgICAgIGNvbHVtbnMgPSBhcHBlbmQoY29sdW1ucywgYHt7LlF1b3RlZE5hbWV9fWApCiAgICAgIHZhbHVlcyA9IGFwcGVuZCh2YWx1ZXMsIGFyZ3MuQXBwZW5kKHt7LkZpZWxkQXJnfX0pKXt7ZW5kfX0Ke3tlbmR9fSAgICBkZWZhdWx0OgogICAgICByZXR1cm4gVXBzZXJ0VW5jaGFuZ2VkLCBlcnJvcnMuRXJyb3JmKCJ1bmtub3duIHt7LlN0cnVjdE5hbWV9fUZpZWxkICVkIiwgZikKICAgIH0KICB9Cnt7ZWxzZX19e3tyYW5nZSAuQ29sdW1uc319e3tpZiBub3QgLkdlbmVyYXRlZEFsd2F5c319ICBpZiByb3cue3suRmllbGROYW1lfX0uU3RhdHVzICE9IHBndHlwZS5VbmRlZmluZWQgewogICAgY29sdW1ucyA9IGFwcGVuZChjb2x1bW5zLCBge3suUXVvdGVkTmFtZX19YCkKICAgIHZhbHVlcyA9IGFwcGVuZCh2YWx1ZXMsIGFyZ3MuQXBwZW5kKCZyb3cue3suRmllbGROYW1lfX0pKQogIH0Ke3tlbmR9fXt7ZW5kfX17e2VuZH19CiAgdmFyIHRhcmdldENvbHVtbnMgW11zdHJpbmcKICBzd2l0Y2ggb3B0cy5Db25mbGljdCB7Cnt7cmFuZ2UgLkNvbmZsaWN0VGFyZ2V0c319ICBjYXNlIHt7JHN0cnVjdH19Q29uZmxpY3RPbnt7Lk5hbWV9fToKICAgIHRhcmdldENvbHVtbnMgPSBbXXN0cmluZ3sge3stIHJhbmdlICRpLCAkY29sdW1uIDo9IC5Db2x1bW5zfX17e2lmICRpfX0sIHt7ZW5kfX1ge3skY29sdW1uLlF1b3RlZE5hbWV9fWB7e2VuZCAtfX0gfQp7e2VuZH19ICBkZWZhdWx0OgogICAgcmV0dXJuIFVwc2VydFVuY2hhbmdlZCwgZXJyb3JzLkVycm9yZigidW5rbm93biB7ey5TdHJ1Y3ROYW1lfX1Db25mbGljdCAlZCIsIG9wdHMuQ29uZmxpY3QpCiAgfQoKICAvLyBUaGUgY29uZmxpY3Rpbmcgcm93IGlzIHVwZGF0ZWQgd2l0aCB0aGUgY29sdW1ucyBvdGhlciB0aGFuIHRoZSBjb25mbGljdAogIC8vIHRhcmdldC4gSXQgaXMgbGVmdCB1bmNoYW5nZWQgd2hlbiB0aGVyZSBhcmUgbm9uZS4KICBzZXRzIDo9IG1ha2UoW11zdHJpbmcsIDAsIGxlbihjb2x1bW5zKSkKbmV4dENvbHVtbjoKICBmb3IgXywgYyA6PSByYW5nZSBjb2x1bW5zIHsKICAgIGZvciBfLCB0YyA6PSByYW5nZSB0YXJnZXRDb2x1bW5zIHsKICAgICAgaWYgYyA9PSB0YyB7CiAgICAgICAgY29udGludWUgbmV4dENvbHVtbgogICAgICB9CiAgICB9CiAgICBzZXRzID0gYXBwZW5kKHNldHMsIGMrIj1leGNsdWRlZC4iK2MpCiAgfQoKICBhY3Rpb24gOj0gYGRvIHVwZGF0ZSBzZXQgYCArIHN0cmluZ3MuSm9pbihzZXRzLCAiLCAiKQogIGlmIG9wdHMuRG9Ob3RoaW5nIHx8IGxlbihzZXRzKSA9PSAwIHsKICAgIGFjdGlvbiA9IGBkbyBub3RoaW5nYAogIH0KCiAgaW5zZXJ0IDo9IGAgZGVmYXVsdCB2YWx1ZXNgCiAgaWYgbGVuKGNvbHVtbnMpID4gMCB7CiAgICBpbnNlcnQgPSBgKGAgKyBzdHJpbmdzLkpvaW4oY29sdW1ucywgIiwgIikgKyBgKQp2YWx1ZXMoYCArIHN0cmluZ3MuSm9pbih2YWx1ZXMsICIsIikgKyBgKWAKICB9CgogIHNxbCA6PSBgaW5zZXJ0IGludG8ge3suUXVhbGlmaWVkVGFibGVOYW1lfX1gICsgaW5zZXJ0ICsgYApvbiBjb25mbGljdCAoYCArIHN0cmluZ3MuSm9pbih0YXJnZXRDb2x1bW5zLCAiLCAiKSArIGApIGAgKyBhY3Rpb24gKyBgCnJldHVybmluZyB4bWF4ID0gMCwge3sgcmFuZ2UgJGksICRjb2x1bW4gOj0gLlJldHVybmluZ0NvbHVtbnN9fXt7aWYgJGl9fSwge3tlbmR9fXt7JGNvbHVtbi5TZWxlY3RFeHByfX17e2VuZH19CiAgYAoKICBwc05hbWUgOj0gcHJlcGFyZWROYW1lKCJwZ3hkYXRhVXBzZXJ0e3suU3RydWN0TmFtZX19Iiwgc3FsKQoKICB2YXIgaW5zZXJ0ZWQgYm9vbAogIGVyciA6PSBwcmVwYXJlUXVlcnlSb3coY3R4LCBkYiwgcHNOYW1lLCBzcWwsIGFyZ3MuLi4pLlNjYW4oJmluc2VydGVkLCB7eyByYW5nZSAkaSwgJGNvbHVtbiA6PSAuUmV0dXJuaW5nQ29sdW1uc319e3tpZiAkaX19LCB7e2VuZH19JnJvdy57eyRjb2x1bW4uRmllbGROYW1lfX17e2VuZH19KQogIGlmIGVycm9ycy5JcyhlcnIsIHBneC5FcnJOb1Jvd3MpIHsKICAgIHJldHVybiBVcHNlcnRVbmNoYW5nZWQsIG5pbAogIH0gZWxzZSBpZiBlcnIgIT0gbmlsIHsKICAgIHJldHVybiBVcHNlcnRVbmNoYW5nZWQsIGVycgogIH0KCiAgaWYgaW5zZXJ0ZWQgewogICAgcmV0dXJuIFVwc2VydEluc2VydGVkLCBuaWwKICB9CiAgcmV0dXJuIFVwc2VydFVwZGF0ZWQsIG5pbAp9Cg==`)
+	if err != nil {
+		panic("Unable to decode template")
+	}
+
+	_, err = templates.New(`upsert_func`).Parse(string(decoded))
+	if err != nil {
+		fmt.Println(string(decoded))
+		panic("Unable to parse template")
+	}
+
+	decoded, err = base64.StdEncoding.DecodeString(`Ly8ge3suU3RydWN0TmFtZX19V2hlcmUgaGFzIGEgZmlsdGVyIGZvciBlYWNoIGNvbHVtbiBvZiB7ey5RdWFsaWZpZWRUYWJsZU5hbWV9fSB0aGF0IGJ1aWxkcyB0aGUgY29uZGl0aW9ucyBvZgovLyBTZWxlY3R7ey5TdHJ1Y3ROYW1lfX1XaGVyZSwgQ291bnR7ey5TdHJ1Y3ROYW1lfX1XaGVyZSwgVXBkYXRle3suU3RydWN0TmFtZX19V2hlcmUgYW5kIERlbGV0ZXt7LlN0cnVjdE5hbWV9fVdoZXJlLgp2YXIge3suU3RydWN0TmFtZX19V2hlcmUgPSBzdHJ1Y3Qgewp7e3JhbmdlIC5Db2x1bW5zfX0gIHt7LkZpZWxkTmFtZX19IHt7JC5TdHJ1Y3ROYW1lfX17ey5GaWVsZE5hbWV9fUZpbHRlcgp7e2VuZH19fXsKe3tyYW5nZSAuQ29sdW1uc319ICB7ey5GaWVsZE5hbWV9fToge3skLlN0cnVjdE5hbWV9fXt7LkZpZWxkTmFtZX19RmlsdGVye2NvbHVtbkZpbHRlcntge3suUXVvdGVkTmFtZX19YH19LAp7e2VuZH19fQp7e3JhbmdlIC5Db2x1bW5zfX0KLy8ge3skLlN0cnVjdE5hbWV9fXt7LkZpZWxkTmFtZX19RmlsdGVyIGJ1aWxkcyBjb25kaXRpb25zIG9uIHt7LkNvbHVtbk5hbWV9fS4KdHlwZSB7eyQuU3RydWN0TmFtZX19e3suRmllbGROYW1lfX1GaWx0ZXIgc3RydWN0eyBjb2x1bW5GaWx0ZXIgfQp7e2lmIC5Hb1R5cGV9fQpmdW5jIChmIHt7JC5TdHJ1Y3ROYW1lfX17ey5GaWVsZE5hbWV9fUZpbHRlcikgRXEodiB7ey5Hb1R5cGV9fSkgQ29uZGl0aW9uIHsgcmV0dXJuIGYuY29tcGFyZSgiID0gIiwgdikgfQpmdW5jIChmIHt7JC5TdHJ1Y3ROYW1lfX17ey5GaWVsZE5hbWV9fUZpbHRlcikgTmUodiB7ey5Hb1R5cGV9fSkgQ29uZGl0aW9uIHsgcmV0dXJuIGYuY29tcGFyZSgiIDw+ICIsIHYpIH0KZnVuYyAoZiB7eyQuU3RydWN0TmFtZX19e3suRmllbGROYW1lfX1GaWx0ZXIpIEx0KHYge3suR29UeXBlfX0pIENvbmRpdGlvbiB7IHJldHVybiBmLmNvbXBhcmUoIiA8ICIsIHYpIH0KZnVuYyAoZiB7eyQuU3RydWN0TmFtZX19e3suRmllbGROYW1lfX1GaWx0ZXIpIExlKHYge3suR29UeXBlfX0pIENvbmRpdGlvbiB7IHJldHVybiBmLmNvbXBhcmUoIiA8PSAiLCB2KSB9CmZ1bmMgKGYge3skLlN0cnVjdE5hbWV9fXt7LkZpZWxkTmFtZX19RmlsdGVyKSBHdCh2IHt7LkdvVHlwZX19KSBDb25kaXRpb24geyByZXR1cm4gZi5jb21wYXJlKCIgPiAiLCB2KSB9CmZ1bmMgKGYge3skLlN0cnVjdE5hbWV9fXt7LkZpZWxkTmFtZX19RmlsdGVyKSBHZSh2IHt7LkdvVHlwZX19KSBDb25kaXRpb24geyByZXR1cm4gZi5jb21wYXJlKCIgPj0gIiwgdikgfQoKe3tpZiBlcSAuSW5QYXJhbSAic2xpY2UifX0KZnVuYyAoZiB7eyQuU3RydWN0TmFtZX19e3suRmllbGROYW1lfX1GaWx0ZXIpIEluKHZzIC4uLnt7LkdvVHlwZX19KSBDb25kaXRpb24geyByZXR1cm4gZi5pbihsZW4odnMpLCB2cykgfQp7e2Vsc2UgaWYgZXEgLkluUGFyYW0gImxpdGVyYWwifX0KZnVuYyAoZiB7eyQuU3RydWN0TmFtZX19e3suRmllbGROYW1lfX1GaWx0ZXIpIEluKHZzIC4uLnt7LkdvVHlwZX19KSBDb25kaXRpb24gewogIHZhbHVlcyA6PSBtYWtlKFtdc3RyaW5nLCBsZW4odnMpKQogIGZvciBpLCB2IDo9IHJhbmdlIHZzIHsKICAgIHZhbHVlc1tpXSA9IHN0cmluZyh2KQogIH0KICByZXR1cm4gZi5pbihsZW4odnMpLCBhcnJheUxpdGVyYWwodmFsdWVzKSkKfQp7e2VuZH19e3tlbmR9fXt7ZW5kfX0KZnVuYyBTZWxlY3R7ey5TdHJ1Y3ROYW1lfX1XaGVyZShjdHggY29udGV4dC5Db250ZXh0LCBkYiBRdWVyeWVyLCB3aGVyZSBDb25kaXRpb24pIChbXXt7LlN0cnVjdE5hbWV9fSwgZXJyb3IpIHsKICB2YXIgYXJncyBwZ3guUXVlcnlBcmdzCiAgc3FsIDo9IFNlbGVjdEFsbHt7LlN0cnVjdE5hbWV9fVNRTCArIGAKd2hlcmUgYCArIHdoZXJlLnNxbCgmYXJncykKCiAgZGJSb3dzLCBlcnIgOj0gcHJlcGFyZVF1ZXJ5KGN0eCwgZGIsIHByZXBhcmVkTmFtZSgicGd4ZGF0YVNlbGVjdHt7LlN0cnVjdE5hbWV9fVdoZXJlIiwgc3FsKSwgc3FsLCBhcmdzLi4uKQogIGlmIGVyciAhPSBuaWwgewogICAgcmV0dXJuIG5pbCwgZXJyCiAgfQogIGRlZmVyIGRiUm93cy5DbG9zZSgpCgogIHZhciByb3dzIFtde3suU3RydWN0TmFtZX19CiAgZm9yIGRiUm93cy5OZXh0KCkgewogICAgdmFyIHJvdyB7ey5TdHJ1Y3ROYW1lfX0KICAgIGVyciA6PSBkYlJvd3MuU2NhbigKe3tyYW5nZSAuQ29sdW1uc319JnJvdy57ey5GaWVsZE5hbWV9fSwKICAgIHt7ZW5kfX0pCiAgICBpZiBlcnIgIT0gbmlsIHsKICAgICAgcmV0dXJuIG5pbCwgZXJyCiAgICB9CiAgICByb3dzID0gYXBwZW5kKHJvd3MsIHJvdykKICB9CgogIGlmIGRiUm93cy5FcnIoKSAhPSBuaWwgewogICAgcmV0dXJuIG5pbCwgZGJSb3dzLkVycigpCiAgfQoKICByZXR1cm4gcm93cywgbmlsCn0KCmZ1bmMgQ291bnR7ey5TdHJ1Y3ROYW1lfX1XaGVyZShjdHggY29udGV4dC5Db250ZXh0LCBkYiBRdWVyeWVyLCB3aGVyZSBDb25kaXRpb24pIChpbnQ2NCwgZXJyb3IpIHsKICB2YXIgYXJncyBwZ3guUXVlcnlBcmdzCiAgc3FsIDo9IGNvdW50e3suU3RydWN0TmFtZX19U1FMICsgYCB3aGVyZSBgICsgd2hlcmUuc3FsKCZhcmdzKQoKICB2YXIgbiBpbnQ2NAogIGVyciA6PSBwcmVwYXJlUXVlcnlSb3coY3R4LCBkYiwgcHJlcGFyZWROYW1lKCJwZ3hkYXRhQ291bnR7ey5TdHJ1Y3ROYW1lfX1XaGVyZSIsIHNxbCksIHNxbCwgYXJncy4uLikuU2NhbigmbikKICByZXR1cm4gbiwgZXJyCn0KCi8vIFVwZGF0ZXt7LlN0cnVjdE5hbWV9fVdoZXJlIHVwZGF0ZXMgdGhlIHJvd3MgbWF0Y2hpbmcgd2hlcmUgbGlrZSBVcGRhdGV7ey5TdHJ1Y3ROYW1lfX0gYW5kIHJldHVybnMgdGhlIG51bWJlciBvZgovLyByb3dzIHVwZGF0ZWQuIHdoZXJlIG11c3QgcmVzdHJpY3QgdGhlIHJvd3MsIHNvIGEgQ29uZGl0aW9uIHRoYXQgbWF0Y2hlcyBldmVyeSByb3cgbGlrZSB0aGUgemVybyBDb25kaXRpb24gaXMKLy8gcmVmdXNlZC4KZnVuYyBVcGRhdGV7ey5TdHJ1Y3ROYW1lfX1XaGVyZShjdHggY29udGV4dC5Db250ZXh0LCBkYiBRdWVyeWVyLCB3aGVyZSBDb25kaXRpb24sIHJvdyAqe3suU3RydWN0TmFtZX19e3tpZiAuR29TdHlsZX19LCBmaWVsZHMgLi4ue3suU3RydWN0TmFtZX19RmllbGR7e2VuZH19KSAoaW50NjQsIGVycm9yKSB7CiAgaWYgd2hlcmUud3JpdGUgPT0gbmlsIHsKICAgIHJldHVybiAwLCBlcnJvcnMuTmV3KCJVcGRhdGV7ey5TdHJ1Y3ROYW1lfX1XaGVyZSByZXF1aXJlcyBhIGNvbmRpdGlvbiIpCiAgfQoKICBzZXRzLCBhcmdzLCBlcnIgOj0gdXBkYXRle3suU3RydWN0TmFtZX19U2V0cyhyb3d7e2lmIC5Hb1N0eWxlfX0sIGZpZWxkc3t7ZW5kfX0pCiAgaWYgZXJyICE9IG5pbCB7CiAgICByZXR1cm4gMCwgZXJyCiAgfQogIGlmIGxlbihzZXRzKSA9PSAwIHsKICAgIHJldHVybiAwLCBuaWwKICB9CgogIHNxbCA6PSBgdXBkYXRlIHt7LlF1YWxpZmllZFRhYmxlTmFtZX19IHNldCBgICsgc3RyaW5ncy5Kb2luKHNldHMsICIsICIpICsgYCB3aGVyZSBgICsgd2hlcmUuc3FsKCZhcmdzKQoKICBjb21tYW5kVGFnLCBlcnIgOj0gcHJlcGFyZUV4ZWMoY3R4LCBkYiwgcHJlcGFyZWROYW1lKCJwZ3hkYXRhVXBkYXRle3suU3RydWN0TmFtZX19V2hlcmUiLCBzcWwpLCBzcWwsIGFyZ3MuLi4pCiAgaWYgZXJyICE9IG5pbCB7CiAgICByZXR1cm4gMCwgZXJyCiAgfQogIHJldHVybiBjb21tYW5kVGFnLlJvd3NBZmZlY3RlZCgpLCBuaWwKfQoKLy8gRGVsZXRle3suU3RydWN0TmFtZX19V2hlcmUgZGVsZXRlcyB0aGUgcm93cyBtYXRjaGluZyB3aGVyZSBhbmQgcmV0dXJucyB0aGUgbnVtYmVyIG9mIHJvd3MgZGVsZXRlZC4gd2hlcmUgbXVzdAovLyByZXN0cmljdCB0aGUgcm93cywgc28gYSBDb25kaXRpb24gdGhhdCBtYXRjaGVzIGV2ZXJ5IHJvdyBsaWtlIHRoZSB6ZXJvIENvbmRpdGlvbiBpcyByZWZ1c2VkLgpmdW5jIERlbGV0ZXt7LlN0cnVjdE5hbWV9fVdoZXJlKGN0eCBjb250ZXh0LkNvbnRleHQsIGRiIFF1ZXJ5ZXIsIHdoZXJlIENvbmRpdGlvbikgKGludDY0LCBlcnJvcikgewogIGlmIHdoZXJlLndyaXRlID09IG5pbCB7CiAgICByZXR1cm4gMCwgZXJyb3JzLk5ldygiRGVsZXRle3suU3RydWN0TmFtZX19V2hlcmUgcmVxdWlyZXMgYSBjb25kaXRpb24iKQogIH0KCiAgdmFyIGFyZ3MgcGd4LlF1ZXJ5QXJncwogIHNxbCA6PSBgZGVsZXRlIGZyb20ge3suUXVhbGlmaWVkVGFibGVOYW1lfX0gd2hlcmUgYCArIHdoZXJlLnNxbCgmYXJncykKCiAgY29tbWFuZFRhZywgZXJyIDo9IHByZXBhcmVFeGVjKGN0eCwgZGIsIHByZXBhcmVkTmFtZSgicGd4ZGF0YURlbGV0ZXt7LlN0cnVjdE5hbWV9fVdoZXJlIiwgc3FsKSwgc3FsLCBhcmdzLi4uKQogIGlmIGVyciAhPSBuaWwgewogICAgcmV0dXJuIDAsIGVycgogIH0KICByZXR1cm4gY29tbWFuZFRhZy5Sb3dzQWZmZWN0ZWQoKSwgbmlsCn0K`)
+	if err != nil {
+		panic("Unable to decode template")
+	}
+
+	_, err = templates.New(`where_func`).Parse(string(decoded))
+	if err != nil {
+		fmt.Println(string(decoded))
+		panic("Unable to parse template")
+	}
+
 	return templates
 }
@@ -3,9 +3,14 @@ package data_test
 import (
 	"bytes"
 	"context"
+	"errors"
+	"reflect"
 	"testing"
+	"time"
 
+	"github.com/jackc/pgconn"
 	"github.com/jackc/pgtype"
+	"github.com/jackc/pgx/v4"
 	"github.com/jackc/pgxdata/test/data"
 )
 
@@ -82,6 +87,144 @@ func TestSelectAll(t *testing.T) {
 	}
 }
 
+func TestForEach(t *testing.T) {
+	t.Parallel()
+
+	tx := begin(t)
+	defer tx.Rollback(context.Background())
+
+	for _, name := range []string{"John", "Jane", "Jim"} {
+		insertedRow := data.Customer{
+			FirstName: pgtype.Varchar{String: name, Status: pgtype.Present},
+			LastName:  pgtype.Varchar{String: "Smith", Status: pgtype.Present},
+		}
+		err := data.InsertCustomer(context.Background(), tx, &insertedRow)
+		if err != nil {
+			t.Fatalf("InsertCustomer unexpectedly failed: %v", err)
+		}
+	}
+
+	var names []string
+	err := data.ForEachCustomer(context.Background(), tx, func(row *data.Customer) error {
+		names = append(names, row.FirstName.String)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("ForEachCustomer unexpectedly failed: %v", err)
+	}
+	if len(names) != 3 {
+		t.Fatalf("Expected ForEachCustomer to visit %d rows, but it was %d", 3, len(names))
+	}
+
+	stop := errors.New("stop")
+	visited := 0
+	err = data.ForEachCustomer(context.Background(), tx, func(row *data.Customer) error {
+		visited++
+		return stop
+	})
+	if err != stop {
+		t.Fatalf("Expected ForEachCustomer to return err stop but it was: %v", err)
+	}
+	if visited != 1 {
+		t.Errorf("Expected ForEachCustomer to visit %d rows, but it was %d", 1, visited)
+	}
+
+	// The connection must be usable after stopping early.
+	count, err := data.CountCustomer(context.Background(), tx)
+	if err != nil {
+		t.Fatalf("CountCustomer unexpectedly failed: %v", err)
+	}
+	if count != 3 {
+		t.Errorf("Expected CountCustomer to return %d, but it was %d", 3, count)
+	}
+
+	rows, err := data.QueryCustomerRows(context.Background(), tx)
+	if err != nil {
+		t.Fatalf("QueryCustomerRows unexpectedly failed: %v", err)
+	}
+	defer rows.Close()
+
+	visited = 0
+	for rows.Next() {
+		if rows.Row().LastName.String != "Smith" {
+			t.Errorf("Expected LastName to be %v, but it was %v", "Smith", rows.Row().LastName.String)
+		}
+		visited++
+	}
+	if rows.Err() != nil {
+		t.Fatalf("CustomerRows unexpectedly failed: %v", rows.Err())
+	}
+	if visited != 3 {
+		t.Errorf("Expected CustomerRows to read %d rows, but it was %d", 3, visited)
+	}
+}
+
+func TestSelectPage(t *testing.T) {
+	t.Parallel()
+
+	tx := begin(t)
+	defer tx.Rollback(context.Background())
+
+	for _, name := range [][2]string{{"John", "Smith"}, {"Jane", "Doe"}, {"Adam", "Smith"}, {"Jim", "Brown"}, {"Anne", "Doe"}} {
+		insertedRow := data.Customer{
+			FirstName: pgtype.Varchar{String: name[0], Status: pgtype.Present},
+			LastName:  pgtype.Varchar{String: name[1], Status: pgtype.Present},
+		}
+		err := data.InsertCustomer(context.Background(), tx, &insertedRow)
+		if err != nil {
+			t.Fatalf("InsertCustomer unexpectedly failed: %v", err)
+		}
+	}
+
+	var ids []int32
+	var after *data.CustomerPageCursor
+	for pages := 0; ; pages++ {
+		if pages > 3 {
+			t.Fatal("Expected SelectCustomerPage to return a nil cursor after the last page")
+		}
+
+		customers, next, err := data.SelectCustomerPage(context.Background(), tx, after, 2)
+		if err != nil {
+			t.Fatalf("SelectCustomerPage unexpectedly failed: %v", err)
+		}
+		for _, c := range customers {
+			ids = append(ids, c.ID.Int)
+		}
+		if next == nil {
+			break
+		}
+		after = next
+	}
+	if len(ids) != 5 {
+		t.Fatalf("Expected SelectCustomerPage to return %d rows, but it was %d", 5, len(ids))
+	}
+	for i := 1; i < len(ids); i++ {
+		if ids[i] <= ids[i-1] {
+			t.Errorf("Expected IDs to be in ascending order, but they were %v", ids)
+		}
+	}
+
+	var names []string
+	var afterName *data.CustomerPageByLastNameFirstNameCursor
+	for {
+		customers, next, err := data.SelectCustomerPageByLastNameFirstName(context.Background(), tx, afterName, 3)
+		if err != nil {
+			t.Fatalf("SelectCustomerPageByLastNameFirstName unexpectedly failed: %v", err)
+		}
+		for _, c := range customers {
+			names = append(names, c.FirstName.String+" "+c.LastName.String)
+		}
+		if next == nil {
+			break
+		}
+		afterName = next
+	}
+	expected := []string{"Jim Brown", "Anne Doe", "Jane Doe", "Adam Smith", "John Smith"}
+	if !reflect.DeepEqual(names, expected) {
+		t.Errorf("Expected names to be %v, but they were %v", expected, names)
+	}
+}
+
 func TestSelectByPK(t *testing.T) {
 	t.Parallel()
 
@@ -223,6 +366,116 @@ func TestSelectByPKWithCompositePK(t *testing.T) {
 	}
 }
 
+func TestSelectByUniqueIndex(t *testing.T) {
+	t.Parallel()
+
+	tx := begin(t)
+	defer tx.Rollback(context.Background())
+
+	_, err := data.SelectCustomerByEmail(context.Background(), tx, "john@example.com")
+	if err != data.ErrNotFound {
+		t.Fatalf("Expected SelectCustomerByEmail to return err data.ErrNotFound but it was: %v", err)
+	}
+
+	insertedRow := data.Customer{
+		FirstName: pgtype.Varchar{String: "John", Status: pgtype.Present},
+		LastName:  pgtype.Varchar{String: "Smith", Status: pgtype.Present},
+		Email:     pgtype.Text{String: "john@example.com", Status: pgtype.Present},
+	}
+	err = data.InsertCustomer(context.Background(), tx, &insertedRow)
+	if err != nil {
+		t.Fatalf("InsertCustomer unexpectedly failed: %v", err)
+	}
+
+	customer, err := data.SelectCustomerByEmail(context.Background(), tx, "john@example.com")
+	if err != nil {
+		t.Fatalf("SelectCustomerByEmail unexpectedly failed: %v", err)
+	}
+	if customer.ID != insertedRow.ID {
+		t.Errorf("Expected ID to be %v, but it was %v", insertedRow.ID, customer.ID)
+	}
+
+	item := data.LineItem{
+		Sku:      pgtype.Varchar{String: "FIND-1", Status: pgtype.Present},
+		Quantity: pgtype.Int4{Int: 3, Status: pgtype.Present},
+	}
+	if err := item.UnitPrice.Set("2.00"); err != nil {
+		t.Fatalf("Set unexpectedly failed: %v", err)
+	}
+	err = data.InsertLineItem(context.Background(), tx, &item)
+	if err != nil {
+		t.Fatalf("InsertLineItem unexpectedly failed: %v", err)
+	}
+
+	lineItem, err := data.SelectLineItemBySku(context.Background(), tx, "FIND-1")
+	if err != nil {
+		t.Fatalf("SelectLineItemBySku unexpectedly failed: %v", err)
+	}
+	if lineItem.ID != item.ID || lineItem.Quantity.Int != 3 {
+		t.Errorf("Expected line item %v, but it was %v", item, *lineItem)
+	}
+}
+
+func TestSelectByForeignKey(t *testing.T) {
+	t.Parallel()
+
+	tx := begin(t)
+	defer tx.Rollback(context.Background())
+
+	var customerIDs []int32
+	for _, name := range []string{"John", "Jane", "Jim"} {
+		customer := data.Customer{
+			FirstName: pgtype.Varchar{String: name, Status: pgtype.Present},
+			LastName:  pgtype.Varchar{String: "Smith", Status: pgtype.Present},
+		}
+		err := data.InsertCustomer(context.Background(), tx, &customer)
+		if err != nil {
+			t.Fatalf("InsertCustomer unexpectedly failed: %v", err)
+		}
+		customerIDs = append(customerIDs, customer.ID.Int)
+	}
+
+	for _, customerID := range []int32{customerIDs[0], customerIDs[0], customerIDs[1]} {
+		order := data.PurchaseOrder{
+			Status:     data.OrderStatusBox{Value: data.OrderStatusPending, Status: pgtype.Present},
+			CustomerID: pgtype.Int4{Int: customerID, Status: pgtype.Present},
+		}
+		err := data.InsertPurchaseOrder(context.Background(), tx, &order)
+		if err != nil {
+			t.Fatalf("InsertPurchaseOrder unexpectedly failed: %v", err)
+		}
+	}
+
+	orders, err := data.SelectPurchaseOrderByCustomerID(context.Background(), tx, customerIDs[0])
+	if err != nil {
+		t.Fatalf("SelectPurchaseOrderByCustomerID unexpectedly failed: %v", err)
+	}
+	if len(orders) != 2 {
+		t.Fatalf("Expected SelectPurchaseOrderByCustomerID to return %d rows, but it was %d", 2, len(orders))
+	}
+	if orders[0].ID.Int >= orders[1].ID.Int {
+		t.Errorf("Expected orders to be ordered by ID, but they were %v", orders)
+	}
+
+	ordersByCustomer, err := data.LoadPurchaseOrderByCustomerID(context.Background(), tx, customerIDs)
+	if err != nil {
+		t.Fatalf("LoadPurchaseOrderByCustomerID unexpectedly failed: %v", err)
+	}
+	if len(ordersByCustomer) != 2 {
+		t.Errorf("Expected LoadPurchaseOrderByCustomerID to return %d keys, but it was %d", 2, len(ordersByCustomer))
+	}
+	for i, n := range []int{2, 1, 0} {
+		if len(ordersByCustomer[customerIDs[i]]) != n {
+			t.Errorf("Expected customer %d to have %d orders, but it had %d", customerIDs[i], n, len(ordersByCustomer[customerIDs[i]]))
+		}
+		for _, order := range ordersByCustomer[customerIDs[i]] {
+			if order.CustomerID.Int != customerIDs[i] {
+				t.Errorf("Expected CustomerID to be %d, but it was %d", customerIDs[i], order.CustomerID.Int)
+			}
+		}
+	}
+}
+
 func TestInsert(t *testing.T) {
 	t.Parallel()
 
@@ -252,6 +505,68 @@ func TestInsert(t *testing.T) {
 	}
 }
 
+func TestInsertDefaultValues(t *testing.T) {
+	t.Parallel()
+
+	tx := begin(t)
+	defer tx.Rollback(context.Background())
+
+	var row data.ScalarTypes
+	err := data.InsertScalarTypes(context.Background(), tx, &row)
+	if err != nil {
+		t.Fatalf("InsertScalarTypes unexpectedly failed: %v", err)
+	}
+	if row.ID.Status != pgtype.Present {
+		t.Errorf("Expected ID to be set, but it was %v", row.ID)
+	}
+
+	// Without fields the row is inserted with default values, which violates
+	// the not null constraint of name rather than being a syntax error.
+	err = data.InsertAccount(context.Background(), tx, &data.Account{})
+	if pgErr, ok := err.(*pgconn.PgError); !ok || pgErr.Code != "23502" {
+		t.Errorf("Expected InsertAccount without fields to fail with a not null violation, but it was: %v", err)
+	}
+}
+
+func TestInsertReturning(t *testing.T) {
+	t.Parallel()
+
+	tx := begin(t)
+	defer tx.Rollback(context.Background())
+
+	insertedRow := data.Customer{
+		FirstName: pgtype.Varchar{String: "John", Status: pgtype.Present},
+		LastName:  pgtype.Varchar{String: "Smith", Status: pgtype.Present},
+	}
+
+	err := data.InsertCustomer(context.Background(), tx, &insertedRow)
+	if err != nil {
+		t.Fatalf("InsertCustomer unexpectedly failed: %v", err)
+	}
+	if insertedRow.CreationTime.Status != pgtype.Present {
+		t.Errorf("Expected CreationTime to be returned, but it was %v", insertedRow.CreationTime)
+	}
+	if insertedRow.BirthDate.Status != pgtype.Null {
+		t.Errorf("Expected BirthDate to be returned as NULL, but it was %v", insertedRow.BirthDate)
+	}
+
+	renamedRow := data.RenamedFieldCustomer{
+		FName:    pgtype.Varchar{String: "Jane", Status: pgtype.Present},
+		LastName: pgtype.Varchar{String: "Smith", Status: pgtype.Present},
+	}
+
+	err = data.InsertRenamedFieldCustomer(context.Background(), tx, &renamedRow)
+	if err != nil {
+		t.Fatalf("InsertRenamedFieldCustomer unexpectedly failed: %v", err)
+	}
+	if renamedRow.ID.Status != pgtype.Present || renamedRow.CreationTime.Status != pgtype.Present {
+		t.Errorf("Expected ID and CreationTime to be returned, but they were %v and %v", renamedRow.ID, renamedRow.CreationTime)
+	}
+	if renamedRow.BirthDate.Status != pgtype.Undefined {
+		t.Errorf("Expected BirthDate to not be returned, but it was %v", renamedRow.BirthDate)
+	}
+}
+
 func TestInsertOverridingPK(t *testing.T) {
 	t.Parallel()
 
@@ -353,6 +668,44 @@ func TestUpdateWithCompositePK(t *testing.T) {
 	}
 }
 
+func TestUpdateReturning(t *testing.T) {
+	t.Parallel()
+
+	tx := begin(t)
+	defer tx.Rollback(context.Background())
+
+	insertedRow := data.Semester{
+		Year:        pgtype.Int2{Int: 2001, Status: pgtype.Present},
+		Season:      pgtype.Varchar{String: "Spring", Status: pgtype.Present},
+		Description: pgtype.Text{String: "First of the millennium", Status: pgtype.Present},
+	}
+
+	err := data.InsertSemester(context.Background(), tx, &insertedRow)
+	if err != nil {
+		t.Fatalf("InsertSemester unexpectedly failed: %v", err)
+	}
+
+	updateAttrs := &data.Semester{
+		Season: pgtype.Varchar{String: "Summer", Status: pgtype.Present},
+	}
+
+	err = data.UpdateSemester(context.Background(), tx, 2001, "Spring", updateAttrs)
+	if err != nil {
+		t.Fatalf("UpdateSemester unexpectedly failed: %v", err)
+	}
+	if updateAttrs.Year != insertedRow.Year {
+		t.Errorf("Expected Year to be %v, but it was %v", insertedRow.Year, updateAttrs.Year)
+	}
+	if updateAttrs.Description != insertedRow.Description {
+		t.Errorf("Expected Description to be %v, but it was %v", insertedRow.Description, updateAttrs.Description)
+	}
+
+	err = data.UpdateSemester(context.Background(), tx, 2001, "Spring", updateAttrs)
+	if err != data.ErrNotFound {
+		t.Errorf("Expected UpdateSemester of missing row to return err data.ErrNotFound but it was: %v", err)
+	}
+}
+
 func TestDelete(t *testing.T) {
 	t.Parallel()
 
@@ -424,6 +777,114 @@ func TestDeleteWithCompositePK(t *testing.T) {
 	}
 }
 
+func TestWhere(t *testing.T) {
+	t.Parallel()
+
+	tx := begin(t)
+	defer tx.Rollback(context.Background())
+
+	for _, w := range []struct {
+		name   string
+		weight int16
+	}{{"bolt", 3}, {"bolt", 8}, {"nut", 2}, {"gear", 12}} {
+		widget := data.Widget{
+			Name:   pgtype.Varchar{String: w.name, Status: pgtype.Present},
+			Weight: pgtype.Int2{Int: w.weight, Status: pgtype.Present},
+		}
+		err := data.InsertWidget(context.Background(), tx, &widget)
+		if err != nil {
+			t.Fatalf("InsertWidget unexpectedly failed: %v", err)
+		}
+	}
+
+	widgets, err := data.SelectWidgetWhere(context.Background(), tx, data.WidgetWhere.Name.Eq("bolt").And(data.WidgetWhere.Weight.Gt(5)))
+	if err != nil {
+		t.Fatalf("SelectWidgetWhere unexpectedly failed: %v", err)
+	}
+	if len(widgets) != 1 || widgets[0].Weight.Int != 8 {
+		t.Errorf("Expected SelectWidgetWhere to return the bolt weighing 8, but it was %v", widgets)
+	}
+
+	n, err := data.CountWidgetWhere(context.Background(), tx, data.WidgetWhere.Name.In("nut", "gear").Or(data.WidgetWhere.Weight.Le(3)))
+	if err != nil {
+		t.Fatalf("CountWidgetWhere unexpectedly failed: %v", err)
+	}
+	if n != 3 {
+		t.Errorf("Expected CountWidgetWhere to return %d, but it was %d", 3, n)
+	}
+
+	n, err = data.CountWidgetWhere(context.Background(), tx, data.Condition{})
+	if err != nil {
+		t.Fatalf("CountWidgetWhere unexpectedly failed: %v", err)
+	}
+	if n != 4 {
+		t.Errorf("Expected CountWidgetWhere to return %d, but it was %d", 4, n)
+	}
+
+	n, err = data.CountWidgetWhere(context.Background(), tx, data.WidgetWhere.Name.In())
+	if err != nil {
+		t.Fatalf("CountWidgetWhere unexpectedly failed: %v", err)
+	}
+	if n != 0 {
+		t.Errorf("Expected CountWidgetWhere to return %d, but it was %d", 0, n)
+	}
+
+	n, err = data.UpdateWidgetWhere(context.Background(), tx, data.WidgetWhere.Name.Eq("bolt"), &data.Widget{
+		Name: pgtype.Varchar{String: "screw", Status: pgtype.Present},
+	})
+	if err != nil {
+		t.Fatalf("UpdateWidgetWhere unexpectedly failed: %v", err)
+	}
+	if n != 2 {
+		t.Errorf("Expected UpdateWidgetWhere to update %d rows, but it was %d", 2, n)
+	}
+
+	n, err = data.DeleteWidgetWhere(context.Background(), tx, data.WidgetWhere.Name.Eq("screw").Not())
+	if err != nil {
+		t.Fatalf("DeleteWidgetWhere unexpectedly failed: %v", err)
+	}
+	if n != 2 {
+		t.Errorf("Expected DeleteWidgetWhere to delete %d rows, but it was %d", 2, n)
+	}
+
+	widgets, err = data.SelectWidgetWhere(context.Background(), tx, data.Condition{})
+	if err != nil {
+		t.Fatalf("SelectWidgetWhere unexpectedly failed: %v", err)
+	}
+	for _, w := range widgets {
+		if w.Name.String != "screw" {
+			t.Errorf("Expected only screws to remain, but found %v", w.Name.String)
+		}
+	}
+
+	for i, where := range []data.Condition{
+		{},
+		data.Condition{}.And(),
+		data.Condition{}.And(data.Condition{}),
+		data.WidgetWhere.Name.Eq("screw").Or(data.Condition{}),
+	} {
+		_, err = data.UpdateWidgetWhere(context.Background(), tx, where, &data.Widget{
+			Weight: pgtype.Int2{Int: 1, Status: pgtype.Present},
+		})
+		if err == nil {
+			t.Errorf("%d. Expected UpdateWidgetWhere without a predicate to fail, but it did not", i)
+		}
+
+		_, err = data.DeleteWidgetWhere(context.Background(), tx, where)
+		if err == nil {
+			t.Errorf("%d. Expected DeleteWidgetWhere without a predicate to fail, but it did not", i)
+		}
+	}
+
+	n, err = data.CountWidgetWhere(context.Background(), tx, data.Condition{}.And(data.WidgetWhere.Name.Eq("screw")))
+	if err != nil {
+		t.Fatalf("CountWidgetWhere unexpectedly failed: %v", err)
+	}
+	if n != 2 {
+		t.Errorf("Expected CountWidgetWhere to return %d, but it was %d", 2, n)
+	}
+}
+
 func TestMappingOfRenamedField(t *testing.T) {
 	t.Parallel()
 
@@ -474,3 +935,1372 @@ func TestByteaByteSliceMapping(t *testing.T) {
 		t.Errorf("Expected Payload to be %v, but it was %v", insertedRow.Payload, blob.Payload)
 	}
 }
+
+func TestSchemaQualifiedTable(t *testing.T) {
+	t.Parallel()
+
+	tx := begin(t)
+	defer tx.Rollback(context.Background())
+
+	insertedRow := data.BillingCustomer{
+		AccountNumber: pgtype.Varchar{String: "A-100", Status: pgtype.Present},
+		CreditLimit:   pgtype.Int4{Int: 5000, Status: pgtype.Present},
+	}
+
+	err := data.InsertBillingCustomer(context.Background(), tx, &insertedRow)
+	if err != nil {
+		t.Fatalf("InsertBillingCustomer unexpectedly failed: %v", err)
+	}
+
+	customerCount, err := data.CountCustomer(context.Background(), tx)
+	if err != nil {
+		t.Fatalf("CountCustomer unexpectedly failed: %v", err)
+	}
+	if customerCount != 0 {
+		t.Fatalf("Expected CountCustomer to return %v, but is was %v", 0, customerCount)
+	}
+
+	billingCustomer, err := data.SelectBillingCustomerByPK(context.Background(), tx, insertedRow.ID.Int)
+	if err != nil {
+		t.Fatalf("SelectBillingCustomerByPK unexpectedly failed: %v", err)
+	}
+
+	if billingCustomer.AccountNumber != insertedRow.AccountNumber {
+		t.Errorf("Expected AccountNumber to be %v, but it was %v", insertedRow.AccountNumber, billingCustomer.AccountNumber)
+	}
+	if billingCustomer.CreditLimit != insertedRow.CreditLimit {
+		t.Errorf("Expected CreditLimit to be %v, but it was %v", insertedRow.CreditLimit, billingCustomer.CreditLimit)
+	}
+}
+
+func TestScalarTypesMapping(t *testing.T) {
+	t.Parallel()
+
+	tx := begin(t)
+	defer tx.Rollback(context.Background())
+
+	insertedRow := data.ScalarTypes{
+		BoolCol:      pgtype.Bool{Bool: true, Status: pgtype.Present},
+		UuidCol:      pgtype.UUID{Bytes: [16]byte{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15}, Status: pgtype.Present},
+		JsonCol:      pgtype.JSON{Bytes: []byte(`{"name": "John"}`), Status: pgtype.Present},
+		JsonbCol:     pgtype.JSONB{Bytes: []byte(`{"name": "Jane"}`), Status: pgtype.Present},
+		RealCol:      pgtype.Float4{Float: 1.5, Status: pgtype.Present},
+		DoubleCol:    pgtype.Float8{Float: 2.25, Status: pgtype.Present},
+		TimestampCol: pgtype.Timestamp{Time: time.Date(2019, 6, 1, 12, 30, 0, 0, time.UTC), Status: pgtype.Present},
+		TimeCol:      pgtype.GenericText{String: "12:34:56", Status: pgtype.Present},
+		IntervalCol:  pgtype.Interval{Days: 2, Microseconds: 3600000000, Status: pgtype.Present},
+		CharCol:      pgtype.BPChar{String: "abc", Status: pgtype.Present},
+	}
+	err := insertedRow.NumericCol.Set("12.34")
+	if err != nil {
+		t.Fatalf("Set unexpectedly failed: %v", err)
+	}
+
+	err = data.InsertScalarTypes(context.Background(), tx, &insertedRow)
+	if err != nil {
+		t.Fatalf("InsertScalarTypes unexpectedly failed: %v", err)
+	}
+
+	row, err := data.SelectScalarTypesByPK(context.Background(), tx, insertedRow.ID.Int)
+	if err != nil {
+		t.Fatalf("SelectScalarTypesByPK unexpectedly failed: %v", err)
+	}
+
+	if row.BoolCol != insertedRow.BoolCol {
+		t.Errorf("Expected BoolCol to be %v, but it was %v", insertedRow.BoolCol, row.BoolCol)
+	}
+	if row.UuidCol != insertedRow.UuidCol {
+		t.Errorf("Expected UuidCol to be %v, but it was %v", insertedRow.UuidCol, row.UuidCol)
+	}
+	if !bytes.Equal(row.JsonCol.Bytes, insertedRow.JsonCol.Bytes) {
+		t.Errorf("Expected JsonCol to be %s, but it was %s", insertedRow.JsonCol.Bytes, row.JsonCol.Bytes)
+	}
+	if !bytes.Equal(row.JsonbCol.Bytes, insertedRow.JsonbCol.Bytes) {
+		t.Errorf("Expected JsonbCol to be %s, but it was %s", insertedRow.JsonbCol.Bytes, row.JsonbCol.Bytes)
+	}
+	var numeric float64
+	err = row.NumericCol.AssignTo(&numeric)
+	if err != nil {
+		t.Fatalf("AssignTo unexpectedly failed: %v", err)
+	}
+	if numeric != 12.34 {
+		t.Errorf("Expected NumericCol to be %v, but it was %v", 12.34, numeric)
+	}
+	if row.RealCol != insertedRow.RealCol {
+		t.Errorf("Expected RealCol to be %v, but it was %v", insertedRow.RealCol, row.RealCol)
+	}
+	if row.DoubleCol != insertedRow.DoubleCol {
+		t.Errorf("Expected DoubleCol to be %v, but it was %v", insertedRow.DoubleCol, row.DoubleCol)
+	}
+	if !row.TimestampCol.Time.Equal(insertedRow.TimestampCol.Time) || row.TimestampCol.Status != pgtype.Present {
+		t.Errorf("Expected TimestampCol to be %v, but it was %v", insertedRow.TimestampCol, row.TimestampCol)
+	}
+	if row.TimeCol != insertedRow.TimeCol {
+		t.Errorf("Expected TimeCol to be %v, but it was %v", insertedRow.TimeCol, row.TimeCol)
+	}
+	if row.IntervalCol != insertedRow.IntervalCol {
+		t.Errorf("Expected IntervalCol to be %v, but it was %v", insertedRow.IntervalCol, row.IntervalCol)
+	}
+	if row.CharCol != insertedRow.CharCol {
+		t.Errorf("Expected CharCol to be %v, but it was %v", insertedRow.CharCol, row.CharCol)
+	}
+}
+
+func TestScalarTypesMappingNull(t *testing.T) {
+	t.Parallel()
+
+	tx := begin(t)
+	defer tx.Rollback(context.Background())
+
+	var insertedRow data.ScalarTypes
+	err := data.InsertScalarTypes(context.Background(), tx, &insertedRow)
+	if err != nil {
+		t.Fatalf("InsertScalarTypes unexpectedly failed: %v", err)
+	}
+
+	rows, err := data.SelectAllScalarTypes(context.Background(), tx)
+	if err != nil {
+		t.Fatalf("SelectAllScalarTypes unexpectedly failed: %v", err)
+	}
+	if len(rows) != 1 {
+		t.Fatalf("Expected SelectAllScalarTypes to return %d rows, but is was %d", 1, len(rows))
+	}
+
+	row := rows[0]
+	statuses := map[string]pgtype.Status{
+		"BoolCol":      row.BoolCol.Status,
+		"UuidCol":      row.UuidCol.Status,
+		"JsonCol":      row.JsonCol.Status,
+		"JsonbCol":     row.JsonbCol.Status,
+		"NumericCol":   row.NumericCol.Status,
+		"RealCol":      row.RealCol.Status,
+		"DoubleCol":    row.DoubleCol.Status,
+		"TimestampCol": row.TimestampCol.Status,
+		"TimeCol":      row.TimeCol.Status,
+		"IntervalCol":  row.IntervalCol.Status,
+		"CharCol":      row.CharCol.Status,
+	}
+	for field, status := range statuses {
+		if status != pgtype.Null {
+			t.Errorf("Expected %s to be null, but its status was %v", field, status)
+		}
+	}
+}
+
+func TestSelectByPKWithUUIDPK(t *testing.T) {
+	t.Parallel()
+
+	tx := begin(t)
+	defer tx.Rollback(context.Background())
+
+	id := [16]byte{15, 14, 13, 12, 11, 10, 9, 8, 7, 6, 5, 4, 3, 2, 1, 0}
+
+	_, err := data.SelectUuidKeyByPK(context.Background(), tx, id)
+	if err != data.ErrNotFound {
+		t.Fatalf("Expected SelectUuidKeyByPK to return err data.ErrNotFound but it was: %v", err)
+	}
+
+	insertedRow := data.UuidKey{
+		ID:   pgtype.UUID{Bytes: id, Status: pgtype.Present},
+		Name: pgtype.Varchar{String: "Foo", Status: pgtype.Present},
+	}
+
+	err = data.InsertUuidKey(context.Background(), tx, &insertedRow)
+	if err != nil {
+		t.Fatalf("InsertUuidKey unexpectedly failed: %v", err)
+	}
+
+	err = data.UpdateUuidKey(context.Background(), tx, id, &data.UuidKey{
+		Name: pgtype.Varchar{String: "Bar", Status: pgtype.Present},
+	})
+	if err != nil {
+		t.Fatalf("UpdateUuidKey unexpectedly failed: %v", err)
+	}
+
+	row, err := data.SelectUuidKeyByPK(context.Background(), tx, id)
+	if err != nil {
+		t.Fatalf("SelectUuidKeyByPK unexpectedly failed: %v", err)
+	}
+	if row.ID != insertedRow.ID {
+		t.Errorf("Expected ID to be %v, but it was %v", insertedRow.ID, row.ID)
+	}
+	if row.Name.String != "Bar" {
+		t.Errorf("Expected Name to be %v, but it was %v", "Bar", row.Name.String)
+	}
+}
+
+func TestArrayMapping(t *testing.T) {
+	t.Parallel()
+
+	tx := begin(t)
+	defer tx.Rollback(context.Background())
+
+	tags := []string{"red", "green"}
+	permissionIDs := []int64{1, 2, 3}
+	flags := []bool{true, false}
+	uuids := [][16]byte{{1}, {2}}
+	amounts := []float64{1.5, 2.25}
+	occurredAt := []time.Time{time.Date(2019, 6, 1, 12, 30, 0, 0, time.UTC)}
+
+	var insertedRow data.ArrayTypes
+	for _, err := range []error{
+		insertedRow.Tags.Set(tags),
+		insertedRow.PermissionIds.Set(permissionIDs),
+		insertedRow.Flags.Set(flags),
+		insertedRow.Uuids.Set(uuids),
+		insertedRow.Amounts.Set(amounts),
+		insertedRow.OccurredAt.Set(occurredAt),
+	} {
+		if err != nil {
+			t.Fatalf("Set unexpectedly failed: %v", err)
+		}
+	}
+
+	err := data.InsertArrayTypes(context.Background(), tx, &insertedRow)
+	if err != nil {
+		t.Fatalf("InsertArrayTypes unexpectedly failed: %v", err)
+	}
+
+	row, err := data.SelectArrayTypesByPK(context.Background(), tx, insertedRow.ID.Int)
+	if err != nil {
+		t.Fatalf("SelectArrayTypesByPK unexpectedly failed: %v", err)
+	}
+
+	var actualTags []string
+	var actualPermissionIDs []int64
+	var actualFlags []bool
+	var actualUUIDs [][16]byte
+	var actualAmounts []float64
+	var actualOccurredAt []time.Time
+	for _, err := range []error{
+		row.Tags.AssignTo(&actualTags),
+		row.PermissionIds.AssignTo(&actualPermissionIDs),
+		row.Flags.AssignTo(&actualFlags),
+		row.Uuids.AssignTo(&actualUUIDs),
+		row.Amounts.AssignTo(&actualAmounts),
+		row.OccurredAt.AssignTo(&actualOccurredAt),
+	} {
+		if err != nil {
+			t.Fatalf("AssignTo unexpectedly failed: %v", err)
+		}
+	}
+
+	if !reflect.DeepEqual(actualTags, tags) {
+		t.Errorf("Expected Tags to be %v, but it was %v", tags, actualTags)
+	}
+	if !reflect.DeepEqual(actualPermissionIDs, permissionIDs) {
+		t.Errorf("Expected PermissionIds to be %v, but it was %v", permissionIDs, actualPermissionIDs)
+	}
+	if !reflect.DeepEqual(actualFlags, flags) {
+		t.Errorf("Expected Flags to be %v, but it was %v", flags, actualFlags)
+	}
+	if !reflect.DeepEqual(actualUUIDs, uuids) {
+		t.Errorf("Expected Uuids to be %v, but it was %v", uuids, actualUUIDs)
+	}
+	if !reflect.DeepEqual(actualAmounts, amounts) {
+		t.Errorf("Expected Amounts to be %v, but it was %v", amounts, actualAmounts)
+	}
+	if len(actualOccurredAt) != 1 || !actualOccurredAt[0].Equal(occurredAt[0]) {
+		t.Errorf("Expected OccurredAt to be %v, but it was %v", occurredAt, actualOccurredAt)
+	}
+}
+
+func TestUpdateArray(t *testing.T) {
+	t.Parallel()
+
+	tx := begin(t)
+	defer tx.Rollback(context.Background())
+
+	insertedRow := data.ArrayTypes{
+		Tags: pgtype.TextArray{Status: pgtype.Null},
+	}
+	err := data.InsertArrayTypes(context.Background(), tx, &insertedRow)
+	if err != nil {
+		t.Fatalf("InsertArrayTypes unexpectedly failed: %v", err)
+	}
+
+	var update data.ArrayTypes
+	err = update.Tags.Set([]string{"blue"})
+	if err != nil {
+		t.Fatalf("Set unexpectedly failed: %v", err)
+	}
+	err = data.UpdateArrayTypes(context.Background(), tx, insertedRow.ID.Int, &update)
+	if err != nil {
+		t.Fatalf("UpdateArrayTypes unexpectedly failed: %v", err)
+	}
+
+	row, err := data.SelectArrayTypesByPK(context.Background(), tx, insertedRow.ID.Int)
+	if err != nil {
+		t.Fatalf("SelectArrayTypesByPK unexpectedly failed: %v", err)
+	}
+
+	var tags []string
+	err = row.Tags.AssignTo(&tags)
+	if err != nil {
+		t.Fatalf("AssignTo unexpectedly failed: %v", err)
+	}
+	if !reflect.DeepEqual(tags, []string{"blue"}) {
+		t.Errorf("Expected Tags to be %v, but it was %v", []string{"blue"}, tags)
+	}
+	if row.PermissionIds.Status != pgtype.Null {
+		t.Errorf("Expected PermissionIds to be null, but its status was %v", row.PermissionIds.Status)
+	}
+}
+
+func TestEnumMapping(t *testing.T) {
+	t.Parallel()
+
+	tx := begin(t)
+	defer tx.Rollback(context.Background())
+
+	insertedRow := data.PurchaseOrder{
+		Status:         data.OrderStatusBox{Value: data.OrderStatusShipped, Status: pgtype.Present},
+		PreviousStatus: data.OrderStatusBox{Status: pgtype.Null},
+	}
+
+	err := data.InsertPurchaseOrder(context.Background(), tx, &insertedRow)
+	if err != nil {
+		t.Fatalf("InsertPurchaseOrder unexpectedly failed: %v", err)
+	}
+
+	order, err := data.SelectPurchaseOrderByPK(context.Background(), tx, insertedRow.ID.Int)
+	if err != nil {
+		t.Fatalf("SelectPurchaseOrderByPK unexpectedly failed: %v", err)
+	}
+	if order.Status != insertedRow.Status {
+		t.Errorf("Expected Status to be %v, but it was %v", insertedRow.Status, order.Status)
+	}
+	if order.PreviousStatus != insertedRow.PreviousStatus {
+		t.Errorf("Expected PreviousStatus to be %v, but it was %v", insertedRow.PreviousStatus, order.PreviousStatus)
+	}
+
+	err = data.UpdatePurchaseOrder(context.Background(), tx, insertedRow.ID.Int, &data.PurchaseOrder{
+		Status:         data.OrderStatusBox{Value: data.OrderStatusDelivered, Status: pgtype.Present},
+		PreviousStatus: order.Status,
+	})
+	if err != nil {
+		t.Fatalf("UpdatePurchaseOrder unexpectedly failed: %v", err)
+	}
+
+	orders, err := data.SelectAllPurchaseOrder(context.Background(), tx)
+	if err != nil {
+		t.Fatalf("SelectAllPurchaseOrder unexpectedly failed: %v", err)
+	}
+	if len(orders) != 1 {
+		t.Fatalf("Expected SelectAllPurchaseOrder to return %d rows, but is was %d", 1, len(orders))
+	}
+	if orders[0].Status.Value != data.OrderStatusDelivered {
+		t.Errorf("Expected Status to be %v, but it was %v", data.OrderStatusDelivered, orders[0].Status.Value)
+	}
+	if orders[0].PreviousStatus.Value != data.OrderStatusShipped {
+		t.Errorf("Expected PreviousStatus to be %v, but it was %v", data.OrderStatusShipped, orders[0].PreviousStatus.Value)
+	}
+
+	n, err := data.CountPurchaseOrderWhere(context.Background(), tx, data.PurchaseOrderWhere.Status.In(data.OrderStatusDelivered, data.OrderStatusShipped))
+	if err != nil {
+		t.Fatalf("CountPurchaseOrderWhere unexpectedly failed: %v", err)
+	}
+	if n != 1 {
+		t.Errorf("Expected CountPurchaseOrderWhere to return %d, but it was %d", 1, n)
+	}
+}
+
+func TestEnumBox(t *testing.T) {
+	t.Parallel()
+
+	if !data.OrderStatusPending.Valid() {
+		t.Errorf("Expected %v to be valid", data.OrderStatusPending)
+	}
+	if data.OrderStatus("lost").Valid() {
+		t.Errorf("Expected %v to be invalid", data.OrderStatus("lost"))
+	}
+
+	var box data.OrderStatusBox
+	err := box.Set("cancelled")
+	if err != nil {
+		t.Fatalf("Set unexpectedly failed: %v", err)
+	}
+	if box.Value != data.OrderStatusCancelled || box.Status != pgtype.Present {
+		t.Errorf("Expected box to be %v, but it was %v", data.OrderStatusCancelled, box)
+	}
+
+	if err := box.Set("lost"); err == nil {
+		t.Error("Expected Set with an invalid label to fail, but it did not")
+	}
+
+	var s string
+	err = box.AssignTo(&s)
+	if err != nil {
+		t.Fatalf("AssignTo unexpectedly failed: %v", err)
+	}
+	if s != "cancelled" {
+		t.Errorf("Expected AssignTo to assign %v, but it was %v", "cancelled", s)
+	}
+}
+
+func TestCompositeMapping(t *testing.T) {
+	t.Parallel()
+
+	tx := begin(t)
+	defer tx.Rollback(context.Background())
+
+	address := data.Address{
+		Street:     pgtype.Text{String: `1 "Main" St, Apt \2`, Status: pgtype.Present},
+		City:       pgtype.Text{String: "", Status: pgtype.Present},
+		PostalCode: pgtype.Varchar{Status: pgtype.Null},
+	}
+	insertedRow := data.Customer{
+		FirstName: pgtype.Varchar{String: "John", Status: pgtype.Present},
+		LastName:  pgtype.Varchar{String: "Smith", Status: pgtype.Present},
+		Email:     pgtype.Text{String: "john@example.com", Status: pgtype.Present},
+		Address:   data.AddressBox{Value: address, Status: pgtype.Present},
+	}
+
+	err := data.InsertCustomer(context.Background(), tx, &insertedRow)
+	if err != nil {
+		t.Fatalf("InsertCustomer unexpectedly failed: %v", err)
+	}
+
+	customer, err := data.SelectCustomerByPK(context.Background(), tx, insertedRow.ID.Int)
+	if err != nil {
+		t.Fatalf("SelectCustomerByPK unexpectedly failed: %v", err)
+	}
+	if customer.Email != insertedRow.Email {
+		t.Errorf("Expected Email to be %v, but it was %v", insertedRow.Email, customer.Email)
+	}
+	if customer.Address != insertedRow.Address {
+		t.Errorf("Expected Address to be %v, but it was %v", insertedRow.Address, customer.Address)
+	}
+
+	var street string
+	err = tx.QueryRow(context.Background(), `select (address).street from customer where id=$1`, insertedRow.ID.Int).Scan(&street)
+	if err != nil {
+		t.Fatalf("QueryRow unexpectedly failed: %v", err)
+	}
+	if street != address.Street.String {
+		t.Errorf("Expected street to be %v, but it was %v", address.Street.String, street)
+	}
+
+	address.City = pgtype.Text{String: "Springfield", Status: pgtype.Present}
+	address.PostalCode = pgtype.Varchar{String: "12345", Status: pgtype.Present}
+	err = data.UpdateCustomer(context.Background(), tx, insertedRow.ID.Int, &data.Customer{
+		Address: data.AddressBox{Value: address, Status: pgtype.Present},
+	})
+	if err != nil {
+		t.Fatalf("UpdateCustomer unexpectedly failed: %v", err)
+	}
+
+	customer, err = data.SelectCustomerByPK(context.Background(), tx, insertedRow.ID.Int)
+	if err != nil {
+		t.Fatalf("SelectCustomerByPK unexpectedly failed: %v", err)
+	}
+	if customer.Address.Value != address {
+		t.Errorf("Expected Address to be %v, but it was %v", address, customer.Address.Value)
+	}
+
+	err = data.UpdateCustomer(context.Background(), tx, insertedRow.ID.Int, &data.Customer{
+		Address: data.AddressBox{Status: pgtype.Null},
+	})
+	if err != nil {
+		t.Fatalf("UpdateCustomer unexpectedly failed: %v", err)
+	}
+
+	customer, err = data.SelectCustomerByPK(context.Background(), tx, insertedRow.ID.Int)
+	if err != nil {
+		t.Fatalf("SelectCustomerByPK unexpectedly failed: %v", err)
+	}
+	if customer.Address.Status != pgtype.Null {
+		t.Errorf("Expected Address to be NULL, but it was %v", customer.Address)
+	}
+}
+
+func TestDomainCheckConstraint(t *testing.T) {
+	t.Parallel()
+
+	tx := begin(t)
+	defer tx.Rollback(context.Background())
+
+	err := data.InsertCustomer(context.Background(), tx, &data.Customer{
+		FirstName: pgtype.Varchar{String: "John", Status: pgtype.Present},
+		LastName:  pgtype.Varchar{String: "Smith", Status: pgtype.Present},
+		Email:     pgtype.Text{String: "not an email", Status: pgtype.Present},
+	})
+	if err == nil {
+		t.Fatal("Expected InsertCustomer to fail the email_address check, but it did not")
+	}
+}
+
+func tstzrange(lower, upper time.Time) pgtype.Tstzrange {
+	return pgtype.Tstzrange{
+		Lower:     pgtype.Timestamptz{Time: lower, Status: pgtype.Present},
+		Upper:     pgtype.Timestamptz{Time: upper, Status: pgtype.Present},
+		LowerType: pgtype.Inclusive,
+		UpperType: pgtype.Exclusive,
+		Status:    pgtype.Present,
+	}
+}
+
+func TestRangeMapping(t *testing.T) {
+	t.Parallel()
+
+	tx := begin(t)
+	defer tx.Rollback(context.Background())
+
+	start := time.Date(2019, 6, 1, 14, 0, 0, 0, time.UTC)
+	insertedRow := data.Reservation{
+		RoomNumber: pgtype.Int4{Int: 101, Status: pgtype.Present},
+		During:     tstzrange(start, start.Add(2*time.Hour)),
+		StayDates: pgtype.Daterange{
+			Lower:     pgtype.Date{Time: time.Date(2019, 6, 1, 0, 0, 0, 0, time.UTC), Status: pgtype.Present},
+			Upper:     pgtype.Date{Time: time.Date(2019, 6, 3, 0, 0, 0, 0, time.UTC), Status: pgtype.Present},
+			LowerType: pgtype.Inclusive,
+			UpperType: pgtype.Exclusive,
+			Status:    pgtype.Present,
+		},
+		Seats: pgtype.Int4range{
+			Lower:     pgtype.Int4{Int: 1, Status: pgtype.Present},
+			Upper:     pgtype.Int4{Int: 5, Status: pgtype.Present},
+			LowerType: pgtype.Inclusive,
+			UpperType: pgtype.Exclusive,
+			Status:    pgtype.Present,
+		},
+		TicketIds: pgtype.Int8range{
+			Lower:     pgtype.Int8{Int: 1000, Status: pgtype.Present},
+			LowerType: pgtype.Inclusive,
+			UpperType: pgtype.Unbounded,
+			Status:    pgtype.Present,
+		},
+		PriceRange: pgtype.Numrange{Status: pgtype.Null},
+	}
+
+	err := data.InsertReservation(context.Background(), tx, &insertedRow)
+	if err != nil {
+		t.Fatalf("InsertReservation unexpectedly failed: %v", err)
+	}
+
+	reservation, err := data.SelectReservationByPK(context.Background(), tx, insertedRow.ID.Int)
+	if err != nil {
+		t.Fatalf("SelectReservationByPK unexpectedly failed: %v", err)
+	}
+	if !reservation.During.Lower.Time.Equal(start) || !reservation.During.Upper.Time.Equal(start.Add(2*time.Hour)) || reservation.During.LowerType != pgtype.Inclusive || reservation.During.UpperType != pgtype.Exclusive {
+		t.Errorf("Expected During to be %v, but it was %v", insertedRow.During, reservation.During)
+	}
+	if reservation.Seats != insertedRow.Seats {
+		t.Errorf("Expected Seats to be %v, but it was %v", insertedRow.Seats, reservation.Seats)
+	}
+	if reservation.TicketIds != insertedRow.TicketIds {
+		t.Errorf("Expected TicketIds to be %v, but it was %v", insertedRow.TicketIds, reservation.TicketIds)
+	}
+	if reservation.PriceRange.Status != pgtype.Null {
+		t.Errorf("Expected PriceRange to be NULL, but it was %v", reservation.PriceRange)
+	}
+}
+
+func TestSelectOverlapping(t *testing.T) {
+	t.Parallel()
+
+	tx := begin(t)
+	defer tx.Rollback(context.Background())
+
+	start := time.Date(2019, 6, 1, 14, 0, 0, 0, time.UTC)
+	for i := 0; i < 2; i++ {
+		err := data.InsertReservation(context.Background(), tx, &data.Reservation{
+			RoomNumber: pgtype.Int4{Int: int32(101 + i), Status: pgtype.Present},
+			During:     tstzrange(start.Add(time.Duration(i)*4*time.Hour), start.Add(time.Duration(i)*4*time.Hour+2*time.Hour)),
+		})
+		if err != nil {
+			t.Fatalf("InsertReservation unexpectedly failed: %v", err)
+		}
+	}
+
+	reservations, err := data.SelectReservationOverlapping(context.Background(), tx, data.ReservationDuringColumn, tstzrange(start.Add(time.Hour), start.Add(3*time.Hour)))
+	if err != nil {
+		t.Fatalf("SelectReservationOverlapping unexpectedly failed: %v", err)
+	}
+	if len(reservations) != 1 || reservations[0].RoomNumber.Int != 101 {
+		t.Errorf("Expected SelectReservationOverlapping to return room 101, but it was %v", reservations)
+	}
+
+	reservations, err = data.SelectReservationOverlapping(context.Background(), tx, data.ReservationDuringColumn, tstzrange(start.Add(-time.Hour), start.Add(24*time.Hour)))
+	if err != nil {
+		t.Fatalf("SelectReservationOverlapping unexpectedly failed: %v", err)
+	}
+	if len(reservations) != 2 {
+		t.Errorf("Expected SelectReservationOverlapping to return %d rows, but it was %d", 2, len(reservations))
+	}
+
+	_, err = data.SelectReservationOverlapping(context.Background(), tx, data.ReservationRangeColumn("seats"), tstzrange(start, start.Add(time.Hour)))
+	if err == nil {
+		t.Error("Expected SelectReservationOverlapping with a column without a GiST index to fail, but it did not")
+	}
+}
+
+func TestGoFieldStyle(t *testing.T) {
+	t.Parallel()
+
+	tx := begin(t)
+	defer tx.Rollback(context.Background())
+
+	openedOn := time.Date(2019, 6, 1, 0, 0, 0, 0, time.UTC)
+	insertedRow := data.Account{
+		Name:     "Checking",
+		Balance:  "12.34",
+		OpenedOn: openedOn,
+		Tags:     []string{"personal"},
+		Status:   data.OrderStatusShipped,
+	}
+
+	err := data.InsertAccount(context.Background(), tx, &insertedRow,
+		data.AccountNameField,
+		data.AccountNicknameField,
+		data.AccountBalanceField,
+		data.AccountOpenedOnField,
+		data.AccountTagsField,
+		data.AccountStatusField,
+	)
+	if err != nil {
+		t.Fatalf("InsertAccount unexpectedly failed: %v", err)
+	}
+	if insertedRow.ID == 0 {
+		t.Error("Expected InsertAccount to set ID, but it did not")
+	}
+
+	account, err := data.SelectAccountByPK(context.Background(), tx, insertedRow.ID)
+	if err != nil {
+		t.Fatalf("SelectAccountByPK unexpectedly failed: %v", err)
+	}
+	if account.Name != "Checking" {
+		t.Errorf("Expected Name to be %v, but it was %v", "Checking", account.Name)
+	}
+	if account.Nickname != nil {
+		t.Errorf("Expected Nickname to be nil, but it was %v", *account.Nickname)
+	}
+	if account.Balance != "12.34" {
+		t.Errorf("Expected Balance to be %v, but it was %v", "12.34", account.Balance)
+	}
+	if !account.OpenedOn.Equal(openedOn) {
+		t.Errorf("Expected OpenedOn to be %v, but it was %v", openedOn, account.OpenedOn)
+	}
+	if account.ClosedAt != nil {
+		t.Errorf("Expected ClosedAt to be nil, but it was %v", *account.ClosedAt)
+	}
+	if !reflect.DeepEqual(account.Tags, []string{"personal"}) {
+		t.Errorf("Expected Tags to be %v, but it was %v", []string{"personal"}, account.Tags)
+	}
+	if account.Status != data.OrderStatusShipped {
+		t.Errorf("Expected Status to be %v, but it was %v", data.OrderStatusShipped, account.Status)
+	}
+	if account.BillingAddress.Status != pgtype.Null {
+		t.Errorf("Expected BillingAddress to be NULL, but it was %v", account.BillingAddress)
+	}
+	if account.DisplayName == nil || *account.DisplayName != "Checking" {
+		t.Errorf("Expected DisplayName to be %v, but it was %v", "Checking", account.DisplayName)
+	}
+
+	nickname := "Main"
+	closedAt := time.Date(2019, 7, 1, 12, 0, 0, 0, time.UTC)
+	externalID := [16]byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16}
+	err = data.UpdateAccount(context.Background(), tx, insertedRow.ID, &data.Account{
+		Name:       "Ignored",
+		Nickname:   &nickname,
+		ClosedAt:   &closedAt,
+		ExternalID: &externalID,
+	}, data.AccountNicknameField, data.AccountClosedAtField, data.AccountExternalIDField, data.AccountTagsField)
+	if err != nil {
+		t.Fatalf("UpdateAccount unexpectedly failed: %v", err)
+	}
+
+	account, err = data.SelectAccountByPK(context.Background(), tx, insertedRow.ID)
+	if err != nil {
+		t.Fatalf("SelectAccountByPK unexpectedly failed: %v", err)
+	}
+	if account.Name != "Checking" {
+		t.Errorf("Expected Name to be unchanged, but it was %v", account.Name)
+	}
+	if account.Nickname == nil || *account.Nickname != nickname {
+		t.Errorf("Expected Nickname to be %v, but it was %v", nickname, account.Nickname)
+	}
+	if account.ClosedAt == nil || !account.ClosedAt.Equal(closedAt) {
+		t.Errorf("Expected ClosedAt to be %v, but it was %v", closedAt, account.ClosedAt)
+	}
+	if account.ExternalID == nil || *account.ExternalID != externalID {
+		t.Errorf("Expected ExternalID to be %v, but it was %v", externalID, account.ExternalID)
+	}
+	if account.Tags != nil {
+		t.Errorf("Expected Tags to be nil, but it was %v", account.Tags)
+	}
+
+	err = data.UpdateAccount(context.Background(), tx, insertedRow.ID, account, data.AccountField(-1))
+	if err == nil {
+		t.Error("Expected UpdateAccount with an unknown field to fail, but it did not")
+	}
+}
+
+func TestGeneratedColumns(t *testing.T) {
+	t.Parallel()
+
+	tx := begin(t)
+	defer tx.Rollback(context.Background())
+
+	insertedRow := data.LineItem{
+		Sku:      pgtype.Varchar{String: "ABC-1", Status: pgtype.Present},
+		Quantity: pgtype.Int4{Int: 2, Status: pgtype.Present},
+	}
+	if err := insertedRow.UnitPrice.Set("3.50"); err != nil {
+		t.Fatalf("Set unexpectedly failed: %v", err)
+	}
+	if err := insertedRow.Total.Set("100"); err != nil {
+		t.Fatalf("Set unexpectedly failed: %v", err)
+	}
+
+	err := data.InsertLineItem(context.Background(), tx, &insertedRow)
+	if err != nil {
+		t.Fatalf("InsertLineItem unexpectedly failed: %v", err)
+	}
+
+	row, err := data.SelectLineItemByPK(context.Background(), tx, insertedRow.ID.Int)
+	if err != nil {
+		t.Fatalf("SelectLineItemByPK unexpectedly failed: %v", err)
+	}
+	var total float64
+	err = row.Total.AssignTo(&total)
+	if err != nil {
+		t.Fatalf("AssignTo unexpectedly failed: %v", err)
+	}
+	if total != 7 {
+		t.Errorf("Expected Total to be %v, but it was %v", 7, total)
+	}
+
+	// The row scanned back by Insert has Total set. Update skips it.
+	insertedRow.Quantity.Int = 3
+	err = data.UpdateLineItem(context.Background(), tx, insertedRow.ID.Int, &insertedRow)
+	if err != nil {
+		t.Fatalf("UpdateLineItem unexpectedly failed: %v", err)
+	}
+	err = insertedRow.Total.AssignTo(&total)
+	if err != nil {
+		t.Fatalf("AssignTo unexpectedly failed: %v", err)
+	}
+	if total != 10.5 {
+		t.Errorf("Expected Total to be %v, but it was %v", 10.5, total)
+	}
+
+	account := data.Account{Name: "Savings", OpenedOn: time.Date(2019, 6, 1, 0, 0, 0, 0, time.UTC)}
+	err = data.InsertAccount(context.Background(), tx, &account, data.AccountNameField, data.AccountOpenedOnField, data.AccountDisplayNameField)
+	if err == nil {
+		t.Error("Expected InsertAccount with DisplayName to fail, but it did not")
+	}
+
+	err = data.InsertAccount(context.Background(), tx, &account, data.AccountNameField, data.AccountOpenedOnField)
+	if err != nil {
+		t.Fatalf("InsertAccount unexpectedly failed: %v", err)
+	}
+	if account.DisplayName == nil || *account.DisplayName != "Savings" {
+		t.Errorf("Expected DisplayName to be %v, but it was %v", "Savings", account.DisplayName)
+	}
+
+	err = data.UpdateAccount(context.Background(), tx, account.ID, &account, data.AccountNameField, data.AccountDisplayNameField)
+	if err == nil || err.Error() != "display_name is generated and cannot be set" {
+		t.Errorf("Expected UpdateAccount with DisplayName to fail, but it returned %v", err)
+	}
+}
+
+func TestUpsert(t *testing.T) {
+	t.Parallel()
+
+	tx := begin(t)
+	defer tx.Rollback(context.Background())
+
+	row := data.Part{
+		Code:        pgtype.Varchar{String: "X-1", Status: pgtype.Present},
+		Description: pgtype.Text{String: "Widget frame", Status: pgtype.Present},
+	}
+
+	result, err := data.UpsertPart(context.Background(), tx, &row, data.PartUpsertOptions{})
+	if err != nil {
+		t.Fatalf("UpsertPart unexpectedly failed: %v", err)
+	}
+	if result != data.UpsertInserted {
+		t.Errorf("Expected UpsertPart to insert, but the result was %v", result)
+	}
+
+	row.Description = pgtype.Text{String: "Steel widget frame", Status: pgtype.Present}
+	result, err = data.UpsertPart(context.Background(), tx, &row, data.PartUpsertOptions{})
+	if err != nil {
+		t.Fatalf("UpsertPart unexpectedly failed: %v", err)
+	}
+	if result != data.UpsertUpdated {
+		t.Errorf("Expected UpsertPart to update, but the result was %v", result)
+	}
+
+	unchanged := data.Part{
+		Code:        pgtype.Varchar{String: "X-1", Status: pgtype.Present},
+		Description: pgtype.Text{String: "Ignored", Status: pgtype.Present},
+	}
+	result, err = data.UpsertPart(context.Background(), tx, &unchanged, data.PartUpsertOptions{DoNothing: true})
+	if err != nil {
+		t.Fatalf("UpsertPart unexpectedly failed: %v", err)
+	}
+	if result != data.UpsertUnchanged {
+		t.Errorf("Expected UpsertPart to leave the row unchanged, but the result was %v", result)
+	}
+
+	// Only the conflict target is set so there is nothing to update.
+	keyOnly := data.Part{Code: pgtype.Varchar{String: "X-1", Status: pgtype.Present}}
+	result, err = data.UpsertPart(context.Background(), tx, &keyOnly, data.PartUpsertOptions{})
+	if err != nil {
+		t.Fatalf("UpsertPart unexpectedly failed: %v", err)
+	}
+	if result != data.UpsertUnchanged {
+		t.Errorf("Expected UpsertPart to leave the row unchanged, but the result was %v", result)
+	}
+
+	part, err := data.SelectPartByPK(context.Background(), tx, "X-1")
+	if err != nil {
+		t.Fatalf("SelectPartByPK unexpectedly failed: %v", err)
+	}
+	if part.Description.String != "Steel widget frame" {
+		t.Errorf("Expected Description to be %v, but it was %v", "Steel widget frame", part.Description.String)
+	}
+}
+
+func TestUpsertUniqueIndex(t *testing.T) {
+	t.Parallel()
+
+	tx := begin(t)
+	defer tx.Rollback(context.Background())
+
+	insertedRow := data.LineItem{
+		Sku:      pgtype.Varchar{String: "UP-1", Status: pgtype.Present},
+		Quantity: pgtype.Int4{Int: 1, Status: pgtype.Present},
+	}
+	if err := insertedRow.UnitPrice.Set("2.00"); err != nil {
+		t.Fatalf("Set unexpectedly failed: %v", err)
+	}
+
+	err := data.InsertLineItem(context.Background(), tx, &insertedRow)
+	if err != nil {
+		t.Fatalf("InsertLineItem unexpectedly failed: %v", err)
+	}
+
+	row := data.LineItem{
+		Sku:      pgtype.Varchar{String: "UP-1", Status: pgtype.Present},
+		Quantity: pgtype.Int4{Int: 5, Status: pgtype.Present},
+	}
+	if err := row.UnitPrice.Set("2.00"); err != nil {
+		t.Fatalf("Set unexpectedly failed: %v", err)
+	}
+
+	result, err := data.UpsertLineItem(context.Background(), tx, &row, data.LineItemUpsertOptions{Conflict: data.LineItemConflictOnSku})
+	if err != nil {
+		t.Fatalf("UpsertLineItem unexpectedly failed: %v", err)
+	}
+	if result != data.UpsertUpdated {
+		t.Errorf("Expected UpsertLineItem to update, but the result was %v", result)
+	}
+	if row.ID != insertedRow.ID {
+		t.Errorf("Expected ID to be %v, but it was %v", insertedRow.ID, row.ID)
+	}
+	var total float64
+	if err := row.Total.AssignTo(&total); err != nil {
+		t.Fatalf("AssignTo unexpectedly failed: %v", err)
+	}
+	if total != 10 {
+		t.Errorf("Expected Total to be %v, but it was %v", 10, total)
+	}
+}
+
+func TestCopyInsert(t *testing.T) {
+	t.Parallel()
+
+	tx := begin(t)
+	defer tx.Rollback(context.Background())
+
+	rows := []data.Widget{
+		{Name: pgtype.Varchar{String: "Copied A", Status: pgtype.Present}, Weight: pgtype.Int2{Int: 1, Status: pgtype.Present}},
+		{Name: pgtype.Varchar{String: "Copied B", Status: pgtype.Present}, Weight: pgtype.Int2{Int: 2, Status: pgtype.Present}},
+	}
+
+	copyCount, err := data.CopyInsertWidget(context.Background(), tx, rows)
+	if err != nil {
+		t.Fatalf("CopyInsertWidget unexpectedly failed: %v", err)
+	}
+	if copyCount != 2 {
+		t.Errorf("Expected CopyInsertWidget to copy %d rows, but it copied %d", 2, copyCount)
+	}
+
+	var count int64
+	err = tx.QueryRow(context.Background(), "select count(*) from widget where name like 'Copied %'").Scan(&count)
+	if err != nil {
+		t.Fatalf("QueryRow unexpectedly failed: %v", err)
+	}
+	if count != 2 {
+		t.Errorf("Expected %d copied widgets, but there were %d", 2, count)
+	}
+
+	rows = append(rows, data.Widget{Name: pgtype.Varchar{String: "Copied C", Status: pgtype.Present}})
+	_, err = data.CopyInsertWidget(context.Background(), tx, rows)
+	if err == nil {
+		t.Error("Expected CopyInsertWidget with a row setting different fields to fail, but it did not")
+	}
+}
+
+func TestCopyInsertCustomer(t *testing.T) {
+	t.Parallel()
+
+	tx := begin(t)
+	defer tx.Rollback(context.Background())
+
+	rows := []data.Customer{
+		{
+			FirstName: pgtype.Varchar{String: "John", Status: pgtype.Present},
+			LastName:  pgtype.Varchar{String: "Smith", Status: pgtype.Present},
+			Email:     pgtype.Text{String: "john@example.com", Status: pgtype.Present},
+			Address:   data.AddressBox{Status: pgtype.Null},
+		},
+	}
+
+	_, err := data.CopyInsertCustomer(context.Background(), tx, rows)
+	if err == nil {
+		t.Error("Expected CopyInsertCustomer with an address to fail, but it did not")
+	}
+
+	err = data.InsertManyCustomer(context.Background(), tx, rows)
+	if err != nil {
+		t.Fatalf("InsertManyCustomer unexpectedly failed: %v", err)
+	}
+
+	rows[0].Address = data.AddressBox{}
+	rows[0].Email = pgtype.Text{String: "jane@example.com", Status: pgtype.Present}
+	copyCount, err := data.CopyInsertCustomer(context.Background(), tx, rows)
+	if err != nil {
+		t.Fatalf("CopyInsertCustomer unexpectedly failed: %v", err)
+	}
+	if copyCount != 1 {
+		t.Errorf("Expected CopyInsertCustomer to copy %d rows, but it copied %d", 1, copyCount)
+	}
+}
+
+func TestCopyInsertAccount(t *testing.T) {
+	t.Parallel()
+
+	tx := begin(t)
+	defer tx.Rollback(context.Background())
+
+	rows := []data.Account{
+		{Name: "Checking", Balance: "12.34", OpenedOn: time.Date(2019, 6, 1, 0, 0, 0, 0, time.UTC), Status: data.OrderStatusShipped},
+		{Name: "Savings", Balance: "0.50", OpenedOn: time.Date(2019, 6, 2, 0, 0, 0, 0, time.UTC), Status: data.OrderStatusPending},
+	}
+
+	copyCount, err := data.CopyInsertAccount(context.Background(), tx, rows,
+		data.AccountNameField,
+		data.AccountBalanceField,
+		data.AccountOpenedOnField,
+		data.AccountStatusField,
+	)
+	if err != nil {
+		t.Fatalf("CopyInsertAccount unexpectedly failed: %v", err)
+	}
+	if copyCount != 2 {
+		t.Errorf("Expected CopyInsertAccount to copy %d rows, but it copied %d", 2, copyCount)
+	}
+
+	accounts, err := data.SelectAllAccount(context.Background(), tx)
+	if err != nil {
+		t.Fatalf("SelectAllAccount unexpectedly failed: %v", err)
+	}
+	if len(accounts) != 2 {
+		t.Fatalf("Expected SelectAllAccount to return %d rows, but it was %d", 2, len(accounts))
+	}
+	for _, account := range accounts {
+		for _, row := range rows {
+			if account.Name == row.Name && (account.Balance != row.Balance || account.Status != row.Status) {
+				t.Errorf("Expected %s to have Balance %v and Status %v, but it was %v and %v", row.Name, row.Balance, row.Status, account.Balance, account.Status)
+			}
+		}
+	}
+
+	_, err = data.CopyInsertAccount(context.Background(), tx, rows, data.AccountNameField, data.AccountOpenedOnField, data.AccountBillingAddressField)
+	if err == nil {
+		t.Error("Expected CopyInsertAccount with BillingAddress to fail, but it did not")
+	}
+}
+
+func TestCopyInsertScalarTypes(t *testing.T) {
+	t.Parallel()
+
+	tx := begin(t)
+	defer tx.Rollback(context.Background())
+
+	rows := []data.ScalarTypes{
+		{
+			BoolCol: pgtype.Bool{Bool: true, Status: pgtype.Present},
+			TimeCol: pgtype.GenericText{String: "12:34:56", Status: pgtype.Present},
+		},
+	}
+
+	_, err := data.CopyInsertScalarTypes(context.Background(), tx, rows)
+	if err == nil {
+		t.Error("Expected CopyInsertScalarTypes with TimeCol to fail, but it did not")
+	}
+
+	err = data.InsertManyScalarTypes(context.Background(), tx, rows)
+	if err != nil {
+		t.Fatalf("InsertManyScalarTypes unexpectedly failed: %v", err)
+	}
+	if rows[0].TimeCol.String != "12:34:56" {
+		t.Errorf("Expected TimeCol to be %v, but it was %v", "12:34:56", rows[0].TimeCol.String)
+	}
+
+	rows[0].TimeCol = pgtype.GenericText{}
+	copyCount, err := data.CopyInsertScalarTypes(context.Background(), tx, rows)
+	if err != nil {
+		t.Fatalf("CopyInsertScalarTypes unexpectedly failed: %v", err)
+	}
+	if copyCount != 1 {
+		t.Errorf("Expected CopyInsertScalarTypes to copy %d rows, but it copied %d", 1, copyCount)
+	}
+}
+
+func TestCopyInsertPurchaseOrder(t *testing.T) {
+	t.Parallel()
+
+	tx := begin(t)
+	defer tx.Rollback(context.Background())
+
+	rows := []data.PurchaseOrder{
+		{
+			Status:         data.OrderStatusBox{Value: data.OrderStatusShipped, Status: pgtype.Present},
+			PreviousStatus: data.OrderStatusBox{Status: pgtype.Null},
+		},
+	}
+
+	copyCount, err := data.CopyInsertPurchaseOrder(context.Background(), tx, rows)
+	if err != nil {
+		t.Fatalf("CopyInsertPurchaseOrder unexpectedly failed: %v", err)
+	}
+	if copyCount != 1 {
+		t.Errorf("Expected CopyInsertPurchaseOrder to copy %d rows, but it copied %d", 1, copyCount)
+	}
+
+	orders, err := data.SelectAllPurchaseOrder(context.Background(), tx)
+	if err != nil {
+		t.Fatalf("SelectAllPurchaseOrder unexpectedly failed: %v", err)
+	}
+	if len(orders) != 1 || orders[0].Status != rows[0].Status || orders[0].PreviousStatus != rows[0].PreviousStatus {
+		t.Errorf("Expected SelectAllPurchaseOrder to return the copied row, but it was %v", orders)
+	}
+}
+
+func TestInsertMany(t *testing.T) {
+	t.Parallel()
+
+	tx := begin(t)
+	defer tx.Rollback(context.Background())
+
+	rows := []data.Customer{
+		{FirstName: pgtype.Varchar{String: "John", Status: pgtype.Present}, LastName: pgtype.Varchar{String: "Smith", Status: pgtype.Present}},
+		{FirstName: pgtype.Varchar{String: "Jane", Status: pgtype.Present}, LastName: pgtype.Varchar{String: "Doe", Status: pgtype.Present}},
+	}
+
+	err := data.InsertManyCustomer(context.Background(), tx, rows)
+	if err != nil {
+		t.Fatalf("InsertManyCustomer unexpectedly failed: %v", err)
+	}
+
+	for i, row := range rows {
+		if row.ID.Status != pgtype.Present || row.CreationTime.Status != pgtype.Present {
+			t.Errorf("%d. Expected ID and CreationTime to be returned, but they were %v and %v", i, row.ID, row.CreationTime)
+			continue
+		}
+
+		customer, err := data.SelectCustomerByPK(context.Background(), tx, row.ID.Int)
+		if err != nil {
+			t.Fatalf("SelectCustomerByPK unexpectedly failed: %v", err)
+		}
+		if customer.FirstName != row.FirstName {
+			t.Errorf("%d. Expected FirstName to be %v, but it was %v", i, row.FirstName, customer.FirstName)
+		}
+	}
+
+	err = data.InsertManyCustomer(context.Background(), tx, []data.Customer{
+		{FirstName: pgtype.Varchar{String: "John", Status: pgtype.Present}, LastName: pgtype.Varchar{String: "Smith", Status: pgtype.Present}},
+		{FirstName: pgtype.Varchar{String: "Jane", Status: pgtype.Present}},
+	})
+	if err == nil {
+		t.Error("Expected InsertManyCustomer with a row setting different fields to fail, but it did not")
+	}
+
+	// A trigger that skips a row makes fewer rows return than were inserted.
+	_, err = tx.Exec(context.Background(), `
+create function pgxdata_test_skip_customer() returns trigger language plpgsql as $$
+begin
+  if new.first_name = 'Skip' then
+    return null;
+  end if;
+  return new;
+end
+$$;
+
+create trigger pgxdata_test_skip_customer before insert on customer
+for each row execute procedure pgxdata_test_skip_customer();
+`)
+	if err != nil {
+		t.Fatalf("creating trigger unexpectedly failed: %v", err)
+	}
+
+	err = data.InsertManyCustomer(context.Background(), tx, []data.Customer{
+		{FirstName: pgtype.Varchar{String: "Skip", Status: pgtype.Present}, LastName: pgtype.Varchar{String: "Smith", Status: pgtype.Present}},
+		{FirstName: pgtype.Varchar{String: "Jane", Status: pgtype.Present}, LastName: pgtype.Varchar{String: "Doe", Status: pgtype.Present}},
+	})
+	if err == nil {
+		t.Error("Expected InsertManyCustomer with a skipped row to fail, but it did not")
+	}
+}
+
+func TestBatch(t *testing.T) {
+	t.Parallel()
+
+	tx := begin(t)
+	defer tx.Rollback(context.Background())
+
+	semester := data.Semester{
+		Year:        pgtype.Int2{Int: 2002, Status: pgtype.Present},
+		Season:      pgtype.Varchar{String: "Fall", Status: pgtype.Present},
+		Description: pgtype.Text{String: "Batched", Status: pgtype.Present},
+	}
+	err := data.InsertSemester(context.Background(), tx, &semester)
+	if err != nil {
+		t.Fatalf("InsertSemester unexpectedly failed: %v", err)
+	}
+
+	b := &pgx.Batch{}
+
+	customer := data.Customer{
+		FirstName: pgtype.Varchar{String: "John", Status: pgtype.Present},
+		LastName:  pgtype.Varchar{String: "Smith", Status: pgtype.Present},
+	}
+	readCustomer := data.QueueInsertCustomer(b, &customer)
+
+	b.Queue("select count(*) from semester where year=$1", []interface{}{2002}, []pgtype.OID{pgtype.Int4OID}, nil)
+
+	var selected data.Semester
+	readSelected := data.QueueSelectSemesterByPK(b, 2002, "Fall", &selected)
+
+	updateAttrs := data.Semester{Description: pgtype.Text{String: "Updated in a batch", Status: pgtype.Present}}
+	readUpdated := data.QueueUpdateSemester(b, 2002, "Fall", &updateAttrs)
+
+	account := data.Account{Name: "Batched", Balance: "1.50", OpenedOn: time.Date(2019, 6, 1, 0, 0, 0, 0, time.UTC), Status: data.OrderStatusPending}
+	readAccount := data.QueueInsertAccount(b, &account, data.AccountNameField, data.AccountBalanceField, data.AccountOpenedOnField, data.AccountStatusField)
+
+	var semesterCount int64
+	readCount := func(results pgx.BatchResults) error {
+		return results.QueryRowResults().Scan(&semesterCount)
+	}
+
+	err = data.ReadBatch(tx.SendBatch(context.Background(), b), readCustomer, readCount, readSelected, readUpdated, readAccount)
+	if err != nil {
+		t.Fatalf("ReadBatch unexpectedly failed: %v", err)
+	}
+
+	if semesterCount != 1 {
+		t.Errorf("Expected the queued count to be %d, but it was %d", 1, semesterCount)
+	}
+
+	if customer.ID.Status != pgtype.Present {
+		t.Errorf("Expected ID to be set, but it was %v", customer.ID)
+	}
+	if selected.Description.String != "Batched" {
+		t.Errorf("Expected Description to be %v, but it was %v", "Batched", selected.Description.String)
+	}
+	if updateAttrs.Year.Int != 2002 || updateAttrs.Description.String != "Updated in a batch" {
+		t.Errorf("Expected updated row to be returned, but it was %v", updateAttrs)
+	}
+	if account.ID == 0 || account.Balance != "1.50" {
+		t.Errorf("Expected ID and Balance to be returned, but they were %v and %v", account.ID, account.Balance)
+	}
+
+	b = &pgx.Batch{}
+	readDeleted := data.QueueDeleteSemester(b, 2002, "Fall")
+	readSelected = data.QueueSelectSemesterByPK(b, 2002, "Fall", &selected)
+	err = data.ReadBatch(tx.SendBatch(context.Background(), b), readDeleted, readSelected)
+	if err != data.ErrNotFound {
+		t.Errorf("Expected ReadBatch to return err data.ErrNotFound but it was: %v", err)
+	}
+}
+
+func TestQueries(t *testing.T) {
+	t.Parallel()
+
+	tx := begin(t)
+	defer tx.Rollback(context.Background())
+
+	customer := data.Customer{
+		FirstName: pgtype.Varchar{String: "John", Status: pgtype.Present},
+		LastName:  pgtype.Varchar{String: "Smith", Status: pgtype.Present},
+	}
+	err := data.InsertCustomer(context.Background(), tx, &customer)
+	if err != nil {
+		t.Fatalf("InsertCustomer unexpectedly failed: %v", err)
+	}
+
+	var orderIDs []int32
+	for _, status := range []data.OrderStatus{data.OrderStatusPending, data.OrderStatusShipped, data.OrderStatusPending} {
+		order := data.PurchaseOrder{
+			Status:     data.OrderStatusBox{Value: status, Status: pgtype.Present},
+			CustomerID: customer.ID,
+		}
+		err := data.InsertPurchaseOrder(context.Background(), tx, &order)
+		if err != nil {
+			t.Fatalf("InsertPurchaseOrder unexpectedly failed: %v", err)
+		}
+		orderIDs = append(orderIDs, order.ID.Int)
+	}
+
+	row, err := data.PurchaseOrderStatus(context.Background(), tx, orderIDs[1])
+	if err != nil {
+		t.Fatalf("PurchaseOrderStatus unexpectedly failed: %v", err)
+	}
+	if row.ID.Int != orderIDs[1] || row.Status.Value != data.OrderStatusShipped || row.PreviousStatus.Status != pgtype.Null {
+		t.Errorf("Expected order %d shipped without a previous status, but it was %v", orderIDs[1], row)
+	}
+
+	_, err = data.PurchaseOrderStatus(context.Background(), tx, -1)
+	if err != data.ErrNotFound {
+		t.Errorf("Expected PurchaseOrderStatus to return err data.ErrNotFound but it was: %v", err)
+	}
+
+	rows, err := data.PurchaseOrdersWithStatus(context.Background(), tx, data.OrderStatusPending)
+	if err != nil {
+		t.Fatalf("PurchaseOrdersWithStatus unexpectedly failed: %v", err)
+	}
+	if len(rows) != 2 || rows[0].ID.Int != orderIDs[0] || rows[1].ID.Int != orderIDs[2] || rows[0].CustomerID != customer.ID {
+		t.Errorf("Expected the pending orders %d and %d of customer %d, but they were %v", orderIDs[0], orderIDs[2], customer.ID.Int, rows)
+	}
+
+	n, err := data.CancelPurchaseOrders(context.Background(), tx, customer.ID.Int)
+	if err != nil {
+		t.Fatalf("CancelPurchaseOrders unexpectedly failed: %v", err)
+	}
+	if n != 3 {
+		t.Errorf("Expected CancelPurchaseOrders to cancel %d orders, but it was %d", 3, n)
+	}
+
+	row, err = data.PurchaseOrderStatus(context.Background(), tx, orderIDs[1])
+	if err != nil {
+		t.Fatalf("PurchaseOrderStatus unexpectedly failed: %v", err)
+	}
+	if row.Status.Value != data.OrderStatusCancelled || row.PreviousStatus.Value != data.OrderStatusShipped {
+		t.Errorf("Expected order %d cancelled after being shipped, but it was %v", orderIDs[1], row)
+	}
+}
+
+func TestFunctions(t *testing.T) {
+	t.Parallel()
+
+	tx := begin(t)
+	defer tx.Rollback(context.Background())
+
+	var customers []data.Customer
+	for _, name := range [][2]string{{"John", "Smith"}, {"Jane", "Smith"}, {"Jim", "Jones"}} {
+		customer := data.Customer{
+			FirstName: pgtype.Varchar{String: name[0], Status: pgtype.Present},
+			LastName:  pgtype.Varchar{String: name[1], Status: pgtype.Present},
+		}
+		err := data.InsertCustomer(context.Background(), tx, &customer)
+		if err != nil {
+			t.Fatalf("InsertCustomer unexpectedly failed: %v", err)
+		}
+		customers = append(customers, customer)
+	}
+
+	count, err := data.CustomerCount(context.Background(), tx, "Smi")
+	if err != nil {
+		t.Fatalf("CustomerCount unexpectedly failed: %v", err)
+	}
+	if count.Int != 2 {
+		t.Errorf("Expected CustomerCount to count %d customers, but it was %d", 2, count.Int)
+	}
+
+	named, err := data.CustomersNamed(context.Background(), tx, "Smith")
+	if err != nil {
+		t.Fatalf("CustomersNamed unexpectedly failed: %v", err)
+	}
+	if len(named) != 2 || named[0].ID != customers[0].ID || named[1].ID != customers[1].ID {
+		t.Fatalf("Expected CustomersNamed to return John and Jane Smith, but it was %v", named)
+	}
+	if named[1].FirstName.String != "Jane" || named[1].CreationTime.Status != pgtype.Present {
+		t.Errorf("Expected Jane with a creation time, but it was %v", named[1])
+	}
+
+	newest, err := data.NewestCustomer(context.Background(), tx)
+	if err != nil {
+		t.Fatalf("NewestCustomer unexpectedly failed: %v", err)
+	}
+	if newest.ID != customers[2].ID || newest.FirstName.String != "Jim" {
+		t.Errorf("Expected NewestCustomer to return Jim %d, but it was %v", customers[2].ID.Int, newest)
+	}
+
+	names, err := data.CustomerNames(context.Background(), tx, customers[1].ID.Int)
+	if err != nil {
+		t.Fatalf("CustomerNames unexpectedly failed: %v", err)
+	}
+	if len(names) != 2 || names[0].ID == nil || *names[0].ID != customers[1].ID.Int || names[1].FullName == nil || *names[1].FullName != "Jim Jones" {
+		t.Errorf("Expected CustomerNames to return Jane Smith and Jim Jones, but it was %v", names)
+	}
+
+	for _, w := range []struct {
+		name   string
+		weight int16
+	}{{"bolt", 3}, {"nut", 2}, {"gear", 12}} {
+		widget := data.Widget{
+			Name:   pgtype.Varchar{String: w.name, Status: pgtype.Present},
+			Weight: pgtype.Int2{Int: w.weight, Status: pgtype.Present},
+		}
+		err := data.InsertWidget(context.Background(), tx, &widget)
+		if err != nil {
+			t.Fatalf("InsertWidget unexpectedly failed: %v", err)
+		}
+	}
+
+	widgetNames, err := data.WidgetNames(context.Background(), tx, 3)
+	if err != nil {
+		t.Fatalf("WidgetNames unexpectedly failed: %v", err)
+	}
+	if len(widgetNames) != 2 || widgetNames[0].String != "bolt" || widgetNames[1].String != "gear" {
+		t.Errorf("Expected WidgetNames to return bolt and gear, but it was %v", widgetNames)
+	}
+
+	order := data.PurchaseOrder{
+		Status:     data.OrderStatusBox{Value: data.OrderStatusShipped, Status: pgtype.Present},
+		CustomerID: customers[0].ID,
+	}
+	err = data.InsertPurchaseOrder(context.Background(), tx, &order)
+	if err != nil {
+		t.Fatalf("InsertPurchaseOrder unexpectedly failed: %v", err)
+	}
+
+	statuses, err := data.PurchaseOrderStatuses(context.Background(), tx, order.ID.Int)
+	if err != nil {
+		t.Fatalf("PurchaseOrderStatuses unexpectedly failed: %v", err)
+	}
+	if len(statuses) != 1 || statuses[0].ID != order.ID || statuses[0].Status.Value != data.OrderStatusShipped {
+		t.Errorf("Expected PurchaseOrderStatuses to return order %d shipped, but it was %v", order.ID.Int, statuses)
+	}
+
+	cancelled, err := data.CancelPurchaseOrder(context.Background(), tx, order.ID.Int)
+	if err != nil {
+		t.Fatalf("CancelPurchaseOrder unexpectedly failed: %v", err)
+	}
+	if cancelled.OldStatus.Value != data.OrderStatusShipped || cancelled.CancelledOn.Status != pgtype.Present {
+		t.Errorf("Expected CancelPurchaseOrder to return the shipped status and a date, but it was %v", cancelled)
+	}
+
+	cancelled, err = data.CancelPurchaseOrder(context.Background(), tx, -1)
+	if err != nil {
+		t.Fatalf("CancelPurchaseOrder unexpectedly failed: %v", err)
+	}
+	if cancelled.OldStatus.Status != pgtype.Null {
+		t.Errorf("Expected CancelPurchaseOrder of a missing order to return a NULL status, but it was %v", cancelled.OldStatus)
+	}
+}
@@ -0,0 +1,1269 @@
+package data
+
+// This file is automatically generated by pgxdata.
+
+import (
+	"context"
+	"strings"
+
+	"github.com/jackc/pgtype"
+	"github.com/jackc/pgx/v4"
+	errors "golang.org/x/xerrors"
+	"time"
+)
+
+type Account struct {
+	// ID is bigint not null generated always as identity.
+	ID int64
+	// Name is text not null.
+	Name string
+	// Nickname is character varying(50).
+	Nickname *string
+	// Balance is numeric(10,2) not null default 0.
+	Balance string
+	// OpenedOn is date not null.
+	OpenedOn time.Time
+	// ClosedAt is timestamp with time zone.
+	ClosedAt *time.Time
+	// ExternalID is uuid.
+	ExternalID *[16]byte
+	// Tags is text[].
+	Tags []string
+	// Status is order_status not null default 'pending'.
+	Status OrderStatus
+	// BillingAddress is address.
+	BillingAddress AddressBox
+	// Settings is jsonb.
+	Settings pgtype.JSONB
+	// DisplayName is text generated always.
+	DisplayName *string
+}
+
+// AccountField identifies a field of Account to set in InsertAccount and
+// UpdateAccount.
+type AccountField int
+
+const (
+	AccountIDField AccountField = iota
+	AccountNameField
+	AccountNicknameField
+	AccountBalanceField
+	AccountOpenedOnField
+	AccountClosedAtField
+	AccountExternalIDField
+	AccountTagsField
+	AccountStatusField
+	AccountBillingAddressField
+	AccountSettingsField
+	AccountDisplayNameField
+)
+
+const countAccountSQL = `select count(*) from "account"`
+
+func CountAccount(ctx context.Context, db Queryer) (int64, error) {
+	var n int64
+	err := prepareQueryRow(ctx, db, "pgxdataCountAccount", countAccountSQL).Scan(&n)
+	return n, err
+}
+
+const SelectAllAccountSQL = `select
+  "id",
+  "name",
+  "nickname",
+  "balance"::text,
+  "opened_on",
+  "closed_at",
+  "external_id",
+  "tags",
+  "status"::text,
+  "billing_address"::text,
+  "settings",
+  "display_name"
+from "account"`
+
+func SelectAllAccount(ctx context.Context, db Queryer) ([]Account, error) {
+	var rows []Account
+
+	err := ForEachAccount(ctx, db, func(row *Account) error {
+		rows = append(rows, *row)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return rows, nil
+}
+
+// AccountRows is a cursor over the rows of "account" that
+// reads one row at a time.
+type AccountRows struct {
+	rows pgx.Rows
+	row  Account
+	err  error
+}
+
+// QueryAccountRows selects all rows of "account". The
+// returned rows must be closed.
+func QueryAccountRows(ctx context.Context, db Queryer) (*AccountRows, error) {
+	dbRows, err := prepareQuery(ctx, db, "pgxdataSelectAllAccount", SelectAllAccountSQL)
+	if err != nil {
+		return nil, err
+	}
+
+	return &AccountRows{rows: dbRows}, nil
+}
+
+// Next reads the next row. It returns false when there are no more rows or an
+// error occurred.
+func (r *AccountRows) Next() bool {
+	if r.err != nil || !r.rows.Next() {
+		return false
+	}
+
+	r.row = Account{}
+	r.err = r.rows.Scan(
+		&r.row.ID,
+		&r.row.Name,
+		&r.row.Nickname,
+		&r.row.Balance,
+		&r.row.OpenedOn,
+		&r.row.ClosedAt,
+		&r.row.ExternalID,
+		&r.row.Tags,
+		&r.row.Status,
+		&r.row.BillingAddress,
+		&r.row.Settings,
+		&r.row.DisplayName,
+	)
+	if r.err != nil {
+		r.rows.Close()
+		return false
+	}
+
+	return true
+}
+
+// Row returns the current row. It is overwritten by the next call to Next.
+func (r *AccountRows) Row() *Account {
+	return &r.row
+}
+
+// Err returns the error, if any, that stopped Next.
+func (r *AccountRows) Err() error {
+	if r.err != nil {
+		return r.err
+	}
+	return r.rows.Err()
+}
+
+// Close closes the rows. It is safe to call Close after all rows have been
+// read.
+func (r *AccountRows) Close() {
+	r.rows.Close()
+}
+
+// ForEachAccount calls fn with each row of "account". The
+// row passed to fn is reused for the next row. If fn returns an error the
+// remaining rows are skipped and the error is returned.
+func ForEachAccount(ctx context.Context, db Queryer, fn func(*Account) error) error {
+	rows, err := QueryAccountRows(ctx, db)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		err := fn(rows.Row())
+		if err != nil {
+			return err
+		}
+	}
+
+	return rows.Err()
+}
+
+// AccountPageCursor is the position after the last row of a
+// page returned by SelectAccountPage.
+type AccountPageCursor struct {
+	ID int64
+}
+
+const selectAccountPageSQL = `select
+  "id",
+  "name",
+  "nickname",
+  "balance"::text,
+  "opened_on",
+  "closed_at",
+  "external_id",
+  "tags",
+  "status"::text,
+  "billing_address"::text,
+  "settings",
+  "display_name"
+from "account"
+order by "id"
+limit $1`
+
+const selectAccountPageAfterSQL = `select
+  "id",
+  "name",
+  "nickname",
+  "balance"::text,
+  "opened_on",
+  "closed_at",
+  "external_id",
+  "tags",
+  "status"::text,
+  "billing_address"::text,
+  "settings",
+  "display_name"
+from "account"
+where "id" > $1
+order by "id"
+limit $2`
+
+// SelectAccountPage selects up to limit rows that follow
+// after, or the first rows when after is nil, ordered by
+// "id". The returned cursor selects the next page. It is nil
+// when there are no more rows.
+func SelectAccountPage(ctx context.Context, db Queryer, after *AccountPageCursor, limit int) ([]Account, *AccountPageCursor, error) {
+	var dbRows pgx.Rows
+	var err error
+	if after == nil {
+		dbRows, err = prepareQuery(ctx, db, "pgxdataSelectAccountPage", selectAccountPageSQL, limit)
+	} else {
+		dbRows, err = prepareQuery(ctx, db, "pgxdataSelectAccountPageAfter", selectAccountPageAfterSQL, after.ID, limit)
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	defer dbRows.Close()
+
+	var rows []Account
+	for dbRows.Next() {
+		var row Account
+		err := dbRows.Scan(
+			&row.ID,
+			&row.Name,
+			&row.Nickname,
+			&row.Balance,
+			&row.OpenedOn,
+			&row.ClosedAt,
+			&row.ExternalID,
+			&row.Tags,
+			&row.Status,
+			&row.BillingAddress,
+			&row.Settings,
+			&row.DisplayName,
+		)
+		if err != nil {
+			return nil, nil, err
+		}
+		rows = append(rows, row)
+	}
+
+	if dbRows.Err() != nil {
+		return nil, nil, dbRows.Err()
+	}
+
+	if len(rows) == 0 || len(rows) < limit {
+		return rows, nil, nil
+	}
+
+	last := &rows[len(rows)-1]
+	next := &AccountPageCursor{}
+	next.ID = last.ID
+
+	return rows, next, nil
+}
+
+const selectAccountByPKSQL = `select
+  "id",
+  "name",
+  "nickname",
+  "balance"::text,
+  "opened_on",
+  "closed_at",
+  "external_id",
+  "tags",
+  "status"::text,
+  "billing_address"::text,
+  "settings",
+  "display_name"
+from "account"
+where "id"=$1`
+
+func SelectAccountByPK(
+	ctx context.Context,
+	db Queryer,
+	id int64,
+) (*Account, error) {
+	var row Account
+	err := prepareQueryRow(ctx, db, "pgxdataSelectAccountByPK", selectAccountByPKSQL, id).Scan(
+		&row.ID,
+		&row.Name,
+		&row.Nickname,
+		&row.Balance,
+		&row.OpenedOn,
+		&row.ClosedAt,
+		&row.ExternalID,
+		&row.Tags,
+		&row.Status,
+		&row.BillingAddress,
+		&row.Settings,
+		&row.DisplayName,
+	)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrNotFound
+	} else if err != nil {
+		return nil, err
+	}
+
+	return &row, nil
+}
+
+func InsertAccount(ctx context.Context, db Queryer, row *Account, fields ...AccountField) error {
+	args := pgx.QueryArgs(make([]interface{}, 0, 12))
+
+	var columns, values []string
+
+	for _, f := range fields {
+		switch f {
+		case AccountIDField:
+			return errors.New("id is generated and cannot be set")
+		case AccountNameField:
+			columns = append(columns, `name`)
+			values = append(values, args.Append(row.Name))
+		case AccountNicknameField:
+			columns = append(columns, `nickname`)
+			values = append(values, args.Append(row.Nickname))
+		case AccountBalanceField:
+			columns = append(columns, `balance`)
+			values = append(values, args.Append(row.Balance))
+		case AccountOpenedOnField:
+			columns = append(columns, `opened_on`)
+			values = append(values, args.Append(row.OpenedOn))
+		case AccountClosedAtField:
+			columns = append(columns, `closed_at`)
+			values = append(values, args.Append(row.ClosedAt))
+		case AccountExternalIDField:
+			columns = append(columns, `external_id`)
+			values = append(values, args.Append(row.ExternalID))
+		case AccountTagsField:
+			columns = append(columns, `tags`)
+			values = append(values, args.Append(row.Tags))
+		case AccountStatusField:
+			columns = append(columns, `status`)
+			values = append(values, args.Append(row.Status))
+		case AccountBillingAddressField:
+			columns = append(columns, `billing_address`)
+			values = append(values, args.Append(&row.BillingAddress))
+		case AccountSettingsField:
+			columns = append(columns, `settings`)
+			values = append(values, args.Append(&row.Settings))
+		case AccountDisplayNameField:
+			return errors.New("display_name is generated and cannot be set")
+		default:
+			return errors.Errorf("unknown AccountField %d", f)
+		}
+	}
+
+	insert := ` default values`
+	if len(columns) > 0 {
+		insert = `(` + strings.Join(columns, ", ") + `)
+values(` + strings.Join(values, ",") + `)`
+	}
+
+	sql := `insert into "account"` + insert + `
+returning "id", "name", "nickname", "balance"::text, "opened_on", "closed_at", "external_id", "tags", "status"::text, "billing_address"::text, "settings", "display_name"
+  `
+
+	psName := preparedName("pgxdataInsertAccount", sql)
+
+	return prepareQueryRow(ctx, db, psName, sql, args...).Scan(&row.ID, &row.Name, &row.Nickname, &row.Balance, &row.OpenedOn, &row.ClosedAt, &row.ExternalID, &row.Tags, &row.Status, &row.BillingAddress, &row.Settings, &row.DisplayName)
+}
+
+// updateAccountSets returns the assignments of the SET clause for the fields of row to update and their
+// arguments.
+func updateAccountSets(row *Account, fields []AccountField) ([]string, pgx.QueryArgs, error) {
+	sets := make([]string, 0, 12)
+	args := pgx.QueryArgs(make([]interface{}, 0, 12))
+
+	for _, f := range fields {
+		switch f {
+		case AccountIDField:
+			return nil, nil, errors.New("id is generated and cannot be set")
+		case AccountNameField:
+			sets = append(sets, `name`+"="+args.Append(row.Name))
+		case AccountNicknameField:
+			sets = append(sets, `nickname`+"="+args.Append(row.Nickname))
+		case AccountBalanceField:
+			sets = append(sets, `balance`+"="+args.Append(row.Balance))
+		case AccountOpenedOnField:
+			sets = append(sets, `opened_on`+"="+args.Append(row.OpenedOn))
+		case AccountClosedAtField:
+			sets = append(sets, `closed_at`+"="+args.Append(row.ClosedAt))
+		case AccountExternalIDField:
+			sets = append(sets, `external_id`+"="+args.Append(row.ExternalID))
+		case AccountTagsField:
+			sets = append(sets, `tags`+"="+args.Append(row.Tags))
+		case AccountStatusField:
+			sets = append(sets, `status`+"="+args.Append(row.Status))
+		case AccountBillingAddressField:
+			sets = append(sets, `billing_address`+"="+args.Append(&row.BillingAddress))
+		case AccountSettingsField:
+			sets = append(sets, `settings`+"="+args.Append(&row.Settings))
+		case AccountDisplayNameField:
+			return nil, nil, errors.New("display_name is generated and cannot be set")
+		default:
+			return nil, nil, errors.Errorf("unknown AccountField %d", f)
+		}
+	}
+
+	return sets, args, nil
+}
+
+func UpdateAccount(ctx context.Context, db Queryer,
+	id int64,
+	row *Account,
+	fields ...AccountField,
+) error {
+	sets, args, err := updateAccountSets(row, fields)
+	if err != nil {
+		return err
+	}
+	if len(sets) == 0 {
+		return nil
+	}
+
+	sql := `update "account" set ` + strings.Join(sets, ", ") + ` where ` + `"id"=` + args.Append(id) + `
+returning "id", "name", "nickname", "balance"::text, "opened_on", "closed_at", "external_id", "tags", "status"::text, "billing_address"::text, "settings", "display_name"`
+
+	psName := preparedName("pgxdataUpdateAccount", sql)
+
+	err = prepareQueryRow(ctx, db, psName, sql, args...).Scan(&row.ID, &row.Name, &row.Nickname, &row.Balance, &row.OpenedOn, &row.ClosedAt, &row.ExternalID, &row.Tags, &row.Status, &row.BillingAddress, &row.Settings, &row.DisplayName)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return ErrNotFound
+	}
+	return err
+}
+
+// AccountConflict identifies the primary key or unique index UpsertAccount
+// detects a conflicting row by.
+type AccountConflict int
+
+const (
+	AccountConflictOnPK AccountConflict = iota
+)
+
+// AccountUpsertOptions configures UpsertAccount.
+type AccountUpsertOptions struct {
+	// Conflict is the primary key or unique index a conflicting row is detected
+	// by. The primary key is the default.
+	Conflict AccountConflict
+
+	// DoNothing leaves a conflicting row unchanged instead of updating it.
+	DoNothing bool
+}
+
+// UpsertAccount inserts row or, when it conflicts with an existing row,
+// updates the existing row with the given fields other than
+// those of the conflict target.
+// Like InsertAccount the persisted row is scanned into row. Nothing is
+// scanned when a conflicting row is left unchanged.
+func UpsertAccount(ctx context.Context, db Queryer, row *Account, opts AccountUpsertOptions, fields ...AccountField) (UpsertResult, error) {
+	args := pgx.QueryArgs(make([]interface{}, 0, 12))
+
+	var columns, values []string
+
+	for _, f := range fields {
+		switch f {
+		case AccountIDField:
+			return UpsertUnchanged, errors.New("id is generated and cannot be set")
+		case AccountNameField:
+			columns = append(columns, `"name"`)
+			values = append(values, args.Append(row.Name))
+		case AccountNicknameField:
+			columns = append(columns, `"nickname"`)
+			values = append(values, args.Append(row.Nickname))
+		case AccountBalanceField:
+			columns = append(columns, `"balance"`)
+			values = append(values, args.Append(row.Balance))
+		case AccountOpenedOnField:
+			columns = append(columns, `"opened_on"`)
+			values = append(values, args.Append(row.OpenedOn))
+		case AccountClosedAtField:
+			columns = append(columns, `"closed_at"`)
+			values = append(values, args.Append(row.ClosedAt))
+		case AccountExternalIDField:
+			columns = append(columns, `"external_id"`)
+			values = append(values, args.Append(row.ExternalID))
+		case AccountTagsField:
+			columns = append(columns, `"tags"`)
+			values = append(values, args.Append(row.Tags))
+		case AccountStatusField:
+			columns = append(columns, `"status"`)
+			values = append(values, args.Append(row.Status))
+		case AccountBillingAddressField:
+			columns = append(columns, `"billing_address"`)
+			values = append(values, args.Append(&row.BillingAddress))
+		case AccountSettingsField:
+			columns = append(columns, `"settings"`)
+			values = append(values, args.Append(&row.Settings))
+		case AccountDisplayNameField:
+			return UpsertUnchanged, errors.New("display_name is generated and cannot be set")
+		default:
+			return UpsertUnchanged, errors.Errorf("unknown AccountField %d", f)
+		}
+	}
+
+	var targetColumns []string
+	switch opts.Conflict {
+	case AccountConflictOnPK:
+		targetColumns = []string{`"id"`}
+	default:
+		return UpsertUnchanged, errors.Errorf("unknown AccountConflict %d", opts.Conflict)
+	}
+
+	// The conflicting row is updated with the columns other than the conflict
+	// target. It is left unchanged when there are none.
+	sets := make([]string, 0, len(columns))
+nextColumn:
+	for _, c := range columns {
+		for _, tc := range targetColumns {
+			if c == tc {
+				continue nextColumn
+			}
+		}
+		sets = append(sets, c+"=excluded."+c)
+	}
+
+	action := `do update set ` + strings.Join(sets, ", ")
+	if opts.DoNothing || len(sets) == 0 {
+		action = `do nothing`
+	}
+
+	insert := ` default values`
+	if len(columns) > 0 {
+		insert = `(` + strings.Join(columns, ", ") + `)
+values(` + strings.Join(values, ",") + `)`
+	}
+
+	sql := `insert into "account"` + insert + `
+on conflict (` + strings.Join(targetColumns, ", ") + `) ` + action + `
+returning xmax = 0, "id", "name", "nickname", "balance"::text, "opened_on", "closed_at", "external_id", "tags", "status"::text, "billing_address"::text, "settings", "display_name"
+  `
+
+	psName := preparedName("pgxdataUpsertAccount", sql)
+
+	var inserted bool
+	err := prepareQueryRow(ctx, db, psName, sql, args...).Scan(&inserted, &row.ID, &row.Name, &row.Nickname, &row.Balance, &row.OpenedOn, &row.ClosedAt, &row.ExternalID, &row.Tags, &row.Status, &row.BillingAddress, &row.Settings, &row.DisplayName)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return UpsertUnchanged, nil
+	} else if err != nil {
+		return UpsertUnchanged, err
+	}
+
+	if inserted {
+		return UpsertInserted, nil
+	}
+	return UpsertUpdated, nil
+}
+
+// insertAccountColumns returns the columns of fields and their
+// values for inserting row.
+func insertAccountColumns(row *Account, fields []AccountField) ([]string, []interface{}, error) {
+	var columns []string
+	var values []interface{}
+
+	for _, f := range fields {
+		switch f {
+		case AccountIDField:
+			return nil, nil, errors.New("id is generated and cannot be set")
+		case AccountNameField:
+			columns = append(columns, `name`)
+			values = append(values, row.Name)
+		case AccountNicknameField:
+			columns = append(columns, `nickname`)
+			values = append(values, row.Nickname)
+		case AccountBalanceField:
+			columns = append(columns, `balance`)
+			values = append(values, row.Balance)
+		case AccountOpenedOnField:
+			columns = append(columns, `opened_on`)
+			values = append(values, row.OpenedOn)
+		case AccountClosedAtField:
+			columns = append(columns, `closed_at`)
+			values = append(values, row.ClosedAt)
+		case AccountExternalIDField:
+			columns = append(columns, `external_id`)
+			values = append(values, row.ExternalID)
+		case AccountTagsField:
+			columns = append(columns, `tags`)
+			values = append(values, row.Tags)
+		case AccountStatusField:
+			columns = append(columns, `status`)
+			values = append(values, row.Status)
+		case AccountBillingAddressField:
+			columns = append(columns, `billing_address`)
+			values = append(values, &row.BillingAddress)
+		case AccountSettingsField:
+			columns = append(columns, `settings`)
+			values = append(values, &row.Settings)
+		case AccountDisplayNameField:
+			return nil, nil, errors.New("display_name is generated and cannot be set")
+		default:
+			return nil, nil, errors.Errorf("unknown AccountField %d", f)
+		}
+	}
+
+	return columns, values, nil
+}
+
+// CopyInsertAccount inserts rows with the PostgreSQL copy protocol and
+// returns the number of rows copied. The columns copied are those of fields. Values
+// must support the binary format. billing_address has no
+// binary format and must be inserted with InsertManyAccount.
+func CopyInsertAccount(ctx context.Context, db CopyFromer, rows []Account, fields ...AccountField) (int64, error) {
+	if len(rows) == 0 {
+		return 0, nil
+	}
+
+	columns, _, err := insertAccountColumns(&rows[0], fields)
+	if err != nil {
+		return 0, err
+	}
+
+	for _, column := range columns {
+		switch column {
+		case `billing_address`:
+			return 0, errors.Errorf("column %s has no binary format for the copy protocol, use InsertManyAccount", column)
+		}
+	}
+
+	return db.CopyFrom(ctx, pgx.Identifier{"account"}, columns, &copyFromAccountSource{rows: rows, columns: columns, fields: fields, idx: -1})
+}
+
+// copyFromAccountSource is a pgx.CopyFromSource of the columns of rows.
+type copyFromAccountSource struct {
+	rows    []Account
+	columns []string
+	fields  []AccountField
+	idx     int
+}
+
+func (s *copyFromAccountSource) Next() bool {
+	s.idx++
+	return s.idx < len(s.rows)
+}
+
+func (s *copyFromAccountSource) Values() ([]interface{}, error) {
+	columns, values, err := insertAccountColumns(&s.rows[s.idx], s.fields)
+	if err != nil {
+		return nil, err
+	}
+	if !equalColumns(columns, s.columns) {
+		return nil, errors.Errorf("row %d does not set the same fields as row 0", s.idx)
+	}
+	for i, column := range columns {
+		if values[i], err = copyAccountValue(column, values[i]); err != nil {
+			return nil, err
+		}
+	}
+	return values, nil
+}
+
+func (s *copyFromAccountSource) Err() error {
+	return nil
+}
+
+// copyAccountValue converts value, the value of column returned by
+// insertAccountColumns, to one pgx sends in the binary format of the
+// copy protocol.
+func copyAccountValue(column string, value interface{}) (interface{}, error) {
+	switch column {
+	case `balance`:
+		v := value.(string)
+		n := &pgtype.Numeric{}
+		err := n.Set(v)
+		return n, err
+	case `status`:
+		return string(value.(OrderStatus)), nil
+	}
+	return value, nil
+}
+
+// insertManyAccountTypes are the types the parameters of
+// InsertManyAccount are cast to. PostgreSQL only infers them for the
+// VALUES list of an INSERT, not for one that is selected from.
+var insertManyAccountTypes = map[string]string{
+	`name`:            `"pg_catalog"."text"`,
+	`nickname`:        `"pg_catalog"."varchar"`,
+	`balance`:         `"pg_catalog"."numeric"`,
+	`opened_on`:       `"pg_catalog"."date"`,
+	`closed_at`:       `"pg_catalog"."timestamptz"`,
+	`external_id`:     `"pg_catalog"."uuid"`,
+	`tags`:            `"pg_catalog"."_text"`,
+	`status`:          `"public"."order_status"`,
+	`billing_address`: `"public"."address"`,
+	`settings`:        `"pg_catalog"."jsonb"`,
+}
+
+// InsertManyAccount inserts rows with multi-row INSERT statements for
+// a Queryer that does not support the copy protocol or for columns without a
+// binary format. The columns inserted are those of fields. Like InsertAccount
+// the persisted rows are scanned into rows.
+func InsertManyAccount(ctx context.Context, db Queryer, rows []Account, fields ...AccountField) error {
+	if len(rows) == 0 {
+		return nil
+	}
+
+	columns, _, err := insertAccountColumns(&rows[0], fields)
+	if err != nil {
+		return err
+	}
+
+	quotedColumns := make([]string, len(columns))
+	for i, column := range columns {
+		quotedColumns[i] = pgx.Identifier{column}.Sanitize()
+	}
+
+	// A statement can have at most 65535 parameters including the ordinality
+	// of each row.
+	batchSize := len(rows)
+	if batchSize > 65535/(len(columns)+1) {
+		batchSize = 65535 / (len(columns) + 1)
+	}
+
+	for start := 0; start < len(rows); start += batchSize {
+		end := start + batchSize
+		if end > len(rows) {
+			end = len(rows)
+		}
+		batch := rows[start:end]
+
+		args := pgx.QueryArgs(make([]interface{}, 0, len(batch)*(len(columns)+1)))
+		valueLists := make([]string, len(batch))
+		for i := range batch {
+			rowColumns, values, err := insertAccountColumns(&batch[i], fields)
+			if err != nil {
+				return err
+			}
+			if !equalColumns(rowColumns, columns) {
+				return errors.Errorf("row %d does not set the same fields as row 0", start+i)
+			}
+
+			placeholders := make([]string, len(values)+1)
+			for j, v := range values {
+				placeholders[j] = args.Append(v) + "::" + insertManyAccountTypes[columns[j]]
+			}
+			placeholders[len(values)] = args.Append(int32(i)) + "::int4"
+			valueLists[i] = "(" + strings.Join(placeholders, ",") + ")"
+		}
+
+		// The rows are inserted in the order of their ordinality so they are
+		// returned in the order of batch.
+		sql := `insert into "account"(` + strings.Join(quotedColumns, ", ") + `)
+select ` + strings.Join(quotedColumns, ", ") + `
+from (values` + strings.Join(valueLists, ",") + `) as input(` + strings.Join(append(quotedColumns, "pgxdata_ordinality"), ", ") + `)
+order by pgxdata_ordinality
+returning "id", "name", "nickname", "balance"::text, "opened_on", "closed_at", "external_id", "tags", "status"::text, "billing_address"::text, "settings", "display_name"`
+
+		// The SQL depends on the number of rows so it is not prepared, which
+		// would leave a prepared statement for every batch size.
+		dbRows, err := db.Query(ctx, sql, args...)
+		if err != nil {
+			return err
+		}
+
+		// PostgreSQL does not guarantee the order of the returned rows, but it
+		// returns them in the order they were inserted. A different number of rows
+		// means they cannot be matched to batch.
+		n := 0
+		for dbRows.Next() {
+			if n < len(batch) {
+				row := &batch[n]
+				if err := dbRows.Scan(&row.ID, &row.Name, &row.Nickname, &row.Balance, &row.OpenedOn, &row.ClosedAt, &row.ExternalID, &row.Tags, &row.Status, &row.BillingAddress, &row.Settings, &row.DisplayName); err != nil {
+					dbRows.Close()
+					return err
+				}
+			}
+			n++
+		}
+
+		if dbRows.Err() != nil {
+			return dbRows.Err()
+		}
+		if n != len(batch) {
+			return errors.Errorf("inserted %d rows but %d rows were returned", len(batch), n)
+		}
+	}
+
+	return nil
+}
+
+// QueueSelectAccountByPK queues selecting the row by primary key in b.
+// The returned reader scans the row into dst or returns ErrNotFound if there
+// is no such row.
+func QueueSelectAccountByPK(b *pgx.Batch, id int64, dst *Account) BatchReader {
+	args := []interface{}{id}
+	oids := []pgtype.OID{pgtype.Int8OID}
+
+	return queue(b, selectAccountByPKSQL, args, oids, func(results pgx.BatchResults) error {
+		err := results.QueryRowResults().Scan(
+			&dst.ID,
+			&dst.Name,
+			&dst.Nickname,
+			&dst.Balance,
+			&dst.OpenedOn,
+			&dst.ClosedAt,
+			&dst.ExternalID,
+			&dst.Tags,
+			&dst.Status,
+			&dst.BillingAddress,
+			&dst.Settings,
+			&dst.DisplayName,
+		)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrNotFound
+		}
+		return err
+	})
+}
+
+// QueueInsertAccount queues inserting row in b. Like InsertAccount
+// the returned reader scans the persisted row into row.
+func QueueInsertAccount(b *pgx.Batch, row *Account, fields ...AccountField) BatchReader {
+	args := pgx.QueryArgs(make([]interface{}, 0, 12))
+	oids := make([]pgtype.OID, 0, 12)
+
+	var columns, values []string
+
+	for _, f := range fields {
+		switch f {
+		case AccountIDField:
+			return queueFailed(errors.New("id is generated and cannot be set"))
+		case AccountNameField:
+			columns = append(columns, `name`)
+			values = append(values, args.Append(row.Name))
+			oids = append(oids, pgtype.TextOID)
+		case AccountNicknameField:
+			columns = append(columns, `nickname`)
+			values = append(values, args.Append(row.Nickname))
+			oids = append(oids, pgtype.VarcharOID)
+		case AccountBalanceField:
+			columns = append(columns, `balance`)
+			values = append(values, args.Append(row.Balance))
+			oids = append(oids, pgtype.NumericOID)
+		case AccountOpenedOnField:
+			columns = append(columns, `opened_on`)
+			values = append(values, args.Append(row.OpenedOn))
+			oids = append(oids, pgtype.DateOID)
+		case AccountClosedAtField:
+			columns = append(columns, `closed_at`)
+			values = append(values, args.Append(row.ClosedAt))
+			oids = append(oids, pgtype.TimestamptzOID)
+		case AccountExternalIDField:
+			columns = append(columns, `external_id`)
+			values = append(values, args.Append(row.ExternalID))
+			oids = append(oids, pgtype.UUIDOID)
+		case AccountTagsField:
+			columns = append(columns, `tags`)
+			values = append(values, args.Append(row.Tags))
+			oids = append(oids, pgtype.TextArrayOID)
+		case AccountStatusField:
+			columns = append(columns, `status`)
+			values = append(values, args.Append(row.Status))
+			oids = append(oids, 0)
+		case AccountBillingAddressField:
+			columns = append(columns, `billing_address`)
+			values = append(values, args.Append(&row.BillingAddress))
+			oids = append(oids, 0)
+		case AccountSettingsField:
+			columns = append(columns, `settings`)
+			values = append(values, args.Append(&row.Settings))
+			oids = append(oids, pgtype.JSONBOID)
+		case AccountDisplayNameField:
+			return queueFailed(errors.New("display_name is generated and cannot be set"))
+		default:
+			return queueFailed(errors.Errorf("unknown AccountField %d", f))
+		}
+	}
+
+	insert := ` default values`
+	if len(columns) > 0 {
+		insert = `(` + strings.Join(columns, ", ") + `)
+values(` + strings.Join(values, ",") + `)`
+	}
+
+	sql := `insert into "account"` + insert + `
+returning "id", "name", "nickname", "balance"::text, "opened_on", "closed_at", "external_id", "tags", "status"::text, "billing_address"::text, "settings", "display_name"`
+
+	return queue(b, sql, args, oids, func(results pgx.BatchResults) error {
+		return results.QueryRowResults().Scan(&row.ID, &row.Name, &row.Nickname, &row.Balance, &row.OpenedOn, &row.ClosedAt, &row.ExternalID, &row.Tags, &row.Status, &row.BillingAddress, &row.Settings, &row.DisplayName)
+	})
+}
+
+// QueueUpdateAccount queues updating the row by primary key in b. Like
+// UpdateAccount the returned reader scans the persisted row into row
+// or returns ErrNotFound if there is no such row. Nothing is queued when
+// there is nothing to update.
+func QueueUpdateAccount(b *pgx.Batch, id int64, row *Account, fields ...AccountField) BatchReader {
+	sets := make([]string, 0, 12)
+	args := pgx.QueryArgs(make([]interface{}, 0, 12))
+	oids := make([]pgtype.OID, 0, 12)
+
+	for _, f := range fields {
+		switch f {
+		case AccountIDField:
+			return queueFailed(errors.New("id is generated and cannot be set"))
+		case AccountNameField:
+			sets = append(sets, `name`+"="+args.Append(row.Name))
+			oids = append(oids, pgtype.TextOID)
+		case AccountNicknameField:
+			sets = append(sets, `nickname`+"="+args.Append(row.Nickname))
+			oids = append(oids, pgtype.VarcharOID)
+		case AccountBalanceField:
+			sets = append(sets, `balance`+"="+args.Append(row.Balance))
+			oids = append(oids, pgtype.NumericOID)
+		case AccountOpenedOnField:
+			sets = append(sets, `opened_on`+"="+args.Append(row.OpenedOn))
+			oids = append(oids, pgtype.DateOID)
+		case AccountClosedAtField:
+			sets = append(sets, `closed_at`+"="+args.Append(row.ClosedAt))
+			oids = append(oids, pgtype.TimestamptzOID)
+		case AccountExternalIDField:
+			sets = append(sets, `external_id`+"="+args.Append(row.ExternalID))
+			oids = append(oids, pgtype.UUIDOID)
+		case AccountTagsField:
+			sets = append(sets, `tags`+"="+args.Append(row.Tags))
+			oids = append(oids, pgtype.TextArrayOID)
+		case AccountStatusField:
+			sets = append(sets, `status`+"="+args.Append(row.Status))
+			oids = append(oids, 0)
+		case AccountBillingAddressField:
+			sets = append(sets, `billing_address`+"="+args.Append(&row.BillingAddress))
+			oids = append(oids, 0)
+		case AccountSettingsField:
+			sets = append(sets, `settings`+"="+args.Append(&row.Settings))
+			oids = append(oids, pgtype.JSONBOID)
+		case AccountDisplayNameField:
+			return queueFailed(errors.New("display_name is generated and cannot be set"))
+		default:
+			return queueFailed(errors.Errorf("unknown AccountField %d", f))
+		}
+	}
+
+	if len(sets) == 0 {
+		return func(pgx.BatchResults) error { return nil }
+	}
+
+	sql := `update "account" set ` + strings.Join(sets, ", ") + ` where ` + `"id"=` + args.Append(id) + `
+returning "id", "name", "nickname", "balance"::text, "opened_on", "closed_at", "external_id", "tags", "status"::text, "billing_address"::text, "settings", "display_name"`
+	oids = append(oids, pgtype.Int8OID)
+
+	return queue(b, sql, args, oids, func(results pgx.BatchResults) error {
+		err := results.QueryRowResults().Scan(&row.ID, &row.Name, &row.Nickname, &row.Balance, &row.OpenedOn, &row.ClosedAt, &row.ExternalID, &row.Tags, &row.Status, &row.BillingAddress, &row.Settings, &row.DisplayName)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrNotFound
+		}
+		return err
+	})
+}
+
+// QueueDeleteAccount queues deleting the row by primary key in b. The
+// returned reader returns ErrNotFound if there is no such row.
+func QueueDeleteAccount(b *pgx.Batch, id int64) BatchReader {
+	args := pgx.QueryArgs(make([]interface{}, 0, 1))
+
+	sql := `delete from "account" where ` + `"id"=` + args.Append(id)
+	oids := []pgtype.OID{pgtype.Int8OID}
+
+	return queue(b, sql, args, oids, func(results pgx.BatchResults) error {
+		commandTag, err := results.ExecResults()
+		if err != nil {
+			return err
+		}
+		if commandTag.RowsAffected() != 1 {
+			return ErrNotFound
+		}
+		return nil
+	})
+}
+
+func DeleteAccount(ctx context.Context, db Queryer,
+	id int64,
+) error {
+	args := pgx.QueryArgs(make([]interface{}, 0, 1))
+
+	sql := `delete from "account" where ` + `"id"=` + args.Append(id)
+
+	commandTag, err := prepareExec(ctx, db, "pgxdataDeleteAccount", sql, args...)
+	if err != nil {
+		return err
+	}
+	if commandTag.RowsAffected() != 1 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+// AccountWhere has a filter for each column of "account" that builds the conditions of
+// SelectAccountWhere, CountAccountWhere, UpdateAccountWhere and DeleteAccountWhere.
+var AccountWhere = struct {
+	ID             AccountIDFilter
+	Name           AccountNameFilter
+	Nickname       AccountNicknameFilter
+	Balance        AccountBalanceFilter
+	OpenedOn       AccountOpenedOnFilter
+	ClosedAt       AccountClosedAtFilter
+	ExternalID     AccountExternalIDFilter
+	Tags           AccountTagsFilter
+	Status         AccountStatusFilter
+	BillingAddress AccountBillingAddressFilter
+	Settings       AccountSettingsFilter
+	DisplayName    AccountDisplayNameFilter
+}{
+	ID:             AccountIDFilter{columnFilter{`"id"`}},
+	Name:           AccountNameFilter{columnFilter{`"name"`}},
+	Nickname:       AccountNicknameFilter{columnFilter{`"nickname"`}},
+	Balance:        AccountBalanceFilter{columnFilter{`"balance"`}},
+	OpenedOn:       AccountOpenedOnFilter{columnFilter{`"opened_on"`}},
+	ClosedAt:       AccountClosedAtFilter{columnFilter{`"closed_at"`}},
+	ExternalID:     AccountExternalIDFilter{columnFilter{`"external_id"`}},
+	Tags:           AccountTagsFilter{columnFilter{`"tags"`}},
+	Status:         AccountStatusFilter{columnFilter{`"status"`}},
+	BillingAddress: AccountBillingAddressFilter{columnFilter{`"billing_address"`}},
+	Settings:       AccountSettingsFilter{columnFilter{`"settings"`}},
+	DisplayName:    AccountDisplayNameFilter{columnFilter{`"display_name"`}},
+}
+
+// AccountIDFilter builds conditions on id.
+type AccountIDFilter struct{ columnFilter }
+
+func (f AccountIDFilter) Eq(v int64) Condition { return f.compare(" = ", v) }
+func (f AccountIDFilter) Ne(v int64) Condition { return f.compare(" <> ", v) }
+func (f AccountIDFilter) Lt(v int64) Condition { return f.compare(" < ", v) }
+func (f AccountIDFilter) Le(v int64) Condition { return f.compare(" <= ", v) }
+func (f AccountIDFilter) Gt(v int64) Condition { return f.compare(" > ", v) }
+func (f AccountIDFilter) Ge(v int64) Condition { return f.compare(" >= ", v) }
+
+func (f AccountIDFilter) In(vs ...int64) Condition { return f.in(len(vs), vs) }
+
+// AccountNameFilter builds conditions on name.
+type AccountNameFilter struct{ columnFilter }
+
+func (f AccountNameFilter) Eq(v string) Condition { return f.compare(" = ", v) }
+func (f AccountNameFilter) Ne(v string) Condition { return f.compare(" <> ", v) }
+func (f AccountNameFilter) Lt(v string) Condition { return f.compare(" < ", v) }
+func (f AccountNameFilter) Le(v string) Condition { return f.compare(" <= ", v) }
+func (f AccountNameFilter) Gt(v string) Condition { return f.compare(" > ", v) }
+func (f AccountNameFilter) Ge(v string) Condition { return f.compare(" >= ", v) }
+
+func (f AccountNameFilter) In(vs ...string) Condition { return f.in(len(vs), vs) }
+
+// AccountNicknameFilter builds conditions on nickname.
+type AccountNicknameFilter struct{ columnFilter }
+
+func (f AccountNicknameFilter) Eq(v string) Condition { return f.compare(" = ", v) }
+func (f AccountNicknameFilter) Ne(v string) Condition { return f.compare(" <> ", v) }
+func (f AccountNicknameFilter) Lt(v string) Condition { return f.compare(" < ", v) }
+func (f AccountNicknameFilter) Le(v string) Condition { return f.compare(" <= ", v) }
+func (f AccountNicknameFilter) Gt(v string) Condition { return f.compare(" > ", v) }
+func (f AccountNicknameFilter) Ge(v string) Condition { return f.compare(" >= ", v) }
+
+func (f AccountNicknameFilter) In(vs ...string) Condition { return f.in(len(vs), vs) }
+
+// AccountBalanceFilter builds conditions on balance.
+type AccountBalanceFilter struct{ columnFilter }
+
+func (f AccountBalanceFilter) Eq(v string) Condition { return f.compare(" = ", v) }
+func (f AccountBalanceFilter) Ne(v string) Condition { return f.compare(" <> ", v) }
+func (f AccountBalanceFilter) Lt(v string) Condition { return f.compare(" < ", v) }
+func (f AccountBalanceFilter) Le(v string) Condition { return f.compare(" <= ", v) }
+func (f AccountBalanceFilter) Gt(v string) Condition { return f.compare(" > ", v) }
+func (f AccountBalanceFilter) Ge(v string) Condition { return f.compare(" >= ", v) }
+
+func (f AccountBalanceFilter) In(vs ...string) Condition {
+	values := make([]string, len(vs))
+	for i, v := range vs {
+		values[i] = string(v)
+	}
+	return f.in(len(vs), arrayLiteral(values))
+}
+
+// AccountOpenedOnFilter builds conditions on opened_on.
+type AccountOpenedOnFilter struct{ columnFilter }
+
+func (f AccountOpenedOnFilter) Eq(v time.Time) Condition { return f.compare(" = ", v) }
+func (f AccountOpenedOnFilter) Ne(v time.Time) Condition { return f.compare(" <> ", v) }
+func (f AccountOpenedOnFilter) Lt(v time.Time) Condition { return f.compare(" < ", v) }
+func (f AccountOpenedOnFilter) Le(v time.Time) Condition { return f.compare(" <= ", v) }
+func (f AccountOpenedOnFilter) Gt(v time.Time) Condition { return f.compare(" > ", v) }
+func (f AccountOpenedOnFilter) Ge(v time.Time) Condition { return f.compare(" >= ", v) }
+
+func (f AccountOpenedOnFilter) In(vs ...time.Time) Condition { return f.in(len(vs), vs) }
+
+// AccountClosedAtFilter builds conditions on closed_at.
+type AccountClosedAtFilter struct{ columnFilter }
+
+func (f AccountClosedAtFilter) Eq(v time.Time) Condition { return f.compare(" = ", v) }
+func (f AccountClosedAtFilter) Ne(v time.Time) Condition { return f.compare(" <> ", v) }
+func (f AccountClosedAtFilter) Lt(v time.Time) Condition { return f.compare(" < ", v) }
+func (f AccountClosedAtFilter) Le(v time.Time) Condition { return f.compare(" <= ", v) }
+func (f AccountClosedAtFilter) Gt(v time.Time) Condition { return f.compare(" > ", v) }
+func (f AccountClosedAtFilter) Ge(v time.Time) Condition { return f.compare(" >= ", v) }
+
+func (f AccountClosedAtFilter) In(vs ...time.Time) Condition { return f.in(len(vs), vs) }
+
+// AccountExternalIDFilter builds conditions on external_id.
+type AccountExternalIDFilter struct{ columnFilter }
+
+func (f AccountExternalIDFilter) Eq(v [16]byte) Condition { return f.compare(" = ", v) }
+func (f AccountExternalIDFilter) Ne(v [16]byte) Condition { return f.compare(" <> ", v) }
+func (f AccountExternalIDFilter) Lt(v [16]byte) Condition { return f.compare(" < ", v) }
+func (f AccountExternalIDFilter) Le(v [16]byte) Condition { return f.compare(" <= ", v) }
+func (f AccountExternalIDFilter) Gt(v [16]byte) Condition { return f.compare(" > ", v) }
+func (f AccountExternalIDFilter) Ge(v [16]byte) Condition { return f.compare(" >= ", v) }
+
+func (f AccountExternalIDFilter) In(vs ...[16]byte) Condition { return f.in(len(vs), vs) }
+
+// AccountTagsFilter builds conditions on tags.
+type AccountTagsFilter struct{ columnFilter }
+
+func (f AccountTagsFilter) Eq(v []string) Condition { return f.compare(" = ", v) }
+func (f AccountTagsFilter) Ne(v []string) Condition { return f.compare(" <> ", v) }
+func (f AccountTagsFilter) Lt(v []string) Condition { return f.compare(" < ", v) }
+func (f AccountTagsFilter) Le(v []string) Condition { return f.compare(" <= ", v) }
+func (f AccountTagsFilter) Gt(v []string) Condition { return f.compare(" > ", v) }
+func (f AccountTagsFilter) Ge(v []string) Condition { return f.compare(" >= ", v) }
+
+// AccountStatusFilter builds conditions on status.
+type AccountStatusFilter struct{ columnFilter }
+
+func (f AccountStatusFilter) Eq(v OrderStatus) Condition { return f.compare(" = ", v) }
+func (f AccountStatusFilter) Ne(v OrderStatus) Condition { return f.compare(" <> ", v) }
+func (f AccountStatusFilter) Lt(v OrderStatus) Condition { return f.compare(" < ", v) }
+func (f AccountStatusFilter) Le(v OrderStatus) Condition { return f.compare(" <= ", v) }
+func (f AccountStatusFilter) Gt(v OrderStatus) Condition { return f.compare(" > ", v) }
+func (f AccountStatusFilter) Ge(v OrderStatus) Condition { return f.compare(" >= ", v) }
+
+func (f AccountStatusFilter) In(vs ...OrderStatus) Condition {
+	values := make([]string, len(vs))
+	for i, v := range vs {
+		values[i] = string(v)
+	}
+	return f.in(len(vs), arrayLiteral(values))
+}
+
+// AccountBillingAddressFilter builds conditions on billing_address.
+type AccountBillingAddressFilter struct{ columnFilter }
+
+// AccountSettingsFilter builds conditions on settings.
+type AccountSettingsFilter struct{ columnFilter }
+
+// AccountDisplayNameFilter builds conditions on display_name.
+type AccountDisplayNameFilter struct{ columnFilter }
+
+func (f AccountDisplayNameFilter) Eq(v string) Condition { return f.compare(" = ", v) }
+func (f AccountDisplayNameFilter) Ne(v string) Condition { return f.compare(" <> ", v) }
+func (f AccountDisplayNameFilter) Lt(v string) Condition { return f.compare(" < ", v) }
+func (f AccountDisplayNameFilter) Le(v string) Condition { return f.compare(" <= ", v) }
+func (f AccountDisplayNameFilter) Gt(v string) Condition { return f.compare(" > ", v) }
+func (f AccountDisplayNameFilter) Ge(v string) Condition { return f.compare(" >= ", v) }
+
+func (f AccountDisplayNameFilter) In(vs ...string) Condition { return f.in(len(vs), vs) }
+
+func SelectAccountWhere(ctx context.Context, db Queryer, where Condition) ([]Account, error) {
+	var args pgx.QueryArgs
+	sql := SelectAllAccountSQL + `
+where ` + where.sql(&args)
+
+	dbRows, err := prepareQuery(ctx, db, preparedName("pgxdataSelectAccountWhere", sql), sql, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer dbRows.Close()
+
+	var rows []Account
+	for dbRows.Next() {
+		var row Account
+		err := dbRows.Scan(
+			&row.ID,
+			&row.Name,
+			&row.Nickname,
+			&row.Balance,
+			&row.OpenedOn,
+			&row.ClosedAt,
+			&row.ExternalID,
+			&row.Tags,
+			&row.Status,
+			&row.BillingAddress,
+			&row.Settings,
+			&row.DisplayName,
+		)
+		if err != nil {
+			return nil, err
+		}
+		rows = append(rows, row)
+	}
+
+	if dbRows.Err() != nil {
+		return nil, dbRows.Err()
+	}
+
+	return rows, nil
+}
+
+func CountAccountWhere(ctx context.Context, db Queryer, where Condition) (int64, error) {
+	var args pgx.QueryArgs
+	sql := countAccountSQL + ` where ` + where.sql(&args)
+
+	var n int64
+	err := prepareQueryRow(ctx, db, preparedName("pgxdataCountAccountWhere", sql), sql, args...).Scan(&n)
+	return n, err
+}
+
+// UpdateAccountWhere updates the rows matching where like UpdateAccount and returns the number of
+// rows updated. where must restrict the rows, so a Condition that matches every row like the zero Condition is
+// refused.
+func UpdateAccountWhere(ctx context.Context, db Queryer, where Condition, row *Account, fields ...AccountField) (int64, error) {
+	if where.write == nil {
+		return 0, errors.New("UpdateAccountWhere requires a condition")
+	}
+
+	sets, args, err := updateAccountSets(row, fields)
+	if err != nil {
+		return 0, err
+	}
+	if len(sets) == 0 {
+		return 0, nil
+	}
+
+	sql := `update "account" set ` + strings.Join(sets, ", ") + ` where ` + where.sql(&args)
+
+	commandTag, err := prepareExec(ctx, db, preparedName("pgxdataUpdateAccountWhere", sql), sql, args...)
+	if err != nil {
+		return 0, err
+	}
+	return commandTag.RowsAffected(), nil
+}
+
+// DeleteAccountWhere deletes the rows matching where and returns the number of rows deleted. where must
+// restrict the rows, so a Condition that matches every row like the zero Condition is refused.
+func DeleteAccountWhere(ctx context.Context, db Queryer, where Condition) (int64, error) {
+	if where.write == nil {
+		return 0, errors.New("DeleteAccountWhere requires a condition")
+	}
+
+	var args pgx.QueryArgs
+	sql := `delete from "account" where ` + where.sql(&args)
+
+	commandTag, err := prepareExec(ctx, db, preparedName("pgxdataDeleteAccountWhere", sql), sql, args...)
+	if err != nil {
+		return 0, err
+	}
+	return commandTag.RowsAffected(), nil
+}
@@ -0,0 +1,123 @@
+package data
+
+// This file is automatically generated by pgxdata.
+
+import (
+	"github.com/jackc/pgtype"
+	errors "golang.org/x/xerrors"
+)
+
+// Address is a value of the PostgreSQL composite type public.address.
+type Address struct {
+	Street     pgtype.Text
+	City       pgtype.Text
+	PostalCode pgtype.Varchar
+}
+
+// AddressBox is the pgtype compatible box for Address used in row
+// structs. Values are transferred in the text format of composite types so
+// all attributes must be Present or Null when encoding.
+type AddressBox struct {
+	Value  Address
+	Status pgtype.Status
+}
+
+func (dst *AddressBox) Set(src interface{}) error {
+	if src == nil {
+		*dst = AddressBox{Status: pgtype.Null}
+		return nil
+	}
+
+	switch value := src.(type) {
+	case Address:
+		*dst = AddressBox{Value: value, Status: pgtype.Present}
+	case *Address:
+		if value == nil {
+			*dst = AddressBox{Status: pgtype.Null}
+		} else {
+			*dst = AddressBox{Value: *value, Status: pgtype.Present}
+		}
+	default:
+		return errors.Errorf("cannot convert %v to Address", value)
+	}
+
+	return nil
+}
+
+func (dst *AddressBox) Get() interface{} {
+	switch dst.Status {
+	case pgtype.Present:
+		return dst.Value
+	case pgtype.Null:
+		return nil
+	default:
+		return dst.Status
+	}
+}
+
+func (src *AddressBox) AssignTo(dst interface{}) error {
+	switch src.Status {
+	case pgtype.Present:
+		switch v := dst.(type) {
+		case *Address:
+			*v = src.Value
+		default:
+			return errors.Errorf("unable to assign to %T", dst)
+		}
+		return nil
+	case pgtype.Null:
+		return pgtype.NullAssignTo(dst)
+	}
+
+	return errors.Errorf("cannot assign %v into %T", src, dst)
+}
+
+func (dst *AddressBox) DecodeText(ci *pgtype.ConnInfo, src []byte) error {
+	if src == nil {
+		*dst = AddressBox{Status: pgtype.Null}
+		return nil
+	}
+
+	fields, err := parseCompositeText(src)
+	if err != nil {
+		return err
+	}
+	if len(fields) != 3 {
+		return errors.Errorf("expected 3 fields for public.address, got %d", len(fields))
+	}
+
+	var v Address
+	if err := v.Street.DecodeText(ci, fields[0]); err != nil {
+		return err
+	}
+	if err := v.City.DecodeText(ci, fields[1]); err != nil {
+		return err
+	}
+	if err := v.PostalCode.DecodeText(ci, fields[2]); err != nil {
+		return err
+	}
+
+	*dst = AddressBox{Value: v, Status: pgtype.Present}
+	return nil
+}
+
+// DecodeBinary is the same as DecodeText as composite types are selected as
+// text which has the same text and binary format.
+func (dst *AddressBox) DecodeBinary(ci *pgtype.ConnInfo, src []byte) error {
+	return dst.DecodeText(ci, src)
+}
+
+func (src *AddressBox) EncodeText(ci *pgtype.ConnInfo, buf []byte) ([]byte, error) {
+	switch src.Status {
+	case pgtype.Null:
+		return nil, nil
+	case pgtype.Undefined:
+		return nil, errors.New("cannot encode status undefined")
+	}
+
+	return appendCompositeText(ci, buf,
+		&src.Value.Street,
+		&src.Value.City,
+		&src.Value.PostalCode,
+	)
+}
@@ -0,0 +1,1013 @@
+package data
+
+// This file is automatically generated by pgxdata.
+
+import (
+	"context"
+	"strings"
+
+	"github.com/jackc/pgtype"
+	"github.com/jackc/pgx/v4"
+	errors "golang.org/x/xerrors"
+	"time"
+)
+
+type ArrayTypes struct {
+	// ID is integer not null default nextval('array_types_id_seq'::regclass).
+	ID pgtype.Int4
+	// Tags is text[].
+	Tags pgtype.TextArray
+	// PermissionIds is bigint[].
+	PermissionIds pgtype.Int8Array
+	// Flags is boolean[].
+	Flags pgtype.BoolArray
+	// Uuids is uuid[].
+	Uuids pgtype.UUIDArray
+	// Amounts is numeric[].
+	Amounts pgtype.NumericArray
+	// OccurredAt is timestamp with time zone[].
+	OccurredAt pgtype.TimestamptzArray
+}
+
+const countArrayTypesSQL = `select count(*) from "array_types"`
+
+func CountArrayTypes(ctx context.Context, db Queryer) (int64, error) {
+	var n int64
+	err := prepareQueryRow(ctx, db, "pgxdataCountArrayTypes", countArrayTypesSQL).Scan(&n)
+	return n, err
+}
+
+const SelectAllArrayTypesSQL = `select
+  "id",
+  "tags",
+  "permission_ids",
+  "flags",
+  "uuids",
+  "amounts",
+  "occurred_at"
+from "array_types"`
+
+func SelectAllArrayTypes(ctx context.Context, db Queryer) ([]ArrayTypes, error) {
+	var rows []ArrayTypes
+
+	err := ForEachArrayTypes(ctx, db, func(row *ArrayTypes) error {
+		rows = append(rows, *row)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return rows, nil
+}
+
+// ArrayTypesRows is a cursor over the rows of "array_types" that
+// reads one row at a time.
+type ArrayTypesRows struct {
+	rows pgx.Rows
+	row  ArrayTypes
+	err  error
+}
+
+// QueryArrayTypesRows selects all rows of "array_types". The
+// returned rows must be closed.
+func QueryArrayTypesRows(ctx context.Context, db Queryer) (*ArrayTypesRows, error) {
+	dbRows, err := prepareQuery(ctx, db, "pgxdataSelectAllArrayTypes", SelectAllArrayTypesSQL)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ArrayTypesRows{rows: dbRows}, nil
+}
+
+// Next reads the next row. It returns false when there are no more rows or an
+// error occurred.
+func (r *ArrayTypesRows) Next() bool {
+	if r.err != nil || !r.rows.Next() {
+		return false
+	}
+
+	r.row = ArrayTypes{}
+	r.err = r.rows.Scan(
+		&r.row.ID,
+		&r.row.Tags,
+		&r.row.PermissionIds,
+		&r.row.Flags,
+		&r.row.Uuids,
+		&r.row.Amounts,
+		&r.row.OccurredAt,
+	)
+	if r.err != nil {
+		r.rows.Close()
+		return false
+	}
+
+	return true
+}
+
+// Row returns the current row. It is overwritten by the next call to Next.
+func (r *ArrayTypesRows) Row() *ArrayTypes {
+	return &r.row
+}
+
+// Err returns the error, if any, that stopped Next.
+func (r *ArrayTypesRows) Err() error {
+	if r.err != nil {
+		return r.err
+	}
+	return r.rows.Err()
+}
+
+// Close closes the rows. It is safe to call Close after all rows have been
+// read.
+func (r *ArrayTypesRows) Close() {
+	r.rows.Close()
+}
+
+// ForEachArrayTypes calls fn with each row of "array_types". The
+// row passed to fn is reused for the next row. If fn returns an error the
+// remaining rows are skipped and the error is returned.
+func ForEachArrayTypes(ctx context.Context, db Queryer, fn func(*ArrayTypes) error) error {
+	rows, err := QueryArrayTypesRows(ctx, db)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		err := fn(rows.Row())
+		if err != nil {
+			return err
+		}
+	}
+
+	return rows.Err()
+}
+
+// ArrayTypesPageCursor is the position after the last row of a
+// page returned by SelectArrayTypesPage.
+type ArrayTypesPageCursor struct {
+	ID int32
+}
+
+const selectArrayTypesPageSQL = `select
+  "id",
+  "tags",
+  "permission_ids",
+  "flags",
+  "uuids",
+  "amounts",
+  "occurred_at"
+from "array_types"
+order by "id"
+limit $1`
+
+const selectArrayTypesPageAfterSQL = `select
+  "id",
+  "tags",
+  "permission_ids",
+  "flags",
+  "uuids",
+  "amounts",
+  "occurred_at"
+from "array_types"
+where "id" > $1
+order by "id"
+limit $2`
+
+// SelectArrayTypesPage selects up to limit rows that follow
+// after, or the first rows when after is nil, ordered by
+// "id". The returned cursor selects the next page. It is nil
+// when there are no more rows.
+func SelectArrayTypesPage(ctx context.Context, db Queryer, after *ArrayTypesPageCursor, limit int) ([]ArrayTypes, *ArrayTypesPageCursor, error) {
+	var dbRows pgx.Rows
+	var err error
+	if after == nil {
+		dbRows, err = prepareQuery(ctx, db, "pgxdataSelectArrayTypesPage", selectArrayTypesPageSQL, limit)
+	} else {
+		dbRows, err = prepareQuery(ctx, db, "pgxdataSelectArrayTypesPageAfter", selectArrayTypesPageAfterSQL, after.ID, limit)
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	defer dbRows.Close()
+
+	var rows []ArrayTypes
+	for dbRows.Next() {
+		var row ArrayTypes
+		err := dbRows.Scan(
+			&row.ID,
+			&row.Tags,
+			&row.PermissionIds,
+			&row.Flags,
+			&row.Uuids,
+			&row.Amounts,
+			&row.OccurredAt,
+		)
+		if err != nil {
+			return nil, nil, err
+		}
+		rows = append(rows, row)
+	}
+
+	if dbRows.Err() != nil {
+		return nil, nil, dbRows.Err()
+	}
+
+	if len(rows) == 0 || len(rows) < limit {
+		return rows, nil, nil
+	}
+
+	last := &rows[len(rows)-1]
+	next := &ArrayTypesPageCursor{}
+	err = last.ID.AssignTo(&next.ID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return rows, next, nil
+}
+
+const selectArrayTypesByPKSQL = `select
+  "id",
+  "tags",
+  "permission_ids",
+  "flags",
+  "uuids",
+  "amounts",
+  "occurred_at"
+from "array_types"
+where "id"=$1`
+
+func SelectArrayTypesByPK(
+	ctx context.Context,
+	db Queryer,
+	id int32,
+) (*ArrayTypes, error) {
+	var row ArrayTypes
+	err := prepareQueryRow(ctx, db, "pgxdataSelectArrayTypesByPK", selectArrayTypesByPKSQL, id).Scan(
+		&row.ID,
+		&row.Tags,
+		&row.PermissionIds,
+		&row.Flags,
+		&row.Uuids,
+		&row.Amounts,
+		&row.OccurredAt,
+	)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrNotFound
+	} else if err != nil {
+		return nil, err
+	}
+
+	return &row, nil
+}
+
+func InsertArrayTypes(ctx context.Context, db Queryer, row *ArrayTypes) error {
+	args := pgx.QueryArgs(make([]interface{}, 0, 7))
+
+	var columns, values []string
+
+	if row.ID.Status != pgtype.Undefined {
+		columns = append(columns, `id`)
+		values = append(values, args.Append(&row.ID))
+	}
+	if row.Tags.Status != pgtype.Undefined {
+		columns = append(columns, `tags`)
+		values = append(values, args.Append(&row.Tags))
+	}
+	if row.PermissionIds.Status != pgtype.Undefined {
+		columns = append(columns, `permission_ids`)
+		values = append(values, args.Append(&row.PermissionIds))
+	}
+	if row.Flags.Status != pgtype.Undefined {
+		columns = append(columns, `flags`)
+		values = append(values, args.Append(&row.Flags))
+	}
+	if row.Uuids.Status != pgtype.Undefined {
+		columns = append(columns, `uuids`)
+		values = append(values, args.Append(&row.Uuids))
+	}
+	if row.Amounts.Status != pgtype.Undefined {
+		columns = append(columns, `amounts`)
+		values = append(values, args.Append(&row.Amounts))
+	}
+	if row.OccurredAt.Status != pgtype.Undefined {
+		columns = append(columns, `occurred_at`)
+		values = append(values, args.Append(&row.OccurredAt))
+	}
+
+	insert := ` default values`
+	if len(columns) > 0 {
+		insert = `(` + strings.Join(columns, ", ") + `)
+values(` + strings.Join(values, ",") + `)`
+	}
+
+	sql := `insert into "array_types"` + insert + `
+returning "id", "tags", "permission_ids", "flags", "uuids", "amounts", "occurred_at"
+  `
+
+	psName := preparedName("pgxdataInsertArrayTypes", sql)
+
+	return prepareQueryRow(ctx, db, psName, sql, args...).Scan(&row.ID, &row.Tags, &row.PermissionIds, &row.Flags, &row.Uuids, &row.Amounts, &row.OccurredAt)
+}
+
+// updateArrayTypesSets returns the assignments of the SET clause for the fields of row to update and their
+// arguments.
+func updateArrayTypesSets(row *ArrayTypes) ([]string, pgx.QueryArgs, error) {
+	sets := make([]string, 0, 7)
+	args := pgx.QueryArgs(make([]interface{}, 0, 7))
+
+	if row.ID.Status != pgtype.Undefined {
+		sets = append(sets, `id`+"="+args.Append(&row.ID))
+	}
+	if row.Tags.Status != pgtype.Undefined {
+		sets = append(sets, `tags`+"="+args.Append(&row.Tags))
+	}
+	if row.PermissionIds.Status != pgtype.Undefined {
+		sets = append(sets, `permission_ids`+"="+args.Append(&row.PermissionIds))
+	}
+	if row.Flags.Status != pgtype.Undefined {
+		sets = append(sets, `flags`+"="+args.Append(&row.Flags))
+	}
+	if row.Uuids.Status != pgtype.Undefined {
+		sets = append(sets, `uuids`+"="+args.Append(&row.Uuids))
+	}
+	if row.Amounts.Status != pgtype.Undefined {
+		sets = append(sets, `amounts`+"="+args.Append(&row.Amounts))
+	}
+	if row.OccurredAt.Status != pgtype.Undefined {
+		sets = append(sets, `occurred_at`+"="+args.Append(&row.OccurredAt))
+	}
+
+	return sets, args, nil
+}
+
+func UpdateArrayTypes(ctx context.Context, db Queryer,
+	id int32,
+	row *ArrayTypes,
+) error {
+	sets, args, err := updateArrayTypesSets(row)
+	if err != nil {
+		return err
+	}
+	if len(sets) == 0 {
+		return nil
+	}
+
+	sql := `update "array_types" set ` + strings.Join(sets, ", ") + ` where ` + `"id"=` + args.Append(id) + `
+returning "id", "tags", "permission_ids", "flags", "uuids", "amounts", "occurred_at"`
+
+	psName := preparedName("pgxdataUpdateArrayTypes", sql)
+
+	err = prepareQueryRow(ctx, db, psName, sql, args...).Scan(&row.ID, &row.Tags, &row.PermissionIds, &row.Flags, &row.Uuids, &row.Amounts, &row.OccurredAt)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return ErrNotFound
+	}
+	return err
+}
+
+// ArrayTypesConflict identifies the primary key or unique index UpsertArrayTypes
+// detects a conflicting row by.
+type ArrayTypesConflict int
+
+const (
+	ArrayTypesConflictOnPK ArrayTypesConflict = iota
+)
+
+// ArrayTypesUpsertOptions configures UpsertArrayTypes.
+type ArrayTypesUpsertOptions struct {
+	// Conflict is the primary key or unique index a conflicting row is detected
+	// by. The primary key is the default.
+	Conflict ArrayTypesConflict
+
+	// DoNothing leaves a conflicting row unchanged instead of updating it.
+	DoNothing bool
+}
+
+// UpsertArrayTypes inserts row or, when it conflicts with an existing row,
+// updates the existing row with the fields of row that are not Undefined other than
+// those of the conflict target.
+// Like InsertArrayTypes the persisted row is scanned into row. Nothing is
+// scanned when a conflicting row is left unchanged.
+func UpsertArrayTypes(ctx context.Context, db Queryer, row *ArrayTypes, opts ArrayTypesUpsertOptions) (UpsertResult, error) {
+	args := pgx.QueryArgs(make([]interface{}, 0, 7))
+
+	var columns, values []string
+
+	if row.ID.Status != pgtype.Undefined {
+		columns = append(columns, `"id"`)
+		values = append(values, args.Append(&row.ID))
+	}
+	if row.Tags.Status != pgtype.Undefined {
+		columns = append(columns, `"tags"`)
+		values = append(values, args.Append(&row.Tags))
+	}
+	if row.PermissionIds.Status != pgtype.Undefined {
+		columns = append(columns, `"permission_ids"`)
+		values = append(values, args.Append(&row.PermissionIds))
+	}
+	if row.Flags.Status != pgtype.Undefined {
+		columns = append(columns, `"flags"`)
+		values = append(values, args.Append(&row.Flags))
+	}
+	if row.Uuids.Status != pgtype.Undefined {
+		columns = append(columns, `"uuids"`)
+		values = append(values, args.Append(&row.Uuids))
+	}
+	if row.Amounts.Status != pgtype.Undefined {
+		columns = append(columns, `"amounts"`)
+		values = append(values, args.Append(&row.Amounts))
+	}
+	if row.OccurredAt.Status != pgtype.Undefined {
+		columns = append(columns, `"occurred_at"`)
+		values = append(values, args.Append(&row.OccurredAt))
+	}
+
+	var targetColumns []string
+	switch opts.Conflict {
+	case ArrayTypesConflictOnPK:
+		targetColumns = []string{`"id"`}
+	default:
+		return UpsertUnchanged, errors.Errorf("unknown ArrayTypesConflict %d", opts.Conflict)
+	}
+
+	// The conflicting row is updated with the columns other than the conflict
+	// target. It is left unchanged when there are none.
+	sets := make([]string, 0, len(columns))
+nextColumn:
+	for _, c := range columns {
+		for _, tc := range targetColumns {
+			if c == tc {
+				continue nextColumn
+			}
+		}
+		sets = append(sets, c+"=excluded."+c)
+	}
+
+	action := `do update set ` + strings.Join(sets, ", ")
+	if opts.DoNothing || len(sets) == 0 {
+		action = `do nothing`
+	}
+
+	insert := ` default values`
+	if len(columns) > 0 {
+		insert = `(` + strings.Join(columns, ", ") + `)
+values(` + strings.Join(values, ",") + `)`
+	}
+
+	sql := `insert into "array_types"` + insert + `
+on conflict (` + strings.Join(targetColumns, ", ") + `) ` + action + `
+returning xmax = 0, "id", "tags", "permission_ids", "flags", "uuids", "amounts", "occurred_at"
+  `
+
+	psName := preparedName("pgxdataUpsertArrayTypes", sql)
+
+	var inserted bool
+	err := prepareQueryRow(ctx, db, psName, sql, args...).Scan(&inserted, &row.ID, &row.Tags, &row.PermissionIds, &row.Flags, &row.Uuids, &row.Amounts, &row.OccurredAt)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return UpsertUnchanged, nil
+	} else if err != nil {
+		return UpsertUnchanged, err
+	}
+
+	if inserted {
+		return UpsertInserted, nil
+	}
+	return UpsertUpdated, nil
+}
+
+// insertArrayTypesColumns returns the columns of row that are not Undefined and their
+// values for inserting row.
+func insertArrayTypesColumns(row *ArrayTypes) ([]string, []interface{}, error) {
+	var columns []string
+	var values []interface{}
+
+	if row.ID.Status != pgtype.Undefined {
+		columns = append(columns, `id`)
+		values = append(values, &row.ID)
+	}
+	if row.Tags.Status != pgtype.Undefined {
+		columns = append(columns, `tags`)
+		values = append(values, &row.Tags)
+	}
+	if row.PermissionIds.Status != pgtype.Undefined {
+		columns = append(columns, `permission_ids`)
+		values = append(values, &row.PermissionIds)
+	}
+	if row.Flags.Status != pgtype.Undefined {
+		columns = append(columns, `flags`)
+		values = append(values, &row.Flags)
+	}
+	if row.Uuids.Status != pgtype.Undefined {
+		columns = append(columns, `uuids`)
+		values = append(values, &row.Uuids)
+	}
+	if row.Amounts.Status != pgtype.Undefined {
+		columns = append(columns, `amounts`)
+		values = append(values, &row.Amounts)
+	}
+	if row.OccurredAt.Status != pgtype.Undefined {
+		columns = append(columns, `occurred_at`)
+		values = append(values, &row.OccurredAt)
+	}
+
+	return columns, values, nil
+}
+
+// CopyInsertArrayTypes inserts rows with the PostgreSQL copy protocol and
+// returns the number of rows copied. The columns copied are those not
+// Undefined in the first row and every row must set the same fields. Values
+// must support the binary format.
+func CopyInsertArrayTypes(ctx context.Context, db CopyFromer, rows []ArrayTypes) (int64, error) {
+	if len(rows) == 0 {
+		return 0, nil
+	}
+
+	columns, _, err := insertArrayTypesColumns(&rows[0])
+	if err != nil {
+		return 0, err
+	}
+
+	return db.CopyFrom(ctx, pgx.Identifier{"array_types"}, columns, &copyFromArrayTypesSource{rows: rows, columns: columns, idx: -1})
+}
+
+// copyFromArrayTypesSource is a pgx.CopyFromSource of the columns of rows.
+type copyFromArrayTypesSource struct {
+	rows    []ArrayTypes
+	columns []string
+	idx     int
+}
+
+func (s *copyFromArrayTypesSource) Next() bool {
+	s.idx++
+	return s.idx < len(s.rows)
+}
+
+func (s *copyFromArrayTypesSource) Values() ([]interface{}, error) {
+	columns, values, err := insertArrayTypesColumns(&s.rows[s.idx])
+	if err != nil {
+		return nil, err
+	}
+	if !equalColumns(columns, s.columns) {
+		return nil, errors.Errorf("row %d does not set the same fields as row 0", s.idx)
+	}
+	return values, nil
+}
+
+func (s *copyFromArrayTypesSource) Err() error {
+	return nil
+}
+
+// insertManyArrayTypesTypes are the types the parameters of
+// InsertManyArrayTypes are cast to. PostgreSQL only infers them for the
+// VALUES list of an INSERT, not for one that is selected from.
+var insertManyArrayTypesTypes = map[string]string{
+	`id`:             `"pg_catalog"."int4"`,
+	`tags`:           `"pg_catalog"."_text"`,
+	`permission_ids`: `"pg_catalog"."_int8"`,
+	`flags`:          `"pg_catalog"."_bool"`,
+	`uuids`:          `"pg_catalog"."_uuid"`,
+	`amounts`:        `"pg_catalog"."_numeric"`,
+	`occurred_at`:    `"pg_catalog"."_timestamptz"`,
+}
+
+// InsertManyArrayTypes inserts rows with multi-row INSERT statements for
+// a Queryer that does not support the copy protocol or for columns without a
+// binary format. The columns inserted are those not Undefined in the
+// first row and every row must set the same fields. Like InsertArrayTypes
+// the persisted rows are scanned into rows.
+func InsertManyArrayTypes(ctx context.Context, db Queryer, rows []ArrayTypes) error {
+	if len(rows) == 0 {
+		return nil
+	}
+
+	columns, _, err := insertArrayTypesColumns(&rows[0])
+	if err != nil {
+		return err
+	}
+
+	quotedColumns := make([]string, len(columns))
+	for i, column := range columns {
+		quotedColumns[i] = pgx.Identifier{column}.Sanitize()
+	}
+
+	// A statement can have at most 65535 parameters including the ordinality
+	// of each row.
+	batchSize := len(rows)
+	if batchSize > 65535/(len(columns)+1) {
+		batchSize = 65535 / (len(columns) + 1)
+	}
+
+	for start := 0; start < len(rows); start += batchSize {
+		end := start + batchSize
+		if end > len(rows) {
+			end = len(rows)
+		}
+		batch := rows[start:end]
+
+		args := pgx.QueryArgs(make([]interface{}, 0, len(batch)*(len(columns)+1)))
+		valueLists := make([]string, len(batch))
+		for i := range batch {
+			rowColumns, values, err := insertArrayTypesColumns(&batch[i])
+			if err != nil {
+				return err
+			}
+			if !equalColumns(rowColumns, columns) {
+				return errors.Errorf("row %d does not set the same fields as row 0", start+i)
+			}
+
+			placeholders := make([]string, len(values)+1)
+			for j, v := range values {
+				placeholders[j] = args.Append(v) + "::" + insertManyArrayTypesTypes[columns[j]]
+			}
+			placeholders[len(values)] = args.Append(int32(i)) + "::int4"
+			valueLists[i] = "(" + strings.Join(placeholders, ",") + ")"
+		}
+
+		// The rows are inserted in the order of their ordinality so they are
+		// returned in the order of batch.
+		sql := `insert into "array_types"(` + strings.Join(quotedColumns, ", ") + `)
+select ` + strings.Join(quotedColumns, ", ") + `
+from (values` + strings.Join(valueLists, ",") + `) as input(` + strings.Join(append(quotedColumns, "pgxdata_ordinality"), ", ") + `)
+order by pgxdata_ordinality
+returning "id", "tags", "permission_ids", "flags", "uuids", "amounts", "occurred_at"`
+
+		// The SQL depends on the number of rows so it is not prepared, which
+		// would leave a prepared statement for every batch size.
+		dbRows, err := db.Query(ctx, sql, args...)
+		if err != nil {
+			return err
+		}
+
+		// PostgreSQL does not guarantee the order of the returned rows, but it
+		// returns them in the order they were inserted. A different number of rows
+		// means they cannot be matched to batch.
+		n := 0
+		for dbRows.Next() {
+			if n < len(batch) {
+				row := &batch[n]
+				if err := dbRows.Scan(&row.ID, &row.Tags, &row.PermissionIds, &row.Flags, &row.Uuids, &row.Amounts, &row.OccurredAt); err != nil {
+					dbRows.Close()
+					return err
+				}
+			}
+			n++
+		}
+
+		if dbRows.Err() != nil {
+			return dbRows.Err()
+		}
+		if n != len(batch) {
+			return errors.Errorf("inserted %d rows but %d rows were returned", len(batch), n)
+		}
+	}
+
+	return nil
+}
+
+// QueueSelectArrayTypesByPK queues selecting the row by primary key in b.
+// The returned reader scans the row into dst or returns ErrNotFound if there
+// is no such row.
+func QueueSelectArrayTypesByPK(b *pgx.Batch, id int32, dst *ArrayTypes) BatchReader {
+	args := []interface{}{id}
+	oids := []pgtype.OID{pgtype.Int4OID}
+
+	return queue(b, selectArrayTypesByPKSQL, args, oids, func(results pgx.BatchResults) error {
+		err := results.QueryRowResults().Scan(
+			&dst.ID,
+			&dst.Tags,
+			&dst.PermissionIds,
+			&dst.Flags,
+			&dst.Uuids,
+			&dst.Amounts,
+			&dst.OccurredAt,
+		)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrNotFound
+		}
+		return err
+	})
+}
+
+// QueueInsertArrayTypes queues inserting row in b. Like InsertArrayTypes
+// the returned reader scans the persisted row into row.
+func QueueInsertArrayTypes(b *pgx.Batch, row *ArrayTypes) BatchReader {
+	args := pgx.QueryArgs(make([]interface{}, 0, 7))
+	oids := make([]pgtype.OID, 0, 7)
+
+	var columns, values []string
+
+	if row.ID.Status != pgtype.Undefined {
+		columns = append(columns, `id`)
+		values = append(values, args.Append(&row.ID))
+		oids = append(oids, pgtype.Int4OID)
+	}
+	if row.Tags.Status != pgtype.Undefined {
+		columns = append(columns, `tags`)
+		values = append(values, args.Append(&row.Tags))
+		oids = append(oids, pgtype.TextArrayOID)
+	}
+	if row.PermissionIds.Status != pgtype.Undefined {
+		columns = append(columns, `permission_ids`)
+		values = append(values, args.Append(&row.PermissionIds))
+		oids = append(oids, pgtype.Int8ArrayOID)
+	}
+	if row.Flags.Status != pgtype.Undefined {
+		columns = append(columns, `flags`)
+		values = append(values, args.Append(&row.Flags))
+		oids = append(oids, pgtype.BoolArrayOID)
+	}
+	if row.Uuids.Status != pgtype.Undefined {
+		columns = append(columns, `uuids`)
+		values = append(values, args.Append(&row.Uuids))
+		oids = append(oids, pgtype.UUIDArrayOID)
+	}
+	if row.Amounts.Status != pgtype.Undefined {
+		columns = append(columns, `amounts`)
+		values = append(values, args.Append(&row.Amounts))
+		oids = append(oids, pgtype.NumericArrayOID)
+	}
+	if row.OccurredAt.Status != pgtype.Undefined {
+		columns = append(columns, `occurred_at`)
+		values = append(values, args.Append(&row.OccurredAt))
+		oids = append(oids, pgtype.TimestamptzArrayOID)
+	}
+
+	insert := ` default values`
+	if len(columns) > 0 {
+		insert = `(` + strings.Join(columns, ", ") + `)
+values(` + strings.Join(values, ",") + `)`
+	}
+
+	sql := `insert into "array_types"` + insert + `
+returning "id", "tags", "permission_ids", "flags", "uuids", "amounts", "occurred_at"`
+
+	return queue(b, sql, args, oids, func(results pgx.BatchResults) error {
+		return results.QueryRowResults().Scan(&row.ID, &row.Tags, &row.PermissionIds, &row.Flags, &row.Uuids, &row.Amounts, &row.OccurredAt)
+	})
+}
+
+// QueueUpdateArrayTypes queues updating the row by primary key in b. Like
+// UpdateArrayTypes the returned reader scans the persisted row into row
+// or returns ErrNotFound if there is no such row. Nothing is queued when
+// there is nothing to update.
+func QueueUpdateArrayTypes(b *pgx.Batch, id int32, row *ArrayTypes) BatchReader {
+	sets := make([]string, 0, 7)
+	args := pgx.QueryArgs(make([]interface{}, 0, 7))
+	oids := make([]pgtype.OID, 0, 7)
+
+	if row.ID.Status != pgtype.Undefined {
+		sets = append(sets, `id`+"="+args.Append(&row.ID))
+		oids = append(oids, pgtype.Int4OID)
+	}
+	if row.Tags.Status != pgtype.Undefined {
+		sets = append(sets, `tags`+"="+args.Append(&row.Tags))
+		oids = append(oids, pgtype.TextArrayOID)
+	}
+	if row.PermissionIds.Status != pgtype.Undefined {
+		sets = append(sets, `permission_ids`+"="+args.Append(&row.PermissionIds))
+		oids = append(oids, pgtype.Int8ArrayOID)
+	}
+	if row.Flags.Status != pgtype.Undefined {
+		sets = append(sets, `flags`+"="+args.Append(&row.Flags))
+		oids = append(oids, pgtype.BoolArrayOID)
+	}
+	if row.Uuids.Status != pgtype.Undefined {
+		sets = append(sets, `uuids`+"="+args.Append(&row.Uuids))
+		oids = append(oids, pgtype.UUIDArrayOID)
+	}
+	if row.Amounts.Status != pgtype.Undefined {
+		sets = append(sets, `amounts`+"="+args.Append(&row.Amounts))
+		oids = append(oids, pgtype.NumericArrayOID)
+	}
+	if row.OccurredAt.Status != pgtype.Undefined {
+		sets = append(sets, `occurred_at`+"="+args.Append(&row.OccurredAt))
+		oids = append(oids, pgtype.TimestamptzArrayOID)
+	}
+
+	if len(sets) == 0 {
+		return func(pgx.BatchResults) error { return nil }
+	}
+
+	sql := `update "array_types" set ` + strings.Join(sets, ", ") + ` where ` + `"id"=` + args.Append(id) + `
+returning "id", "tags", "permission_ids", "flags", "uuids", "amounts", "occurred_at"`
+	oids = append(oids, pgtype.Int4OID)
+
+	return queue(b, sql, args, oids, func(results pgx.BatchResults) error {
+		err := results.QueryRowResults().Scan(&row.ID, &row.Tags, &row.PermissionIds, &row.Flags, &row.Uuids, &row.Amounts, &row.OccurredAt)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrNotFound
+		}
+		return err
+	})
+}
+
+// QueueDeleteArrayTypes queues deleting the row by primary key in b. The
+// returned reader returns ErrNotFound if there is no such row.
+func QueueDeleteArrayTypes(b *pgx.Batch, id int32) BatchReader {
+	args := pgx.QueryArgs(make([]interface{}, 0, 1))
+
+	sql := `delete from "array_types" where ` + `"id"=` + args.Append(id)
+	oids := []pgtype.OID{pgtype.Int4OID}
+
+	return queue(b, sql, args, oids, func(results pgx.BatchResults) error {
+		commandTag, err := results.ExecResults()
+		if err != nil {
+			return err
+		}
+		if commandTag.RowsAffected() != 1 {
+			return ErrNotFound
+		}
+		return nil
+	})
+}
+
+func DeleteArrayTypes(ctx context.Context, db Queryer,
+	id int32,
+) error {
+	args := pgx.QueryArgs(make([]interface{}, 0, 1))
+
+	sql := `delete from "array_types" where ` + `"id"=` + args.Append(id)
+
+	commandTag, err := prepareExec(ctx, db, "pgxdataDeleteArrayTypes", sql, args...)
+	if err != nil {
+		return err
+	}
+	if commandTag.RowsAffected() != 1 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+// ArrayTypesWhere has a filter for each column of "array_types" that builds the conditions of
+// SelectArrayTypesWhere, CountArrayTypesWhere, UpdateArrayTypesWhere and DeleteArrayTypesWhere.
+var ArrayTypesWhere = struct {
+	ID            ArrayTypesIDFilter
+	Tags          ArrayTypesTagsFilter
+	PermissionIds ArrayTypesPermissionIdsFilter
+	Flags         ArrayTypesFlagsFilter
+	Uuids         ArrayTypesUuidsFilter
+	Amounts       ArrayTypesAmountsFilter
+	OccurredAt    ArrayTypesOccurredAtFilter
+}{
+	ID:            ArrayTypesIDFilter{columnFilter{`"id"`}},
+	Tags:          ArrayTypesTagsFilter{columnFilter{`"tags"`}},
+	PermissionIds: ArrayTypesPermissionIdsFilter{columnFilter{`"permission_ids"`}},
+	Flags:         ArrayTypesFlagsFilter{columnFilter{`"flags"`}},
+	Uuids:         ArrayTypesUuidsFilter{columnFilter{`"uuids"`}},
+	Amounts:       ArrayTypesAmountsFilter{columnFilter{`"amounts"`}},
+	OccurredAt:    ArrayTypesOccurredAtFilter{columnFilter{`"occurred_at"`}},
+}
+
+// ArrayTypesIDFilter builds conditions on id.
+type ArrayTypesIDFilter struct{ columnFilter }
+
+func (f ArrayTypesIDFilter) Eq(v int32) Condition { return f.compare(" = ", v) }
+func (f ArrayTypesIDFilter) Ne(v int32) Condition { return f.compare(" <> ", v) }
+func (f ArrayTypesIDFilter) Lt(v int32) Condition { return f.compare(" < ", v) }
+func (f ArrayTypesIDFilter) Le(v int32) Condition { return f.compare(" <= ", v) }
+func (f ArrayTypesIDFilter) Gt(v int32) Condition { return f.compare(" > ", v) }
+func (f ArrayTypesIDFilter) Ge(v int32) Condition { return f.compare(" >= ", v) }
+
+func (f ArrayTypesIDFilter) In(vs ...int32) Condition { return f.in(len(vs), vs) }
+
+// ArrayTypesTagsFilter builds conditions on tags.
+type ArrayTypesTagsFilter struct{ columnFilter }
+
+func (f ArrayTypesTagsFilter) Eq(v []string) Condition { return f.compare(" = ", v) }
+func (f ArrayTypesTagsFilter) Ne(v []string) Condition { return f.compare(" <> ", v) }
+func (f ArrayTypesTagsFilter) Lt(v []string) Condition { return f.compare(" < ", v) }
+func (f ArrayTypesTagsFilter) Le(v []string) Condition { return f.compare(" <= ", v) }
+func (f ArrayTypesTagsFilter) Gt(v []string) Condition { return f.compare(" > ", v) }
+func (f ArrayTypesTagsFilter) Ge(v []string) Condition { return f.compare(" >= ", v) }
+
+// ArrayTypesPermissionIdsFilter builds conditions on permission_ids.
+type ArrayTypesPermissionIdsFilter struct{ columnFilter }
+
+func (f ArrayTypesPermissionIdsFilter) Eq(v []int64) Condition { return f.compare(" = ", v) }
+func (f ArrayTypesPermissionIdsFilter) Ne(v []int64) Condition { return f.compare(" <> ", v) }
+func (f ArrayTypesPermissionIdsFilter) Lt(v []int64) Condition { return f.compare(" < ", v) }
+func (f ArrayTypesPermissionIdsFilter) Le(v []int64) Condition { return f.compare(" <= ", v) }
+func (f ArrayTypesPermissionIdsFilter) Gt(v []int64) Condition { return f.compare(" > ", v) }
+func (f ArrayTypesPermissionIdsFilter) Ge(v []int64) Condition { return f.compare(" >= ", v) }
+
+// ArrayTypesFlagsFilter builds conditions on flags.
+type ArrayTypesFlagsFilter struct{ columnFilter }
+
+func (f ArrayTypesFlagsFilter) Eq(v []bool) Condition { return f.compare(" = ", v) }
+func (f ArrayTypesFlagsFilter) Ne(v []bool) Condition { return f.compare(" <> ", v) }
+func (f ArrayTypesFlagsFilter) Lt(v []bool) Condition { return f.compare(" < ", v) }
+func (f ArrayTypesFlagsFilter) Le(v []bool) Condition { return f.compare(" <= ", v) }
+func (f ArrayTypesFlagsFilter) Gt(v []bool) Condition { return f.compare(" > ", v) }
+func (f ArrayTypesFlagsFilter) Ge(v []bool) Condition { return f.compare(" >= ", v) }
+
+// ArrayTypesUuidsFilter builds conditions on uuids.
+type ArrayTypesUuidsFilter struct{ columnFilter }
+
+func (f ArrayTypesUuidsFilter) Eq(v [][16]byte) Condition { return f.compare(" = ", v) }
+func (f ArrayTypesUuidsFilter) Ne(v [][16]byte) Condition { return f.compare(" <> ", v) }
+func (f ArrayTypesUuidsFilter) Lt(v [][16]byte) Condition { return f.compare(" < ", v) }
+func (f ArrayTypesUuidsFilter) Le(v [][16]byte) Condition { return f.compare(" <= ", v) }
+func (f ArrayTypesUuidsFilter) Gt(v [][16]byte) Condition { return f.compare(" > ", v) }
+func (f ArrayTypesUuidsFilter) Ge(v [][16]byte) Condition { return f.compare(" >= ", v) }
+
+// ArrayTypesAmountsFilter builds conditions on amounts.
+type ArrayTypesAmountsFilter struct{ columnFilter }
+
+// ArrayTypesOccurredAtFilter builds conditions on occurred_at.
+type ArrayTypesOccurredAtFilter struct{ columnFilter }
+
+func (f ArrayTypesOccurredAtFilter) Eq(v []time.Time) Condition { return f.compare(" = ", v) }
+func (f ArrayTypesOccurredAtFilter) Ne(v []time.Time) Condition { return f.compare(" <> ", v) }
+func (f ArrayTypesOccurredAtFilter) Lt(v []time.Time) Condition { return f.compare(" < ", v) }
+func (f ArrayTypesOccurredAtFilter) Le(v []time.Time) Condition { return f.compare(" <= ", v) }
+func (f ArrayTypesOccurredAtFilter) Gt(v []time.Time) Condition { return f.compare(" > ", v) }
+func (f ArrayTypesOccurredAtFilter) Ge(v []time.Time) Condition { return f.compare(" >= ", v) }
+
+func SelectArrayTypesWhere(ctx context.Context, db Queryer, where Condition) ([]ArrayTypes, error) {
+	var args pgx.QueryArgs
+	sql := SelectAllArrayTypesSQL + `
+where ` + where.sql(&args)
+
+	dbRows, err := prepareQuery(ctx, db, preparedName("pgxdataSelectArrayTypesWhere", sql), sql, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer dbRows.Close()
+
+	var rows []ArrayTypes
+	for dbRows.Next() {
+		var row ArrayTypes
+		err := dbRows.Scan(
+			&row.ID,
+			&row.Tags,
+			&row.PermissionIds,
+			&row.Flags,
+			&row.Uuids,
+			&row.Amounts,
+			&row.OccurredAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+		rows = append(rows, row)
+	}
+
+	if dbRows.Err() != nil {
+		return nil, dbRows.Err()
+	}
+
+	return rows, nil
+}
+
+func CountArrayTypesWhere(ctx context.Context, db Queryer, where Condition) (int64, error) {
+	var args pgx.QueryArgs
+	sql := countArrayTypesSQL + ` where ` + where.sql(&args)
+
+	var n int64
+	err := prepareQueryRow(ctx, db, preparedName("pgxdataCountArrayTypesWhere", sql), sql, args...).Scan(&n)
+	return n, err
+}
+
+// UpdateArrayTypesWhere updates the rows matching where like UpdateArrayTypes and returns the number of
+// rows updated. where must restrict the rows, so a Condition that matches every row like the zero Condition is
+// refused.
+func UpdateArrayTypesWhere(ctx context.Context, db Queryer, where Condition, row *ArrayTypes) (int64, error) {
+	if where.write == nil {
+		return 0, errors.New("UpdateArrayTypesWhere requires a condition")
+	}
+
+	sets, args, err := updateArrayTypesSets(row)
+	if err != nil {
+		return 0, err
+	}
+	if len(sets) == 0 {
+		return 0, nil
+	}
+
+	sql := `update "array_types" set ` + strings.Join(sets, ", ") + ` where ` + where.sql(&args)
+
+	commandTag, err := prepareExec(ctx, db, preparedName("pgxdataUpdateArrayTypesWhere", sql), sql, args...)
+	if err != nil {
+		return 0, err
+	}
+	return commandTag.RowsAffected(), nil
+}
+
+// DeleteArrayTypesWhere deletes the rows matching where and returns the number of rows deleted. where must
+// restrict the rows, so a Condition that matches every row like the zero Condition is refused.
+func DeleteArrayTypesWhere(ctx context.Context, db Queryer, where Condition) (int64, error) {
+	if where.write == nil {
+		return 0, errors.New("DeleteArrayTypesWhere requires a condition")
+	}
+
+	var args pgx.QueryArgs
+	sql := `delete from "array_types" where ` + where.sql(&args)
+
+	commandTag, err := prepareExec(ctx, db, preparedName("pgxdataDeleteArrayTypesWhere", sql), sql, args...)
+	if err != nil {
+		return 0, err
+	}
+	return commandTag.RowsAffected(), nil
+}
@@ -0,0 +1,831 @@
+package data
+
+// This file is automatically generated by pgxdata.
+
+import (
+	"context"
+	"strings"
+
+	"github.com/jackc/pgtype"
+	"github.com/jackc/pgx/v4"
+	errors "golang.org/x/xerrors"
+)
+
+type BillingCustomer struct {
+	// ID is integer not null default nextval('billing.customer_id_seq'::regclass).
+	ID pgtype.Int4
+	// AccountNumber is character varying not null.
+	AccountNumber pgtype.Varchar
+	// CreditLimit is integer not null.
+	CreditLimit pgtype.Int4
+}
+
+const countBillingCustomerSQL = `select count(*) from "billing"."customer"`
+
+func CountBillingCustomer(ctx context.Context, db Queryer) (int64, error) {
+	var n int64
+	err := prepareQueryRow(ctx, db, "pgxdataCountBillingCustomer", countBillingCustomerSQL).Scan(&n)
+	return n, err
+}
+
+const SelectAllBillingCustomerSQL = `select
+  "id",
+  "account_number",
+  "credit_limit"
+from "billing"."customer"`
+
+func SelectAllBillingCustomer(ctx context.Context, db Queryer) ([]BillingCustomer, error) {
+	var rows []BillingCustomer
+
+	err := ForEachBillingCustomer(ctx, db, func(row *BillingCustomer) error {
+		rows = append(rows, *row)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return rows, nil
+}
+
+// BillingCustomerRows is a cursor over the rows of "billing"."customer" that
+// reads one row at a time.
+type BillingCustomerRows struct {
+	rows pgx.Rows
+	row  BillingCustomer
+	err  error
+}
+
+// QueryBillingCustomerRows selects all rows of "billing"."customer". The
+// returned rows must be closed.
+func QueryBillingCustomerRows(ctx context.Context, db Queryer) (*BillingCustomerRows, error) {
+	dbRows, err := prepareQuery(ctx, db, "pgxdataSelectAllBillingCustomer", SelectAllBillingCustomerSQL)
+	if err != nil {
+		return nil, err
+	}
+
+	return &BillingCustomerRows{rows: dbRows}, nil
+}
+
+// Next reads the next row. It returns false when there are no more rows or an
+// error occurred.
+func (r *BillingCustomerRows) Next() bool {
+	if r.err != nil || !r.rows.Next() {
+		return false
+	}
+
+	r.row = BillingCustomer{}
+	r.err = r.rows.Scan(
+		&r.row.ID,
+		&r.row.AccountNumber,
+		&r.row.CreditLimit,
+	)
+	if r.err != nil {
+		r.rows.Close()
+		return false
+	}
+
+	return true
+}
+
+// Row returns the current row. It is overwritten by the next call to Next.
+func (r *BillingCustomerRows) Row() *BillingCustomer {
+	return &r.row
+}
+
+// Err returns the error, if any, that stopped Next.
+func (r *BillingCustomerRows) Err() error {
+	if r.err != nil {
+		return r.err
+	}
+	return r.rows.Err()
+}
+
+// Close closes the rows. It is safe to call Close after all rows have been
+// read.
+func (r *BillingCustomerRows) Close() {
+	r.rows.Close()
+}
+
+// ForEachBillingCustomer calls fn with each row of "billing"."customer". The
+// row passed to fn is reused for the next row. If fn returns an error the
+// remaining rows are skipped and the error is returned.
+func ForEachBillingCustomer(ctx context.Context, db Queryer, fn func(*BillingCustomer) error) error {
+	rows, err := QueryBillingCustomerRows(ctx, db)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		err := fn(rows.Row())
+		if err != nil {
+			return err
+		}
+	}
+
+	return rows.Err()
+}
+
+// BillingCustomerPageCursor is the position after the last row of a
+// page returned by SelectBillingCustomerPage.
+type BillingCustomerPageCursor struct {
+	ID int32
+}
+
+const selectBillingCustomerPageSQL = `select
+  "id",
+  "account_number",
+  "credit_limit"
+from "billing"."customer"
+order by "id"
+limit $1`
+
+const selectBillingCustomerPageAfterSQL = `select
+  "id",
+  "account_number",
+  "credit_limit"
+from "billing"."customer"
+where "id" > $1
+order by "id"
+limit $2`
+
+// SelectBillingCustomerPage selects up to limit rows that follow
+// after, or the first rows when after is nil, ordered by
+// "id". The returned cursor selects the next page. It is nil
+// when there are no more rows.
+func SelectBillingCustomerPage(ctx context.Context, db Queryer, after *BillingCustomerPageCursor, limit int) ([]BillingCustomer, *BillingCustomerPageCursor, error) {
+	var dbRows pgx.Rows
+	var err error
+	if after == nil {
+		dbRows, err = prepareQuery(ctx, db, "pgxdataSelectBillingCustomerPage", selectBillingCustomerPageSQL, limit)
+	} else {
+		dbRows, err = prepareQuery(ctx, db, "pgxdataSelectBillingCustomerPageAfter", selectBillingCustomerPageAfterSQL, after.ID, limit)
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	defer dbRows.Close()
+
+	var rows []BillingCustomer
+	for dbRows.Next() {
+		var row BillingCustomer
+		err := dbRows.Scan(
+			&row.ID,
+			&row.AccountNumber,
+			&row.CreditLimit,
+		)
+		if err != nil {
+			return nil, nil, err
+		}
+		rows = append(rows, row)
+	}
+
+	if dbRows.Err() != nil {
+		return nil, nil, dbRows.Err()
+	}
+
+	if len(rows) == 0 || len(rows) < limit {
+		return rows, nil, nil
+	}
+
+	last := &rows[len(rows)-1]
+	next := &BillingCustomerPageCursor{}
+	err = last.ID.AssignTo(&next.ID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return rows, next, nil
+}
+
+const selectBillingCustomerByPKSQL = `select
+  "id",
+  "account_number",
+  "credit_limit"
+from "billing"."customer"
+where "id"=$1`
+
+func SelectBillingCustomerByPK(
+	ctx context.Context,
+	db Queryer,
+	id int32,
+) (*BillingCustomer, error) {
+	var row BillingCustomer
+	err := prepareQueryRow(ctx, db, "pgxdataSelectBillingCustomerByPK", selectBillingCustomerByPKSQL, id).Scan(
+		&row.ID,
+		&row.AccountNumber,
+		&row.CreditLimit,
+	)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrNotFound
+	} else if err != nil {
+		return nil, err
+	}
+
+	return &row, nil
+}
+
+func InsertBillingCustomer(ctx context.Context, db Queryer, row *BillingCustomer) error {
+	args := pgx.QueryArgs(make([]interface{}, 0, 3))
+
+	var columns, values []string
+
+	if row.ID.Status != pgtype.Undefined {
+		columns = append(columns, `id`)
+		values = append(values, args.Append(&row.ID))
+	}
+	if row.AccountNumber.Status != pgtype.Undefined {
+		columns = append(columns, `account_number`)
+		values = append(values, args.Append(&row.AccountNumber))
+	}
+	if row.CreditLimit.Status != pgtype.Undefined {
+		columns = append(columns, `credit_limit`)
+		values = append(values, args.Append(&row.CreditLimit))
+	}
+
+	insert := ` default values`
+	if len(columns) > 0 {
+		insert = `(` + strings.Join(columns, ", ") + `)
+values(` + strings.Join(values, ",") + `)`
+	}
+
+	sql := `insert into "billing"."customer"` + insert + `
+returning "id", "account_number", "credit_limit"
+  `
+
+	psName := preparedName("pgxdataInsertBillingCustomer", sql)
+
+	return prepareQueryRow(ctx, db, psName, sql, args...).Scan(&row.ID, &row.AccountNumber, &row.CreditLimit)
+}
+
+// updateBillingCustomerSets returns the assignments of the SET clause for the fields of row to update and their
+// arguments.
+func updateBillingCustomerSets(row *BillingCustomer) ([]string, pgx.QueryArgs, error) {
+	sets := make([]string, 0, 3)
+	args := pgx.QueryArgs(make([]interface{}, 0, 3))
+
+	if row.ID.Status != pgtype.Undefined {
+		sets = append(sets, `id`+"="+args.Append(&row.ID))
+	}
+	if row.AccountNumber.Status != pgtype.Undefined {
+		sets = append(sets, `account_number`+"="+args.Append(&row.AccountNumber))
+	}
+	if row.CreditLimit.Status != pgtype.Undefined {
+		sets = append(sets, `credit_limit`+"="+args.Append(&row.CreditLimit))
+	}
+
+	return sets, args, nil
+}
+
+func UpdateBillingCustomer(ctx context.Context, db Queryer,
+	id int32,
+	row *BillingCustomer,
+) error {
+	sets, args, err := updateBillingCustomerSets(row)
+	if err != nil {
+		return err
+	}
+	if len(sets) == 0 {
+		return nil
+	}
+
+	sql := `update "billing"."customer" set ` + strings.Join(sets, ", ") + ` where ` + `"id"=` + args.Append(id) + `
+returning "id", "account_number", "credit_limit"`
+
+	psName := preparedName("pgxdataUpdateBillingCustomer", sql)
+
+	err = prepareQueryRow(ctx, db, psName, sql, args...).Scan(&row.ID, &row.AccountNumber, &row.CreditLimit)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return ErrNotFound
+	}
+	return err
+}
+
+// BillingCustomerConflict identifies the primary key or unique index UpsertBillingCustomer
+// detects a conflicting row by.
+type BillingCustomerConflict int
+
+const (
+	BillingCustomerConflictOnPK BillingCustomerConflict = iota
+)
+
+// BillingCustomerUpsertOptions configures UpsertBillingCustomer.
+type BillingCustomerUpsertOptions struct {
+	// Conflict is the primary key or unique index a conflicting row is detected
+	// by. The primary key is the default.
+	Conflict BillingCustomerConflict
+
+	// DoNothing leaves a conflicting row unchanged instead of updating it.
+	DoNothing bool
+}
+
+// UpsertBillingCustomer inserts row or, when it conflicts with an existing row,
+// updates the existing row with the fields of row that are not Undefined other than
+// those of the conflict target.
+// Like InsertBillingCustomer the persisted row is scanned into row. Nothing is
+// scanned when a conflicting row is left unchanged.
+func UpsertBillingCustomer(ctx context.Context, db Queryer, row *BillingCustomer, opts BillingCustomerUpsertOptions) (UpsertResult, error) {
+	args := pgx.QueryArgs(make([]interface{}, 0, 3))
+
+	var columns, values []string
+
+	if row.ID.Status != pgtype.Undefined {
+		columns = append(columns, `"id"`)
+		values = append(values, args.Append(&row.ID))
+	}
+	if row.AccountNumber.Status != pgtype.Undefined {
+		columns = append(columns, `"account_number"`)
+		values = append(values, args.Append(&row.AccountNumber))
+	}
+	if row.CreditLimit.Status != pgtype.Undefined {
+		columns = append(columns, `"credit_limit"`)
+		values = append(values, args.Append(&row.CreditLimit))
+	}
+
+	var targetColumns []string
+	switch opts.Conflict {
+	case BillingCustomerConflictOnPK:
+		targetColumns = []string{`"id"`}
+	default:
+		return UpsertUnchanged, errors.Errorf("unknown BillingCustomerConflict %d", opts.Conflict)
+	}
+
+	// The conflicting row is updated with the columns other than the conflict
+	// target. It is left unchanged when there are none.
+	sets := make([]string, 0, len(columns))
+nextColumn:
+	for _, c := range columns {
+		for _, tc := range targetColumns {
+			if c == tc {
+				continue nextColumn
+			}
+		}
+		sets = append(sets, c+"=excluded."+c)
+	}
+
+	action := `do update set ` + strings.Join(sets, ", ")
+	if opts.DoNothing || len(sets) == 0 {
+		action = `do nothing`
+	}
+
+	insert := ` default values`
+	if len(columns) > 0 {
+		insert = `(` + strings.Join(columns, ", ") + `)
+values(` + strings.Join(values, ",") + `)`
+	}
+
+	sql := `insert into "billing"."customer"` + insert + `
+on conflict (` + strings.Join(targetColumns, ", ") + `) ` + action + `
+returning xmax = 0, "id", "account_number", "credit_limit"
+  `
+
+	psName := preparedName("pgxdataUpsertBillingCustomer", sql)
+
+	var inserted bool
+	err := prepareQueryRow(ctx, db, psName, sql, args...).Scan(&inserted, &row.ID, &row.AccountNumber, &row.CreditLimit)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return UpsertUnchanged, nil
+	} else if err != nil {
+		return UpsertUnchanged, err
+	}
+
+	if inserted {
+		return UpsertInserted, nil
+	}
+	return UpsertUpdated, nil
+}
+
+// insertBillingCustomerColumns returns the columns of row that are not Undefined and their
+// values for inserting row.
+func insertBillingCustomerColumns(row *BillingCustomer) ([]string, []interface{}, error) {
+	var columns []string
+	var values []interface{}
+
+	if row.ID.Status != pgtype.Undefined {
+		columns = append(columns, `id`)
+		values = append(values, &row.ID)
+	}
+	if row.AccountNumber.Status != pgtype.Undefined {
+		columns = append(columns, `account_number`)
+		values = append(values, &row.AccountNumber)
+	}
+	if row.CreditLimit.Status != pgtype.Undefined {
+		columns = append(columns, `credit_limit`)
+		values = append(values, &row.CreditLimit)
+	}
+
+	return columns, values, nil
+}
+
+// CopyInsertBillingCustomer inserts rows with the PostgreSQL copy protocol and
+// returns the number of rows copied. The columns copied are those not
+// Undefined in the first row and every row must set the same fields. Values
+// must support the binary format.
+func CopyInsertBillingCustomer(ctx context.Context, db CopyFromer, rows []BillingCustomer) (int64, error) {
+	if len(rows) == 0 {
+		return 0, nil
+	}
+
+	columns, _, err := insertBillingCustomerColumns(&rows[0])
+	if err != nil {
+		return 0, err
+	}
+
+	return db.CopyFrom(ctx, pgx.Identifier{"billing", "customer"}, columns, &copyFromBillingCustomerSource{rows: rows, columns: columns, idx: -1})
+}
+
+// copyFromBillingCustomerSource is a pgx.CopyFromSource of the columns of rows.
+type copyFromBillingCustomerSource struct {
+	rows    []BillingCustomer
+	columns []string
+	idx     int
+}
+
+func (s *copyFromBillingCustomerSource) Next() bool {
+	s.idx++
+	return s.idx < len(s.rows)
+}
+
+func (s *copyFromBillingCustomerSource) Values() ([]interface{}, error) {
+	columns, values, err := insertBillingCustomerColumns(&s.rows[s.idx])
+	if err != nil {
+		return nil, err
+	}
+	if !equalColumns(columns, s.columns) {
+		return nil, errors.Errorf("row %d does not set the same fields as row 0", s.idx)
+	}
+	return values, nil
+}
+
+func (s *copyFromBillingCustomerSource) Err() error {
+	return nil
+}
+
+// insertManyBillingCustomerTypes are the types the parameters of
+// InsertManyBillingCustomer are cast to. PostgreSQL only infers them for the
+// VALUES list of an INSERT, not for one that is selected from.
+var insertManyBillingCustomerTypes = map[string]string{
+	`id`:             `"pg_catalog"."int4"`,
+	`account_number`: `"pg_catalog"."varchar"`,
+	`credit_limit`:   `"pg_catalog"."int4"`,
+}
+
+// InsertManyBillingCustomer inserts rows with multi-row INSERT statements for
+// a Queryer that does not support the copy protocol or for columns without a
+// binary format. The columns inserted are those not Undefined in the
+// first row and every row must set the same fields. Like InsertBillingCustomer
+// the persisted rows are scanned into rows.
+func InsertManyBillingCustomer(ctx context.Context, db Queryer, rows []BillingCustomer) error {
+	if len(rows) == 0 {
+		return nil
+	}
+
+	columns, _, err := insertBillingCustomerColumns(&rows[0])
+	if err != nil {
+		return err
+	}
+
+	quotedColumns := make([]string, len(columns))
+	for i, column := range columns {
+		quotedColumns[i] = pgx.Identifier{column}.Sanitize()
+	}
+
+	// A statement can have at most 65535 parameters including the ordinality
+	// of each row.
+	batchSize := len(rows)
+	if batchSize > 65535/(len(columns)+1) {
+		batchSize = 65535 / (len(columns) + 1)
+	}
+
+	for start := 0; start < len(rows); start += batchSize {
+		end := start + batchSize
+		if end > len(rows) {
+			end = len(rows)
+		}
+		batch := rows[start:end]
+
+		args := pgx.QueryArgs(make([]interface{}, 0, len(batch)*(len(columns)+1)))
+		valueLists := make([]string, len(batch))
+		for i := range batch {
+			rowColumns, values, err := insertBillingCustomerColumns(&batch[i])
+			if err != nil {
+				return err
+			}
+			if !equalColumns(rowColumns, columns) {
+				return errors.Errorf("row %d does not set the same fields as row 0", start+i)
+			}
+
+			placeholders := make([]string, len(values)+1)
+			for j, v := range values {
+				placeholders[j] = args.Append(v) + "::" + insertManyBillingCustomerTypes[columns[j]]
+			}
+			placeholders[len(values)] = args.Append(int32(i)) + "::int4"
+			valueLists[i] = "(" + strings.Join(placeholders, ",") + ")"
+		}
+
+		// The rows are inserted in the order of their ordinality so they are
+		// returned in the order of batch.
+		sql := `insert into "billing"."customer"(` + strings.Join(quotedColumns, ", ") + `)
+select ` + strings.Join(quotedColumns, ", ") + `
+from (values` + strings.Join(valueLists, ",") + `) as input(` + strings.Join(append(quotedColumns, "pgxdata_ordinality"), ", ") + `)
+order by pgxdata_ordinality
+returning "id", "account_number", "credit_limit"`
+
+		// The SQL depends on the number of rows so it is not prepared, which
+		// would leave a prepared statement for every batch size.
+		dbRows, err := db.Query(ctx, sql, args...)
+		if err != nil {
+			return err
+		}
+
+		// PostgreSQL does not guarantee the order of the returned rows, but it
+		// returns them in the order they were inserted. A different number of rows
+		// means they cannot be matched to batch.
+		n := 0
+		for dbRows.Next() {
+			if n < len(batch) {
+				row := &batch[n]
+				if err := dbRows.Scan(&row.ID, &row.AccountNumber, &row.CreditLimit); err != nil {
+					dbRows.Close()
+					return err
+				}
+			}
+			n++
+		}
+
+		if dbRows.Err() != nil {
+			return dbRows.Err()
+		}
+		if n != len(batch) {
+			return errors.Errorf("inserted %d rows but %d rows were returned", len(batch), n)
+		}
+	}
+
+	return nil
+}
+
+// QueueSelectBillingCustomerByPK queues selecting the row by primary key in b.
+// The returned reader scans the row into dst or returns ErrNotFound if there
+// is no such row.
+func QueueSelectBillingCustomerByPK(b *pgx.Batch, id int32, dst *BillingCustomer) BatchReader {
+	args := []interface{}{id}
+	oids := []pgtype.OID{pgtype.Int4OID}
+
+	return queue(b, selectBillingCustomerByPKSQL, args, oids, func(results pgx.BatchResults) error {
+		err := results.QueryRowResults().Scan(
+			&dst.ID,
+			&dst.AccountNumber,
+			&dst.CreditLimit,
+		)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrNotFound
+		}
+		return err
+	})
+}
+
+// QueueInsertBillingCustomer queues inserting row in b. Like InsertBillingCustomer
+// the returned reader scans the persisted row into row.
+func QueueInsertBillingCustomer(b *pgx.Batch, row *BillingCustomer) BatchReader {
+	args := pgx.QueryArgs(make([]interface{}, 0, 3))
+	oids := make([]pgtype.OID, 0, 3)
+
+	var columns, values []string
+
+	if row.ID.Status != pgtype.Undefined {
+		columns = append(columns, `id`)
+		values = append(values, args.Append(&row.ID))
+		oids = append(oids, pgtype.Int4OID)
+	}
+	if row.AccountNumber.Status != pgtype.Undefined {
+		columns = append(columns, `account_number`)
+		values = append(values, args.Append(&row.AccountNumber))
+		oids = append(oids, pgtype.VarcharOID)
+	}
+	if row.CreditLimit.Status != pgtype.Undefined {
+		columns = append(columns, `credit_limit`)
+		values = append(values, args.Append(&row.CreditLimit))
+		oids = append(oids, pgtype.Int4OID)
+	}
+
+	insert := ` default values`
+	if len(columns) > 0 {
+		insert = `(` + strings.Join(columns, ", ") + `)
+values(` + strings.Join(values, ",") + `)`
+	}
+
+	sql := `insert into "billing"."customer"` + insert + `
+returning "id", "account_number", "credit_limit"`
+
+	return queue(b, sql, args, oids, func(results pgx.BatchResults) error {
+		return results.QueryRowResults().Scan(&row.ID, &row.AccountNumber, &row.CreditLimit)
+	})
+}
+
+// QueueUpdateBillingCustomer queues updating the row by primary key in b. Like
+// UpdateBillingCustomer the returned reader scans the persisted row into row
+// or returns ErrNotFound if there is no such row. Nothing is queued when
+// there is nothing to update.
+func QueueUpdateBillingCustomer(b *pgx.Batch, id int32, row *BillingCustomer) BatchReader {
+	sets := make([]string, 0, 3)
+	args := pgx.QueryArgs(make([]interface{}, 0, 3))
+	oids := make([]pgtype.OID, 0, 3)
+
+	if row.ID.Status != pgtype.Undefined {
+		sets = append(sets, `id`+"="+args.Append(&row.ID))
+		oids = append(oids, pgtype.Int4OID)
+	}
+	if row.AccountNumber.Status != pgtype.Undefined {
+		sets = append(sets, `account_number`+"="+args.Append(&row.AccountNumber))
+		oids = append(oids, pgtype.VarcharOID)
+	}
+	if row.CreditLimit.Status != pgtype.Undefined {
+		sets = append(sets, `credit_limit`+"="+args.Append(&row.CreditLimit))
+		oids = append(oids, pgtype.Int4OID)
+	}
+
+	if len(sets) == 0 {
+		return func(pgx.BatchResults) error { return nil }
+	}
+
+	sql := `update "billing"."customer" set ` + strings.Join(sets, ", ") + ` where ` + `"id"=` + args.Append(id) + `
+returning "id", "account_number", "credit_limit"`
+	oids = append(oids, pgtype.Int4OID)
+
+	return queue(b, sql, args, oids, func(results pgx.BatchResults) error {
+		err := results.QueryRowResults().Scan(&row.ID, &row.AccountNumber, &row.CreditLimit)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrNotFound
+		}
+		return err
+	})
+}
+
+// QueueDeleteBillingCustomer queues deleting the row by primary key in b. The
+// returned reader returns ErrNotFound if there is no such row.
+func QueueDeleteBillingCustomer(b *pgx.Batch, id int32) BatchReader {
+	args := pgx.QueryArgs(make([]interface{}, 0, 1))
+
+	sql := `delete from "billing"."customer" where ` + `"id"=` + args.Append(id)
+	oids := []pgtype.OID{pgtype.Int4OID}
+
+	return queue(b, sql, args, oids, func(results pgx.BatchResults) error {
+		commandTag, err := results.ExecResults()
+		if err != nil {
+			return err
+		}
+		if commandTag.RowsAffected() != 1 {
+			return ErrNotFound
+		}
+		return nil
+	})
+}
+
+func DeleteBillingCustomer(ctx context.Context, db Queryer,
+	id int32,
+) error {
+	args := pgx.QueryArgs(make([]interface{}, 0, 1))
+
+	sql := `delete from "billing"."customer" where ` + `"id"=` + args.Append(id)
+
+	commandTag, err := prepareExec(ctx, db, "pgxdataDeleteBillingCustomer", sql, args...)
+	if err != nil {
+		return err
+	}
+	if commandTag.RowsAffected() != 1 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+// BillingCustomerWhere has a filter for each column of "billing"."customer" that builds the conditions of
+// SelectBillingCustomerWhere, CountBillingCustomerWhere, UpdateBillingCustomerWhere and DeleteBillingCustomerWhere.
+var BillingCustomerWhere = struct {
+	ID            BillingCustomerIDFilter
+	AccountNumber BillingCustomerAccountNumberFilter
+	CreditLimit   BillingCustomerCreditLimitFilter
+}{
+	ID:            BillingCustomerIDFilter{columnFilter{`"id"`}},
+	AccountNumber: BillingCustomerAccountNumberFilter{columnFilter{`"account_number"`}},
+	CreditLimit:   BillingCustomerCreditLimitFilter{columnFilter{`"credit_limit"`}},
+}
+
+// BillingCustomerIDFilter builds conditions on id.
+type BillingCustomerIDFilter struct{ columnFilter }
+
+func (f BillingCustomerIDFilter) Eq(v int32) Condition { return f.compare(" = ", v) }
+func (f BillingCustomerIDFilter) Ne(v int32) Condition { return f.compare(" <> ", v) }
+func (f BillingCustomerIDFilter) Lt(v int32) Condition { return f.compare(" < ", v) }
+func (f BillingCustomerIDFilter) Le(v int32) Condition { return f.compare(" <= ", v) }
+func (f BillingCustomerIDFilter) Gt(v int32) Condition { return f.compare(" > ", v) }
+func (f BillingCustomerIDFilter) Ge(v int32) Condition { return f.compare(" >= ", v) }
+
+func (f BillingCustomerIDFilter) In(vs ...int32) Condition { return f.in(len(vs), vs) }
+
+// BillingCustomerAccountNumberFilter builds conditions on account_number.
+type BillingCustomerAccountNumberFilter struct{ columnFilter }
+
+func (f BillingCustomerAccountNumberFilter) Eq(v string) Condition { return f.compare(" = ", v) }
+func (f BillingCustomerAccountNumberFilter) Ne(v string) Condition { return f.compare(" <> ", v) }
+func (f BillingCustomerAccountNumberFilter) Lt(v string) Condition { return f.compare(" < ", v) }
+func (f BillingCustomerAccountNumberFilter) Le(v string) Condition { return f.compare(" <= ", v) }
+func (f BillingCustomerAccountNumberFilter) Gt(v string) Condition { return f.compare(" > ", v) }
+func (f BillingCustomerAccountNumberFilter) Ge(v string) Condition { return f.compare(" >= ", v) }
+
+func (f BillingCustomerAccountNumberFilter) In(vs ...string) Condition { return f.in(len(vs), vs) }
+
+// BillingCustomerCreditLimitFilter builds conditions on credit_limit.
+type BillingCustomerCreditLimitFilter struct{ columnFilter }
+
+func (f BillingCustomerCreditLimitFilter) Eq(v int32) Condition { return f.compare(" = ", v) }
+func (f BillingCustomerCreditLimitFilter) Ne(v int32) Condition { return f.compare(" <> ", v) }
+func (f BillingCustomerCreditLimitFilter) Lt(v int32) Condition { return f.compare(" < ", v) }
+func (f BillingCustomerCreditLimitFilter) Le(v int32) Condition { return f.compare(" <= ", v) }
+func (f BillingCustomerCreditLimitFilter) Gt(v int32) Condition { return f.compare(" > ", v) }
+func (f BillingCustomerCreditLimitFilter) Ge(v int32) Condition { return f.compare(" >= ", v) }
+
+func (f BillingCustomerCreditLimitFilter) In(vs ...int32) Condition { return f.in(len(vs), vs) }
+
+func SelectBillingCustomerWhere(ctx context.Context, db Queryer, where Condition) ([]BillingCustomer, error) {
+	var args pgx.QueryArgs
+	sql := SelectAllBillingCustomerSQL + `
+where ` + where.sql(&args)
+
+	dbRows, err := prepareQuery(ctx, db, preparedName("pgxdataSelectBillingCustomerWhere", sql), sql, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer dbRows.Close()
+
+	var rows []BillingCustomer
+	for dbRows.Next() {
+		var row BillingCustomer
+		err := dbRows.Scan(
+			&row.ID,
+			&row.AccountNumber,
+			&row.CreditLimit,
+		)
+		if err != nil {
+			return nil, err
+		}
+		rows = append(rows, row)
+	}
+
+	if dbRows.Err() != nil {
+		return nil, dbRows.Err()
+	}
+
+	return rows, nil
+}
+
+func CountBillingCustomerWhere(ctx context.Context, db Queryer, where Condition) (int64, error) {
+	var args pgx.QueryArgs
+	sql := countBillingCustomerSQL + ` where ` + where.sql(&args)
+
+	var n int64
+	err := prepareQueryRow(ctx, db, preparedName("pgxdataCountBillingCustomerWhere", sql), sql, args...).Scan(&n)
+	return n, err
+}
+
+// UpdateBillingCustomerWhere updates the rows matching where like UpdateBillingCustomer and returns the number of
+// rows updated. where must restrict the rows, so a Condition that matches every row like the zero Condition is
+// refused.
+func UpdateBillingCustomerWhere(ctx context.Context, db Queryer, where Condition, row *BillingCustomer) (int64, error) {
+	if where.write == nil {
+		return 0, errors.New("UpdateBillingCustomerWhere requires a condition")
+	}
+
+	sets, args, err := updateBillingCustomerSets(row)
+	if err != nil {
+		return 0, err
+	}
+	if len(sets) == 0 {
+		return 0, nil
+	}
+
+	sql := `update "billing"."customer" set ` + strings.Join(sets, ", ") + ` where ` + where.sql(&args)
+
+	commandTag, err := prepareExec(ctx, db, preparedName("pgxdataUpdateBillingCustomerWhere", sql), sql, args...)
+	if err != nil {
+		return 0, err
+	}
+	return commandTag.RowsAffected(), nil
+}
+
+// DeleteBillingCustomerWhere deletes the rows matching where and returns the number of rows deleted. where must
+// restrict the rows, so a Condition that matches every row like the zero Condition is refused.
+func DeleteBillingCustomerWhere(ctx context.Context, db Queryer, where Condition) (int64, error) {
+	if where.write == nil {
+		return 0, errors.New("DeleteBillingCustomerWhere requires a condition")
+	}
+
+	var args pgx.QueryArgs
+	sql := `delete from "billing"."customer" where ` + where.sql(&args)
+
+	commandTag, err := prepareExec(ctx, db, preparedName("pgxdataDeleteBillingCustomerWhere", sql), sql, args...)
+	if err != nil {
+		return 0, err
+	}
+	return commandTag.RowsAffected(), nil
+}
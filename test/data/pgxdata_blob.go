@@ -12,7 +12,9 @@ import (
 )
 
 type Blob struct {
-	ID      pgtype.Int4
+	// ID is integer not null default nextval('blob_id_seq'::regclass).
+	ID pgtype.Int4
+	// Payload is bytea not null.
 	Payload pgtype.Bytea
 }
 
@@ -32,25 +34,162 @@ from "blob"`
 func SelectAllBlob(ctx context.Context, db Queryer) ([]Blob, error) {
 	var rows []Blob
 
+	err := ForEachBlob(ctx, db, func(row *Blob) error {
+		rows = append(rows, *row)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return rows, nil
+}
+
+// BlobRows is a cursor over the rows of "blob" that
+// reads one row at a time.
+type BlobRows struct {
+	rows pgx.Rows
+	row  Blob
+	err  error
+}
+
+// QueryBlobRows selects all rows of "blob". The
+// returned rows must be closed.
+func QueryBlobRows(ctx context.Context, db Queryer) (*BlobRows, error) {
 	dbRows, err := prepareQuery(ctx, db, "pgxdataSelectAllBlob", SelectAllBlobSQL)
 	if err != nil {
 		return nil, err
 	}
 
+	return &BlobRows{rows: dbRows}, nil
+}
+
+// Next reads the next row. It returns false when there are no more rows or an
+// error occurred.
+func (r *BlobRows) Next() bool {
+	if r.err != nil || !r.rows.Next() {
+		return false
+	}
+
+	r.row = Blob{}
+	r.err = r.rows.Scan(
+		&r.row.ID,
+		&r.row.Payload,
+	)
+	if r.err != nil {
+		r.rows.Close()
+		return false
+	}
+
+	return true
+}
+
+// Row returns the current row. It is overwritten by the next call to Next.
+func (r *BlobRows) Row() *Blob {
+	return &r.row
+}
+
+// Err returns the error, if any, that stopped Next.
+func (r *BlobRows) Err() error {
+	if r.err != nil {
+		return r.err
+	}
+	return r.rows.Err()
+}
+
+// Close closes the rows. It is safe to call Close after all rows have been
+// read.
+func (r *BlobRows) Close() {
+	r.rows.Close()
+}
+
+// ForEachBlob calls fn with each row of "blob". The
+// row passed to fn is reused for the next row. If fn returns an error the
+// remaining rows are skipped and the error is returned.
+func ForEachBlob(ctx context.Context, db Queryer, fn func(*Blob) error) error {
+	rows, err := QueryBlobRows(ctx, db)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		err := fn(rows.Row())
+		if err != nil {
+			return err
+		}
+	}
+
+	return rows.Err()
+}
+
+// BlobPageCursor is the position after the last row of a
+// page returned by SelectBlobPage.
+type BlobPageCursor struct {
+	ID int32
+}
+
+const selectBlobPageSQL = `select
+  "id",
+  "payload"
+from "blob"
+order by "id"
+limit $1`
+
+const selectBlobPageAfterSQL = `select
+  "id",
+  "payload"
+from "blob"
+where "id" > $1
+order by "id"
+limit $2`
+
+// SelectBlobPage selects up to limit rows that follow
+// after, or the first rows when after is nil, ordered by
+// "id". The returned cursor selects the next page. It is nil
+// when there are no more rows.
+func SelectBlobPage(ctx context.Context, db Queryer, after *BlobPageCursor, limit int) ([]Blob, *BlobPageCursor, error) {
+	var dbRows pgx.Rows
+	var err error
+	if after == nil {
+		dbRows, err = prepareQuery(ctx, db, "pgxdataSelectBlobPage", selectBlobPageSQL, limit)
+	} else {
+		dbRows, err = prepareQuery(ctx, db, "pgxdataSelectBlobPageAfter", selectBlobPageAfterSQL, after.ID, limit)
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	defer dbRows.Close()
+
+	var rows []Blob
 	for dbRows.Next() {
 		var row Blob
-		dbRows.Scan(
+		err := dbRows.Scan(
 			&row.ID,
 			&row.Payload,
 		)
+		if err != nil {
+			return nil, nil, err
+		}
 		rows = append(rows, row)
 	}
 
 	if dbRows.Err() != nil {
-		return nil, dbRows.Err()
+		return nil, nil, dbRows.Err()
 	}
 
-	return rows, nil
+	if len(rows) == 0 || len(rows) < limit {
+		return rows, nil, nil
+	}
+
+	last := &rows[len(rows)-1]
+	next := &BlobPageCursor{}
+	err = last.ID.AssignTo(&next.ID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return rows, next, nil
 }
 
 const selectBlobByPKSQL = `select
@@ -92,20 +231,24 @@ func InsertBlob(ctx context.Context, db Queryer, row *Blob) error {
 		values = append(values, args.Append(&row.Payload))
 	}
 
-	sql := `insert into "blob"(` + strings.Join(columns, ", ") + `)
-values(` + strings.Join(values, ",") + `)
-returning "id"
+	insert := ` default values`
+	if len(columns) > 0 {
+		insert = `(` + strings.Join(columns, ", ") + `)
+values(` + strings.Join(values, ",") + `)`
+	}
+
+	sql := `insert into "blob"` + insert + `
+returning "id", "payload"
   `
 
 	psName := preparedName("pgxdataInsertBlob", sql)
 
-	return prepareQueryRow(ctx, db, psName, sql, args...).Scan(&row.ID)
+	return prepareQueryRow(ctx, db, psName, sql, args...).Scan(&row.ID, &row.Payload)
 }
 
-func UpdateBlob(ctx context.Context, db Queryer,
-	id int32,
-	row *Blob,
-) error {
+// updateBlobSets returns the assignments of the SET clause for the fields of row to update and their
+// arguments.
+func updateBlobSets(row *Blob) ([]string, pgx.QueryArgs, error) {
 	sets := make([]string, 0, 2)
 	args := pgx.QueryArgs(make([]interface{}, 0, 2))
 
@@ -116,24 +259,394 @@ func UpdateBlob(ctx context.Context, db Queryer,
 		sets = append(sets, `payload`+"="+args.Append(&row.Payload))
 	}
 
+	return sets, args, nil
+}
+
+func UpdateBlob(ctx context.Context, db Queryer,
+	id int32,
+	row *Blob,
+) error {
+	sets, args, err := updateBlobSets(row)
+	if err != nil {
+		return err
+	}
 	if len(sets) == 0 {
 		return nil
 	}
 
-	sql := `update "blob" set ` + strings.Join(sets, ", ") + ` where ` + `"id"=` + args.Append(id)
+	sql := `update "blob" set ` + strings.Join(sets, ", ") + ` where ` + `"id"=` + args.Append(id) + `
+returning "id", "payload"`
 
 	psName := preparedName("pgxdataUpdateBlob", sql)
 
-	commandTag, err := prepareExec(ctx, db, psName, sql, args...)
+	err = prepareQueryRow(ctx, db, psName, sql, args...).Scan(&row.ID, &row.Payload)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return ErrNotFound
+	}
+	return err
+}
+
+// BlobConflict identifies the primary key or unique index UpsertBlob
+// detects a conflicting row by.
+type BlobConflict int
+
+const (
+	BlobConflictOnPK BlobConflict = iota
+)
+
+// BlobUpsertOptions configures UpsertBlob.
+type BlobUpsertOptions struct {
+	// Conflict is the primary key or unique index a conflicting row is detected
+	// by. The primary key is the default.
+	Conflict BlobConflict
+
+	// DoNothing leaves a conflicting row unchanged instead of updating it.
+	DoNothing bool
+}
+
+// UpsertBlob inserts row or, when it conflicts with an existing row,
+// updates the existing row with the fields of row that are not Undefined other than
+// those of the conflict target.
+// Like InsertBlob the persisted row is scanned into row. Nothing is
+// scanned when a conflicting row is left unchanged.
+func UpsertBlob(ctx context.Context, db Queryer, row *Blob, opts BlobUpsertOptions) (UpsertResult, error) {
+	args := pgx.QueryArgs(make([]interface{}, 0, 2))
+
+	var columns, values []string
+
+	if row.ID.Status != pgtype.Undefined {
+		columns = append(columns, `"id"`)
+		values = append(values, args.Append(&row.ID))
+	}
+	if row.Payload.Status != pgtype.Undefined {
+		columns = append(columns, `"payload"`)
+		values = append(values, args.Append(&row.Payload))
+	}
+
+	var targetColumns []string
+	switch opts.Conflict {
+	case BlobConflictOnPK:
+		targetColumns = []string{`"id"`}
+	default:
+		return UpsertUnchanged, errors.Errorf("unknown BlobConflict %d", opts.Conflict)
+	}
+
+	// The conflicting row is updated with the columns other than the conflict
+	// target. It is left unchanged when there are none.
+	sets := make([]string, 0, len(columns))
+nextColumn:
+	for _, c := range columns {
+		for _, tc := range targetColumns {
+			if c == tc {
+				continue nextColumn
+			}
+		}
+		sets = append(sets, c+"=excluded."+c)
+	}
+
+	action := `do update set ` + strings.Join(sets, ", ")
+	if opts.DoNothing || len(sets) == 0 {
+		action = `do nothing`
+	}
+
+	insert := ` default values`
+	if len(columns) > 0 {
+		insert = `(` + strings.Join(columns, ", ") + `)
+values(` + strings.Join(values, ",") + `)`
+	}
+
+	sql := `insert into "blob"` + insert + `
+on conflict (` + strings.Join(targetColumns, ", ") + `) ` + action + `
+returning xmax = 0, "id", "payload"
+  `
+
+	psName := preparedName("pgxdataUpsertBlob", sql)
+
+	var inserted bool
+	err := prepareQueryRow(ctx, db, psName, sql, args...).Scan(&inserted, &row.ID, &row.Payload)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return UpsertUnchanged, nil
+	} else if err != nil {
+		return UpsertUnchanged, err
+	}
+
+	if inserted {
+		return UpsertInserted, nil
+	}
+	return UpsertUpdated, nil
+}
+
+// insertBlobColumns returns the columns of row that are not Undefined and their
+// values for inserting row.
+func insertBlobColumns(row *Blob) ([]string, []interface{}, error) {
+	var columns []string
+	var values []interface{}
+
+	if row.ID.Status != pgtype.Undefined {
+		columns = append(columns, `id`)
+		values = append(values, &row.ID)
+	}
+	if row.Payload.Status != pgtype.Undefined {
+		columns = append(columns, `payload`)
+		values = append(values, &row.Payload)
+	}
+
+	return columns, values, nil
+}
+
+// CopyInsertBlob inserts rows with the PostgreSQL copy protocol and
+// returns the number of rows copied. The columns copied are those not
+// Undefined in the first row and every row must set the same fields. Values
+// must support the binary format.
+func CopyInsertBlob(ctx context.Context, db CopyFromer, rows []Blob) (int64, error) {
+	if len(rows) == 0 {
+		return 0, nil
+	}
+
+	columns, _, err := insertBlobColumns(&rows[0])
+	if err != nil {
+		return 0, err
+	}
+
+	return db.CopyFrom(ctx, pgx.Identifier{"blob"}, columns, &copyFromBlobSource{rows: rows, columns: columns, idx: -1})
+}
+
+// copyFromBlobSource is a pgx.CopyFromSource of the columns of rows.
+type copyFromBlobSource struct {
+	rows    []Blob
+	columns []string
+	idx     int
+}
+
+func (s *copyFromBlobSource) Next() bool {
+	s.idx++
+	return s.idx < len(s.rows)
+}
+
+func (s *copyFromBlobSource) Values() ([]interface{}, error) {
+	columns, values, err := insertBlobColumns(&s.rows[s.idx])
+	if err != nil {
+		return nil, err
+	}
+	if !equalColumns(columns, s.columns) {
+		return nil, errors.Errorf("row %d does not set the same fields as row 0", s.idx)
+	}
+	return values, nil
+}
+
+func (s *copyFromBlobSource) Err() error {
+	return nil
+}
+
+// insertManyBlobTypes are the types the parameters of
+// InsertManyBlob are cast to. PostgreSQL only infers them for the
+// VALUES list of an INSERT, not for one that is selected from.
+var insertManyBlobTypes = map[string]string{
+	`id`:      `"pg_catalog"."int4"`,
+	`payload`: `"pg_catalog"."bytea"`,
+}
+
+// InsertManyBlob inserts rows with multi-row INSERT statements for
+// a Queryer that does not support the copy protocol or for columns without a
+// binary format. The columns inserted are those not Undefined in the
+// first row and every row must set the same fields. Like InsertBlob
+// the persisted rows are scanned into rows.
+func InsertManyBlob(ctx context.Context, db Queryer, rows []Blob) error {
+	if len(rows) == 0 {
+		return nil
+	}
+
+	columns, _, err := insertBlobColumns(&rows[0])
 	if err != nil {
 		return err
 	}
-	if commandTag.RowsAffected() != 1 {
-		return ErrNotFound
+
+	quotedColumns := make([]string, len(columns))
+	for i, column := range columns {
+		quotedColumns[i] = pgx.Identifier{column}.Sanitize()
+	}
+
+	// A statement can have at most 65535 parameters including the ordinality
+	// of each row.
+	batchSize := len(rows)
+	if batchSize > 65535/(len(columns)+1) {
+		batchSize = 65535 / (len(columns) + 1)
+	}
+
+	for start := 0; start < len(rows); start += batchSize {
+		end := start + batchSize
+		if end > len(rows) {
+			end = len(rows)
+		}
+		batch := rows[start:end]
+
+		args := pgx.QueryArgs(make([]interface{}, 0, len(batch)*(len(columns)+1)))
+		valueLists := make([]string, len(batch))
+		for i := range batch {
+			rowColumns, values, err := insertBlobColumns(&batch[i])
+			if err != nil {
+				return err
+			}
+			if !equalColumns(rowColumns, columns) {
+				return errors.Errorf("row %d does not set the same fields as row 0", start+i)
+			}
+
+			placeholders := make([]string, len(values)+1)
+			for j, v := range values {
+				placeholders[j] = args.Append(v) + "::" + insertManyBlobTypes[columns[j]]
+			}
+			placeholders[len(values)] = args.Append(int32(i)) + "::int4"
+			valueLists[i] = "(" + strings.Join(placeholders, ",") + ")"
+		}
+
+		// The rows are inserted in the order of their ordinality so they are
+		// returned in the order of batch.
+		sql := `insert into "blob"(` + strings.Join(quotedColumns, ", ") + `)
+select ` + strings.Join(quotedColumns, ", ") + `
+from (values` + strings.Join(valueLists, ",") + `) as input(` + strings.Join(append(quotedColumns, "pgxdata_ordinality"), ", ") + `)
+order by pgxdata_ordinality
+returning "id", "payload"`
+
+		// The SQL depends on the number of rows so it is not prepared, which
+		// would leave a prepared statement for every batch size.
+		dbRows, err := db.Query(ctx, sql, args...)
+		if err != nil {
+			return err
+		}
+
+		// PostgreSQL does not guarantee the order of the returned rows, but it
+		// returns them in the order they were inserted. A different number of rows
+		// means they cannot be matched to batch.
+		n := 0
+		for dbRows.Next() {
+			if n < len(batch) {
+				row := &batch[n]
+				if err := dbRows.Scan(&row.ID, &row.Payload); err != nil {
+					dbRows.Close()
+					return err
+				}
+			}
+			n++
+		}
+
+		if dbRows.Err() != nil {
+			return dbRows.Err()
+		}
+		if n != len(batch) {
+			return errors.Errorf("inserted %d rows but %d rows were returned", len(batch), n)
+		}
 	}
+
 	return nil
 }
 
+// QueueSelectBlobByPK queues selecting the row by primary key in b.
+// The returned reader scans the row into dst or returns ErrNotFound if there
+// is no such row.
+func QueueSelectBlobByPK(b *pgx.Batch, id int32, dst *Blob) BatchReader {
+	args := []interface{}{id}
+	oids := []pgtype.OID{pgtype.Int4OID}
+
+	return queue(b, selectBlobByPKSQL, args, oids, func(results pgx.BatchResults) error {
+		err := results.QueryRowResults().Scan(
+			&dst.ID,
+			&dst.Payload,
+		)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrNotFound
+		}
+		return err
+	})
+}
+
+// QueueInsertBlob queues inserting row in b. Like InsertBlob
+// the returned reader scans the persisted row into row.
+func QueueInsertBlob(b *pgx.Batch, row *Blob) BatchReader {
+	args := pgx.QueryArgs(make([]interface{}, 0, 2))
+	oids := make([]pgtype.OID, 0, 2)
+
+	var columns, values []string
+
+	if row.ID.Status != pgtype.Undefined {
+		columns = append(columns, `id`)
+		values = append(values, args.Append(&row.ID))
+		oids = append(oids, pgtype.Int4OID)
+	}
+	if row.Payload.Status != pgtype.Undefined {
+		columns = append(columns, `payload`)
+		values = append(values, args.Append(&row.Payload))
+		oids = append(oids, pgtype.ByteaOID)
+	}
+
+	insert := ` default values`
+	if len(columns) > 0 {
+		insert = `(` + strings.Join(columns, ", ") + `)
+values(` + strings.Join(values, ",") + `)`
+	}
+
+	sql := `insert into "blob"` + insert + `
+returning "id", "payload"`
+
+	return queue(b, sql, args, oids, func(results pgx.BatchResults) error {
+		return results.QueryRowResults().Scan(&row.ID, &row.Payload)
+	})
+}
+
+// QueueUpdateBlob queues updating the row by primary key in b. Like
+// UpdateBlob the returned reader scans the persisted row into row
+// or returns ErrNotFound if there is no such row. Nothing is queued when
+// there is nothing to update.
+func QueueUpdateBlob(b *pgx.Batch, id int32, row *Blob) BatchReader {
+	sets := make([]string, 0, 2)
+	args := pgx.QueryArgs(make([]interface{}, 0, 2))
+	oids := make([]pgtype.OID, 0, 2)
+
+	if row.ID.Status != pgtype.Undefined {
+		sets = append(sets, `id`+"="+args.Append(&row.ID))
+		oids = append(oids, pgtype.Int4OID)
+	}
+	if row.Payload.Status != pgtype.Undefined {
+		sets = append(sets, `payload`+"="+args.Append(&row.Payload))
+		oids = append(oids, pgtype.ByteaOID)
+	}
+
+	if len(sets) == 0 {
+		return func(pgx.BatchResults) error { return nil }
+	}
+
+	sql := `update "blob" set ` + strings.Join(sets, ", ") + ` where ` + `"id"=` + args.Append(id) + `
+returning "id", "payload"`
+	oids = append(oids, pgtype.Int4OID)
+
+	return queue(b, sql, args, oids, func(results pgx.BatchResults) error {
+		err := results.QueryRowResults().Scan(&row.ID, &row.Payload)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrNotFound
+		}
+		return err
+	})
+}
+
+// QueueDeleteBlob queues deleting the row by primary key in b. The
+// returned reader returns ErrNotFound if there is no such row.
+func QueueDeleteBlob(b *pgx.Batch, id int32) BatchReader {
+	args := pgx.QueryArgs(make([]interface{}, 0, 1))
+
+	sql := `delete from "blob" where ` + `"id"=` + args.Append(id)
+	oids := []pgtype.OID{pgtype.Int4OID}
+
+	return queue(b, sql, args, oids, func(results pgx.BatchResults) error {
+		commandTag, err := results.ExecResults()
+		if err != nil {
+			return err
+		}
+		if commandTag.RowsAffected() != 1 {
+			return ErrNotFound
+		}
+		return nil
+	})
+}
+
 func DeleteBlob(ctx context.Context, db Queryer,
 	id int32,
 ) error {
@@ -150,3 +663,119 @@ func DeleteBlob(ctx context.Context, db Queryer,
 	}
 	return nil
 }
+
+// BlobWhere has a filter for each column of "blob" that builds the conditions of
+// SelectBlobWhere, CountBlobWhere, UpdateBlobWhere and DeleteBlobWhere.
+var BlobWhere = struct {
+	ID      BlobIDFilter
+	Payload BlobPayloadFilter
+}{
+	ID:      BlobIDFilter{columnFilter{`"id"`}},
+	Payload: BlobPayloadFilter{columnFilter{`"payload"`}},
+}
+
+// BlobIDFilter builds conditions on id.
+type BlobIDFilter struct{ columnFilter }
+
+func (f BlobIDFilter) Eq(v int32) Condition { return f.compare(" = ", v) }
+func (f BlobIDFilter) Ne(v int32) Condition { return f.compare(" <> ", v) }
+func (f BlobIDFilter) Lt(v int32) Condition { return f.compare(" < ", v) }
+func (f BlobIDFilter) Le(v int32) Condition { return f.compare(" <= ", v) }
+func (f BlobIDFilter) Gt(v int32) Condition { return f.compare(" > ", v) }
+func (f BlobIDFilter) Ge(v int32) Condition { return f.compare(" >= ", v) }
+
+func (f BlobIDFilter) In(vs ...int32) Condition { return f.in(len(vs), vs) }
+
+// BlobPayloadFilter builds conditions on payload.
+type BlobPayloadFilter struct{ columnFilter }
+
+func (f BlobPayloadFilter) Eq(v []byte) Condition { return f.compare(" = ", v) }
+func (f BlobPayloadFilter) Ne(v []byte) Condition { return f.compare(" <> ", v) }
+func (f BlobPayloadFilter) Lt(v []byte) Condition { return f.compare(" < ", v) }
+func (f BlobPayloadFilter) Le(v []byte) Condition { return f.compare(" <= ", v) }
+func (f BlobPayloadFilter) Gt(v []byte) Condition { return f.compare(" > ", v) }
+func (f BlobPayloadFilter) Ge(v []byte) Condition { return f.compare(" >= ", v) }
+
+func (f BlobPayloadFilter) In(vs ...[]byte) Condition { return f.in(len(vs), vs) }
+
+func SelectBlobWhere(ctx context.Context, db Queryer, where Condition) ([]Blob, error) {
+	var args pgx.QueryArgs
+	sql := SelectAllBlobSQL + `
+where ` + where.sql(&args)
+
+	dbRows, err := prepareQuery(ctx, db, preparedName("pgxdataSelectBlobWhere", sql), sql, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer dbRows.Close()
+
+	var rows []Blob
+	for dbRows.Next() {
+		var row Blob
+		err := dbRows.Scan(
+			&row.ID,
+			&row.Payload,
+		)
+		if err != nil {
+			return nil, err
+		}
+		rows = append(rows, row)
+	}
+
+	if dbRows.Err() != nil {
+		return nil, dbRows.Err()
+	}
+
+	return rows, nil
+}
+
+func CountBlobWhere(ctx context.Context, db Queryer, where Condition) (int64, error) {
+	var args pgx.QueryArgs
+	sql := countBlobSQL + ` where ` + where.sql(&args)
+
+	var n int64
+	err := prepareQueryRow(ctx, db, preparedName("pgxdataCountBlobWhere", sql), sql, args...).Scan(&n)
+	return n, err
+}
+
+// UpdateBlobWhere updates the rows matching where like UpdateBlob and returns the number of
+// rows updated. where must restrict the rows, so a Condition that matches every row like the zero Condition is
+// refused.
+func UpdateBlobWhere(ctx context.Context, db Queryer, where Condition, row *Blob) (int64, error) {
+	if where.write == nil {
+		return 0, errors.New("UpdateBlobWhere requires a condition")
+	}
+
+	sets, args, err := updateBlobSets(row)
+	if err != nil {
+		return 0, err
+	}
+	if len(sets) == 0 {
+		return 0, nil
+	}
+
+	sql := `update "blob" set ` + strings.Join(sets, ", ") + ` where ` + where.sql(&args)
+
+	commandTag, err := prepareExec(ctx, db, preparedName("pgxdataUpdateBlobWhere", sql), sql, args...)
+	if err != nil {
+		return 0, err
+	}
+	return commandTag.RowsAffected(), nil
+}
+
+// DeleteBlobWhere deletes the rows matching where and returns the number of rows deleted. where must
+// restrict the rows, so a Condition that matches every row like the zero Condition is refused.
+func DeleteBlobWhere(ctx context.Context, db Queryer, where Condition) (int64, error) {
+	if where.write == nil {
+		return 0, errors.New("DeleteBlobWhere requires a condition")
+	}
+
+	var args pgx.QueryArgs
+	sql := `delete from "blob" where ` + where.sql(&args)
+
+	commandTag, err := prepareExec(ctx, db, preparedName("pgxdataDeleteBlobWhere", sql), sql, args...)
+	if err != nil {
+		return 0, err
+	}
+	return commandTag.RowsAffected(), nil
+}
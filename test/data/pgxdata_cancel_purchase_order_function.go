@@ -0,0 +1,36 @@
+package data
+
+// This file is automatically generated by pgxdata.
+
+import (
+	"context"
+
+	"github.com/jackc/pgtype"
+	"github.com/jackc/pgx/v4"
+	errors "golang.org/x/xerrors"
+)
+
+const cancelPurchaseOrderSQL = `call "cancel_purchase_order"($1, null, null)`
+
+// CancelPurchaseOrderRow is a row returned by CancelPurchaseOrder.
+type CancelPurchaseOrderRow struct {
+	OldStatus   OrderStatusBox
+	CancelledOn pgtype.Date
+}
+
+// CancelPurchaseOrder calls cancel_purchase_order and returns its row. It returns
+// ErrNotFound when there is no row.
+func CancelPurchaseOrder(ctx context.Context, db Queryer, orderID int32) (*CancelPurchaseOrderRow, error) {
+	var row CancelPurchaseOrderRow
+	err := prepareQueryRow(ctx, db, preparedName("pgxdataCancelPurchaseOrder", cancelPurchaseOrderSQL), cancelPurchaseOrderSQL, pgx.QueryResultFormats{pgx.TextFormatCode, pgx.BinaryFormatCode}, orderID).Scan(
+		&row.OldStatus,
+		&row.CancelledOn,
+	)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrNotFound
+	} else if err != nil {
+		return nil, err
+	}
+
+	return &row, nil
+}
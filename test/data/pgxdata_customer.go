@@ -9,14 +9,24 @@ import (
 	"github.com/jackc/pgtype"
 	"github.com/jackc/pgx/v4"
 	errors "golang.org/x/xerrors"
+	"time"
 )
 
 type Customer struct {
-	ID           pgtype.Int4
-	FirstName    pgtype.Varchar
-	LastName     pgtype.Varchar
-	BirthDate    pgtype.Date
+	// ID is integer not null default nextval('customer_id_seq'::regclass).
+	ID pgtype.Int4
+	// FirstName is character varying not null.
+	FirstName pgtype.Varchar
+	// LastName is character varying not null.
+	LastName pgtype.Varchar
+	// BirthDate is date.
+	BirthDate pgtype.Date
+	// CreationTime is timestamp with time zone not null default now().
 	CreationTime pgtype.Timestamptz
+	// Email is email_address.
+	Email pgtype.Text
+	// Address is address.
+	Address AddressBox
 }
 
 const countCustomerSQL = `select count(*) from "customer"`
@@ -32,34 +42,284 @@ const SelectAllCustomerSQL = `select
   "first_name",
   "last_name",
   "birth_date",
-  "creation_time"
+  "creation_time",
+  "email",
+  "address"::text
 from "customer"`
 
 func SelectAllCustomer(ctx context.Context, db Queryer) ([]Customer, error) {
 	var rows []Customer
 
+	err := ForEachCustomer(ctx, db, func(row *Customer) error {
+		rows = append(rows, *row)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return rows, nil
+}
+
+// CustomerRows is a cursor over the rows of "customer" that
+// reads one row at a time.
+type CustomerRows struct {
+	rows pgx.Rows
+	row  Customer
+	err  error
+}
+
+// QueryCustomerRows selects all rows of "customer". The
+// returned rows must be closed.
+func QueryCustomerRows(ctx context.Context, db Queryer) (*CustomerRows, error) {
 	dbRows, err := prepareQuery(ctx, db, "pgxdataSelectAllCustomer", SelectAllCustomerSQL)
 	if err != nil {
 		return nil, err
 	}
 
+	return &CustomerRows{rows: dbRows}, nil
+}
+
+// Next reads the next row. It returns false when there are no more rows or an
+// error occurred.
+func (r *CustomerRows) Next() bool {
+	if r.err != nil || !r.rows.Next() {
+		return false
+	}
+
+	r.row = Customer{}
+	r.err = r.rows.Scan(
+		&r.row.ID,
+		&r.row.FirstName,
+		&r.row.LastName,
+		&r.row.BirthDate,
+		&r.row.CreationTime,
+		&r.row.Email,
+		&r.row.Address,
+	)
+	if r.err != nil {
+		r.rows.Close()
+		return false
+	}
+
+	return true
+}
+
+// Row returns the current row. It is overwritten by the next call to Next.
+func (r *CustomerRows) Row() *Customer {
+	return &r.row
+}
+
+// Err returns the error, if any, that stopped Next.
+func (r *CustomerRows) Err() error {
+	if r.err != nil {
+		return r.err
+	}
+	return r.rows.Err()
+}
+
+// Close closes the rows. It is safe to call Close after all rows have been
+// read.
+func (r *CustomerRows) Close() {
+	r.rows.Close()
+}
+
+// ForEachCustomer calls fn with each row of "customer". The
+// row passed to fn is reused for the next row. If fn returns an error the
+// remaining rows are skipped and the error is returned.
+func ForEachCustomer(ctx context.Context, db Queryer, fn func(*Customer) error) error {
+	rows, err := QueryCustomerRows(ctx, db)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		err := fn(rows.Row())
+		if err != nil {
+			return err
+		}
+	}
+
+	return rows.Err()
+}
+
+// CustomerPageCursor is the position after the last row of a
+// page returned by SelectCustomerPage.
+type CustomerPageCursor struct {
+	ID int32
+}
+
+const selectCustomerPageSQL = `select
+  "id",
+  "first_name",
+  "last_name",
+  "birth_date",
+  "creation_time",
+  "email",
+  "address"::text
+from "customer"
+order by "id"
+limit $1`
+
+const selectCustomerPageAfterSQL = `select
+  "id",
+  "first_name",
+  "last_name",
+  "birth_date",
+  "creation_time",
+  "email",
+  "address"::text
+from "customer"
+where "id" > $1
+order by "id"
+limit $2`
+
+// SelectCustomerPage selects up to limit rows that follow
+// after, or the first rows when after is nil, ordered by
+// "id". The returned cursor selects the next page. It is nil
+// when there are no more rows.
+func SelectCustomerPage(ctx context.Context, db Queryer, after *CustomerPageCursor, limit int) ([]Customer, *CustomerPageCursor, error) {
+	var dbRows pgx.Rows
+	var err error
+	if after == nil {
+		dbRows, err = prepareQuery(ctx, db, "pgxdataSelectCustomerPage", selectCustomerPageSQL, limit)
+	} else {
+		dbRows, err = prepareQuery(ctx, db, "pgxdataSelectCustomerPageAfter", selectCustomerPageAfterSQL, after.ID, limit)
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	defer dbRows.Close()
+
+	var rows []Customer
 	for dbRows.Next() {
 		var row Customer
-		dbRows.Scan(
+		err := dbRows.Scan(
 			&row.ID,
 			&row.FirstName,
 			&row.LastName,
 			&row.BirthDate,
 			&row.CreationTime,
+			&row.Email,
+			&row.Address,
 		)
+		if err != nil {
+			return nil, nil, err
+		}
 		rows = append(rows, row)
 	}
 
 	if dbRows.Err() != nil {
-		return nil, dbRows.Err()
+		return nil, nil, dbRows.Err()
 	}
 
-	return rows, nil
+	if len(rows) == 0 || len(rows) < limit {
+		return rows, nil, nil
+	}
+
+	last := &rows[len(rows)-1]
+	next := &CustomerPageCursor{}
+	err = last.ID.AssignTo(&next.ID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return rows, next, nil
+}
+
+// CustomerPageByLastNameFirstNameCursor is the position after the last row of a
+// page returned by SelectCustomerPageByLastNameFirstName.
+type CustomerPageByLastNameFirstNameCursor struct {
+	LastName  string
+	FirstName string
+	ID        int32
+}
+
+const selectCustomerPageLastNameFirstNameSQL = `select
+  "id",
+  "first_name",
+  "last_name",
+  "birth_date",
+  "creation_time",
+  "email",
+  "address"::text
+from "customer"
+order by "last_name", "first_name", "id"
+limit $1`
+
+const selectCustomerPageLastNameFirstNameAfterSQL = `select
+  "id",
+  "first_name",
+  "last_name",
+  "birth_date",
+  "creation_time",
+  "email",
+  "address"::text
+from "customer"
+where ("last_name", "first_name", "id") > ($1, $2, $3)
+order by "last_name", "first_name", "id"
+limit $4`
+
+// SelectCustomerPageByLastNameFirstName selects up to limit rows that follow
+// after, or the first rows when after is nil, ordered by
+// "last_name", "first_name", "id". The returned cursor selects the next page. It is nil
+// when there are no more rows.
+func SelectCustomerPageByLastNameFirstName(ctx context.Context, db Queryer, after *CustomerPageByLastNameFirstNameCursor, limit int) ([]Customer, *CustomerPageByLastNameFirstNameCursor, error) {
+	var dbRows pgx.Rows
+	var err error
+	if after == nil {
+		dbRows, err = prepareQuery(ctx, db, "pgxdataSelectCustomerPageLastNameFirstName", selectCustomerPageLastNameFirstNameSQL, limit)
+	} else {
+		dbRows, err = prepareQuery(ctx, db, "pgxdataSelectCustomerPageLastNameFirstNameAfter", selectCustomerPageLastNameFirstNameAfterSQL, after.LastName, after.FirstName, after.ID, limit)
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	defer dbRows.Close()
+
+	var rows []Customer
+	for dbRows.Next() {
+		var row Customer
+		err := dbRows.Scan(
+			&row.ID,
+			&row.FirstName,
+			&row.LastName,
+			&row.BirthDate,
+			&row.CreationTime,
+			&row.Email,
+			&row.Address,
+		)
+		if err != nil {
+			return nil, nil, err
+		}
+		rows = append(rows, row)
+	}
+
+	if dbRows.Err() != nil {
+		return nil, nil, dbRows.Err()
+	}
+
+	if len(rows) == 0 || len(rows) < limit {
+		return rows, nil, nil
+	}
+
+	last := &rows[len(rows)-1]
+	next := &CustomerPageByLastNameFirstNameCursor{}
+	err = last.LastName.AssignTo(&next.LastName)
+	if err != nil {
+		return nil, nil, err
+	}
+	err = last.FirstName.AssignTo(&next.FirstName)
+	if err != nil {
+		return nil, nil, err
+	}
+	err = last.ID.AssignTo(&next.ID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return rows, next, nil
 }
 
 const selectCustomerByPKSQL = `select
@@ -67,7 +327,9 @@ const selectCustomerByPKSQL = `select
   "first_name",
   "last_name",
   "birth_date",
-  "creation_time"
+  "creation_time",
+  "email",
+  "address"::text
 from "customer"
 where "id"=$1`
 
@@ -83,6 +345,43 @@ func SelectCustomerByPK(
 		&row.LastName,
 		&row.BirthDate,
 		&row.CreationTime,
+		&row.Email,
+		&row.Address,
+	)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrNotFound
+	} else if err != nil {
+		return nil, err
+	}
+
+	return &row, nil
+}
+
+const selectCustomerByEmailSQL = `select
+  "id",
+  "first_name",
+  "last_name",
+  "birth_date",
+  "creation_time",
+  "email",
+  "address"::text
+from "customer"
+where "email"=$1`
+
+func SelectCustomerByEmail(
+	ctx context.Context,
+	db Queryer,
+	email string,
+) (*Customer, error) {
+	var row Customer
+	err := prepareQueryRow(ctx, db, "pgxdataSelectCustomerByEmail", selectCustomerByEmailSQL, email).Scan(
+		&row.ID,
+		&row.FirstName,
+		&row.LastName,
+		&row.BirthDate,
+		&row.CreationTime,
+		&row.Email,
+		&row.Address,
 	)
 	if errors.Is(err, pgx.ErrNoRows) {
 		return nil, ErrNotFound
@@ -94,7 +393,7 @@ func SelectCustomerByPK(
 }
 
 func InsertCustomer(ctx context.Context, db Queryer, row *Customer) error {
-	args := pgx.QueryArgs(make([]interface{}, 0, 5))
+	args := pgx.QueryArgs(make([]interface{}, 0, 7))
 
 	var columns, values []string
 
@@ -118,23 +417,35 @@ func InsertCustomer(ctx context.Context, db Queryer, row *Customer) error {
 		columns = append(columns, `creation_time`)
 		values = append(values, args.Append(&row.CreationTime))
 	}
+	if row.Email.Status != pgtype.Undefined {
+		columns = append(columns, `email`)
+		values = append(values, args.Append(&row.Email))
+	}
+	if row.Address.Status != pgtype.Undefined {
+		columns = append(columns, `address`)
+		values = append(values, args.Append(&row.Address))
+	}
 
-	sql := `insert into "customer"(` + strings.Join(columns, ", ") + `)
-values(` + strings.Join(values, ",") + `)
-returning "id"
+	insert := ` default values`
+	if len(columns) > 0 {
+		insert = `(` + strings.Join(columns, ", ") + `)
+values(` + strings.Join(values, ",") + `)`
+	}
+
+	sql := `insert into "customer"` + insert + `
+returning "id", "first_name", "last_name", "birth_date", "creation_time", "email", "address"::text
   `
 
 	psName := preparedName("pgxdataInsertCustomer", sql)
 
-	return prepareQueryRow(ctx, db, psName, sql, args...).Scan(&row.ID)
+	return prepareQueryRow(ctx, db, psName, sql, args...).Scan(&row.ID, &row.FirstName, &row.LastName, &row.BirthDate, &row.CreationTime, &row.Email, &row.Address)
 }
 
-func UpdateCustomer(ctx context.Context, db Queryer,
-	id int32,
-	row *Customer,
-) error {
-	sets := make([]string, 0, 5)
-	args := pgx.QueryArgs(make([]interface{}, 0, 5))
+// updateCustomerSets returns the assignments of the SET clause for the fields of row to update and their
+// arguments.
+func updateCustomerSets(row *Customer) ([]string, pgx.QueryArgs, error) {
+	sets := make([]string, 0, 7)
+	args := pgx.QueryArgs(make([]interface{}, 0, 7))
 
 	if row.ID.Status != pgtype.Undefined {
 		sets = append(sets, `id`+"="+args.Append(&row.ID))
@@ -151,25 +462,507 @@ func UpdateCustomer(ctx context.Context, db Queryer,
 	if row.CreationTime.Status != pgtype.Undefined {
 		sets = append(sets, `creation_time`+"="+args.Append(&row.CreationTime))
 	}
+	if row.Email.Status != pgtype.Undefined {
+		sets = append(sets, `email`+"="+args.Append(&row.Email))
+	}
+	if row.Address.Status != pgtype.Undefined {
+		sets = append(sets, `address`+"="+args.Append(&row.Address))
+	}
+
+	return sets, args, nil
+}
 
+func UpdateCustomer(ctx context.Context, db Queryer,
+	id int32,
+	row *Customer,
+) error {
+	sets, args, err := updateCustomerSets(row)
+	if err != nil {
+		return err
+	}
 	if len(sets) == 0 {
 		return nil
 	}
 
-	sql := `update "customer" set ` + strings.Join(sets, ", ") + ` where ` + `"id"=` + args.Append(id)
+	sql := `update "customer" set ` + strings.Join(sets, ", ") + ` where ` + `"id"=` + args.Append(id) + `
+returning "id", "first_name", "last_name", "birth_date", "creation_time", "email", "address"::text`
 
 	psName := preparedName("pgxdataUpdateCustomer", sql)
 
-	commandTag, err := prepareExec(ctx, db, psName, sql, args...)
+	err = prepareQueryRow(ctx, db, psName, sql, args...).Scan(&row.ID, &row.FirstName, &row.LastName, &row.BirthDate, &row.CreationTime, &row.Email, &row.Address)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return ErrNotFound
+	}
+	return err
+}
+
+// CustomerConflict identifies the primary key or unique index UpsertCustomer
+// detects a conflicting row by.
+type CustomerConflict int
+
+const (
+	CustomerConflictOnPK CustomerConflict = iota
+	CustomerConflictOnEmail
+)
+
+// CustomerUpsertOptions configures UpsertCustomer.
+type CustomerUpsertOptions struct {
+	// Conflict is the primary key or unique index a conflicting row is detected
+	// by. The primary key is the default.
+	Conflict CustomerConflict
+
+	// DoNothing leaves a conflicting row unchanged instead of updating it.
+	DoNothing bool
+}
+
+// UpsertCustomer inserts row or, when it conflicts with an existing row,
+// updates the existing row with the fields of row that are not Undefined other than
+// those of the conflict target.
+// Like InsertCustomer the persisted row is scanned into row. Nothing is
+// scanned when a conflicting row is left unchanged.
+func UpsertCustomer(ctx context.Context, db Queryer, row *Customer, opts CustomerUpsertOptions) (UpsertResult, error) {
+	args := pgx.QueryArgs(make([]interface{}, 0, 7))
+
+	var columns, values []string
+
+	if row.ID.Status != pgtype.Undefined {
+		columns = append(columns, `"id"`)
+		values = append(values, args.Append(&row.ID))
+	}
+	if row.FirstName.Status != pgtype.Undefined {
+		columns = append(columns, `"first_name"`)
+		values = append(values, args.Append(&row.FirstName))
+	}
+	if row.LastName.Status != pgtype.Undefined {
+		columns = append(columns, `"last_name"`)
+		values = append(values, args.Append(&row.LastName))
+	}
+	if row.BirthDate.Status != pgtype.Undefined {
+		columns = append(columns, `"birth_date"`)
+		values = append(values, args.Append(&row.BirthDate))
+	}
+	if row.CreationTime.Status != pgtype.Undefined {
+		columns = append(columns, `"creation_time"`)
+		values = append(values, args.Append(&row.CreationTime))
+	}
+	if row.Email.Status != pgtype.Undefined {
+		columns = append(columns, `"email"`)
+		values = append(values, args.Append(&row.Email))
+	}
+	if row.Address.Status != pgtype.Undefined {
+		columns = append(columns, `"address"`)
+		values = append(values, args.Append(&row.Address))
+	}
+
+	var targetColumns []string
+	switch opts.Conflict {
+	case CustomerConflictOnPK:
+		targetColumns = []string{`"id"`}
+	case CustomerConflictOnEmail:
+		targetColumns = []string{`"email"`}
+	default:
+		return UpsertUnchanged, errors.Errorf("unknown CustomerConflict %d", opts.Conflict)
+	}
+
+	// The conflicting row is updated with the columns other than the conflict
+	// target. It is left unchanged when there are none.
+	sets := make([]string, 0, len(columns))
+nextColumn:
+	for _, c := range columns {
+		for _, tc := range targetColumns {
+			if c == tc {
+				continue nextColumn
+			}
+		}
+		sets = append(sets, c+"=excluded."+c)
+	}
+
+	action := `do update set ` + strings.Join(sets, ", ")
+	if opts.DoNothing || len(sets) == 0 {
+		action = `do nothing`
+	}
+
+	insert := ` default values`
+	if len(columns) > 0 {
+		insert = `(` + strings.Join(columns, ", ") + `)
+values(` + strings.Join(values, ",") + `)`
+	}
+
+	sql := `insert into "customer"` + insert + `
+on conflict (` + strings.Join(targetColumns, ", ") + `) ` + action + `
+returning xmax = 0, "id", "first_name", "last_name", "birth_date", "creation_time", "email", "address"::text
+  `
+
+	psName := preparedName("pgxdataUpsertCustomer", sql)
+
+	var inserted bool
+	err := prepareQueryRow(ctx, db, psName, sql, args...).Scan(&inserted, &row.ID, &row.FirstName, &row.LastName, &row.BirthDate, &row.CreationTime, &row.Email, &row.Address)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return UpsertUnchanged, nil
+	} else if err != nil {
+		return UpsertUnchanged, err
+	}
+
+	if inserted {
+		return UpsertInserted, nil
+	}
+	return UpsertUpdated, nil
+}
+
+// insertCustomerColumns returns the columns of row that are not Undefined and their
+// values for inserting row.
+func insertCustomerColumns(row *Customer) ([]string, []interface{}, error) {
+	var columns []string
+	var values []interface{}
+
+	if row.ID.Status != pgtype.Undefined {
+		columns = append(columns, `id`)
+		values = append(values, &row.ID)
+	}
+	if row.FirstName.Status != pgtype.Undefined {
+		columns = append(columns, `first_name`)
+		values = append(values, &row.FirstName)
+	}
+	if row.LastName.Status != pgtype.Undefined {
+		columns = append(columns, `last_name`)
+		values = append(values, &row.LastName)
+	}
+	if row.BirthDate.Status != pgtype.Undefined {
+		columns = append(columns, `birth_date`)
+		values = append(values, &row.BirthDate)
+	}
+	if row.CreationTime.Status != pgtype.Undefined {
+		columns = append(columns, `creation_time`)
+		values = append(values, &row.CreationTime)
+	}
+	if row.Email.Status != pgtype.Undefined {
+		columns = append(columns, `email`)
+		values = append(values, &row.Email)
+	}
+	if row.Address.Status != pgtype.Undefined {
+		columns = append(columns, `address`)
+		values = append(values, &row.Address)
+	}
+
+	return columns, values, nil
+}
+
+// CopyInsertCustomer inserts rows with the PostgreSQL copy protocol and
+// returns the number of rows copied. The columns copied are those not
+// Undefined in the first row and every row must set the same fields. Values
+// must support the binary format. address has no
+// binary format and must be inserted with InsertManyCustomer.
+func CopyInsertCustomer(ctx context.Context, db CopyFromer, rows []Customer) (int64, error) {
+	if len(rows) == 0 {
+		return 0, nil
+	}
+
+	columns, _, err := insertCustomerColumns(&rows[0])
+	if err != nil {
+		return 0, err
+	}
+
+	for _, column := range columns {
+		switch column {
+		case `address`:
+			return 0, errors.Errorf("column %s has no binary format for the copy protocol, use InsertManyCustomer", column)
+		}
+	}
+
+	return db.CopyFrom(ctx, pgx.Identifier{"customer"}, columns, &copyFromCustomerSource{rows: rows, columns: columns, idx: -1})
+}
+
+// copyFromCustomerSource is a pgx.CopyFromSource of the columns of rows.
+type copyFromCustomerSource struct {
+	rows    []Customer
+	columns []string
+	idx     int
+}
+
+func (s *copyFromCustomerSource) Next() bool {
+	s.idx++
+	return s.idx < len(s.rows)
+}
+
+func (s *copyFromCustomerSource) Values() ([]interface{}, error) {
+	columns, values, err := insertCustomerColumns(&s.rows[s.idx])
+	if err != nil {
+		return nil, err
+	}
+	if !equalColumns(columns, s.columns) {
+		return nil, errors.Errorf("row %d does not set the same fields as row 0", s.idx)
+	}
+	return values, nil
+}
+
+func (s *copyFromCustomerSource) Err() error {
+	return nil
+}
+
+// insertManyCustomerTypes are the types the parameters of
+// InsertManyCustomer are cast to. PostgreSQL only infers them for the
+// VALUES list of an INSERT, not for one that is selected from.
+var insertManyCustomerTypes = map[string]string{
+	`id`:            `"pg_catalog"."int4"`,
+	`first_name`:    `"pg_catalog"."varchar"`,
+	`last_name`:     `"pg_catalog"."varchar"`,
+	`birth_date`:    `"pg_catalog"."date"`,
+	`creation_time`: `"pg_catalog"."timestamptz"`,
+	`email`:         `"pg_catalog"."text"`,
+	`address`:       `"public"."address"`,
+}
+
+// InsertManyCustomer inserts rows with multi-row INSERT statements for
+// a Queryer that does not support the copy protocol or for columns without a
+// binary format. The columns inserted are those not Undefined in the
+// first row and every row must set the same fields. Like InsertCustomer
+// the persisted rows are scanned into rows.
+func InsertManyCustomer(ctx context.Context, db Queryer, rows []Customer) error {
+	if len(rows) == 0 {
+		return nil
+	}
+
+	columns, _, err := insertCustomerColumns(&rows[0])
 	if err != nil {
 		return err
 	}
-	if commandTag.RowsAffected() != 1 {
-		return ErrNotFound
+
+	quotedColumns := make([]string, len(columns))
+	for i, column := range columns {
+		quotedColumns[i] = pgx.Identifier{column}.Sanitize()
 	}
+
+	// A statement can have at most 65535 parameters including the ordinality
+	// of each row.
+	batchSize := len(rows)
+	if batchSize > 65535/(len(columns)+1) {
+		batchSize = 65535 / (len(columns) + 1)
+	}
+
+	for start := 0; start < len(rows); start += batchSize {
+		end := start + batchSize
+		if end > len(rows) {
+			end = len(rows)
+		}
+		batch := rows[start:end]
+
+		args := pgx.QueryArgs(make([]interface{}, 0, len(batch)*(len(columns)+1)))
+		valueLists := make([]string, len(batch))
+		for i := range batch {
+			rowColumns, values, err := insertCustomerColumns(&batch[i])
+			if err != nil {
+				return err
+			}
+			if !equalColumns(rowColumns, columns) {
+				return errors.Errorf("row %d does not set the same fields as row 0", start+i)
+			}
+
+			placeholders := make([]string, len(values)+1)
+			for j, v := range values {
+				placeholders[j] = args.Append(v) + "::" + insertManyCustomerTypes[columns[j]]
+			}
+			placeholders[len(values)] = args.Append(int32(i)) + "::int4"
+			valueLists[i] = "(" + strings.Join(placeholders, ",") + ")"
+		}
+
+		// The rows are inserted in the order of their ordinality so they are
+		// returned in the order of batch.
+		sql := `insert into "customer"(` + strings.Join(quotedColumns, ", ") + `)
+select ` + strings.Join(quotedColumns, ", ") + `
+from (values` + strings.Join(valueLists, ",") + `) as input(` + strings.Join(append(quotedColumns, "pgxdata_ordinality"), ", ") + `)
+order by pgxdata_ordinality
+returning "id", "first_name", "last_name", "birth_date", "creation_time", "email", "address"::text`
+
+		// The SQL depends on the number of rows so it is not prepared, which
+		// would leave a prepared statement for every batch size.
+		dbRows, err := db.Query(ctx, sql, args...)
+		if err != nil {
+			return err
+		}
+
+		// PostgreSQL does not guarantee the order of the returned rows, but it
+		// returns them in the order they were inserted. A different number of rows
+		// means they cannot be matched to batch.
+		n := 0
+		for dbRows.Next() {
+			if n < len(batch) {
+				row := &batch[n]
+				if err := dbRows.Scan(&row.ID, &row.FirstName, &row.LastName, &row.BirthDate, &row.CreationTime, &row.Email, &row.Address); err != nil {
+					dbRows.Close()
+					return err
+				}
+			}
+			n++
+		}
+
+		if dbRows.Err() != nil {
+			return dbRows.Err()
+		}
+		if n != len(batch) {
+			return errors.Errorf("inserted %d rows but %d rows were returned", len(batch), n)
+		}
+	}
+
 	return nil
 }
 
+// QueueSelectCustomerByPK queues selecting the row by primary key in b.
+// The returned reader scans the row into dst or returns ErrNotFound if there
+// is no such row.
+func QueueSelectCustomerByPK(b *pgx.Batch, id int32, dst *Customer) BatchReader {
+	args := []interface{}{id}
+	oids := []pgtype.OID{pgtype.Int4OID}
+
+	return queue(b, selectCustomerByPKSQL, args, oids, func(results pgx.BatchResults) error {
+		err := results.QueryRowResults().Scan(
+			&dst.ID,
+			&dst.FirstName,
+			&dst.LastName,
+			&dst.BirthDate,
+			&dst.CreationTime,
+			&dst.Email,
+			&dst.Address,
+		)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrNotFound
+		}
+		return err
+	})
+}
+
+// QueueInsertCustomer queues inserting row in b. Like InsertCustomer
+// the returned reader scans the persisted row into row.
+func QueueInsertCustomer(b *pgx.Batch, row *Customer) BatchReader {
+	args := pgx.QueryArgs(make([]interface{}, 0, 7))
+	oids := make([]pgtype.OID, 0, 7)
+
+	var columns, values []string
+
+	if row.ID.Status != pgtype.Undefined {
+		columns = append(columns, `id`)
+		values = append(values, args.Append(&row.ID))
+		oids = append(oids, pgtype.Int4OID)
+	}
+	if row.FirstName.Status != pgtype.Undefined {
+		columns = append(columns, `first_name`)
+		values = append(values, args.Append(&row.FirstName))
+		oids = append(oids, pgtype.VarcharOID)
+	}
+	if row.LastName.Status != pgtype.Undefined {
+		columns = append(columns, `last_name`)
+		values = append(values, args.Append(&row.LastName))
+		oids = append(oids, pgtype.VarcharOID)
+	}
+	if row.BirthDate.Status != pgtype.Undefined {
+		columns = append(columns, `birth_date`)
+		values = append(values, args.Append(&row.BirthDate))
+		oids = append(oids, pgtype.DateOID)
+	}
+	if row.CreationTime.Status != pgtype.Undefined {
+		columns = append(columns, `creation_time`)
+		values = append(values, args.Append(&row.CreationTime))
+		oids = append(oids, pgtype.TimestamptzOID)
+	}
+	if row.Email.Status != pgtype.Undefined {
+		columns = append(columns, `email`)
+		values = append(values, args.Append(&row.Email))
+		oids = append(oids, pgtype.TextOID)
+	}
+	if row.Address.Status != pgtype.Undefined {
+		columns = append(columns, `address`)
+		values = append(values, args.Append(&row.Address))
+		oids = append(oids, 0)
+	}
+
+	insert := ` default values`
+	if len(columns) > 0 {
+		insert = `(` + strings.Join(columns, ", ") + `)
+values(` + strings.Join(values, ",") + `)`
+	}
+
+	sql := `insert into "customer"` + insert + `
+returning "id", "first_name", "last_name", "birth_date", "creation_time", "email", "address"::text`
+
+	return queue(b, sql, args, oids, func(results pgx.BatchResults) error {
+		return results.QueryRowResults().Scan(&row.ID, &row.FirstName, &row.LastName, &row.BirthDate, &row.CreationTime, &row.Email, &row.Address)
+	})
+}
+
+// QueueUpdateCustomer queues updating the row by primary key in b. Like
+// UpdateCustomer the returned reader scans the persisted row into row
+// or returns ErrNotFound if there is no such row. Nothing is queued when
+// there is nothing to update.
+func QueueUpdateCustomer(b *pgx.Batch, id int32, row *Customer) BatchReader {
+	sets := make([]string, 0, 7)
+	args := pgx.QueryArgs(make([]interface{}, 0, 7))
+	oids := make([]pgtype.OID, 0, 7)
+
+	if row.ID.Status != pgtype.Undefined {
+		sets = append(sets, `id`+"="+args.Append(&row.ID))
+		oids = append(oids, pgtype.Int4OID)
+	}
+	if row.FirstName.Status != pgtype.Undefined {
+		sets = append(sets, `first_name`+"="+args.Append(&row.FirstName))
+		oids = append(oids, pgtype.VarcharOID)
+	}
+	if row.LastName.Status != pgtype.Undefined {
+		sets = append(sets, `last_name`+"="+args.Append(&row.LastName))
+		oids = append(oids, pgtype.VarcharOID)
+	}
+	if row.BirthDate.Status != pgtype.Undefined {
+		sets = append(sets, `birth_date`+"="+args.Append(&row.BirthDate))
+		oids = append(oids, pgtype.DateOID)
+	}
+	if row.CreationTime.Status != pgtype.Undefined {
+		sets = append(sets, `creation_time`+"="+args.Append(&row.CreationTime))
+		oids = append(oids, pgtype.TimestamptzOID)
+	}
+	if row.Email.Status != pgtype.Undefined {
+		sets = append(sets, `email`+"="+args.Append(&row.Email))
+		oids = append(oids, pgtype.TextOID)
+	}
+	if row.Address.Status != pgtype.Undefined {
+		sets = append(sets, `address`+"="+args.Append(&row.Address))
+		oids = append(oids, 0)
+	}
+
+	if len(sets) == 0 {
+		return func(pgx.BatchResults) error { return nil }
+	}
+
+	sql := `update "customer" set ` + strings.Join(sets, ", ") + ` where ` + `"id"=` + args.Append(id) + `
+returning "id", "first_name", "last_name", "birth_date", "creation_time", "email", "address"::text`
+	oids = append(oids, pgtype.Int4OID)
+
+	return queue(b, sql, args, oids, func(results pgx.BatchResults) error {
+		err := results.QueryRowResults().Scan(&row.ID, &row.FirstName, &row.LastName, &row.BirthDate, &row.CreationTime, &row.Email, &row.Address)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrNotFound
+		}
+		return err
+	})
+}
+
+// QueueDeleteCustomer queues deleting the row by primary key in b. The
+// returned reader returns ErrNotFound if there is no such row.
+func QueueDeleteCustomer(b *pgx.Batch, id int32) BatchReader {
+	args := pgx.QueryArgs(make([]interface{}, 0, 1))
+
+	sql := `delete from "customer" where ` + `"id"=` + args.Append(id)
+	oids := []pgtype.OID{pgtype.Int4OID}
+
+	return queue(b, sql, args, oids, func(results pgx.BatchResults) error {
+		commandTag, err := results.ExecResults()
+		if err != nil {
+			return err
+		}
+		if commandTag.RowsAffected() != 1 {
+			return ErrNotFound
+		}
+		return nil
+	})
+}
+
 func DeleteCustomer(ctx context.Context, db Queryer,
 	id int32,
 ) error {
@@ -186,3 +979,185 @@ func DeleteCustomer(ctx context.Context, db Queryer,
 	}
 	return nil
 }
+
+// CustomerWhere has a filter for each column of "customer" that builds the conditions of
+// SelectCustomerWhere, CountCustomerWhere, UpdateCustomerWhere and DeleteCustomerWhere.
+var CustomerWhere = struct {
+	ID           CustomerIDFilter
+	FirstName    CustomerFirstNameFilter
+	LastName     CustomerLastNameFilter
+	BirthDate    CustomerBirthDateFilter
+	CreationTime CustomerCreationTimeFilter
+	Email        CustomerEmailFilter
+	Address      CustomerAddressFilter
+}{
+	ID:           CustomerIDFilter{columnFilter{`"id"`}},
+	FirstName:    CustomerFirstNameFilter{columnFilter{`"first_name"`}},
+	LastName:     CustomerLastNameFilter{columnFilter{`"last_name"`}},
+	BirthDate:    CustomerBirthDateFilter{columnFilter{`"birth_date"`}},
+	CreationTime: CustomerCreationTimeFilter{columnFilter{`"creation_time"`}},
+	Email:        CustomerEmailFilter{columnFilter{`"email"`}},
+	Address:      CustomerAddressFilter{columnFilter{`"address"`}},
+}
+
+// CustomerIDFilter builds conditions on id.
+type CustomerIDFilter struct{ columnFilter }
+
+func (f CustomerIDFilter) Eq(v int32) Condition { return f.compare(" = ", v) }
+func (f CustomerIDFilter) Ne(v int32) Condition { return f.compare(" <> ", v) }
+func (f CustomerIDFilter) Lt(v int32) Condition { return f.compare(" < ", v) }
+func (f CustomerIDFilter) Le(v int32) Condition { return f.compare(" <= ", v) }
+func (f CustomerIDFilter) Gt(v int32) Condition { return f.compare(" > ", v) }
+func (f CustomerIDFilter) Ge(v int32) Condition { return f.compare(" >= ", v) }
+
+func (f CustomerIDFilter) In(vs ...int32) Condition { return f.in(len(vs), vs) }
+
+// CustomerFirstNameFilter builds conditions on first_name.
+type CustomerFirstNameFilter struct{ columnFilter }
+
+func (f CustomerFirstNameFilter) Eq(v string) Condition { return f.compare(" = ", v) }
+func (f CustomerFirstNameFilter) Ne(v string) Condition { return f.compare(" <> ", v) }
+func (f CustomerFirstNameFilter) Lt(v string) Condition { return f.compare(" < ", v) }
+func (f CustomerFirstNameFilter) Le(v string) Condition { return f.compare(" <= ", v) }
+func (f CustomerFirstNameFilter) Gt(v string) Condition { return f.compare(" > ", v) }
+func (f CustomerFirstNameFilter) Ge(v string) Condition { return f.compare(" >= ", v) }
+
+func (f CustomerFirstNameFilter) In(vs ...string) Condition { return f.in(len(vs), vs) }
+
+// CustomerLastNameFilter builds conditions on last_name.
+type CustomerLastNameFilter struct{ columnFilter }
+
+func (f CustomerLastNameFilter) Eq(v string) Condition { return f.compare(" = ", v) }
+func (f CustomerLastNameFilter) Ne(v string) Condition { return f.compare(" <> ", v) }
+func (f CustomerLastNameFilter) Lt(v string) Condition { return f.compare(" < ", v) }
+func (f CustomerLastNameFilter) Le(v string) Condition { return f.compare(" <= ", v) }
+func (f CustomerLastNameFilter) Gt(v string) Condition { return f.compare(" > ", v) }
+func (f CustomerLastNameFilter) Ge(v string) Condition { return f.compare(" >= ", v) }
+
+func (f CustomerLastNameFilter) In(vs ...string) Condition { return f.in(len(vs), vs) }
+
+// CustomerBirthDateFilter builds conditions on birth_date.
+type CustomerBirthDateFilter struct{ columnFilter }
+
+func (f CustomerBirthDateFilter) Eq(v time.Time) Condition { return f.compare(" = ", v) }
+func (f CustomerBirthDateFilter) Ne(v time.Time) Condition { return f.compare(" <> ", v) }
+func (f CustomerBirthDateFilter) Lt(v time.Time) Condition { return f.compare(" < ", v) }
+func (f CustomerBirthDateFilter) Le(v time.Time) Condition { return f.compare(" <= ", v) }
+func (f CustomerBirthDateFilter) Gt(v time.Time) Condition { return f.compare(" > ", v) }
+func (f CustomerBirthDateFilter) Ge(v time.Time) Condition { return f.compare(" >= ", v) }
+
+func (f CustomerBirthDateFilter) In(vs ...time.Time) Condition { return f.in(len(vs), vs) }
+
+// CustomerCreationTimeFilter builds conditions on creation_time.
+type CustomerCreationTimeFilter struct{ columnFilter }
+
+func (f CustomerCreationTimeFilter) Eq(v time.Time) Condition { return f.compare(" = ", v) }
+func (f CustomerCreationTimeFilter) Ne(v time.Time) Condition { return f.compare(" <> ", v) }
+func (f CustomerCreationTimeFilter) Lt(v time.Time) Condition { return f.compare(" < ", v) }
+func (f CustomerCreationTimeFilter) Le(v time.Time) Condition { return f.compare(" <= ", v) }
+func (f CustomerCreationTimeFilter) Gt(v time.Time) Condition { return f.compare(" > ", v) }
+func (f CustomerCreationTimeFilter) Ge(v time.Time) Condition { return f.compare(" >= ", v) }
+
+func (f CustomerCreationTimeFilter) In(vs ...time.Time) Condition { return f.in(len(vs), vs) }
+
+// CustomerEmailFilter builds conditions on email.
+type CustomerEmailFilter struct{ columnFilter }
+
+func (f CustomerEmailFilter) Eq(v string) Condition { return f.compare(" = ", v) }
+func (f CustomerEmailFilter) Ne(v string) Condition { return f.compare(" <> ", v) }
+func (f CustomerEmailFilter) Lt(v string) Condition { return f.compare(" < ", v) }
+func (f CustomerEmailFilter) Le(v string) Condition { return f.compare(" <= ", v) }
+func (f CustomerEmailFilter) Gt(v string) Condition { return f.compare(" > ", v) }
+func (f CustomerEmailFilter) Ge(v string) Condition { return f.compare(" >= ", v) }
+
+func (f CustomerEmailFilter) In(vs ...string) Condition { return f.in(len(vs), vs) }
+
+// CustomerAddressFilter builds conditions on address.
+type CustomerAddressFilter struct{ columnFilter }
+
+func SelectCustomerWhere(ctx context.Context, db Queryer, where Condition) ([]Customer, error) {
+	var args pgx.QueryArgs
+	sql := SelectAllCustomerSQL + `
+where ` + where.sql(&args)
+
+	dbRows, err := prepareQuery(ctx, db, preparedName("pgxdataSelectCustomerWhere", sql), sql, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer dbRows.Close()
+
+	var rows []Customer
+	for dbRows.Next() {
+		var row Customer
+		err := dbRows.Scan(
+			&row.ID,
+			&row.FirstName,
+			&row.LastName,
+			&row.BirthDate,
+			&row.CreationTime,
+			&row.Email,
+			&row.Address,
+		)
+		if err != nil {
+			return nil, err
+		}
+		rows = append(rows, row)
+	}
+
+	if dbRows.Err() != nil {
+		return nil, dbRows.Err()
+	}
+
+	return rows, nil
+}
+
+func CountCustomerWhere(ctx context.Context, db Queryer, where Condition) (int64, error) {
+	var args pgx.QueryArgs
+	sql := countCustomerSQL + ` where ` + where.sql(&args)
+
+	var n int64
+	err := prepareQueryRow(ctx, db, preparedName("pgxdataCountCustomerWhere", sql), sql, args...).Scan(&n)
+	return n, err
+}
+
+// UpdateCustomerWhere updates the rows matching where like UpdateCustomer and returns the number of
+// rows updated. where must restrict the rows, so a Condition that matches every row like the zero Condition is
+// refused.
+func UpdateCustomerWhere(ctx context.Context, db Queryer, where Condition, row *Customer) (int64, error) {
+	if where.write == nil {
+		return 0, errors.New("UpdateCustomerWhere requires a condition")
+	}
+
+	sets, args, err := updateCustomerSets(row)
+	if err != nil {
+		return 0, err
+	}
+	if len(sets) == 0 {
+		return 0, nil
+	}
+
+	sql := `update "customer" set ` + strings.Join(sets, ", ") + ` where ` + where.sql(&args)
+
+	commandTag, err := prepareExec(ctx, db, preparedName("pgxdataUpdateCustomerWhere", sql), sql, args...)
+	if err != nil {
+		return 0, err
+	}
+	return commandTag.RowsAffected(), nil
+}
+
+// DeleteCustomerWhere deletes the rows matching where and returns the number of rows deleted. where must
+// restrict the rows, so a Condition that matches every row like the zero Condition is refused.
+func DeleteCustomerWhere(ctx context.Context, db Queryer, where Condition) (int64, error) {
+	if where.write == nil {
+		return 0, errors.New("DeleteCustomerWhere requires a condition")
+	}
+
+	var args pgx.QueryArgs
+	sql := `delete from "customer" where ` + where.sql(&args)
+
+	commandTag, err := prepareExec(ctx, db, preparedName("pgxdataDeleteCustomerWhere", sql), sql, args...)
+	if err != nil {
+		return 0, err
+	}
+	return commandTag.RowsAffected(), nil
+}
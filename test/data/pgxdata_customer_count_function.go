@@ -0,0 +1,24 @@
+package data
+
+// This file is automatically generated by pgxdata.
+
+import (
+	"context"
+
+	"github.com/jackc/pgtype"
+	"github.com/jackc/pgx/v4"
+	errors "golang.org/x/xerrors"
+)
+
+const customerCountSQL = `select "customer_count"($1)`
+
+// CustomerCount calls customer_count and returns its result. It returns
+// ErrNotFound when there is no result.
+func CustomerCount(ctx context.Context, db Queryer, lastNamePrefix string) (pgtype.Int8, error) {
+	var result pgtype.Int8
+	err := prepareQueryRow(ctx, db, preparedName("pgxdataCustomerCount", customerCountSQL), customerCountSQL, lastNamePrefix).Scan(&result)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return result, ErrNotFound
+	}
+	return result, err
+}
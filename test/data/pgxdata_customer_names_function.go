@@ -0,0 +1,43 @@
+package data
+
+// This file is automatically generated by pgxdata.
+
+import (
+	"context"
+)
+
+const customerNamesSQL = `select "id", "full_name" from "customer_names"($1)`
+
+// CustomerNamesRow is a row returned by CustomerNames.
+type CustomerNamesRow struct {
+	ID       *int32
+	FullName *string
+}
+
+// CustomerNames calls customer_names and returns its rows.
+func CustomerNames(ctx context.Context, db Queryer, minID int32) ([]CustomerNamesRow, error) {
+	dbRows, err := prepareQuery(ctx, db, preparedName("pgxdataCustomerNames", customerNamesSQL), customerNamesSQL, minID)
+	if err != nil {
+		return nil, err
+	}
+	defer dbRows.Close()
+
+	var rows []CustomerNamesRow
+	for dbRows.Next() {
+		var row CustomerNamesRow
+		err := dbRows.Scan(
+			&row.ID,
+			&row.FullName,
+		)
+		if err != nil {
+			return nil, err
+		}
+		rows = append(rows, row)
+	}
+
+	if dbRows.Err() != nil {
+		return nil, dbRows.Err()
+	}
+
+	return rows, nil
+}
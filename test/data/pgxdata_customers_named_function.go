@@ -0,0 +1,42 @@
+package data
+
+// This file is automatically generated by pgxdata.
+
+import (
+	"context"
+)
+
+const customersNamedSQL = `select "id", "first_name", "last_name", "birth_date", "creation_time", "email", "address"::text from "customers_named"($1)`
+
+// CustomersNamed calls customers_named and returns its rows.
+func CustomersNamed(ctx context.Context, db Queryer, arg1 string) ([]Customer, error) {
+	dbRows, err := prepareQuery(ctx, db, preparedName("pgxdataCustomersNamed", customersNamedSQL), customersNamedSQL, arg1)
+	if err != nil {
+		return nil, err
+	}
+	defer dbRows.Close()
+
+	var rows []Customer
+	for dbRows.Next() {
+		var row Customer
+		err := dbRows.Scan(
+			&row.ID,
+			&row.FirstName,
+			&row.LastName,
+			&row.BirthDate,
+			&row.CreationTime,
+			&row.Email,
+			&row.Address,
+		)
+		if err != nil {
+			return nil, err
+		}
+		rows = append(rows, row)
+	}
+
+	if dbRows.Err() != nil {
+		return nil, dbRows.Err()
+	}
+
+	return rows, nil
+}
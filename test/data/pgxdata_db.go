@@ -7,8 +7,10 @@ import (
 	"fmt"
 	"hash/fnv"
 	"io"
+	"strings"
 
 	"github.com/jackc/pgconn"
+	"github.com/jackc/pgtype"
 	"github.com/jackc/pgx/v4"
 	errors "golang.org/x/xerrors"
 )
@@ -17,12 +19,68 @@ const PGXDATA_VERSION = "0.1.0"
 
 var ErrNotFound = errors.New("not found")
 
+// UpsertResult reports what an upsert did.
+type UpsertResult int
+
+const (
+	// UpsertUnchanged means a conflicting row was left unchanged.
+	UpsertUnchanged UpsertResult = iota
+	// UpsertInserted means a new row was inserted.
+	UpsertInserted
+	// UpsertUpdated means a conflicting row was updated.
+	UpsertUpdated
+)
+
 type Queryer interface {
 	Query(ctx context.Context, sql string, args ...interface{}) (pgx.Rows, error)
 	QueryRow(ctx context.Context, sql string, args ...interface{}) pgx.Row
 	Exec(ctx context.Context, sql string, arguments ...interface{}) (pgconn.CommandTag, error)
 }
 
+// CopyFromer is a Queryer that supports the PostgreSQL copy protocol such as
+// *pgx.Conn and the pools and transactions of pgx.
+type CopyFromer interface {
+	CopyFrom(ctx context.Context, tableName pgx.Identifier, columnNames []string, rowSrc pgx.CopyFromSource) (int64, error)
+}
+
+// BatchReader reads the result of a statement queued in a pgx.Batch by a
+// generated Queue function. The readers of a batch must be called with its
+// pgx.BatchResults in the order the statements were queued.
+type BatchReader func(pgx.BatchResults) error
+
+// ReadBatch calls readers with results in order and closes results. All
+// results are read and the first error is returned, e.g. ErrNotFound when a
+// row to select, update or delete does not exist.
+func ReadBatch(results pgx.BatchResults, readers ...BatchReader) error {
+	var err error
+	for _, read := range readers {
+		if readErr := read(results); readErr != nil && err == nil {
+			err = readErr
+		}
+	}
+	if closeErr := results.Close(); closeErr != nil && err == nil {
+		err = closeErr
+	}
+
+	return err
+}
+
+// queue adds sql to b and returns read. Batched statements are not prepared so
+// parameterOIDs gives the types of args. Results are read in the text format
+// which all types can decode.
+func queue(b *pgx.Batch, sql string, args []interface{}, parameterOIDs []pgtype.OID, read BatchReader) BatchReader {
+	b.Queue(sql, args, parameterOIDs, []int16{pgx.TextFormatCode})
+	return read
+}
+
+// queueFailed returns the reader of a statement that could not be queued. It
+// returns err without reading a result.
+func queueFailed(err error) BatchReader {
+	return func(pgx.BatchResults) error {
+		return err
+	}
+}
+
 type preparer interface {
 	Prepare(ctx context.Context, name, sql string) (*pgx.PreparedStatement, error)
 	Deallocate(ctx context.Context, name string) error
@@ -62,6 +120,148 @@ func prepareExec(ctx context.Context, db Queryer, name, sql string, args ...inte
 	return db.Exec(ctx, sql, args...)
 }
 
+// equalColumns returns true if a and b are the same columns in the same order.
+func equalColumns(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// Condition is a SQL condition on the columns of a table built with the
+// generated <Struct>Where filters. The zero Condition matches every row. It has
+// no predicate, and neither has any condition combined only from zero
+// Conditions.
+type Condition struct {
+	write func(args *pgx.QueryArgs) string
+}
+
+// And returns a condition that matches when c and all others match. Zero
+// Conditions are left out.
+func (c Condition) And(others ...Condition) Condition {
+	return c.join(" and ", others)
+}
+
+// Or returns a condition that matches when c or any of others match. It is the
+// zero Condition when any of them is.
+func (c Condition) Or(others ...Condition) Condition {
+	if c.write == nil {
+		return Condition{}
+	}
+	for _, o := range others {
+		if o.write == nil {
+			return Condition{}
+		}
+	}
+	return c.join(" or ", others)
+}
+
+// Not returns a condition that matches when c does not match.
+func (c Condition) Not() Condition {
+	return Condition{write: func(args *pgx.QueryArgs) string {
+		return "not (" + c.sql(args) + ")"
+	}}
+}
+
+// join combines the conditions with predicates of c and others with op.
+func (c Condition) join(op string, others []Condition) Condition {
+	var conditions []Condition
+	for _, c := range append([]Condition{c}, others...) {
+		if c.write != nil {
+			conditions = append(conditions, c)
+		}
+	}
+	switch len(conditions) {
+	case 0:
+		return Condition{}
+	case 1:
+		return conditions[0]
+	}
+	return Condition{write: func(args *pgx.QueryArgs) string {
+		parts := make([]string, len(conditions))
+		for i, c := range conditions {
+			parts[i] = "(" + c.sql(args) + ")"
+		}
+		return strings.Join(parts, op)
+	}}
+}
+
+// sql returns the SQL of c and appends its arguments to args.
+func (c Condition) sql(args *pgx.QueryArgs) string {
+	if c.write == nil {
+		return "true"
+	}
+	return c.write(args)
+}
+
+// columnFilter builds the conditions of a column. The generated filters embed
+// it and add the comparisons typed for the column.
+type columnFilter struct {
+	column string
+}
+
+// IsNull returns a condition that matches when the column is NULL.
+func (f columnFilter) IsNull() Condition {
+	return Condition{write: func(args *pgx.QueryArgs) string {
+		return f.column + " is null"
+	}}
+}
+
+// IsNotNull returns a condition that matches when the column is not NULL.
+func (f columnFilter) IsNotNull() Condition {
+	return Condition{write: func(args *pgx.QueryArgs) string {
+		return f.column + " is not null"
+	}}
+}
+
+func (f columnFilter) compare(op string, value interface{}) Condition {
+	return Condition{write: func(args *pgx.QueryArgs) string {
+		return f.column + op + args.Append(value)
+	}}
+}
+
+// in returns a condition that matches when the column equals any of the n
+// values of the array parameter values. The SQL does not depend on n so one
+// prepared statement serves every number of values.
+func (f columnFilter) in(n int, values interface{}) Condition {
+	if n == 0 {
+		return Condition{write: func(args *pgx.QueryArgs) string {
+			return "false"
+		}}
+	}
+	return Condition{write: func(args *pgx.QueryArgs) string {
+		return f.column + " = any(" + args.Append(values) + ")"
+	}}
+}
+
+// arrayLiteral returns the text format of a one dimensional array of values.
+// pgx sends strings in the text format so PostgreSQL parses it as an array of
+// the type of the parameter.
+func arrayLiteral(values []string) string {
+	var sb strings.Builder
+	sb.WriteByte('{')
+	for i, v := range values {
+		if i > 0 {
+			sb.WriteByte(',')
+		}
+		sb.WriteByte('"')
+		for _, r := range v {
+			if r == '"' || r == '\\' {
+				sb.WriteByte('\\')
+			}
+			sb.WriteRune(r)
+		}
+		sb.WriteByte('"')
+	}
+	sb.WriteByte('}')
+	return sb.String()
+}
+
 func preparedName(baseName, sql string) string {
 	h := fnv.New32a()
 	if _, err := io.WriteString(h, sql); err != nil {
@@ -71,3 +271,78 @@ func preparedName(baseName, sql string) string {
 
 	return fmt.Sprintf("%s%d", baseName, h.Sum32())
 }
+
+// parseCompositeText splits the text format of a composite value into the text
+// of its fields. NULL fields are nil.
+func parseCompositeText(src []byte) ([][]byte, error) {
+	if len(src) < 2 || src[0] != '(' || src[len(src)-1] != ')' {
+		return nil, errors.Errorf("invalid composite value: %s", src)
+	}
+	src = src[1 : len(src)-1]
+
+	var fields [][]byte
+	for i := 0; ; i++ {
+		var field []byte
+		null := true
+		quoted := false
+		for ; i < len(src) && (quoted || src[i] != ','); i++ {
+			switch {
+			case src[i] == '"' && quoted && i+1 < len(src) && src[i+1] == '"':
+				field = append(field, '"')
+				i++
+			case src[i] == '"':
+				quoted = !quoted
+			case src[i] == '\\' && i+1 < len(src):
+				field = append(field, src[i+1])
+				i++
+			default:
+				field = append(field, src[i])
+			}
+			null = false
+		}
+		if quoted {
+			return nil, errors.Errorf("invalid composite value: unterminated quote")
+		}
+
+		if null {
+			fields = append(fields, nil)
+		} else {
+			fields = append(fields, append([]byte{}, field...))
+		}
+
+		if i >= len(src) {
+			return fields, nil
+		}
+	}
+}
+
+// appendCompositeText appends the text format of a composite value with
+// fields to buf.
+func appendCompositeText(ci *pgtype.ConnInfo, buf []byte, fields ...pgtype.TextEncoder) ([]byte, error) {
+	buf = append(buf, '(')
+	for i, f := range fields {
+		if i > 0 {
+			buf = append(buf, ',')
+		}
+
+		fieldBuf, err := f.EncodeText(ci, nil)
+		if err != nil {
+			return nil, err
+		}
+		if fieldBuf == nil {
+			continue
+		}
+
+		buf = append(buf, '"')
+		for _, b := range fieldBuf {
+			if b == '"' || b == '\\' {
+				buf = append(buf, b)
+			}
+			buf = append(buf, b)
+		}
+		buf = append(buf, '"')
+	}
+	buf = append(buf, ')')
+
+	return buf, nil
+}
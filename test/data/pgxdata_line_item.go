@@ -0,0 +1,952 @@
+package data
+
+// This file is automatically generated by pgxdata.
+
+import (
+	"context"
+	"strings"
+
+	"github.com/jackc/pgtype"
+	"github.com/jackc/pgx/v4"
+	errors "golang.org/x/xerrors"
+)
+
+type LineItem struct {
+	// ID is integer not null generated by default as identity.
+	ID pgtype.Int4
+	// Sku is character varying(20) not null.
+	Sku pgtype.Varchar
+	// Quantity is integer not null default 1.
+	Quantity pgtype.Int4
+	// UnitPrice is numeric(10,2) not null.
+	UnitPrice pgtype.Numeric
+	// Total is numeric(12,2) generated always.
+	Total pgtype.Numeric
+}
+
+const countLineItemSQL = `select count(*) from "line_item"`
+
+func CountLineItem(ctx context.Context, db Queryer) (int64, error) {
+	var n int64
+	err := prepareQueryRow(ctx, db, "pgxdataCountLineItem", countLineItemSQL).Scan(&n)
+	return n, err
+}
+
+const SelectAllLineItemSQL = `select
+  "id",
+  "sku",
+  "quantity",
+  "unit_price",
+  "total"
+from "line_item"`
+
+func SelectAllLineItem(ctx context.Context, db Queryer) ([]LineItem, error) {
+	var rows []LineItem
+
+	err := ForEachLineItem(ctx, db, func(row *LineItem) error {
+		rows = append(rows, *row)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return rows, nil
+}
+
+// LineItemRows is a cursor over the rows of "line_item" that
+// reads one row at a time.
+type LineItemRows struct {
+	rows pgx.Rows
+	row  LineItem
+	err  error
+}
+
+// QueryLineItemRows selects all rows of "line_item". The
+// returned rows must be closed.
+func QueryLineItemRows(ctx context.Context, db Queryer) (*LineItemRows, error) {
+	dbRows, err := prepareQuery(ctx, db, "pgxdataSelectAllLineItem", SelectAllLineItemSQL)
+	if err != nil {
+		return nil, err
+	}
+
+	return &LineItemRows{rows: dbRows}, nil
+}
+
+// Next reads the next row. It returns false when there are no more rows or an
+// error occurred.
+func (r *LineItemRows) Next() bool {
+	if r.err != nil || !r.rows.Next() {
+		return false
+	}
+
+	r.row = LineItem{}
+	r.err = r.rows.Scan(
+		&r.row.ID,
+		&r.row.Sku,
+		&r.row.Quantity,
+		&r.row.UnitPrice,
+		&r.row.Total,
+	)
+	if r.err != nil {
+		r.rows.Close()
+		return false
+	}
+
+	return true
+}
+
+// Row returns the current row. It is overwritten by the next call to Next.
+func (r *LineItemRows) Row() *LineItem {
+	return &r.row
+}
+
+// Err returns the error, if any, that stopped Next.
+func (r *LineItemRows) Err() error {
+	if r.err != nil {
+		return r.err
+	}
+	return r.rows.Err()
+}
+
+// Close closes the rows. It is safe to call Close after all rows have been
+// read.
+func (r *LineItemRows) Close() {
+	r.rows.Close()
+}
+
+// ForEachLineItem calls fn with each row of "line_item". The
+// row passed to fn is reused for the next row. If fn returns an error the
+// remaining rows are skipped and the error is returned.
+func ForEachLineItem(ctx context.Context, db Queryer, fn func(*LineItem) error) error {
+	rows, err := QueryLineItemRows(ctx, db)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		err := fn(rows.Row())
+		if err != nil {
+			return err
+		}
+	}
+
+	return rows.Err()
+}
+
+// LineItemPageCursor is the position after the last row of a
+// page returned by SelectLineItemPage.
+type LineItemPageCursor struct {
+	ID int32
+}
+
+const selectLineItemPageSQL = `select
+  "id",
+  "sku",
+  "quantity",
+  "unit_price",
+  "total"
+from "line_item"
+order by "id"
+limit $1`
+
+const selectLineItemPageAfterSQL = `select
+  "id",
+  "sku",
+  "quantity",
+  "unit_price",
+  "total"
+from "line_item"
+where "id" > $1
+order by "id"
+limit $2`
+
+// SelectLineItemPage selects up to limit rows that follow
+// after, or the first rows when after is nil, ordered by
+// "id". The returned cursor selects the next page. It is nil
+// when there are no more rows.
+func SelectLineItemPage(ctx context.Context, db Queryer, after *LineItemPageCursor, limit int) ([]LineItem, *LineItemPageCursor, error) {
+	var dbRows pgx.Rows
+	var err error
+	if after == nil {
+		dbRows, err = prepareQuery(ctx, db, "pgxdataSelectLineItemPage", selectLineItemPageSQL, limit)
+	} else {
+		dbRows, err = prepareQuery(ctx, db, "pgxdataSelectLineItemPageAfter", selectLineItemPageAfterSQL, after.ID, limit)
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	defer dbRows.Close()
+
+	var rows []LineItem
+	for dbRows.Next() {
+		var row LineItem
+		err := dbRows.Scan(
+			&row.ID,
+			&row.Sku,
+			&row.Quantity,
+			&row.UnitPrice,
+			&row.Total,
+		)
+		if err != nil {
+			return nil, nil, err
+		}
+		rows = append(rows, row)
+	}
+
+	if dbRows.Err() != nil {
+		return nil, nil, dbRows.Err()
+	}
+
+	if len(rows) == 0 || len(rows) < limit {
+		return rows, nil, nil
+	}
+
+	last := &rows[len(rows)-1]
+	next := &LineItemPageCursor{}
+	err = last.ID.AssignTo(&next.ID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return rows, next, nil
+}
+
+const selectLineItemByPKSQL = `select
+  "id",
+  "sku",
+  "quantity",
+  "unit_price",
+  "total"
+from "line_item"
+where "id"=$1`
+
+func SelectLineItemByPK(
+	ctx context.Context,
+	db Queryer,
+	id int32,
+) (*LineItem, error) {
+	var row LineItem
+	err := prepareQueryRow(ctx, db, "pgxdataSelectLineItemByPK", selectLineItemByPKSQL, id).Scan(
+		&row.ID,
+		&row.Sku,
+		&row.Quantity,
+		&row.UnitPrice,
+		&row.Total,
+	)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrNotFound
+	} else if err != nil {
+		return nil, err
+	}
+
+	return &row, nil
+}
+
+const selectLineItemBySkuSQL = `select
+  "id",
+  "sku",
+  "quantity",
+  "unit_price",
+  "total"
+from "line_item"
+where "sku"=$1`
+
+func SelectLineItemBySku(
+	ctx context.Context,
+	db Queryer,
+	sku string,
+) (*LineItem, error) {
+	var row LineItem
+	err := prepareQueryRow(ctx, db, "pgxdataSelectLineItemBySku", selectLineItemBySkuSQL, sku).Scan(
+		&row.ID,
+		&row.Sku,
+		&row.Quantity,
+		&row.UnitPrice,
+		&row.Total,
+	)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrNotFound
+	} else if err != nil {
+		return nil, err
+	}
+
+	return &row, nil
+}
+
+func InsertLineItem(ctx context.Context, db Queryer, row *LineItem) error {
+	args := pgx.QueryArgs(make([]interface{}, 0, 5))
+
+	var columns, values []string
+
+	if row.ID.Status != pgtype.Undefined {
+		columns = append(columns, `id`)
+		values = append(values, args.Append(&row.ID))
+	}
+	if row.Sku.Status != pgtype.Undefined {
+		columns = append(columns, `sku`)
+		values = append(values, args.Append(&row.Sku))
+	}
+	if row.Quantity.Status != pgtype.Undefined {
+		columns = append(columns, `quantity`)
+		values = append(values, args.Append(&row.Quantity))
+	}
+	if row.UnitPrice.Status != pgtype.Undefined {
+		columns = append(columns, `unit_price`)
+		values = append(values, args.Append(&row.UnitPrice))
+	}
+
+	insert := ` default values`
+	if len(columns) > 0 {
+		insert = `(` + strings.Join(columns, ", ") + `)
+values(` + strings.Join(values, ",") + `)`
+	}
+
+	sql := `insert into "line_item"` + insert + `
+returning "id", "sku", "quantity", "unit_price", "total"
+  `
+
+	psName := preparedName("pgxdataInsertLineItem", sql)
+
+	return prepareQueryRow(ctx, db, psName, sql, args...).Scan(&row.ID, &row.Sku, &row.Quantity, &row.UnitPrice, &row.Total)
+}
+
+// updateLineItemSets returns the assignments of the SET clause for the fields of row to update and their
+// arguments.
+func updateLineItemSets(row *LineItem) ([]string, pgx.QueryArgs, error) {
+	sets := make([]string, 0, 5)
+	args := pgx.QueryArgs(make([]interface{}, 0, 5))
+
+	if row.ID.Status != pgtype.Undefined {
+		sets = append(sets, `id`+"="+args.Append(&row.ID))
+	}
+	if row.Sku.Status != pgtype.Undefined {
+		sets = append(sets, `sku`+"="+args.Append(&row.Sku))
+	}
+	if row.Quantity.Status != pgtype.Undefined {
+		sets = append(sets, `quantity`+"="+args.Append(&row.Quantity))
+	}
+	if row.UnitPrice.Status != pgtype.Undefined {
+		sets = append(sets, `unit_price`+"="+args.Append(&row.UnitPrice))
+	}
+
+	return sets, args, nil
+}
+
+func UpdateLineItem(ctx context.Context, db Queryer,
+	id int32,
+	row *LineItem,
+) error {
+	sets, args, err := updateLineItemSets(row)
+	if err != nil {
+		return err
+	}
+	if len(sets) == 0 {
+		return nil
+	}
+
+	sql := `update "line_item" set ` + strings.Join(sets, ", ") + ` where ` + `"id"=` + args.Append(id) + `
+returning "id", "sku", "quantity", "unit_price", "total"`
+
+	psName := preparedName("pgxdataUpdateLineItem", sql)
+
+	err = prepareQueryRow(ctx, db, psName, sql, args...).Scan(&row.ID, &row.Sku, &row.Quantity, &row.UnitPrice, &row.Total)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return ErrNotFound
+	}
+	return err
+}
+
+// LineItemConflict identifies the primary key or unique index UpsertLineItem
+// detects a conflicting row by.
+type LineItemConflict int
+
+const (
+	LineItemConflictOnPK LineItemConflict = iota
+	LineItemConflictOnSku
+)
+
+// LineItemUpsertOptions configures UpsertLineItem.
+type LineItemUpsertOptions struct {
+	// Conflict is the primary key or unique index a conflicting row is detected
+	// by. The primary key is the default.
+	Conflict LineItemConflict
+
+	// DoNothing leaves a conflicting row unchanged instead of updating it.
+	DoNothing bool
+}
+
+// UpsertLineItem inserts row or, when it conflicts with an existing row,
+// updates the existing row with the fields of row that are not Undefined other than
+// those of the conflict target.
+// Like InsertLineItem the persisted row is scanned into row. Nothing is
+// scanned when a conflicting row is left unchanged.
+func UpsertLineItem(ctx context.Context, db Queryer, row *LineItem, opts LineItemUpsertOptions) (UpsertResult, error) {
+	args := pgx.QueryArgs(make([]interface{}, 0, 5))
+
+	var columns, values []string
+
+	if row.ID.Status != pgtype.Undefined {
+		columns = append(columns, `"id"`)
+		values = append(values, args.Append(&row.ID))
+	}
+	if row.Sku.Status != pgtype.Undefined {
+		columns = append(columns, `"sku"`)
+		values = append(values, args.Append(&row.Sku))
+	}
+	if row.Quantity.Status != pgtype.Undefined {
+		columns = append(columns, `"quantity"`)
+		values = append(values, args.Append(&row.Quantity))
+	}
+	if row.UnitPrice.Status != pgtype.Undefined {
+		columns = append(columns, `"unit_price"`)
+		values = append(values, args.Append(&row.UnitPrice))
+	}
+
+	var targetColumns []string
+	switch opts.Conflict {
+	case LineItemConflictOnPK:
+		targetColumns = []string{`"id"`}
+	case LineItemConflictOnSku:
+		targetColumns = []string{`"sku"`}
+	default:
+		return UpsertUnchanged, errors.Errorf("unknown LineItemConflict %d", opts.Conflict)
+	}
+
+	// The conflicting row is updated with the columns other than the conflict
+	// target. It is left unchanged when there are none.
+	sets := make([]string, 0, len(columns))
+nextColumn:
+	for _, c := range columns {
+		for _, tc := range targetColumns {
+			if c == tc {
+				continue nextColumn
+			}
+		}
+		sets = append(sets, c+"=excluded."+c)
+	}
+
+	action := `do update set ` + strings.Join(sets, ", ")
+	if opts.DoNothing || len(sets) == 0 {
+		action = `do nothing`
+	}
+
+	insert := ` default values`
+	if len(columns) > 0 {
+		insert = `(` + strings.Join(columns, ", ") + `)
+values(` + strings.Join(values, ",") + `)`
+	}
+
+	sql := `insert into "line_item"` + insert + `
+on conflict (` + strings.Join(targetColumns, ", ") + `) ` + action + `
+returning xmax = 0, "id", "sku", "quantity", "unit_price", "total"
+  `
+
+	psName := preparedName("pgxdataUpsertLineItem", sql)
+
+	var inserted bool
+	err := prepareQueryRow(ctx, db, psName, sql, args...).Scan(&inserted, &row.ID, &row.Sku, &row.Quantity, &row.UnitPrice, &row.Total)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return UpsertUnchanged, nil
+	} else if err != nil {
+		return UpsertUnchanged, err
+	}
+
+	if inserted {
+		return UpsertInserted, nil
+	}
+	return UpsertUpdated, nil
+}
+
+// insertLineItemColumns returns the columns of row that are not Undefined and their
+// values for inserting row.
+func insertLineItemColumns(row *LineItem) ([]string, []interface{}, error) {
+	var columns []string
+	var values []interface{}
+
+	if row.ID.Status != pgtype.Undefined {
+		columns = append(columns, `id`)
+		values = append(values, &row.ID)
+	}
+	if row.Sku.Status != pgtype.Undefined {
+		columns = append(columns, `sku`)
+		values = append(values, &row.Sku)
+	}
+	if row.Quantity.Status != pgtype.Undefined {
+		columns = append(columns, `quantity`)
+		values = append(values, &row.Quantity)
+	}
+	if row.UnitPrice.Status != pgtype.Undefined {
+		columns = append(columns, `unit_price`)
+		values = append(values, &row.UnitPrice)
+	}
+
+	return columns, values, nil
+}
+
+// CopyInsertLineItem inserts rows with the PostgreSQL copy protocol and
+// returns the number of rows copied. The columns copied are those not
+// Undefined in the first row and every row must set the same fields. Values
+// must support the binary format.
+func CopyInsertLineItem(ctx context.Context, db CopyFromer, rows []LineItem) (int64, error) {
+	if len(rows) == 0 {
+		return 0, nil
+	}
+
+	columns, _, err := insertLineItemColumns(&rows[0])
+	if err != nil {
+		return 0, err
+	}
+
+	return db.CopyFrom(ctx, pgx.Identifier{"line_item"}, columns, &copyFromLineItemSource{rows: rows, columns: columns, idx: -1})
+}
+
+// copyFromLineItemSource is a pgx.CopyFromSource of the columns of rows.
+type copyFromLineItemSource struct {
+	rows    []LineItem
+	columns []string
+	idx     int
+}
+
+func (s *copyFromLineItemSource) Next() bool {
+	s.idx++
+	return s.idx < len(s.rows)
+}
+
+func (s *copyFromLineItemSource) Values() ([]interface{}, error) {
+	columns, values, err := insertLineItemColumns(&s.rows[s.idx])
+	if err != nil {
+		return nil, err
+	}
+	if !equalColumns(columns, s.columns) {
+		return nil, errors.Errorf("row %d does not set the same fields as row 0", s.idx)
+	}
+	return values, nil
+}
+
+func (s *copyFromLineItemSource) Err() error {
+	return nil
+}
+
+// insertManyLineItemTypes are the types the parameters of
+// InsertManyLineItem are cast to. PostgreSQL only infers them for the
+// VALUES list of an INSERT, not for one that is selected from.
+var insertManyLineItemTypes = map[string]string{
+	`id`:         `"pg_catalog"."int4"`,
+	`sku`:        `"pg_catalog"."varchar"`,
+	`quantity`:   `"pg_catalog"."int4"`,
+	`unit_price`: `"pg_catalog"."numeric"`,
+}
+
+// InsertManyLineItem inserts rows with multi-row INSERT statements for
+// a Queryer that does not support the copy protocol or for columns without a
+// binary format. The columns inserted are those not Undefined in the
+// first row and every row must set the same fields. Like InsertLineItem
+// the persisted rows are scanned into rows.
+func InsertManyLineItem(ctx context.Context, db Queryer, rows []LineItem) error {
+	if len(rows) == 0 {
+		return nil
+	}
+
+	columns, _, err := insertLineItemColumns(&rows[0])
+	if err != nil {
+		return err
+	}
+
+	quotedColumns := make([]string, len(columns))
+	for i, column := range columns {
+		quotedColumns[i] = pgx.Identifier{column}.Sanitize()
+	}
+
+	// A statement can have at most 65535 parameters including the ordinality
+	// of each row.
+	batchSize := len(rows)
+	if batchSize > 65535/(len(columns)+1) {
+		batchSize = 65535 / (len(columns) + 1)
+	}
+
+	for start := 0; start < len(rows); start += batchSize {
+		end := start + batchSize
+		if end > len(rows) {
+			end = len(rows)
+		}
+		batch := rows[start:end]
+
+		args := pgx.QueryArgs(make([]interface{}, 0, len(batch)*(len(columns)+1)))
+		valueLists := make([]string, len(batch))
+		for i := range batch {
+			rowColumns, values, err := insertLineItemColumns(&batch[i])
+			if err != nil {
+				return err
+			}
+			if !equalColumns(rowColumns, columns) {
+				return errors.Errorf("row %d does not set the same fields as row 0", start+i)
+			}
+
+			placeholders := make([]string, len(values)+1)
+			for j, v := range values {
+				placeholders[j] = args.Append(v) + "::" + insertManyLineItemTypes[columns[j]]
+			}
+			placeholders[len(values)] = args.Append(int32(i)) + "::int4"
+			valueLists[i] = "(" + strings.Join(placeholders, ",") + ")"
+		}
+
+		// The rows are inserted in the order of their ordinality so they are
+		// returned in the order of batch.
+		sql := `insert into "line_item"(` + strings.Join(quotedColumns, ", ") + `)
+select ` + strings.Join(quotedColumns, ", ") + `
+from (values` + strings.Join(valueLists, ",") + `) as input(` + strings.Join(append(quotedColumns, "pgxdata_ordinality"), ", ") + `)
+order by pgxdata_ordinality
+returning "id", "sku", "quantity", "unit_price", "total"`
+
+		// The SQL depends on the number of rows so it is not prepared, which
+		// would leave a prepared statement for every batch size.
+		dbRows, err := db.Query(ctx, sql, args...)
+		if err != nil {
+			return err
+		}
+
+		// PostgreSQL does not guarantee the order of the returned rows, but it
+		// returns them in the order they were inserted. A different number of rows
+		// means they cannot be matched to batch.
+		n := 0
+		for dbRows.Next() {
+			if n < len(batch) {
+				row := &batch[n]
+				if err := dbRows.Scan(&row.ID, &row.Sku, &row.Quantity, &row.UnitPrice, &row.Total); err != nil {
+					dbRows.Close()
+					return err
+				}
+			}
+			n++
+		}
+
+		if dbRows.Err() != nil {
+			return dbRows.Err()
+		}
+		if n != len(batch) {
+			return errors.Errorf("inserted %d rows but %d rows were returned", len(batch), n)
+		}
+	}
+
+	return nil
+}
+
+// QueueSelectLineItemByPK queues selecting the row by primary key in b.
+// The returned reader scans the row into dst or returns ErrNotFound if there
+// is no such row.
+func QueueSelectLineItemByPK(b *pgx.Batch, id int32, dst *LineItem) BatchReader {
+	args := []interface{}{id}
+	oids := []pgtype.OID{pgtype.Int4OID}
+
+	return queue(b, selectLineItemByPKSQL, args, oids, func(results pgx.BatchResults) error {
+		err := results.QueryRowResults().Scan(
+			&dst.ID,
+			&dst.Sku,
+			&dst.Quantity,
+			&dst.UnitPrice,
+			&dst.Total,
+		)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrNotFound
+		}
+		return err
+	})
+}
+
+// QueueInsertLineItem queues inserting row in b. Like InsertLineItem
+// the returned reader scans the persisted row into row.
+func QueueInsertLineItem(b *pgx.Batch, row *LineItem) BatchReader {
+	args := pgx.QueryArgs(make([]interface{}, 0, 5))
+	oids := make([]pgtype.OID, 0, 5)
+
+	var columns, values []string
+
+	if row.ID.Status != pgtype.Undefined {
+		columns = append(columns, `id`)
+		values = append(values, args.Append(&row.ID))
+		oids = append(oids, pgtype.Int4OID)
+	}
+	if row.Sku.Status != pgtype.Undefined {
+		columns = append(columns, `sku`)
+		values = append(values, args.Append(&row.Sku))
+		oids = append(oids, pgtype.VarcharOID)
+	}
+	if row.Quantity.Status != pgtype.Undefined {
+		columns = append(columns, `quantity`)
+		values = append(values, args.Append(&row.Quantity))
+		oids = append(oids, pgtype.Int4OID)
+	}
+	if row.UnitPrice.Status != pgtype.Undefined {
+		columns = append(columns, `unit_price`)
+		values = append(values, args.Append(&row.UnitPrice))
+		oids = append(oids, pgtype.NumericOID)
+	}
+
+	insert := ` default values`
+	if len(columns) > 0 {
+		insert = `(` + strings.Join(columns, ", ") + `)
+values(` + strings.Join(values, ",") + `)`
+	}
+
+	sql := `insert into "line_item"` + insert + `
+returning "id", "sku", "quantity", "unit_price", "total"`
+
+	return queue(b, sql, args, oids, func(results pgx.BatchResults) error {
+		return results.QueryRowResults().Scan(&row.ID, &row.Sku, &row.Quantity, &row.UnitPrice, &row.Total)
+	})
+}
+
+// QueueUpdateLineItem queues updating the row by primary key in b. Like
+// UpdateLineItem the returned reader scans the persisted row into row
+// or returns ErrNotFound if there is no such row. Nothing is queued when
+// there is nothing to update.
+func QueueUpdateLineItem(b *pgx.Batch, id int32, row *LineItem) BatchReader {
+	sets := make([]string, 0, 5)
+	args := pgx.QueryArgs(make([]interface{}, 0, 5))
+	oids := make([]pgtype.OID, 0, 5)
+
+	if row.ID.Status != pgtype.Undefined {
+		sets = append(sets, `id`+"="+args.Append(&row.ID))
+		oids = append(oids, pgtype.Int4OID)
+	}
+	if row.Sku.Status != pgtype.Undefined {
+		sets = append(sets, `sku`+"="+args.Append(&row.Sku))
+		oids = append(oids, pgtype.VarcharOID)
+	}
+	if row.Quantity.Status != pgtype.Undefined {
+		sets = append(sets, `quantity`+"="+args.Append(&row.Quantity))
+		oids = append(oids, pgtype.Int4OID)
+	}
+	if row.UnitPrice.Status != pgtype.Undefined {
+		sets = append(sets, `unit_price`+"="+args.Append(&row.UnitPrice))
+		oids = append(oids, pgtype.NumericOID)
+	}
+
+	if len(sets) == 0 {
+		return func(pgx.BatchResults) error { return nil }
+	}
+
+	sql := `update "line_item" set ` + strings.Join(sets, ", ") + ` where ` + `"id"=` + args.Append(id) + `
+returning "id", "sku", "quantity", "unit_price", "total"`
+	oids = append(oids, pgtype.Int4OID)
+
+	return queue(b, sql, args, oids, func(results pgx.BatchResults) error {
+		err := results.QueryRowResults().Scan(&row.ID, &row.Sku, &row.Quantity, &row.UnitPrice, &row.Total)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrNotFound
+		}
+		return err
+	})
+}
+
+// QueueDeleteLineItem queues deleting the row by primary key in b. The
+// returned reader returns ErrNotFound if there is no such row.
+func QueueDeleteLineItem(b *pgx.Batch, id int32) BatchReader {
+	args := pgx.QueryArgs(make([]interface{}, 0, 1))
+
+	sql := `delete from "line_item" where ` + `"id"=` + args.Append(id)
+	oids := []pgtype.OID{pgtype.Int4OID}
+
+	return queue(b, sql, args, oids, func(results pgx.BatchResults) error {
+		commandTag, err := results.ExecResults()
+		if err != nil {
+			return err
+		}
+		if commandTag.RowsAffected() != 1 {
+			return ErrNotFound
+		}
+		return nil
+	})
+}
+
+func DeleteLineItem(ctx context.Context, db Queryer,
+	id int32,
+) error {
+	args := pgx.QueryArgs(make([]interface{}, 0, 1))
+
+	sql := `delete from "line_item" where ` + `"id"=` + args.Append(id)
+
+	commandTag, err := prepareExec(ctx, db, "pgxdataDeleteLineItem", sql, args...)
+	if err != nil {
+		return err
+	}
+	if commandTag.RowsAffected() != 1 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+// LineItemWhere has a filter for each column of "line_item" that builds the conditions of
+// SelectLineItemWhere, CountLineItemWhere, UpdateLineItemWhere and DeleteLineItemWhere.
+var LineItemWhere = struct {
+	ID        LineItemIDFilter
+	Sku       LineItemSkuFilter
+	Quantity  LineItemQuantityFilter
+	UnitPrice LineItemUnitPriceFilter
+	Total     LineItemTotalFilter
+}{
+	ID:        LineItemIDFilter{columnFilter{`"id"`}},
+	Sku:       LineItemSkuFilter{columnFilter{`"sku"`}},
+	Quantity:  LineItemQuantityFilter{columnFilter{`"quantity"`}},
+	UnitPrice: LineItemUnitPriceFilter{columnFilter{`"unit_price"`}},
+	Total:     LineItemTotalFilter{columnFilter{`"total"`}},
+}
+
+// LineItemIDFilter builds conditions on id.
+type LineItemIDFilter struct{ columnFilter }
+
+func (f LineItemIDFilter) Eq(v int32) Condition { return f.compare(" = ", v) }
+func (f LineItemIDFilter) Ne(v int32) Condition { return f.compare(" <> ", v) }
+func (f LineItemIDFilter) Lt(v int32) Condition { return f.compare(" < ", v) }
+func (f LineItemIDFilter) Le(v int32) Condition { return f.compare(" <= ", v) }
+func (f LineItemIDFilter) Gt(v int32) Condition { return f.compare(" > ", v) }
+func (f LineItemIDFilter) Ge(v int32) Condition { return f.compare(" >= ", v) }
+
+func (f LineItemIDFilter) In(vs ...int32) Condition { return f.in(len(vs), vs) }
+
+// LineItemSkuFilter builds conditions on sku.
+type LineItemSkuFilter struct{ columnFilter }
+
+func (f LineItemSkuFilter) Eq(v string) Condition { return f.compare(" = ", v) }
+func (f LineItemSkuFilter) Ne(v string) Condition { return f.compare(" <> ", v) }
+func (f LineItemSkuFilter) Lt(v string) Condition { return f.compare(" < ", v) }
+func (f LineItemSkuFilter) Le(v string) Condition { return f.compare(" <= ", v) }
+func (f LineItemSkuFilter) Gt(v string) Condition { return f.compare(" > ", v) }
+func (f LineItemSkuFilter) Ge(v string) Condition { return f.compare(" >= ", v) }
+
+func (f LineItemSkuFilter) In(vs ...string) Condition { return f.in(len(vs), vs) }
+
+// LineItemQuantityFilter builds conditions on quantity.
+type LineItemQuantityFilter struct{ columnFilter }
+
+func (f LineItemQuantityFilter) Eq(v int32) Condition { return f.compare(" = ", v) }
+func (f LineItemQuantityFilter) Ne(v int32) Condition { return f.compare(" <> ", v) }
+func (f LineItemQuantityFilter) Lt(v int32) Condition { return f.compare(" < ", v) }
+func (f LineItemQuantityFilter) Le(v int32) Condition { return f.compare(" <= ", v) }
+func (f LineItemQuantityFilter) Gt(v int32) Condition { return f.compare(" > ", v) }
+func (f LineItemQuantityFilter) Ge(v int32) Condition { return f.compare(" >= ", v) }
+
+func (f LineItemQuantityFilter) In(vs ...int32) Condition { return f.in(len(vs), vs) }
+
+// LineItemUnitPriceFilter builds conditions on unit_price.
+type LineItemUnitPriceFilter struct{ columnFilter }
+
+func (f LineItemUnitPriceFilter) Eq(v string) Condition { return f.compare(" = ", v) }
+func (f LineItemUnitPriceFilter) Ne(v string) Condition { return f.compare(" <> ", v) }
+func (f LineItemUnitPriceFilter) Lt(v string) Condition { return f.compare(" < ", v) }
+func (f LineItemUnitPriceFilter) Le(v string) Condition { return f.compare(" <= ", v) }
+func (f LineItemUnitPriceFilter) Gt(v string) Condition { return f.compare(" > ", v) }
+func (f LineItemUnitPriceFilter) Ge(v string) Condition { return f.compare(" >= ", v) }
+
+func (f LineItemUnitPriceFilter) In(vs ...string) Condition {
+	values := make([]string, len(vs))
+	for i, v := range vs {
+		values[i] = string(v)
+	}
+	return f.in(len(vs), arrayLiteral(values))
+}
+
+// LineItemTotalFilter builds conditions on total.
+type LineItemTotalFilter struct{ columnFilter }
+
+func (f LineItemTotalFilter) Eq(v string) Condition { return f.compare(" = ", v) }
+func (f LineItemTotalFilter) Ne(v string) Condition { return f.compare(" <> ", v) }
+func (f LineItemTotalFilter) Lt(v string) Condition { return f.compare(" < ", v) }
+func (f LineItemTotalFilter) Le(v string) Condition { return f.compare(" <= ", v) }
+func (f LineItemTotalFilter) Gt(v string) Condition { return f.compare(" > ", v) }
+func (f LineItemTotalFilter) Ge(v string) Condition { return f.compare(" >= ", v) }
+
+func (f LineItemTotalFilter) In(vs ...string) Condition {
+	values := make([]string, len(vs))
+	for i, v := range vs {
+		values[i] = string(v)
+	}
+	return f.in(len(vs), arrayLiteral(values))
+}
+
+func SelectLineItemWhere(ctx context.Context, db Queryer, where Condition) ([]LineItem, error) {
+	var args pgx.QueryArgs
+	sql := SelectAllLineItemSQL + `
+where ` + where.sql(&args)
+
+	dbRows, err := prepareQuery(ctx, db, preparedName("pgxdataSelectLineItemWhere", sql), sql, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer dbRows.Close()
+
+	var rows []LineItem
+	for dbRows.Next() {
+		var row LineItem
+		err := dbRows.Scan(
+			&row.ID,
+			&row.Sku,
+			&row.Quantity,
+			&row.UnitPrice,
+			&row.Total,
+		)
+		if err != nil {
+			return nil, err
+		}
+		rows = append(rows, row)
+	}
+
+	if dbRows.Err() != nil {
+		return nil, dbRows.Err()
+	}
+
+	return rows, nil
+}
+
+func CountLineItemWhere(ctx context.Context, db Queryer, where Condition) (int64, error) {
+	var args pgx.QueryArgs
+	sql := countLineItemSQL + ` where ` + where.sql(&args)
+
+	var n int64
+	err := prepareQueryRow(ctx, db, preparedName("pgxdataCountLineItemWhere", sql), sql, args...).Scan(&n)
+	return n, err
+}
+
+// UpdateLineItemWhere updates the rows matching where like UpdateLineItem and returns the number of
+// rows updated. where must restrict the rows, so a Condition that matches every row like the zero Condition is
+// refused.
+func UpdateLineItemWhere(ctx context.Context, db Queryer, where Condition, row *LineItem) (int64, error) {
+	if where.write == nil {
+		return 0, errors.New("UpdateLineItemWhere requires a condition")
+	}
+
+	sets, args, err := updateLineItemSets(row)
+	if err != nil {
+		return 0, err
+	}
+	if len(sets) == 0 {
+		return 0, nil
+	}
+
+	sql := `update "line_item" set ` + strings.Join(sets, ", ") + ` where ` + where.sql(&args)
+
+	commandTag, err := prepareExec(ctx, db, preparedName("pgxdataUpdateLineItemWhere", sql), sql, args...)
+	if err != nil {
+		return 0, err
+	}
+	return commandTag.RowsAffected(), nil
+}
+
+// DeleteLineItemWhere deletes the rows matching where and returns the number of rows deleted. where must
+// restrict the rows, so a Condition that matches every row like the zero Condition is refused.
+func DeleteLineItemWhere(ctx context.Context, db Queryer, where Condition) (int64, error) {
+	if where.write == nil {
+		return 0, errors.New("DeleteLineItemWhere requires a condition")
+	}
+
+	var args pgx.QueryArgs
+	sql := `delete from "line_item" where ` + where.sql(&args)
+
+	commandTag, err := prepareExec(ctx, db, preparedName("pgxdataDeleteLineItemWhere", sql), sql, args...)
+	if err != nil {
+		return 0, err
+	}
+	return commandTag.RowsAffected(), nil
+}
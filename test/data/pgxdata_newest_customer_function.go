@@ -0,0 +1,34 @@
+package data
+
+// This file is automatically generated by pgxdata.
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v4"
+	errors "golang.org/x/xerrors"
+)
+
+const newestCustomerSQL = `select "id", "first_name", "last_name", "birth_date", "creation_time", "email", "address"::text from "newest_customer"()`
+
+// NewestCustomer calls newest_customer and returns its row. It returns
+// ErrNotFound when there is no row.
+func NewestCustomer(ctx context.Context, db Queryer) (*Customer, error) {
+	var row Customer
+	err := prepareQueryRow(ctx, db, preparedName("pgxdataNewestCustomer", newestCustomerSQL), newestCustomerSQL).Scan(
+		&row.ID,
+		&row.FirstName,
+		&row.LastName,
+		&row.BirthDate,
+		&row.CreationTime,
+		&row.Email,
+		&row.Address,
+	)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrNotFound
+	} else if err != nil {
+		return nil, err
+	}
+
+	return &row, nil
+}
@@ -0,0 +1,126 @@
+package data
+
+// This file is automatically generated by pgxdata.
+
+import (
+	"github.com/jackc/pgtype"
+	errors "golang.org/x/xerrors"
+)
+
+// OrderStatus is a label of the PostgreSQL enum public.order_status.
+type OrderStatus string
+
+const (
+	OrderStatusPending   OrderStatus = "pending"
+	OrderStatusShipped   OrderStatus = "shipped"
+	OrderStatusDelivered OrderStatus = "delivered"
+	OrderStatusCancelled OrderStatus = "cancelled"
+)
+
+// Valid returns true if v is a label of public.order_status.
+func (v OrderStatus) Valid() bool {
+	switch v {
+	case OrderStatusPending, OrderStatusShipped, OrderStatusDelivered, OrderStatusCancelled:
+		return true
+	}
+	return false
+}
+
+// OrderStatusBox is the pgtype compatible box for OrderStatus used in row
+// structs.
+type OrderStatusBox struct {
+	Value  OrderStatus
+	Status pgtype.Status
+}
+
+func (dst *OrderStatusBox) Set(src interface{}) error {
+	if src == nil {
+		*dst = OrderStatusBox{Status: pgtype.Null}
+		return nil
+	}
+
+	var v OrderStatus
+	switch value := src.(type) {
+	case OrderStatus:
+		v = value
+	case string:
+		v = OrderStatus(value)
+	case *OrderStatus:
+		if value == nil {
+			*dst = OrderStatusBox{Status: pgtype.Null}
+			return nil
+		}
+		v = *value
+	case *string:
+		if value == nil {
+			*dst = OrderStatusBox{Status: pgtype.Null}
+			return nil
+		}
+		v = OrderStatus(*value)
+	default:
+		return errors.Errorf("cannot convert %v to OrderStatus", value)
+	}
+
+	if !v.Valid() {
+		return errors.Errorf("%q is not a label of public.order_status", string(v))
+	}
+	*dst = OrderStatusBox{Value: v, Status: pgtype.Present}
+	return nil
+}
+
+func (dst *OrderStatusBox) Get() interface{} {
+	switch dst.Status {
+	case pgtype.Present:
+		return dst.Value
+	case pgtype.Null:
+		return nil
+	default:
+		return dst.Status
+	}
+}
+
+func (src *OrderStatusBox) AssignTo(dst interface{}) error {
+	switch src.Status {
+	case pgtype.Present:
+		switch v := dst.(type) {
+		case *OrderStatus:
+			*v = src.Value
+		case *string:
+			*v = string(src.Value)
+		default:
+			return errors.Errorf("unable to assign to %T", dst)
+		}
+		return nil
+	case pgtype.Null:
+		return pgtype.NullAssignTo(dst)
+	}
+
+	return errors.Errorf("cannot assign %v into %T", src, dst)
+}
+
+func (dst *OrderStatusBox) DecodeText(ci *pgtype.ConnInfo, src []byte) error {
+	if src == nil {
+		*dst = OrderStatusBox{Status: pgtype.Null}
+		return nil
+	}
+
+	*dst = OrderStatusBox{Value: OrderStatus(src), Status: pgtype.Present}
+	return nil
+}
+
+// DecodeBinary is the same as DecodeText as enums are selected as text which
+// has the same text and binary format.
+func (dst *OrderStatusBox) DecodeBinary(ci *pgtype.ConnInfo, src []byte) error {
+	return dst.DecodeText(ci, src)
+}
+
+func (src *OrderStatusBox) EncodeText(ci *pgtype.ConnInfo, buf []byte) ([]byte, error) {
+	switch src.Status {
+	case pgtype.Null:
+		return nil, nil
+	case pgtype.Undefined:
+		return nil, errors.New("cannot encode status undefined")
+	}
+
+	return append(buf, src.Value...), nil
+}
@@ -12,7 +12,9 @@ import (
 )
 
 type Part struct {
-	Code        pgtype.Varchar
+	// Code is character varying not null.
+	Code pgtype.Varchar
+	// Description is text not null.
 	Description pgtype.Text
 }
 
@@ -32,25 +34,162 @@ from "part"`
 func SelectAllPart(ctx context.Context, db Queryer) ([]Part, error) {
 	var rows []Part
 
+	err := ForEachPart(ctx, db, func(row *Part) error {
+		rows = append(rows, *row)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return rows, nil
+}
+
+// PartRows is a cursor over the rows of "part" that
+// reads one row at a time.
+type PartRows struct {
+	rows pgx.Rows
+	row  Part
+	err  error
+}
+
+// QueryPartRows selects all rows of "part". The
+// returned rows must be closed.
+func QueryPartRows(ctx context.Context, db Queryer) (*PartRows, error) {
 	dbRows, err := prepareQuery(ctx, db, "pgxdataSelectAllPart", SelectAllPartSQL)
 	if err != nil {
 		return nil, err
 	}
 
+	return &PartRows{rows: dbRows}, nil
+}
+
+// Next reads the next row. It returns false when there are no more rows or an
+// error occurred.
+func (r *PartRows) Next() bool {
+	if r.err != nil || !r.rows.Next() {
+		return false
+	}
+
+	r.row = Part{}
+	r.err = r.rows.Scan(
+		&r.row.Code,
+		&r.row.Description,
+	)
+	if r.err != nil {
+		r.rows.Close()
+		return false
+	}
+
+	return true
+}
+
+// Row returns the current row. It is overwritten by the next call to Next.
+func (r *PartRows) Row() *Part {
+	return &r.row
+}
+
+// Err returns the error, if any, that stopped Next.
+func (r *PartRows) Err() error {
+	if r.err != nil {
+		return r.err
+	}
+	return r.rows.Err()
+}
+
+// Close closes the rows. It is safe to call Close after all rows have been
+// read.
+func (r *PartRows) Close() {
+	r.rows.Close()
+}
+
+// ForEachPart calls fn with each row of "part". The
+// row passed to fn is reused for the next row. If fn returns an error the
+// remaining rows are skipped and the error is returned.
+func ForEachPart(ctx context.Context, db Queryer, fn func(*Part) error) error {
+	rows, err := QueryPartRows(ctx, db)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		err := fn(rows.Row())
+		if err != nil {
+			return err
+		}
+	}
+
+	return rows.Err()
+}
+
+// PartPageCursor is the position after the last row of a
+// page returned by SelectPartPage.
+type PartPageCursor struct {
+	Code string
+}
+
+const selectPartPageSQL = `select
+  "code",
+  "description"
+from "part"
+order by "code"
+limit $1`
+
+const selectPartPageAfterSQL = `select
+  "code",
+  "description"
+from "part"
+where "code" > $1
+order by "code"
+limit $2`
+
+// SelectPartPage selects up to limit rows that follow
+// after, or the first rows when after is nil, ordered by
+// "code". The returned cursor selects the next page. It is nil
+// when there are no more rows.
+func SelectPartPage(ctx context.Context, db Queryer, after *PartPageCursor, limit int) ([]Part, *PartPageCursor, error) {
+	var dbRows pgx.Rows
+	var err error
+	if after == nil {
+		dbRows, err = prepareQuery(ctx, db, "pgxdataSelectPartPage", selectPartPageSQL, limit)
+	} else {
+		dbRows, err = prepareQuery(ctx, db, "pgxdataSelectPartPageAfter", selectPartPageAfterSQL, after.Code, limit)
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	defer dbRows.Close()
+
+	var rows []Part
 	for dbRows.Next() {
 		var row Part
-		dbRows.Scan(
+		err := dbRows.Scan(
 			&row.Code,
 			&row.Description,
 		)
+		if err != nil {
+			return nil, nil, err
+		}
 		rows = append(rows, row)
 	}
 
 	if dbRows.Err() != nil {
-		return nil, dbRows.Err()
+		return nil, nil, dbRows.Err()
 	}
 
-	return rows, nil
+	if len(rows) == 0 || len(rows) < limit {
+		return rows, nil, nil
+	}
+
+	last := &rows[len(rows)-1]
+	next := &PartPageCursor{}
+	err = last.Code.AssignTo(&next.Code)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return rows, next, nil
 }
 
 const selectPartByPKSQL = `select
@@ -92,20 +231,24 @@ func InsertPart(ctx context.Context, db Queryer, row *Part) error {
 		values = append(values, args.Append(&row.Description))
 	}
 
-	sql := `insert into "part"(` + strings.Join(columns, ", ") + `)
-values(` + strings.Join(values, ",") + `)
-returning "code"
+	insert := ` default values`
+	if len(columns) > 0 {
+		insert = `(` + strings.Join(columns, ", ") + `)
+values(` + strings.Join(values, ",") + `)`
+	}
+
+	sql := `insert into "part"` + insert + `
+returning "code", "description"
   `
 
 	psName := preparedName("pgxdataInsertPart", sql)
 
-	return prepareQueryRow(ctx, db, psName, sql, args...).Scan(&row.Code)
+	return prepareQueryRow(ctx, db, psName, sql, args...).Scan(&row.Code, &row.Description)
 }
 
-func UpdatePart(ctx context.Context, db Queryer,
-	code string,
-	row *Part,
-) error {
+// updatePartSets returns the assignments of the SET clause for the fields of row to update and their
+// arguments.
+func updatePartSets(row *Part) ([]string, pgx.QueryArgs, error) {
 	sets := make([]string, 0, 2)
 	args := pgx.QueryArgs(make([]interface{}, 0, 2))
 
@@ -116,24 +259,394 @@ func UpdatePart(ctx context.Context, db Queryer,
 		sets = append(sets, `description`+"="+args.Append(&row.Description))
 	}
 
+	return sets, args, nil
+}
+
+func UpdatePart(ctx context.Context, db Queryer,
+	code string,
+	row *Part,
+) error {
+	sets, args, err := updatePartSets(row)
+	if err != nil {
+		return err
+	}
 	if len(sets) == 0 {
 		return nil
 	}
 
-	sql := `update "part" set ` + strings.Join(sets, ", ") + ` where ` + `"code"=` + args.Append(code)
+	sql := `update "part" set ` + strings.Join(sets, ", ") + ` where ` + `"code"=` + args.Append(code) + `
+returning "code", "description"`
 
 	psName := preparedName("pgxdataUpdatePart", sql)
 
-	commandTag, err := prepareExec(ctx, db, psName, sql, args...)
+	err = prepareQueryRow(ctx, db, psName, sql, args...).Scan(&row.Code, &row.Description)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return ErrNotFound
+	}
+	return err
+}
+
+// PartConflict identifies the primary key or unique index UpsertPart
+// detects a conflicting row by.
+type PartConflict int
+
+const (
+	PartConflictOnPK PartConflict = iota
+)
+
+// PartUpsertOptions configures UpsertPart.
+type PartUpsertOptions struct {
+	// Conflict is the primary key or unique index a conflicting row is detected
+	// by. The primary key is the default.
+	Conflict PartConflict
+
+	// DoNothing leaves a conflicting row unchanged instead of updating it.
+	DoNothing bool
+}
+
+// UpsertPart inserts row or, when it conflicts with an existing row,
+// updates the existing row with the fields of row that are not Undefined other than
+// those of the conflict target.
+// Like InsertPart the persisted row is scanned into row. Nothing is
+// scanned when a conflicting row is left unchanged.
+func UpsertPart(ctx context.Context, db Queryer, row *Part, opts PartUpsertOptions) (UpsertResult, error) {
+	args := pgx.QueryArgs(make([]interface{}, 0, 2))
+
+	var columns, values []string
+
+	if row.Code.Status != pgtype.Undefined {
+		columns = append(columns, `"code"`)
+		values = append(values, args.Append(&row.Code))
+	}
+	if row.Description.Status != pgtype.Undefined {
+		columns = append(columns, `"description"`)
+		values = append(values, args.Append(&row.Description))
+	}
+
+	var targetColumns []string
+	switch opts.Conflict {
+	case PartConflictOnPK:
+		targetColumns = []string{`"code"`}
+	default:
+		return UpsertUnchanged, errors.Errorf("unknown PartConflict %d", opts.Conflict)
+	}
+
+	// The conflicting row is updated with the columns other than the conflict
+	// target. It is left unchanged when there are none.
+	sets := make([]string, 0, len(columns))
+nextColumn:
+	for _, c := range columns {
+		for _, tc := range targetColumns {
+			if c == tc {
+				continue nextColumn
+			}
+		}
+		sets = append(sets, c+"=excluded."+c)
+	}
+
+	action := `do update set ` + strings.Join(sets, ", ")
+	if opts.DoNothing || len(sets) == 0 {
+		action = `do nothing`
+	}
+
+	insert := ` default values`
+	if len(columns) > 0 {
+		insert = `(` + strings.Join(columns, ", ") + `)
+values(` + strings.Join(values, ",") + `)`
+	}
+
+	sql := `insert into "part"` + insert + `
+on conflict (` + strings.Join(targetColumns, ", ") + `) ` + action + `
+returning xmax = 0, "code", "description"
+  `
+
+	psName := preparedName("pgxdataUpsertPart", sql)
+
+	var inserted bool
+	err := prepareQueryRow(ctx, db, psName, sql, args...).Scan(&inserted, &row.Code, &row.Description)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return UpsertUnchanged, nil
+	} else if err != nil {
+		return UpsertUnchanged, err
+	}
+
+	if inserted {
+		return UpsertInserted, nil
+	}
+	return UpsertUpdated, nil
+}
+
+// insertPartColumns returns the columns of row that are not Undefined and their
+// values for inserting row.
+func insertPartColumns(row *Part) ([]string, []interface{}, error) {
+	var columns []string
+	var values []interface{}
+
+	if row.Code.Status != pgtype.Undefined {
+		columns = append(columns, `code`)
+		values = append(values, &row.Code)
+	}
+	if row.Description.Status != pgtype.Undefined {
+		columns = append(columns, `description`)
+		values = append(values, &row.Description)
+	}
+
+	return columns, values, nil
+}
+
+// CopyInsertPart inserts rows with the PostgreSQL copy protocol and
+// returns the number of rows copied. The columns copied are those not
+// Undefined in the first row and every row must set the same fields. Values
+// must support the binary format.
+func CopyInsertPart(ctx context.Context, db CopyFromer, rows []Part) (int64, error) {
+	if len(rows) == 0 {
+		return 0, nil
+	}
+
+	columns, _, err := insertPartColumns(&rows[0])
+	if err != nil {
+		return 0, err
+	}
+
+	return db.CopyFrom(ctx, pgx.Identifier{"part"}, columns, &copyFromPartSource{rows: rows, columns: columns, idx: -1})
+}
+
+// copyFromPartSource is a pgx.CopyFromSource of the columns of rows.
+type copyFromPartSource struct {
+	rows    []Part
+	columns []string
+	idx     int
+}
+
+func (s *copyFromPartSource) Next() bool {
+	s.idx++
+	return s.idx < len(s.rows)
+}
+
+func (s *copyFromPartSource) Values() ([]interface{}, error) {
+	columns, values, err := insertPartColumns(&s.rows[s.idx])
+	if err != nil {
+		return nil, err
+	}
+	if !equalColumns(columns, s.columns) {
+		return nil, errors.Errorf("row %d does not set the same fields as row 0", s.idx)
+	}
+	return values, nil
+}
+
+func (s *copyFromPartSource) Err() error {
+	return nil
+}
+
+// insertManyPartTypes are the types the parameters of
+// InsertManyPart are cast to. PostgreSQL only infers them for the
+// VALUES list of an INSERT, not for one that is selected from.
+var insertManyPartTypes = map[string]string{
+	`code`:        `"pg_catalog"."varchar"`,
+	`description`: `"pg_catalog"."text"`,
+}
+
+// InsertManyPart inserts rows with multi-row INSERT statements for
+// a Queryer that does not support the copy protocol or for columns without a
+// binary format. The columns inserted are those not Undefined in the
+// first row and every row must set the same fields. Like InsertPart
+// the persisted rows are scanned into rows.
+func InsertManyPart(ctx context.Context, db Queryer, rows []Part) error {
+	if len(rows) == 0 {
+		return nil
+	}
+
+	columns, _, err := insertPartColumns(&rows[0])
 	if err != nil {
 		return err
 	}
-	if commandTag.RowsAffected() != 1 {
-		return ErrNotFound
+
+	quotedColumns := make([]string, len(columns))
+	for i, column := range columns {
+		quotedColumns[i] = pgx.Identifier{column}.Sanitize()
+	}
+
+	// A statement can have at most 65535 parameters including the ordinality
+	// of each row.
+	batchSize := len(rows)
+	if batchSize > 65535/(len(columns)+1) {
+		batchSize = 65535 / (len(columns) + 1)
+	}
+
+	for start := 0; start < len(rows); start += batchSize {
+		end := start + batchSize
+		if end > len(rows) {
+			end = len(rows)
+		}
+		batch := rows[start:end]
+
+		args := pgx.QueryArgs(make([]interface{}, 0, len(batch)*(len(columns)+1)))
+		valueLists := make([]string, len(batch))
+		for i := range batch {
+			rowColumns, values, err := insertPartColumns(&batch[i])
+			if err != nil {
+				return err
+			}
+			if !equalColumns(rowColumns, columns) {
+				return errors.Errorf("row %d does not set the same fields as row 0", start+i)
+			}
+
+			placeholders := make([]string, len(values)+1)
+			for j, v := range values {
+				placeholders[j] = args.Append(v) + "::" + insertManyPartTypes[columns[j]]
+			}
+			placeholders[len(values)] = args.Append(int32(i)) + "::int4"
+			valueLists[i] = "(" + strings.Join(placeholders, ",") + ")"
+		}
+
+		// The rows are inserted in the order of their ordinality so they are
+		// returned in the order of batch.
+		sql := `insert into "part"(` + strings.Join(quotedColumns, ", ") + `)
+select ` + strings.Join(quotedColumns, ", ") + `
+from (values` + strings.Join(valueLists, ",") + `) as input(` + strings.Join(append(quotedColumns, "pgxdata_ordinality"), ", ") + `)
+order by pgxdata_ordinality
+returning "code", "description"`
+
+		// The SQL depends on the number of rows so it is not prepared, which
+		// would leave a prepared statement for every batch size.
+		dbRows, err := db.Query(ctx, sql, args...)
+		if err != nil {
+			return err
+		}
+
+		// PostgreSQL does not guarantee the order of the returned rows, but it
+		// returns them in the order they were inserted. A different number of rows
+		// means they cannot be matched to batch.
+		n := 0
+		for dbRows.Next() {
+			if n < len(batch) {
+				row := &batch[n]
+				if err := dbRows.Scan(&row.Code, &row.Description); err != nil {
+					dbRows.Close()
+					return err
+				}
+			}
+			n++
+		}
+
+		if dbRows.Err() != nil {
+			return dbRows.Err()
+		}
+		if n != len(batch) {
+			return errors.Errorf("inserted %d rows but %d rows were returned", len(batch), n)
+		}
 	}
+
 	return nil
 }
 
+// QueueSelectPartByPK queues selecting the row by primary key in b.
+// The returned reader scans the row into dst or returns ErrNotFound if there
+// is no such row.
+func QueueSelectPartByPK(b *pgx.Batch, code string, dst *Part) BatchReader {
+	args := []interface{}{code}
+	oids := []pgtype.OID{pgtype.VarcharOID}
+
+	return queue(b, selectPartByPKSQL, args, oids, func(results pgx.BatchResults) error {
+		err := results.QueryRowResults().Scan(
+			&dst.Code,
+			&dst.Description,
+		)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrNotFound
+		}
+		return err
+	})
+}
+
+// QueueInsertPart queues inserting row in b. Like InsertPart
+// the returned reader scans the persisted row into row.
+func QueueInsertPart(b *pgx.Batch, row *Part) BatchReader {
+	args := pgx.QueryArgs(make([]interface{}, 0, 2))
+	oids := make([]pgtype.OID, 0, 2)
+
+	var columns, values []string
+
+	if row.Code.Status != pgtype.Undefined {
+		columns = append(columns, `code`)
+		values = append(values, args.Append(&row.Code))
+		oids = append(oids, pgtype.VarcharOID)
+	}
+	if row.Description.Status != pgtype.Undefined {
+		columns = append(columns, `description`)
+		values = append(values, args.Append(&row.Description))
+		oids = append(oids, pgtype.TextOID)
+	}
+
+	insert := ` default values`
+	if len(columns) > 0 {
+		insert = `(` + strings.Join(columns, ", ") + `)
+values(` + strings.Join(values, ",") + `)`
+	}
+
+	sql := `insert into "part"` + insert + `
+returning "code", "description"`
+
+	return queue(b, sql, args, oids, func(results pgx.BatchResults) error {
+		return results.QueryRowResults().Scan(&row.Code, &row.Description)
+	})
+}
+
+// QueueUpdatePart queues updating the row by primary key in b. Like
+// UpdatePart the returned reader scans the persisted row into row
+// or returns ErrNotFound if there is no such row. Nothing is queued when
+// there is nothing to update.
+func QueueUpdatePart(b *pgx.Batch, code string, row *Part) BatchReader {
+	sets := make([]string, 0, 2)
+	args := pgx.QueryArgs(make([]interface{}, 0, 2))
+	oids := make([]pgtype.OID, 0, 2)
+
+	if row.Code.Status != pgtype.Undefined {
+		sets = append(sets, `code`+"="+args.Append(&row.Code))
+		oids = append(oids, pgtype.VarcharOID)
+	}
+	if row.Description.Status != pgtype.Undefined {
+		sets = append(sets, `description`+"="+args.Append(&row.Description))
+		oids = append(oids, pgtype.TextOID)
+	}
+
+	if len(sets) == 0 {
+		return func(pgx.BatchResults) error { return nil }
+	}
+
+	sql := `update "part" set ` + strings.Join(sets, ", ") + ` where ` + `"code"=` + args.Append(code) + `
+returning "code", "description"`
+	oids = append(oids, pgtype.VarcharOID)
+
+	return queue(b, sql, args, oids, func(results pgx.BatchResults) error {
+		err := results.QueryRowResults().Scan(&row.Code, &row.Description)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrNotFound
+		}
+		return err
+	})
+}
+
+// QueueDeletePart queues deleting the row by primary key in b. The
+// returned reader returns ErrNotFound if there is no such row.
+func QueueDeletePart(b *pgx.Batch, code string) BatchReader {
+	args := pgx.QueryArgs(make([]interface{}, 0, 1))
+
+	sql := `delete from "part" where ` + `"code"=` + args.Append(code)
+	oids := []pgtype.OID{pgtype.VarcharOID}
+
+	return queue(b, sql, args, oids, func(results pgx.BatchResults) error {
+		commandTag, err := results.ExecResults()
+		if err != nil {
+			return err
+		}
+		if commandTag.RowsAffected() != 1 {
+			return ErrNotFound
+		}
+		return nil
+	})
+}
+
 func DeletePart(ctx context.Context, db Queryer,
 	code string,
 ) error {
@@ -150,3 +663,119 @@ func DeletePart(ctx context.Context, db Queryer,
 	}
 	return nil
 }
+
+// PartWhere has a filter for each column of "part" that builds the conditions of
+// SelectPartWhere, CountPartWhere, UpdatePartWhere and DeletePartWhere.
+var PartWhere = struct {
+	Code        PartCodeFilter
+	Description PartDescriptionFilter
+}{
+	Code:        PartCodeFilter{columnFilter{`"code"`}},
+	Description: PartDescriptionFilter{columnFilter{`"description"`}},
+}
+
+// PartCodeFilter builds conditions on code.
+type PartCodeFilter struct{ columnFilter }
+
+func (f PartCodeFilter) Eq(v string) Condition { return f.compare(" = ", v) }
+func (f PartCodeFilter) Ne(v string) Condition { return f.compare(" <> ", v) }
+func (f PartCodeFilter) Lt(v string) Condition { return f.compare(" < ", v) }
+func (f PartCodeFilter) Le(v string) Condition { return f.compare(" <= ", v) }
+func (f PartCodeFilter) Gt(v string) Condition { return f.compare(" > ", v) }
+func (f PartCodeFilter) Ge(v string) Condition { return f.compare(" >= ", v) }
+
+func (f PartCodeFilter) In(vs ...string) Condition { return f.in(len(vs), vs) }
+
+// PartDescriptionFilter builds conditions on description.
+type PartDescriptionFilter struct{ columnFilter }
+
+func (f PartDescriptionFilter) Eq(v string) Condition { return f.compare(" = ", v) }
+func (f PartDescriptionFilter) Ne(v string) Condition { return f.compare(" <> ", v) }
+func (f PartDescriptionFilter) Lt(v string) Condition { return f.compare(" < ", v) }
+func (f PartDescriptionFilter) Le(v string) Condition { return f.compare(" <= ", v) }
+func (f PartDescriptionFilter) Gt(v string) Condition { return f.compare(" > ", v) }
+func (f PartDescriptionFilter) Ge(v string) Condition { return f.compare(" >= ", v) }
+
+func (f PartDescriptionFilter) In(vs ...string) Condition { return f.in(len(vs), vs) }
+
+func SelectPartWhere(ctx context.Context, db Queryer, where Condition) ([]Part, error) {
+	var args pgx.QueryArgs
+	sql := SelectAllPartSQL + `
+where ` + where.sql(&args)
+
+	dbRows, err := prepareQuery(ctx, db, preparedName("pgxdataSelectPartWhere", sql), sql, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer dbRows.Close()
+
+	var rows []Part
+	for dbRows.Next() {
+		var row Part
+		err := dbRows.Scan(
+			&row.Code,
+			&row.Description,
+		)
+		if err != nil {
+			return nil, err
+		}
+		rows = append(rows, row)
+	}
+
+	if dbRows.Err() != nil {
+		return nil, dbRows.Err()
+	}
+
+	return rows, nil
+}
+
+func CountPartWhere(ctx context.Context, db Queryer, where Condition) (int64, error) {
+	var args pgx.QueryArgs
+	sql := countPartSQL + ` where ` + where.sql(&args)
+
+	var n int64
+	err := prepareQueryRow(ctx, db, preparedName("pgxdataCountPartWhere", sql), sql, args...).Scan(&n)
+	return n, err
+}
+
+// UpdatePartWhere updates the rows matching where like UpdatePart and returns the number of
+// rows updated. where must restrict the rows, so a Condition that matches every row like the zero Condition is
+// refused.
+func UpdatePartWhere(ctx context.Context, db Queryer, where Condition, row *Part) (int64, error) {
+	if where.write == nil {
+		return 0, errors.New("UpdatePartWhere requires a condition")
+	}
+
+	sets, args, err := updatePartSets(row)
+	if err != nil {
+		return 0, err
+	}
+	if len(sets) == 0 {
+		return 0, nil
+	}
+
+	sql := `update "part" set ` + strings.Join(sets, ", ") + ` where ` + where.sql(&args)
+
+	commandTag, err := prepareExec(ctx, db, preparedName("pgxdataUpdatePartWhere", sql), sql, args...)
+	if err != nil {
+		return 0, err
+	}
+	return commandTag.RowsAffected(), nil
+}
+
+// DeletePartWhere deletes the rows matching where and returns the number of rows deleted. where must
+// restrict the rows, so a Condition that matches every row like the zero Condition is refused.
+func DeletePartWhere(ctx context.Context, db Queryer, where Condition) (int64, error) {
+	if where.write == nil {
+		return 0, errors.New("DeletePartWhere requires a condition")
+	}
+
+	var args pgx.QueryArgs
+	sql := `delete from "part" where ` + where.sql(&args)
+
+	commandTag, err := prepareExec(ctx, db, preparedName("pgxdataDeletePartWhere", sql), sql, args...)
+	if err != nil {
+		return 0, err
+	}
+	return commandTag.RowsAffected(), nil
+}
@@ -0,0 +1,1010 @@
+package data
+
+// This file is automatically generated by pgxdata.
+
+import (
+	"context"
+	"strings"
+
+	"github.com/jackc/pgtype"
+	"github.com/jackc/pgx/v4"
+	errors "golang.org/x/xerrors"
+)
+
+type PurchaseOrder struct {
+	// ID is integer not null default nextval('purchase_order_id_seq'::regclass).
+	ID pgtype.Int4
+	// Status is order_status not null.
+	Status OrderStatusBox
+	// PreviousStatus is order_status.
+	PreviousStatus OrderStatusBox
+	// CustomerID is integer.
+	CustomerID pgtype.Int4
+}
+
+const countPurchaseOrderSQL = `select count(*) from "purchase_order"`
+
+func CountPurchaseOrder(ctx context.Context, db Queryer) (int64, error) {
+	var n int64
+	err := prepareQueryRow(ctx, db, "pgxdataCountPurchaseOrder", countPurchaseOrderSQL).Scan(&n)
+	return n, err
+}
+
+const SelectAllPurchaseOrderSQL = `select
+  "id",
+  "status"::text,
+  "previous_status"::text,
+  "customer_id"
+from "purchase_order"`
+
+func SelectAllPurchaseOrder(ctx context.Context, db Queryer) ([]PurchaseOrder, error) {
+	var rows []PurchaseOrder
+
+	err := ForEachPurchaseOrder(ctx, db, func(row *PurchaseOrder) error {
+		rows = append(rows, *row)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return rows, nil
+}
+
+// PurchaseOrderRows is a cursor over the rows of "purchase_order" that
+// reads one row at a time.
+type PurchaseOrderRows struct {
+	rows pgx.Rows
+	row  PurchaseOrder
+	err  error
+}
+
+// QueryPurchaseOrderRows selects all rows of "purchase_order". The
+// returned rows must be closed.
+func QueryPurchaseOrderRows(ctx context.Context, db Queryer) (*PurchaseOrderRows, error) {
+	dbRows, err := prepareQuery(ctx, db, "pgxdataSelectAllPurchaseOrder", SelectAllPurchaseOrderSQL)
+	if err != nil {
+		return nil, err
+	}
+
+	return &PurchaseOrderRows{rows: dbRows}, nil
+}
+
+// Next reads the next row. It returns false when there are no more rows or an
+// error occurred.
+func (r *PurchaseOrderRows) Next() bool {
+	if r.err != nil || !r.rows.Next() {
+		return false
+	}
+
+	r.row = PurchaseOrder{}
+	r.err = r.rows.Scan(
+		&r.row.ID,
+		&r.row.Status,
+		&r.row.PreviousStatus,
+		&r.row.CustomerID,
+	)
+	if r.err != nil {
+		r.rows.Close()
+		return false
+	}
+
+	return true
+}
+
+// Row returns the current row. It is overwritten by the next call to Next.
+func (r *PurchaseOrderRows) Row() *PurchaseOrder {
+	return &r.row
+}
+
+// Err returns the error, if any, that stopped Next.
+func (r *PurchaseOrderRows) Err() error {
+	if r.err != nil {
+		return r.err
+	}
+	return r.rows.Err()
+}
+
+// Close closes the rows. It is safe to call Close after all rows have been
+// read.
+func (r *PurchaseOrderRows) Close() {
+	r.rows.Close()
+}
+
+// ForEachPurchaseOrder calls fn with each row of "purchase_order". The
+// row passed to fn is reused for the next row. If fn returns an error the
+// remaining rows are skipped and the error is returned.
+func ForEachPurchaseOrder(ctx context.Context, db Queryer, fn func(*PurchaseOrder) error) error {
+	rows, err := QueryPurchaseOrderRows(ctx, db)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		err := fn(rows.Row())
+		if err != nil {
+			return err
+		}
+	}
+
+	return rows.Err()
+}
+
+// PurchaseOrderPageCursor is the position after the last row of a
+// page returned by SelectPurchaseOrderPage.
+type PurchaseOrderPageCursor struct {
+	ID int32
+}
+
+const selectPurchaseOrderPageSQL = `select
+  "id",
+  "status"::text,
+  "previous_status"::text,
+  "customer_id"
+from "purchase_order"
+order by "id"
+limit $1`
+
+const selectPurchaseOrderPageAfterSQL = `select
+  "id",
+  "status"::text,
+  "previous_status"::text,
+  "customer_id"
+from "purchase_order"
+where "id" > $1
+order by "id"
+limit $2`
+
+// SelectPurchaseOrderPage selects up to limit rows that follow
+// after, or the first rows when after is nil, ordered by
+// "id". The returned cursor selects the next page. It is nil
+// when there are no more rows.
+func SelectPurchaseOrderPage(ctx context.Context, db Queryer, after *PurchaseOrderPageCursor, limit int) ([]PurchaseOrder, *PurchaseOrderPageCursor, error) {
+	var dbRows pgx.Rows
+	var err error
+	if after == nil {
+		dbRows, err = prepareQuery(ctx, db, "pgxdataSelectPurchaseOrderPage", selectPurchaseOrderPageSQL, limit)
+	} else {
+		dbRows, err = prepareQuery(ctx, db, "pgxdataSelectPurchaseOrderPageAfter", selectPurchaseOrderPageAfterSQL, after.ID, limit)
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	defer dbRows.Close()
+
+	var rows []PurchaseOrder
+	for dbRows.Next() {
+		var row PurchaseOrder
+		err := dbRows.Scan(
+			&row.ID,
+			&row.Status,
+			&row.PreviousStatus,
+			&row.CustomerID,
+		)
+		if err != nil {
+			return nil, nil, err
+		}
+		rows = append(rows, row)
+	}
+
+	if dbRows.Err() != nil {
+		return nil, nil, dbRows.Err()
+	}
+
+	if len(rows) == 0 || len(rows) < limit {
+		return rows, nil, nil
+	}
+
+	last := &rows[len(rows)-1]
+	next := &PurchaseOrderPageCursor{}
+	err = last.ID.AssignTo(&next.ID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return rows, next, nil
+}
+
+const selectPurchaseOrderByPKSQL = `select
+  "id",
+  "status"::text,
+  "previous_status"::text,
+  "customer_id"
+from "purchase_order"
+where "id"=$1`
+
+func SelectPurchaseOrderByPK(
+	ctx context.Context,
+	db Queryer,
+	id int32,
+) (*PurchaseOrder, error) {
+	var row PurchaseOrder
+	err := prepareQueryRow(ctx, db, "pgxdataSelectPurchaseOrderByPK", selectPurchaseOrderByPKSQL, id).Scan(
+		&row.ID,
+		&row.Status,
+		&row.PreviousStatus,
+		&row.CustomerID,
+	)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrNotFound
+	} else if err != nil {
+		return nil, err
+	}
+
+	return &row, nil
+}
+
+const selectPurchaseOrderByCustomerIDSQL = `select
+  "id",
+  "status"::text,
+  "previous_status"::text,
+  "customer_id"
+from "purchase_order"
+where "customer_id"=$1
+order by "id"`
+
+// SelectPurchaseOrderByCustomerID selects the rows of "purchase_order" that reference the given key.
+func SelectPurchaseOrderByCustomerID(
+	ctx context.Context,
+	db Queryer,
+	customerID int32,
+) ([]PurchaseOrder, error) {
+	var rows []PurchaseOrder
+
+	dbRows, err := prepareQuery(ctx, db, "pgxdataSelectPurchaseOrderByCustomerID", selectPurchaseOrderByCustomerIDSQL, customerID)
+	if err != nil {
+		return nil, err
+	}
+	defer dbRows.Close()
+
+	for dbRows.Next() {
+		var row PurchaseOrder
+		err := dbRows.Scan(
+			&row.ID,
+			&row.Status,
+			&row.PreviousStatus,
+			&row.CustomerID,
+		)
+		if err != nil {
+			return nil, err
+		}
+		rows = append(rows, row)
+	}
+
+	if dbRows.Err() != nil {
+		return nil, dbRows.Err()
+	}
+
+	return rows, nil
+}
+
+const loadPurchaseOrderByCustomerIDSQL = `select
+  "id",
+  "status"::text,
+  "previous_status"::text,
+  "customer_id",
+  "customer_id"
+from "purchase_order"
+where "customer_id" = any($1)
+order by "id"`
+
+// LoadPurchaseOrderByCustomerID selects the rows of "purchase_order" that reference any of keys in one query and
+// groups them by key. Keys without rows are not in the map.
+func LoadPurchaseOrderByCustomerID(ctx context.Context, db Queryer, keys []int32) (map[int32][]PurchaseOrder, error) {
+	rowsByKey := make(map[int32][]PurchaseOrder)
+	if len(keys) == 0 {
+		return rowsByKey, nil
+	}
+
+	dbRows, err := prepareQuery(ctx, db, "pgxdataLoadPurchaseOrderByCustomerID", loadPurchaseOrderByCustomerIDSQL, keys)
+	if err != nil {
+		return nil, err
+	}
+	defer dbRows.Close()
+
+	for dbRows.Next() {
+		var row PurchaseOrder
+		var key int32
+		err := dbRows.Scan(
+			&row.ID,
+			&row.Status,
+			&row.PreviousStatus,
+			&row.CustomerID,
+			&key,
+		)
+		if err != nil {
+			return nil, err
+		}
+		rowsByKey[key] = append(rowsByKey[key], row)
+	}
+
+	if dbRows.Err() != nil {
+		return nil, dbRows.Err()
+	}
+
+	return rowsByKey, nil
+}
+
+func InsertPurchaseOrder(ctx context.Context, db Queryer, row *PurchaseOrder) error {
+	args := pgx.QueryArgs(make([]interface{}, 0, 4))
+
+	var columns, values []string
+
+	if row.ID.Status != pgtype.Undefined {
+		columns = append(columns, `id`)
+		values = append(values, args.Append(&row.ID))
+	}
+	if row.Status.Status != pgtype.Undefined {
+		columns = append(columns, `status`)
+		values = append(values, args.Append(&row.Status))
+	}
+	if row.PreviousStatus.Status != pgtype.Undefined {
+		columns = append(columns, `previous_status`)
+		values = append(values, args.Append(&row.PreviousStatus))
+	}
+	if row.CustomerID.Status != pgtype.Undefined {
+		columns = append(columns, `customer_id`)
+		values = append(values, args.Append(&row.CustomerID))
+	}
+
+	insert := ` default values`
+	if len(columns) > 0 {
+		insert = `(` + strings.Join(columns, ", ") + `)
+values(` + strings.Join(values, ",") + `)`
+	}
+
+	sql := `insert into "purchase_order"` + insert + `
+returning "id", "status"::text, "previous_status"::text, "customer_id"
+  `
+
+	psName := preparedName("pgxdataInsertPurchaseOrder", sql)
+
+	return prepareQueryRow(ctx, db, psName, sql, args...).Scan(&row.ID, &row.Status, &row.PreviousStatus, &row.CustomerID)
+}
+
+// updatePurchaseOrderSets returns the assignments of the SET clause for the fields of row to update and their
+// arguments.
+func updatePurchaseOrderSets(row *PurchaseOrder) ([]string, pgx.QueryArgs, error) {
+	sets := make([]string, 0, 4)
+	args := pgx.QueryArgs(make([]interface{}, 0, 4))
+
+	if row.ID.Status != pgtype.Undefined {
+		sets = append(sets, `id`+"="+args.Append(&row.ID))
+	}
+	if row.Status.Status != pgtype.Undefined {
+		sets = append(sets, `status`+"="+args.Append(&row.Status))
+	}
+	if row.PreviousStatus.Status != pgtype.Undefined {
+		sets = append(sets, `previous_status`+"="+args.Append(&row.PreviousStatus))
+	}
+	if row.CustomerID.Status != pgtype.Undefined {
+		sets = append(sets, `customer_id`+"="+args.Append(&row.CustomerID))
+	}
+
+	return sets, args, nil
+}
+
+func UpdatePurchaseOrder(ctx context.Context, db Queryer,
+	id int32,
+	row *PurchaseOrder,
+) error {
+	sets, args, err := updatePurchaseOrderSets(row)
+	if err != nil {
+		return err
+	}
+	if len(sets) == 0 {
+		return nil
+	}
+
+	sql := `update "purchase_order" set ` + strings.Join(sets, ", ") + ` where ` + `"id"=` + args.Append(id) + `
+returning "id", "status"::text, "previous_status"::text, "customer_id"`
+
+	psName := preparedName("pgxdataUpdatePurchaseOrder", sql)
+
+	err = prepareQueryRow(ctx, db, psName, sql, args...).Scan(&row.ID, &row.Status, &row.PreviousStatus, &row.CustomerID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return ErrNotFound
+	}
+	return err
+}
+
+// PurchaseOrderConflict identifies the primary key or unique index UpsertPurchaseOrder
+// detects a conflicting row by.
+type PurchaseOrderConflict int
+
+const (
+	PurchaseOrderConflictOnPK PurchaseOrderConflict = iota
+)
+
+// PurchaseOrderUpsertOptions configures UpsertPurchaseOrder.
+type PurchaseOrderUpsertOptions struct {
+	// Conflict is the primary key or unique index a conflicting row is detected
+	// by. The primary key is the default.
+	Conflict PurchaseOrderConflict
+
+	// DoNothing leaves a conflicting row unchanged instead of updating it.
+	DoNothing bool
+}
+
+// UpsertPurchaseOrder inserts row or, when it conflicts with an existing row,
+// updates the existing row with the fields of row that are not Undefined other than
+// those of the conflict target.
+// Like InsertPurchaseOrder the persisted row is scanned into row. Nothing is
+// scanned when a conflicting row is left unchanged.
+func UpsertPurchaseOrder(ctx context.Context, db Queryer, row *PurchaseOrder, opts PurchaseOrderUpsertOptions) (UpsertResult, error) {
+	args := pgx.QueryArgs(make([]interface{}, 0, 4))
+
+	var columns, values []string
+
+	if row.ID.Status != pgtype.Undefined {
+		columns = append(columns, `"id"`)
+		values = append(values, args.Append(&row.ID))
+	}
+	if row.Status.Status != pgtype.Undefined {
+		columns = append(columns, `"status"`)
+		values = append(values, args.Append(&row.Status))
+	}
+	if row.PreviousStatus.Status != pgtype.Undefined {
+		columns = append(columns, `"previous_status"`)
+		values = append(values, args.Append(&row.PreviousStatus))
+	}
+	if row.CustomerID.Status != pgtype.Undefined {
+		columns = append(columns, `"customer_id"`)
+		values = append(values, args.Append(&row.CustomerID))
+	}
+
+	var targetColumns []string
+	switch opts.Conflict {
+	case PurchaseOrderConflictOnPK:
+		targetColumns = []string{`"id"`}
+	default:
+		return UpsertUnchanged, errors.Errorf("unknown PurchaseOrderConflict %d", opts.Conflict)
+	}
+
+	// The conflicting row is updated with the columns other than the conflict
+	// target. It is left unchanged when there are none.
+	sets := make([]string, 0, len(columns))
+nextColumn:
+	for _, c := range columns {
+		for _, tc := range targetColumns {
+			if c == tc {
+				continue nextColumn
+			}
+		}
+		sets = append(sets, c+"=excluded."+c)
+	}
+
+	action := `do update set ` + strings.Join(sets, ", ")
+	if opts.DoNothing || len(sets) == 0 {
+		action = `do nothing`
+	}
+
+	insert := ` default values`
+	if len(columns) > 0 {
+		insert = `(` + strings.Join(columns, ", ") + `)
+values(` + strings.Join(values, ",") + `)`
+	}
+
+	sql := `insert into "purchase_order"` + insert + `
+on conflict (` + strings.Join(targetColumns, ", ") + `) ` + action + `
+returning xmax = 0, "id", "status"::text, "previous_status"::text, "customer_id"
+  `
+
+	psName := preparedName("pgxdataUpsertPurchaseOrder", sql)
+
+	var inserted bool
+	err := prepareQueryRow(ctx, db, psName, sql, args...).Scan(&inserted, &row.ID, &row.Status, &row.PreviousStatus, &row.CustomerID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return UpsertUnchanged, nil
+	} else if err != nil {
+		return UpsertUnchanged, err
+	}
+
+	if inserted {
+		return UpsertInserted, nil
+	}
+	return UpsertUpdated, nil
+}
+
+// insertPurchaseOrderColumns returns the columns of row that are not Undefined and their
+// values for inserting row.
+func insertPurchaseOrderColumns(row *PurchaseOrder) ([]string, []interface{}, error) {
+	var columns []string
+	var values []interface{}
+
+	if row.ID.Status != pgtype.Undefined {
+		columns = append(columns, `id`)
+		values = append(values, &row.ID)
+	}
+	if row.Status.Status != pgtype.Undefined {
+		columns = append(columns, `status`)
+		values = append(values, &row.Status)
+	}
+	if row.PreviousStatus.Status != pgtype.Undefined {
+		columns = append(columns, `previous_status`)
+		values = append(values, &row.PreviousStatus)
+	}
+	if row.CustomerID.Status != pgtype.Undefined {
+		columns = append(columns, `customer_id`)
+		values = append(values, &row.CustomerID)
+	}
+
+	return columns, values, nil
+}
+
+// CopyInsertPurchaseOrder inserts rows with the PostgreSQL copy protocol and
+// returns the number of rows copied. The columns copied are those not
+// Undefined in the first row and every row must set the same fields. Values
+// must support the binary format.
+func CopyInsertPurchaseOrder(ctx context.Context, db CopyFromer, rows []PurchaseOrder) (int64, error) {
+	if len(rows) == 0 {
+		return 0, nil
+	}
+
+	columns, _, err := insertPurchaseOrderColumns(&rows[0])
+	if err != nil {
+		return 0, err
+	}
+
+	return db.CopyFrom(ctx, pgx.Identifier{"purchase_order"}, columns, &copyFromPurchaseOrderSource{rows: rows, columns: columns, idx: -1})
+}
+
+// copyFromPurchaseOrderSource is a pgx.CopyFromSource of the columns of rows.
+type copyFromPurchaseOrderSource struct {
+	rows    []PurchaseOrder
+	columns []string
+	idx     int
+}
+
+func (s *copyFromPurchaseOrderSource) Next() bool {
+	s.idx++
+	return s.idx < len(s.rows)
+}
+
+func (s *copyFromPurchaseOrderSource) Values() ([]interface{}, error) {
+	columns, values, err := insertPurchaseOrderColumns(&s.rows[s.idx])
+	if err != nil {
+		return nil, err
+	}
+	if !equalColumns(columns, s.columns) {
+		return nil, errors.Errorf("row %d does not set the same fields as row 0", s.idx)
+	}
+	for i, column := range columns {
+		if values[i], err = copyPurchaseOrderValue(column, values[i]); err != nil {
+			return nil, err
+		}
+	}
+	return values, nil
+}
+
+func (s *copyFromPurchaseOrderSource) Err() error {
+	return nil
+}
+
+// copyPurchaseOrderValue converts value, the value of column returned by
+// insertPurchaseOrderColumns, to one pgx sends in the binary format of the
+// copy protocol.
+func copyPurchaseOrderValue(column string, value interface{}) (interface{}, error) {
+	switch column {
+	case `status`:
+		v := value.(*OrderStatusBox)
+		if v.Status != pgtype.Present {
+			return nil, nil
+		}
+		return string(v.Value), nil
+	case `previous_status`:
+		v := value.(*OrderStatusBox)
+		if v.Status != pgtype.Present {
+			return nil, nil
+		}
+		return string(v.Value), nil
+	}
+	return value, nil
+}
+
+// insertManyPurchaseOrderTypes are the types the parameters of
+// InsertManyPurchaseOrder are cast to. PostgreSQL only infers them for the
+// VALUES list of an INSERT, not for one that is selected from.
+var insertManyPurchaseOrderTypes = map[string]string{
+	`id`:              `"pg_catalog"."int4"`,
+	`status`:          `"public"."order_status"`,
+	`previous_status`: `"public"."order_status"`,
+	`customer_id`:     `"pg_catalog"."int4"`,
+}
+
+// InsertManyPurchaseOrder inserts rows with multi-row INSERT statements for
+// a Queryer that does not support the copy protocol or for columns without a
+// binary format. The columns inserted are those not Undefined in the
+// first row and every row must set the same fields. Like InsertPurchaseOrder
+// the persisted rows are scanned into rows.
+func InsertManyPurchaseOrder(ctx context.Context, db Queryer, rows []PurchaseOrder) error {
+	if len(rows) == 0 {
+		return nil
+	}
+
+	columns, _, err := insertPurchaseOrderColumns(&rows[0])
+	if err != nil {
+		return err
+	}
+
+	quotedColumns := make([]string, len(columns))
+	for i, column := range columns {
+		quotedColumns[i] = pgx.Identifier{column}.Sanitize()
+	}
+
+	// A statement can have at most 65535 parameters including the ordinality
+	// of each row.
+	batchSize := len(rows)
+	if batchSize > 65535/(len(columns)+1) {
+		batchSize = 65535 / (len(columns) + 1)
+	}
+
+	for start := 0; start < len(rows); start += batchSize {
+		end := start + batchSize
+		if end > len(rows) {
+			end = len(rows)
+		}
+		batch := rows[start:end]
+
+		args := pgx.QueryArgs(make([]interface{}, 0, len(batch)*(len(columns)+1)))
+		valueLists := make([]string, len(batch))
+		for i := range batch {
+			rowColumns, values, err := insertPurchaseOrderColumns(&batch[i])
+			if err != nil {
+				return err
+			}
+			if !equalColumns(rowColumns, columns) {
+				return errors.Errorf("row %d does not set the same fields as row 0", start+i)
+			}
+
+			placeholders := make([]string, len(values)+1)
+			for j, v := range values {
+				placeholders[j] = args.Append(v) + "::" + insertManyPurchaseOrderTypes[columns[j]]
+			}
+			placeholders[len(values)] = args.Append(int32(i)) + "::int4"
+			valueLists[i] = "(" + strings.Join(placeholders, ",") + ")"
+		}
+
+		// The rows are inserted in the order of their ordinality so they are
+		// returned in the order of batch.
+		sql := `insert into "purchase_order"(` + strings.Join(quotedColumns, ", ") + `)
+select ` + strings.Join(quotedColumns, ", ") + `
+from (values` + strings.Join(valueLists, ",") + `) as input(` + strings.Join(append(quotedColumns, "pgxdata_ordinality"), ", ") + `)
+order by pgxdata_ordinality
+returning "id", "status"::text, "previous_status"::text, "customer_id"`
+
+		// The SQL depends on the number of rows so it is not prepared, which
+		// would leave a prepared statement for every batch size.
+		dbRows, err := db.Query(ctx, sql, args...)
+		if err != nil {
+			return err
+		}
+
+		// PostgreSQL does not guarantee the order of the returned rows, but it
+		// returns them in the order they were inserted. A different number of rows
+		// means they cannot be matched to batch.
+		n := 0
+		for dbRows.Next() {
+			if n < len(batch) {
+				row := &batch[n]
+				if err := dbRows.Scan(&row.ID, &row.Status, &row.PreviousStatus, &row.CustomerID); err != nil {
+					dbRows.Close()
+					return err
+				}
+			}
+			n++
+		}
+
+		if dbRows.Err() != nil {
+			return dbRows.Err()
+		}
+		if n != len(batch) {
+			return errors.Errorf("inserted %d rows but %d rows were returned", len(batch), n)
+		}
+	}
+
+	return nil
+}
+
+// QueueSelectPurchaseOrderByPK queues selecting the row by primary key in b.
+// The returned reader scans the row into dst or returns ErrNotFound if there
+// is no such row.
+func QueueSelectPurchaseOrderByPK(b *pgx.Batch, id int32, dst *PurchaseOrder) BatchReader {
+	args := []interface{}{id}
+	oids := []pgtype.OID{pgtype.Int4OID}
+
+	return queue(b, selectPurchaseOrderByPKSQL, args, oids, func(results pgx.BatchResults) error {
+		err := results.QueryRowResults().Scan(
+			&dst.ID,
+			&dst.Status,
+			&dst.PreviousStatus,
+			&dst.CustomerID,
+		)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrNotFound
+		}
+		return err
+	})
+}
+
+// QueueInsertPurchaseOrder queues inserting row in b. Like InsertPurchaseOrder
+// the returned reader scans the persisted row into row.
+func QueueInsertPurchaseOrder(b *pgx.Batch, row *PurchaseOrder) BatchReader {
+	args := pgx.QueryArgs(make([]interface{}, 0, 4))
+	oids := make([]pgtype.OID, 0, 4)
+
+	var columns, values []string
+
+	if row.ID.Status != pgtype.Undefined {
+		columns = append(columns, `id`)
+		values = append(values, args.Append(&row.ID))
+		oids = append(oids, pgtype.Int4OID)
+	}
+	if row.Status.Status != pgtype.Undefined {
+		columns = append(columns, `status`)
+		values = append(values, args.Append(&row.Status))
+		oids = append(oids, 0)
+	}
+	if row.PreviousStatus.Status != pgtype.Undefined {
+		columns = append(columns, `previous_status`)
+		values = append(values, args.Append(&row.PreviousStatus))
+		oids = append(oids, 0)
+	}
+	if row.CustomerID.Status != pgtype.Undefined {
+		columns = append(columns, `customer_id`)
+		values = append(values, args.Append(&row.CustomerID))
+		oids = append(oids, pgtype.Int4OID)
+	}
+
+	insert := ` default values`
+	if len(columns) > 0 {
+		insert = `(` + strings.Join(columns, ", ") + `)
+values(` + strings.Join(values, ",") + `)`
+	}
+
+	sql := `insert into "purchase_order"` + insert + `
+returning "id", "status"::text, "previous_status"::text, "customer_id"`
+
+	return queue(b, sql, args, oids, func(results pgx.BatchResults) error {
+		return results.QueryRowResults().Scan(&row.ID, &row.Status, &row.PreviousStatus, &row.CustomerID)
+	})
+}
+
+// QueueUpdatePurchaseOrder queues updating the row by primary key in b. Like
+// UpdatePurchaseOrder the returned reader scans the persisted row into row
+// or returns ErrNotFound if there is no such row. Nothing is queued when
+// there is nothing to update.
+func QueueUpdatePurchaseOrder(b *pgx.Batch, id int32, row *PurchaseOrder) BatchReader {
+	sets := make([]string, 0, 4)
+	args := pgx.QueryArgs(make([]interface{}, 0, 4))
+	oids := make([]pgtype.OID, 0, 4)
+
+	if row.ID.Status != pgtype.Undefined {
+		sets = append(sets, `id`+"="+args.Append(&row.ID))
+		oids = append(oids, pgtype.Int4OID)
+	}
+	if row.Status.Status != pgtype.Undefined {
+		sets = append(sets, `status`+"="+args.Append(&row.Status))
+		oids = append(oids, 0)
+	}
+	if row.PreviousStatus.Status != pgtype.Undefined {
+		sets = append(sets, `previous_status`+"="+args.Append(&row.PreviousStatus))
+		oids = append(oids, 0)
+	}
+	if row.CustomerID.Status != pgtype.Undefined {
+		sets = append(sets, `customer_id`+"="+args.Append(&row.CustomerID))
+		oids = append(oids, pgtype.Int4OID)
+	}
+
+	if len(sets) == 0 {
+		return func(pgx.BatchResults) error { return nil }
+	}
+
+	sql := `update "purchase_order" set ` + strings.Join(sets, ", ") + ` where ` + `"id"=` + args.Append(id) + `
+returning "id", "status"::text, "previous_status"::text, "customer_id"`
+	oids = append(oids, pgtype.Int4OID)
+
+	return queue(b, sql, args, oids, func(results pgx.BatchResults) error {
+		err := results.QueryRowResults().Scan(&row.ID, &row.Status, &row.PreviousStatus, &row.CustomerID)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrNotFound
+		}
+		return err
+	})
+}
+
+// QueueDeletePurchaseOrder queues deleting the row by primary key in b. The
+// returned reader returns ErrNotFound if there is no such row.
+func QueueDeletePurchaseOrder(b *pgx.Batch, id int32) BatchReader {
+	args := pgx.QueryArgs(make([]interface{}, 0, 1))
+
+	sql := `delete from "purchase_order" where ` + `"id"=` + args.Append(id)
+	oids := []pgtype.OID{pgtype.Int4OID}
+
+	return queue(b, sql, args, oids, func(results pgx.BatchResults) error {
+		commandTag, err := results.ExecResults()
+		if err != nil {
+			return err
+		}
+		if commandTag.RowsAffected() != 1 {
+			return ErrNotFound
+		}
+		return nil
+	})
+}
+
+func DeletePurchaseOrder(ctx context.Context, db Queryer,
+	id int32,
+) error {
+	args := pgx.QueryArgs(make([]interface{}, 0, 1))
+
+	sql := `delete from "purchase_order" where ` + `"id"=` + args.Append(id)
+
+	commandTag, err := prepareExec(ctx, db, "pgxdataDeletePurchaseOrder", sql, args...)
+	if err != nil {
+		return err
+	}
+	if commandTag.RowsAffected() != 1 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+// PurchaseOrderWhere has a filter for each column of "purchase_order" that builds the conditions of
+// SelectPurchaseOrderWhere, CountPurchaseOrderWhere, UpdatePurchaseOrderWhere and DeletePurchaseOrderWhere.
+var PurchaseOrderWhere = struct {
+	ID             PurchaseOrderIDFilter
+	Status         PurchaseOrderStatusFilter
+	PreviousStatus PurchaseOrderPreviousStatusFilter
+	CustomerID     PurchaseOrderCustomerIDFilter
+}{
+	ID:             PurchaseOrderIDFilter{columnFilter{`"id"`}},
+	Status:         PurchaseOrderStatusFilter{columnFilter{`"status"`}},
+	PreviousStatus: PurchaseOrderPreviousStatusFilter{columnFilter{`"previous_status"`}},
+	CustomerID:     PurchaseOrderCustomerIDFilter{columnFilter{`"customer_id"`}},
+}
+
+// PurchaseOrderIDFilter builds conditions on id.
+type PurchaseOrderIDFilter struct{ columnFilter }
+
+func (f PurchaseOrderIDFilter) Eq(v int32) Condition { return f.compare(" = ", v) }
+func (f PurchaseOrderIDFilter) Ne(v int32) Condition { return f.compare(" <> ", v) }
+func (f PurchaseOrderIDFilter) Lt(v int32) Condition { return f.compare(" < ", v) }
+func (f PurchaseOrderIDFilter) Le(v int32) Condition { return f.compare(" <= ", v) }
+func (f PurchaseOrderIDFilter) Gt(v int32) Condition { return f.compare(" > ", v) }
+func (f PurchaseOrderIDFilter) Ge(v int32) Condition { return f.compare(" >= ", v) }
+
+func (f PurchaseOrderIDFilter) In(vs ...int32) Condition { return f.in(len(vs), vs) }
+
+// PurchaseOrderStatusFilter builds conditions on status.
+type PurchaseOrderStatusFilter struct{ columnFilter }
+
+func (f PurchaseOrderStatusFilter) Eq(v OrderStatus) Condition { return f.compare(" = ", v) }
+func (f PurchaseOrderStatusFilter) Ne(v OrderStatus) Condition { return f.compare(" <> ", v) }
+func (f PurchaseOrderStatusFilter) Lt(v OrderStatus) Condition { return f.compare(" < ", v) }
+func (f PurchaseOrderStatusFilter) Le(v OrderStatus) Condition { return f.compare(" <= ", v) }
+func (f PurchaseOrderStatusFilter) Gt(v OrderStatus) Condition { return f.compare(" > ", v) }
+func (f PurchaseOrderStatusFilter) Ge(v OrderStatus) Condition { return f.compare(" >= ", v) }
+
+func (f PurchaseOrderStatusFilter) In(vs ...OrderStatus) Condition {
+	values := make([]string, len(vs))
+	for i, v := range vs {
+		values[i] = string(v)
+	}
+	return f.in(len(vs), arrayLiteral(values))
+}
+
+// PurchaseOrderPreviousStatusFilter builds conditions on previous_status.
+type PurchaseOrderPreviousStatusFilter struct{ columnFilter }
+
+func (f PurchaseOrderPreviousStatusFilter) Eq(v OrderStatus) Condition { return f.compare(" = ", v) }
+func (f PurchaseOrderPreviousStatusFilter) Ne(v OrderStatus) Condition { return f.compare(" <> ", v) }
+func (f PurchaseOrderPreviousStatusFilter) Lt(v OrderStatus) Condition { return f.compare(" < ", v) }
+func (f PurchaseOrderPreviousStatusFilter) Le(v OrderStatus) Condition { return f.compare(" <= ", v) }
+func (f PurchaseOrderPreviousStatusFilter) Gt(v OrderStatus) Condition { return f.compare(" > ", v) }
+func (f PurchaseOrderPreviousStatusFilter) Ge(v OrderStatus) Condition { return f.compare(" >= ", v) }
+
+func (f PurchaseOrderPreviousStatusFilter) In(vs ...OrderStatus) Condition {
+	values := make([]string, len(vs))
+	for i, v := range vs {
+		values[i] = string(v)
+	}
+	return f.in(len(vs), arrayLiteral(values))
+}
+
+// PurchaseOrderCustomerIDFilter builds conditions on customer_id.
+type PurchaseOrderCustomerIDFilter struct{ columnFilter }
+
+func (f PurchaseOrderCustomerIDFilter) Eq(v int32) Condition { return f.compare(" = ", v) }
+func (f PurchaseOrderCustomerIDFilter) Ne(v int32) Condition { return f.compare(" <> ", v) }
+func (f PurchaseOrderCustomerIDFilter) Lt(v int32) Condition { return f.compare(" < ", v) }
+func (f PurchaseOrderCustomerIDFilter) Le(v int32) Condition { return f.compare(" <= ", v) }
+func (f PurchaseOrderCustomerIDFilter) Gt(v int32) Condition { return f.compare(" > ", v) }
+func (f PurchaseOrderCustomerIDFilter) Ge(v int32) Condition { return f.compare(" >= ", v) }
+
+func (f PurchaseOrderCustomerIDFilter) In(vs ...int32) Condition { return f.in(len(vs), vs) }
+
+func SelectPurchaseOrderWhere(ctx context.Context, db Queryer, where Condition) ([]PurchaseOrder, error) {
+	var args pgx.QueryArgs
+	sql := SelectAllPurchaseOrderSQL + `
+where ` + where.sql(&args)
+
+	dbRows, err := prepareQuery(ctx, db, preparedName("pgxdataSelectPurchaseOrderWhere", sql), sql, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer dbRows.Close()
+
+	var rows []PurchaseOrder
+	for dbRows.Next() {
+		var row PurchaseOrder
+		err := dbRows.Scan(
+			&row.ID,
+			&row.Status,
+			&row.PreviousStatus,
+			&row.CustomerID,
+		)
+		if err != nil {
+			return nil, err
+		}
+		rows = append(rows, row)
+	}
+
+	if dbRows.Err() != nil {
+		return nil, dbRows.Err()
+	}
+
+	return rows, nil
+}
+
+func CountPurchaseOrderWhere(ctx context.Context, db Queryer, where Condition) (int64, error) {
+	var args pgx.QueryArgs
+	sql := countPurchaseOrderSQL + ` where ` + where.sql(&args)
+
+	var n int64
+	err := prepareQueryRow(ctx, db, preparedName("pgxdataCountPurchaseOrderWhere", sql), sql, args...).Scan(&n)
+	return n, err
+}
+
+// UpdatePurchaseOrderWhere updates the rows matching where like UpdatePurchaseOrder and returns the number of
+// rows updated. where must restrict the rows, so a Condition that matches every row like the zero Condition is
+// refused.
+func UpdatePurchaseOrderWhere(ctx context.Context, db Queryer, where Condition, row *PurchaseOrder) (int64, error) {
+	if where.write == nil {
+		return 0, errors.New("UpdatePurchaseOrderWhere requires a condition")
+	}
+
+	sets, args, err := updatePurchaseOrderSets(row)
+	if err != nil {
+		return 0, err
+	}
+	if len(sets) == 0 {
+		return 0, nil
+	}
+
+	sql := `update "purchase_order" set ` + strings.Join(sets, ", ") + ` where ` + where.sql(&args)
+
+	commandTag, err := prepareExec(ctx, db, preparedName("pgxdataUpdatePurchaseOrderWhere", sql), sql, args...)
+	if err != nil {
+		return 0, err
+	}
+	return commandTag.RowsAffected(), nil
+}
+
+// DeletePurchaseOrderWhere deletes the rows matching where and returns the number of rows deleted. where must
+// restrict the rows, so a Condition that matches every row like the zero Condition is refused.
+func DeletePurchaseOrderWhere(ctx context.Context, db Queryer, where Condition) (int64, error) {
+	if where.write == nil {
+		return 0, errors.New("DeletePurchaseOrderWhere requires a condition")
+	}
+
+	var args pgx.QueryArgs
+	sql := `delete from "purchase_order" where ` + where.sql(&args)
+
+	commandTag, err := prepareExec(ctx, db, preparedName("pgxdataDeletePurchaseOrderWhere", sql), sql, args...)
+	if err != nil {
+		return 0, err
+	}
+	return commandTag.RowsAffected(), nil
+}
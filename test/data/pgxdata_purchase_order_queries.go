@@ -0,0 +1,91 @@
+package data
+
+// This file is automatically generated by pgxdata.
+
+import (
+	"context"
+
+	"github.com/jackc/pgtype"
+	"github.com/jackc/pgx/v4"
+	errors "golang.org/x/xerrors"
+)
+
+const purchaseOrderStatusSQL = `select id, status, previous_status
+from purchase_order
+where id = $1`
+
+// PurchaseOrderStatusRow is a row returned by PurchaseOrderStatus.
+type PurchaseOrderStatusRow struct {
+	ID             pgtype.Int4
+	Status         OrderStatusBox
+	PreviousStatus OrderStatusBox
+}
+
+// PurchaseOrderStatus returns the first row of purchaseOrderStatusSQL. It returns ErrNotFound when there are no rows.
+func PurchaseOrderStatus(ctx context.Context, db Queryer, id int32) (*PurchaseOrderStatusRow, error) {
+	var row PurchaseOrderStatusRow
+	err := prepareQueryRow(ctx, db, preparedName("pgxdataPurchaseOrderStatus", purchaseOrderStatusSQL), purchaseOrderStatusSQL, pgx.QueryResultFormats{pgx.BinaryFormatCode, pgx.TextFormatCode, pgx.TextFormatCode}, id).Scan(
+		&row.ID,
+		&row.Status,
+		&row.PreviousStatus,
+	)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrNotFound
+	} else if err != nil {
+		return nil, err
+	}
+
+	return &row, nil
+}
+
+const purchaseOrdersWithStatusSQL = `select id, customer_id
+from purchase_order
+where status = $1
+order by id`
+
+// PurchaseOrdersWithStatusRow is a row returned by PurchaseOrdersWithStatus.
+type PurchaseOrdersWithStatusRow struct {
+	ID         pgtype.Int4
+	CustomerID pgtype.Int4
+}
+
+// PurchaseOrdersWithStatus returns the rows of purchaseOrdersWithStatusSQL.
+func PurchaseOrdersWithStatus(ctx context.Context, db Queryer, status OrderStatus) ([]PurchaseOrdersWithStatusRow, error) {
+	dbRows, err := prepareQuery(ctx, db, preparedName("pgxdataPurchaseOrdersWithStatus", purchaseOrdersWithStatusSQL), purchaseOrdersWithStatusSQL, status)
+	if err != nil {
+		return nil, err
+	}
+	defer dbRows.Close()
+
+	var rows []PurchaseOrdersWithStatusRow
+	for dbRows.Next() {
+		var row PurchaseOrdersWithStatusRow
+		err := dbRows.Scan(
+			&row.ID,
+			&row.CustomerID,
+		)
+		if err != nil {
+			return nil, err
+		}
+		rows = append(rows, row)
+	}
+
+	if dbRows.Err() != nil {
+		return nil, dbRows.Err()
+	}
+
+	return rows, nil
+}
+
+const cancelPurchaseOrdersSQL = `update purchase_order
+set previous_status = status, status = 'cancelled'
+where customer_id = $1 and status <> 'cancelled'`
+
+// CancelPurchaseOrders runs cancelPurchaseOrdersSQL and returns the number of rows affected.
+func CancelPurchaseOrders(ctx context.Context, db Queryer, customerID int32) (int64, error) {
+	commandTag, err := prepareExec(ctx, db, preparedName("pgxdataCancelPurchaseOrders", cancelPurchaseOrdersSQL), cancelPurchaseOrdersSQL, customerID)
+	if err != nil {
+		return 0, err
+	}
+	return commandTag.RowsAffected(), nil
+}
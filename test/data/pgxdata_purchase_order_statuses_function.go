@@ -0,0 +1,44 @@
+package data
+
+// This file is automatically generated by pgxdata.
+
+import (
+	"context"
+	"github.com/jackc/pgtype"
+)
+
+const purchaseOrderStatusesSQL = `select "id", "status"::text from "purchase_order_statuses"($1)`
+
+// PurchaseOrderStatusesRow is a row returned by PurchaseOrderStatuses.
+type PurchaseOrderStatusesRow struct {
+	ID     pgtype.Int4
+	Status OrderStatusBox
+}
+
+// PurchaseOrderStatuses calls purchase_order_statuses and returns its rows.
+func PurchaseOrderStatuses(ctx context.Context, db Queryer, minID int32) ([]PurchaseOrderStatusesRow, error) {
+	dbRows, err := prepareQuery(ctx, db, preparedName("pgxdataPurchaseOrderStatuses", purchaseOrderStatusesSQL), purchaseOrderStatusesSQL, minID)
+	if err != nil {
+		return nil, err
+	}
+	defer dbRows.Close()
+
+	var rows []PurchaseOrderStatusesRow
+	for dbRows.Next() {
+		var row PurchaseOrderStatusesRow
+		err := dbRows.Scan(
+			&row.ID,
+			&row.Status,
+		)
+		if err != nil {
+			return nil, err
+		}
+		rows = append(rows, row)
+	}
+
+	if dbRows.Err() != nil {
+		return nil, dbRows.Err()
+	}
+
+	return rows, nil
+}
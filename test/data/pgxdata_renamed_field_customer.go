@@ -9,14 +9,24 @@ import (
 	"github.com/jackc/pgtype"
 	"github.com/jackc/pgx/v4"
 	errors "golang.org/x/xerrors"
+	"time"
 )
 
 type RenamedFieldCustomer struct {
-	ID           pgtype.Int4
-	FName        pgtype.Varchar
-	LastName     pgtype.Varchar
-	BirthDate    pgtype.Date
+	// ID is integer not null default nextval('customer_id_seq'::regclass).
+	ID pgtype.Int4
+	// FName is character varying not null.
+	FName pgtype.Varchar
+	// LastName is character varying not null.
+	LastName pgtype.Varchar
+	// BirthDate is date.
+	BirthDate pgtype.Date
+	// CreationTime is timestamp with time zone not null default now().
 	CreationTime pgtype.Timestamptz
+	// Email is email_address.
+	Email pgtype.Text
+	// Address is address.
+	Address AddressBox
 }
 
 const countRenamedFieldCustomerSQL = `select count(*) from "customer"`
@@ -32,34 +42,190 @@ const SelectAllRenamedFieldCustomerSQL = `select
   "first_name",
   "last_name",
   "birth_date",
-  "creation_time"
+  "creation_time",
+  "email",
+  "address"::text
 from "customer"`
 
 func SelectAllRenamedFieldCustomer(ctx context.Context, db Queryer) ([]RenamedFieldCustomer, error) {
 	var rows []RenamedFieldCustomer
 
+	err := ForEachRenamedFieldCustomer(ctx, db, func(row *RenamedFieldCustomer) error {
+		rows = append(rows, *row)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return rows, nil
+}
+
+// RenamedFieldCustomerRows is a cursor over the rows of "customer" that
+// reads one row at a time.
+type RenamedFieldCustomerRows struct {
+	rows pgx.Rows
+	row  RenamedFieldCustomer
+	err  error
+}
+
+// QueryRenamedFieldCustomerRows selects all rows of "customer". The
+// returned rows must be closed.
+func QueryRenamedFieldCustomerRows(ctx context.Context, db Queryer) (*RenamedFieldCustomerRows, error) {
 	dbRows, err := prepareQuery(ctx, db, "pgxdataSelectAllRenamedFieldCustomer", SelectAllRenamedFieldCustomerSQL)
 	if err != nil {
 		return nil, err
 	}
 
+	return &RenamedFieldCustomerRows{rows: dbRows}, nil
+}
+
+// Next reads the next row. It returns false when there are no more rows or an
+// error occurred.
+func (r *RenamedFieldCustomerRows) Next() bool {
+	if r.err != nil || !r.rows.Next() {
+		return false
+	}
+
+	r.row = RenamedFieldCustomer{}
+	r.err = r.rows.Scan(
+		&r.row.ID,
+		&r.row.FName,
+		&r.row.LastName,
+		&r.row.BirthDate,
+		&r.row.CreationTime,
+		&r.row.Email,
+		&r.row.Address,
+	)
+	if r.err != nil {
+		r.rows.Close()
+		return false
+	}
+
+	return true
+}
+
+// Row returns the current row. It is overwritten by the next call to Next.
+func (r *RenamedFieldCustomerRows) Row() *RenamedFieldCustomer {
+	return &r.row
+}
+
+// Err returns the error, if any, that stopped Next.
+func (r *RenamedFieldCustomerRows) Err() error {
+	if r.err != nil {
+		return r.err
+	}
+	return r.rows.Err()
+}
+
+// Close closes the rows. It is safe to call Close after all rows have been
+// read.
+func (r *RenamedFieldCustomerRows) Close() {
+	r.rows.Close()
+}
+
+// ForEachRenamedFieldCustomer calls fn with each row of "customer". The
+// row passed to fn is reused for the next row. If fn returns an error the
+// remaining rows are skipped and the error is returned.
+func ForEachRenamedFieldCustomer(ctx context.Context, db Queryer, fn func(*RenamedFieldCustomer) error) error {
+	rows, err := QueryRenamedFieldCustomerRows(ctx, db)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		err := fn(rows.Row())
+		if err != nil {
+			return err
+		}
+	}
+
+	return rows.Err()
+}
+
+// RenamedFieldCustomerPageCursor is the position after the last row of a
+// page returned by SelectRenamedFieldCustomerPage.
+type RenamedFieldCustomerPageCursor struct {
+	ID int32
+}
+
+const selectRenamedFieldCustomerPageSQL = `select
+  "id",
+  "first_name",
+  "last_name",
+  "birth_date",
+  "creation_time",
+  "email",
+  "address"::text
+from "customer"
+order by "id"
+limit $1`
+
+const selectRenamedFieldCustomerPageAfterSQL = `select
+  "id",
+  "first_name",
+  "last_name",
+  "birth_date",
+  "creation_time",
+  "email",
+  "address"::text
+from "customer"
+where "id" > $1
+order by "id"
+limit $2`
+
+// SelectRenamedFieldCustomerPage selects up to limit rows that follow
+// after, or the first rows when after is nil, ordered by
+// "id". The returned cursor selects the next page. It is nil
+// when there are no more rows.
+func SelectRenamedFieldCustomerPage(ctx context.Context, db Queryer, after *RenamedFieldCustomerPageCursor, limit int) ([]RenamedFieldCustomer, *RenamedFieldCustomerPageCursor, error) {
+	var dbRows pgx.Rows
+	var err error
+	if after == nil {
+		dbRows, err = prepareQuery(ctx, db, "pgxdataSelectRenamedFieldCustomerPage", selectRenamedFieldCustomerPageSQL, limit)
+	} else {
+		dbRows, err = prepareQuery(ctx, db, "pgxdataSelectRenamedFieldCustomerPageAfter", selectRenamedFieldCustomerPageAfterSQL, after.ID, limit)
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	defer dbRows.Close()
+
+	var rows []RenamedFieldCustomer
 	for dbRows.Next() {
 		var row RenamedFieldCustomer
-		dbRows.Scan(
+		err := dbRows.Scan(
 			&row.ID,
 			&row.FName,
 			&row.LastName,
 			&row.BirthDate,
 			&row.CreationTime,
+			&row.Email,
+			&row.Address,
 		)
+		if err != nil {
+			return nil, nil, err
+		}
 		rows = append(rows, row)
 	}
 
 	if dbRows.Err() != nil {
-		return nil, dbRows.Err()
+		return nil, nil, dbRows.Err()
 	}
 
-	return rows, nil
+	if len(rows) == 0 || len(rows) < limit {
+		return rows, nil, nil
+	}
+
+	last := &rows[len(rows)-1]
+	next := &RenamedFieldCustomerPageCursor{}
+	err = last.ID.AssignTo(&next.ID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return rows, next, nil
 }
 
 const selectRenamedFieldCustomerByPKSQL = `select
@@ -67,7 +233,9 @@ const selectRenamedFieldCustomerByPKSQL = `select
   "first_name",
   "last_name",
   "birth_date",
-  "creation_time"
+  "creation_time",
+  "email",
+  "address"::text
 from "customer"
 where "id"=$1`
 
@@ -83,6 +251,43 @@ func SelectRenamedFieldCustomerByPK(
 		&row.LastName,
 		&row.BirthDate,
 		&row.CreationTime,
+		&row.Email,
+		&row.Address,
+	)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrNotFound
+	} else if err != nil {
+		return nil, err
+	}
+
+	return &row, nil
+}
+
+const selectRenamedFieldCustomerByEmailSQL = `select
+  "id",
+  "first_name",
+  "last_name",
+  "birth_date",
+  "creation_time",
+  "email",
+  "address"::text
+from "customer"
+where "email"=$1`
+
+func SelectRenamedFieldCustomerByEmail(
+	ctx context.Context,
+	db Queryer,
+	email string,
+) (*RenamedFieldCustomer, error) {
+	var row RenamedFieldCustomer
+	err := prepareQueryRow(ctx, db, "pgxdataSelectRenamedFieldCustomerByEmail", selectRenamedFieldCustomerByEmailSQL, email).Scan(
+		&row.ID,
+		&row.FName,
+		&row.LastName,
+		&row.BirthDate,
+		&row.CreationTime,
+		&row.Email,
+		&row.Address,
 	)
 	if errors.Is(err, pgx.ErrNoRows) {
 		return nil, ErrNotFound
@@ -94,7 +299,7 @@ func SelectRenamedFieldCustomerByPK(
 }
 
 func InsertRenamedFieldCustomer(ctx context.Context, db Queryer, row *RenamedFieldCustomer) error {
-	args := pgx.QueryArgs(make([]interface{}, 0, 5))
+	args := pgx.QueryArgs(make([]interface{}, 0, 7))
 
 	var columns, values []string
 
@@ -118,23 +323,35 @@ func InsertRenamedFieldCustomer(ctx context.Context, db Queryer, row *RenamedFie
 		columns = append(columns, `creation_time`)
 		values = append(values, args.Append(&row.CreationTime))
 	}
+	if row.Email.Status != pgtype.Undefined {
+		columns = append(columns, `email`)
+		values = append(values, args.Append(&row.Email))
+	}
+	if row.Address.Status != pgtype.Undefined {
+		columns = append(columns, `address`)
+		values = append(values, args.Append(&row.Address))
+	}
 
-	sql := `insert into "customer"(` + strings.Join(columns, ", ") + `)
-values(` + strings.Join(values, ",") + `)
-returning "id"
+	insert := ` default values`
+	if len(columns) > 0 {
+		insert = `(` + strings.Join(columns, ", ") + `)
+values(` + strings.Join(values, ",") + `)`
+	}
+
+	sql := `insert into "customer"` + insert + `
+returning "id", "creation_time"
   `
 
 	psName := preparedName("pgxdataInsertRenamedFieldCustomer", sql)
 
-	return prepareQueryRow(ctx, db, psName, sql, args...).Scan(&row.ID)
+	return prepareQueryRow(ctx, db, psName, sql, args...).Scan(&row.ID, &row.CreationTime)
 }
 
-func UpdateRenamedFieldCustomer(ctx context.Context, db Queryer,
-	id int32,
-	row *RenamedFieldCustomer,
-) error {
-	sets := make([]string, 0, 5)
-	args := pgx.QueryArgs(make([]interface{}, 0, 5))
+// updateRenamedFieldCustomerSets returns the assignments of the SET clause for the fields of row to update and their
+// arguments.
+func updateRenamedFieldCustomerSets(row *RenamedFieldCustomer) ([]string, pgx.QueryArgs, error) {
+	sets := make([]string, 0, 7)
+	args := pgx.QueryArgs(make([]interface{}, 0, 7))
 
 	if row.ID.Status != pgtype.Undefined {
 		sets = append(sets, `id`+"="+args.Append(&row.ID))
@@ -151,25 +368,507 @@ func UpdateRenamedFieldCustomer(ctx context.Context, db Queryer,
 	if row.CreationTime.Status != pgtype.Undefined {
 		sets = append(sets, `creation_time`+"="+args.Append(&row.CreationTime))
 	}
+	if row.Email.Status != pgtype.Undefined {
+		sets = append(sets, `email`+"="+args.Append(&row.Email))
+	}
+	if row.Address.Status != pgtype.Undefined {
+		sets = append(sets, `address`+"="+args.Append(&row.Address))
+	}
+
+	return sets, args, nil
+}
 
+func UpdateRenamedFieldCustomer(ctx context.Context, db Queryer,
+	id int32,
+	row *RenamedFieldCustomer,
+) error {
+	sets, args, err := updateRenamedFieldCustomerSets(row)
+	if err != nil {
+		return err
+	}
 	if len(sets) == 0 {
 		return nil
 	}
 
-	sql := `update "customer" set ` + strings.Join(sets, ", ") + ` where ` + `"id"=` + args.Append(id)
+	sql := `update "customer" set ` + strings.Join(sets, ", ") + ` where ` + `"id"=` + args.Append(id) + `
+returning "id", "creation_time"`
 
 	psName := preparedName("pgxdataUpdateRenamedFieldCustomer", sql)
 
-	commandTag, err := prepareExec(ctx, db, psName, sql, args...)
+	err = prepareQueryRow(ctx, db, psName, sql, args...).Scan(&row.ID, &row.CreationTime)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return ErrNotFound
+	}
+	return err
+}
+
+// RenamedFieldCustomerConflict identifies the primary key or unique index UpsertRenamedFieldCustomer
+// detects a conflicting row by.
+type RenamedFieldCustomerConflict int
+
+const (
+	RenamedFieldCustomerConflictOnPK RenamedFieldCustomerConflict = iota
+	RenamedFieldCustomerConflictOnEmail
+)
+
+// RenamedFieldCustomerUpsertOptions configures UpsertRenamedFieldCustomer.
+type RenamedFieldCustomerUpsertOptions struct {
+	// Conflict is the primary key or unique index a conflicting row is detected
+	// by. The primary key is the default.
+	Conflict RenamedFieldCustomerConflict
+
+	// DoNothing leaves a conflicting row unchanged instead of updating it.
+	DoNothing bool
+}
+
+// UpsertRenamedFieldCustomer inserts row or, when it conflicts with an existing row,
+// updates the existing row with the fields of row that are not Undefined other than
+// those of the conflict target.
+// Like InsertRenamedFieldCustomer the persisted row is scanned into row. Nothing is
+// scanned when a conflicting row is left unchanged.
+func UpsertRenamedFieldCustomer(ctx context.Context, db Queryer, row *RenamedFieldCustomer, opts RenamedFieldCustomerUpsertOptions) (UpsertResult, error) {
+	args := pgx.QueryArgs(make([]interface{}, 0, 7))
+
+	var columns, values []string
+
+	if row.ID.Status != pgtype.Undefined {
+		columns = append(columns, `"id"`)
+		values = append(values, args.Append(&row.ID))
+	}
+	if row.FName.Status != pgtype.Undefined {
+		columns = append(columns, `"first_name"`)
+		values = append(values, args.Append(&row.FName))
+	}
+	if row.LastName.Status != pgtype.Undefined {
+		columns = append(columns, `"last_name"`)
+		values = append(values, args.Append(&row.LastName))
+	}
+	if row.BirthDate.Status != pgtype.Undefined {
+		columns = append(columns, `"birth_date"`)
+		values = append(values, args.Append(&row.BirthDate))
+	}
+	if row.CreationTime.Status != pgtype.Undefined {
+		columns = append(columns, `"creation_time"`)
+		values = append(values, args.Append(&row.CreationTime))
+	}
+	if row.Email.Status != pgtype.Undefined {
+		columns = append(columns, `"email"`)
+		values = append(values, args.Append(&row.Email))
+	}
+	if row.Address.Status != pgtype.Undefined {
+		columns = append(columns, `"address"`)
+		values = append(values, args.Append(&row.Address))
+	}
+
+	var targetColumns []string
+	switch opts.Conflict {
+	case RenamedFieldCustomerConflictOnPK:
+		targetColumns = []string{`"id"`}
+	case RenamedFieldCustomerConflictOnEmail:
+		targetColumns = []string{`"email"`}
+	default:
+		return UpsertUnchanged, errors.Errorf("unknown RenamedFieldCustomerConflict %d", opts.Conflict)
+	}
+
+	// The conflicting row is updated with the columns other than the conflict
+	// target. It is left unchanged when there are none.
+	sets := make([]string, 0, len(columns))
+nextColumn:
+	for _, c := range columns {
+		for _, tc := range targetColumns {
+			if c == tc {
+				continue nextColumn
+			}
+		}
+		sets = append(sets, c+"=excluded."+c)
+	}
+
+	action := `do update set ` + strings.Join(sets, ", ")
+	if opts.DoNothing || len(sets) == 0 {
+		action = `do nothing`
+	}
+
+	insert := ` default values`
+	if len(columns) > 0 {
+		insert = `(` + strings.Join(columns, ", ") + `)
+values(` + strings.Join(values, ",") + `)`
+	}
+
+	sql := `insert into "customer"` + insert + `
+on conflict (` + strings.Join(targetColumns, ", ") + `) ` + action + `
+returning xmax = 0, "id", "creation_time"
+  `
+
+	psName := preparedName("pgxdataUpsertRenamedFieldCustomer", sql)
+
+	var inserted bool
+	err := prepareQueryRow(ctx, db, psName, sql, args...).Scan(&inserted, &row.ID, &row.CreationTime)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return UpsertUnchanged, nil
+	} else if err != nil {
+		return UpsertUnchanged, err
+	}
+
+	if inserted {
+		return UpsertInserted, nil
+	}
+	return UpsertUpdated, nil
+}
+
+// insertRenamedFieldCustomerColumns returns the columns of row that are not Undefined and their
+// values for inserting row.
+func insertRenamedFieldCustomerColumns(row *RenamedFieldCustomer) ([]string, []interface{}, error) {
+	var columns []string
+	var values []interface{}
+
+	if row.ID.Status != pgtype.Undefined {
+		columns = append(columns, `id`)
+		values = append(values, &row.ID)
+	}
+	if row.FName.Status != pgtype.Undefined {
+		columns = append(columns, `first_name`)
+		values = append(values, &row.FName)
+	}
+	if row.LastName.Status != pgtype.Undefined {
+		columns = append(columns, `last_name`)
+		values = append(values, &row.LastName)
+	}
+	if row.BirthDate.Status != pgtype.Undefined {
+		columns = append(columns, `birth_date`)
+		values = append(values, &row.BirthDate)
+	}
+	if row.CreationTime.Status != pgtype.Undefined {
+		columns = append(columns, `creation_time`)
+		values = append(values, &row.CreationTime)
+	}
+	if row.Email.Status != pgtype.Undefined {
+		columns = append(columns, `email`)
+		values = append(values, &row.Email)
+	}
+	if row.Address.Status != pgtype.Undefined {
+		columns = append(columns, `address`)
+		values = append(values, &row.Address)
+	}
+
+	return columns, values, nil
+}
+
+// CopyInsertRenamedFieldCustomer inserts rows with the PostgreSQL copy protocol and
+// returns the number of rows copied. The columns copied are those not
+// Undefined in the first row and every row must set the same fields. Values
+// must support the binary format. address has no
+// binary format and must be inserted with InsertManyRenamedFieldCustomer.
+func CopyInsertRenamedFieldCustomer(ctx context.Context, db CopyFromer, rows []RenamedFieldCustomer) (int64, error) {
+	if len(rows) == 0 {
+		return 0, nil
+	}
+
+	columns, _, err := insertRenamedFieldCustomerColumns(&rows[0])
+	if err != nil {
+		return 0, err
+	}
+
+	for _, column := range columns {
+		switch column {
+		case `address`:
+			return 0, errors.Errorf("column %s has no binary format for the copy protocol, use InsertManyRenamedFieldCustomer", column)
+		}
+	}
+
+	return db.CopyFrom(ctx, pgx.Identifier{"customer"}, columns, &copyFromRenamedFieldCustomerSource{rows: rows, columns: columns, idx: -1})
+}
+
+// copyFromRenamedFieldCustomerSource is a pgx.CopyFromSource of the columns of rows.
+type copyFromRenamedFieldCustomerSource struct {
+	rows    []RenamedFieldCustomer
+	columns []string
+	idx     int
+}
+
+func (s *copyFromRenamedFieldCustomerSource) Next() bool {
+	s.idx++
+	return s.idx < len(s.rows)
+}
+
+func (s *copyFromRenamedFieldCustomerSource) Values() ([]interface{}, error) {
+	columns, values, err := insertRenamedFieldCustomerColumns(&s.rows[s.idx])
+	if err != nil {
+		return nil, err
+	}
+	if !equalColumns(columns, s.columns) {
+		return nil, errors.Errorf("row %d does not set the same fields as row 0", s.idx)
+	}
+	return values, nil
+}
+
+func (s *copyFromRenamedFieldCustomerSource) Err() error {
+	return nil
+}
+
+// insertManyRenamedFieldCustomerTypes are the types the parameters of
+// InsertManyRenamedFieldCustomer are cast to. PostgreSQL only infers them for the
+// VALUES list of an INSERT, not for one that is selected from.
+var insertManyRenamedFieldCustomerTypes = map[string]string{
+	`id`:            `"pg_catalog"."int4"`,
+	`first_name`:    `"pg_catalog"."varchar"`,
+	`last_name`:     `"pg_catalog"."varchar"`,
+	`birth_date`:    `"pg_catalog"."date"`,
+	`creation_time`: `"pg_catalog"."timestamptz"`,
+	`email`:         `"pg_catalog"."text"`,
+	`address`:       `"public"."address"`,
+}
+
+// InsertManyRenamedFieldCustomer inserts rows with multi-row INSERT statements for
+// a Queryer that does not support the copy protocol or for columns without a
+// binary format. The columns inserted are those not Undefined in the
+// first row and every row must set the same fields. Like InsertRenamedFieldCustomer
+// the persisted rows are scanned into rows.
+func InsertManyRenamedFieldCustomer(ctx context.Context, db Queryer, rows []RenamedFieldCustomer) error {
+	if len(rows) == 0 {
+		return nil
+	}
+
+	columns, _, err := insertRenamedFieldCustomerColumns(&rows[0])
 	if err != nil {
 		return err
 	}
-	if commandTag.RowsAffected() != 1 {
-		return ErrNotFound
+
+	quotedColumns := make([]string, len(columns))
+	for i, column := range columns {
+		quotedColumns[i] = pgx.Identifier{column}.Sanitize()
+	}
+
+	// A statement can have at most 65535 parameters including the ordinality
+	// of each row.
+	batchSize := len(rows)
+	if batchSize > 65535/(len(columns)+1) {
+		batchSize = 65535 / (len(columns) + 1)
+	}
+
+	for start := 0; start < len(rows); start += batchSize {
+		end := start + batchSize
+		if end > len(rows) {
+			end = len(rows)
+		}
+		batch := rows[start:end]
+
+		args := pgx.QueryArgs(make([]interface{}, 0, len(batch)*(len(columns)+1)))
+		valueLists := make([]string, len(batch))
+		for i := range batch {
+			rowColumns, values, err := insertRenamedFieldCustomerColumns(&batch[i])
+			if err != nil {
+				return err
+			}
+			if !equalColumns(rowColumns, columns) {
+				return errors.Errorf("row %d does not set the same fields as row 0", start+i)
+			}
+
+			placeholders := make([]string, len(values)+1)
+			for j, v := range values {
+				placeholders[j] = args.Append(v) + "::" + insertManyRenamedFieldCustomerTypes[columns[j]]
+			}
+			placeholders[len(values)] = args.Append(int32(i)) + "::int4"
+			valueLists[i] = "(" + strings.Join(placeholders, ",") + ")"
+		}
+
+		// The rows are inserted in the order of their ordinality so they are
+		// returned in the order of batch.
+		sql := `insert into "customer"(` + strings.Join(quotedColumns, ", ") + `)
+select ` + strings.Join(quotedColumns, ", ") + `
+from (values` + strings.Join(valueLists, ",") + `) as input(` + strings.Join(append(quotedColumns, "pgxdata_ordinality"), ", ") + `)
+order by pgxdata_ordinality
+returning "id", "creation_time"`
+
+		// The SQL depends on the number of rows so it is not prepared, which
+		// would leave a prepared statement for every batch size.
+		dbRows, err := db.Query(ctx, sql, args...)
+		if err != nil {
+			return err
+		}
+
+		// PostgreSQL does not guarantee the order of the returned rows, but it
+		// returns them in the order they were inserted. A different number of rows
+		// means they cannot be matched to batch.
+		n := 0
+		for dbRows.Next() {
+			if n < len(batch) {
+				row := &batch[n]
+				if err := dbRows.Scan(&row.ID, &row.CreationTime); err != nil {
+					dbRows.Close()
+					return err
+				}
+			}
+			n++
+		}
+
+		if dbRows.Err() != nil {
+			return dbRows.Err()
+		}
+		if n != len(batch) {
+			return errors.Errorf("inserted %d rows but %d rows were returned", len(batch), n)
+		}
 	}
+
 	return nil
 }
 
+// QueueSelectRenamedFieldCustomerByPK queues selecting the row by primary key in b.
+// The returned reader scans the row into dst or returns ErrNotFound if there
+// is no such row.
+func QueueSelectRenamedFieldCustomerByPK(b *pgx.Batch, id int32, dst *RenamedFieldCustomer) BatchReader {
+	args := []interface{}{id}
+	oids := []pgtype.OID{pgtype.Int4OID}
+
+	return queue(b, selectRenamedFieldCustomerByPKSQL, args, oids, func(results pgx.BatchResults) error {
+		err := results.QueryRowResults().Scan(
+			&dst.ID,
+			&dst.FName,
+			&dst.LastName,
+			&dst.BirthDate,
+			&dst.CreationTime,
+			&dst.Email,
+			&dst.Address,
+		)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrNotFound
+		}
+		return err
+	})
+}
+
+// QueueInsertRenamedFieldCustomer queues inserting row in b. Like InsertRenamedFieldCustomer
+// the returned reader scans the persisted row into row.
+func QueueInsertRenamedFieldCustomer(b *pgx.Batch, row *RenamedFieldCustomer) BatchReader {
+	args := pgx.QueryArgs(make([]interface{}, 0, 7))
+	oids := make([]pgtype.OID, 0, 7)
+
+	var columns, values []string
+
+	if row.ID.Status != pgtype.Undefined {
+		columns = append(columns, `id`)
+		values = append(values, args.Append(&row.ID))
+		oids = append(oids, pgtype.Int4OID)
+	}
+	if row.FName.Status != pgtype.Undefined {
+		columns = append(columns, `first_name`)
+		values = append(values, args.Append(&row.FName))
+		oids = append(oids, pgtype.VarcharOID)
+	}
+	if row.LastName.Status != pgtype.Undefined {
+		columns = append(columns, `last_name`)
+		values = append(values, args.Append(&row.LastName))
+		oids = append(oids, pgtype.VarcharOID)
+	}
+	if row.BirthDate.Status != pgtype.Undefined {
+		columns = append(columns, `birth_date`)
+		values = append(values, args.Append(&row.BirthDate))
+		oids = append(oids, pgtype.DateOID)
+	}
+	if row.CreationTime.Status != pgtype.Undefined {
+		columns = append(columns, `creation_time`)
+		values = append(values, args.Append(&row.CreationTime))
+		oids = append(oids, pgtype.TimestamptzOID)
+	}
+	if row.Email.Status != pgtype.Undefined {
+		columns = append(columns, `email`)
+		values = append(values, args.Append(&row.Email))
+		oids = append(oids, pgtype.TextOID)
+	}
+	if row.Address.Status != pgtype.Undefined {
+		columns = append(columns, `address`)
+		values = append(values, args.Append(&row.Address))
+		oids = append(oids, 0)
+	}
+
+	insert := ` default values`
+	if len(columns) > 0 {
+		insert = `(` + strings.Join(columns, ", ") + `)
+values(` + strings.Join(values, ",") + `)`
+	}
+
+	sql := `insert into "customer"` + insert + `
+returning "id", "creation_time"`
+
+	return queue(b, sql, args, oids, func(results pgx.BatchResults) error {
+		return results.QueryRowResults().Scan(&row.ID, &row.CreationTime)
+	})
+}
+
+// QueueUpdateRenamedFieldCustomer queues updating the row by primary key in b. Like
+// UpdateRenamedFieldCustomer the returned reader scans the persisted row into row
+// or returns ErrNotFound if there is no such row. Nothing is queued when
+// there is nothing to update.
+func QueueUpdateRenamedFieldCustomer(b *pgx.Batch, id int32, row *RenamedFieldCustomer) BatchReader {
+	sets := make([]string, 0, 7)
+	args := pgx.QueryArgs(make([]interface{}, 0, 7))
+	oids := make([]pgtype.OID, 0, 7)
+
+	if row.ID.Status != pgtype.Undefined {
+		sets = append(sets, `id`+"="+args.Append(&row.ID))
+		oids = append(oids, pgtype.Int4OID)
+	}
+	if row.FName.Status != pgtype.Undefined {
+		sets = append(sets, `first_name`+"="+args.Append(&row.FName))
+		oids = append(oids, pgtype.VarcharOID)
+	}
+	if row.LastName.Status != pgtype.Undefined {
+		sets = append(sets, `last_name`+"="+args.Append(&row.LastName))
+		oids = append(oids, pgtype.VarcharOID)
+	}
+	if row.BirthDate.Status != pgtype.Undefined {
+		sets = append(sets, `birth_date`+"="+args.Append(&row.BirthDate))
+		oids = append(oids, pgtype.DateOID)
+	}
+	if row.CreationTime.Status != pgtype.Undefined {
+		sets = append(sets, `creation_time`+"="+args.Append(&row.CreationTime))
+		oids = append(oids, pgtype.TimestamptzOID)
+	}
+	if row.Email.Status != pgtype.Undefined {
+		sets = append(sets, `email`+"="+args.Append(&row.Email))
+		oids = append(oids, pgtype.TextOID)
+	}
+	if row.Address.Status != pgtype.Undefined {
+		sets = append(sets, `address`+"="+args.Append(&row.Address))
+		oids = append(oids, 0)
+	}
+
+	if len(sets) == 0 {
+		return func(pgx.BatchResults) error { return nil }
+	}
+
+	sql := `update "customer" set ` + strings.Join(sets, ", ") + ` where ` + `"id"=` + args.Append(id) + `
+returning "id", "creation_time"`
+	oids = append(oids, pgtype.Int4OID)
+
+	return queue(b, sql, args, oids, func(results pgx.BatchResults) error {
+		err := results.QueryRowResults().Scan(&row.ID, &row.CreationTime)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrNotFound
+		}
+		return err
+	})
+}
+
+// QueueDeleteRenamedFieldCustomer queues deleting the row by primary key in b. The
+// returned reader returns ErrNotFound if there is no such row.
+func QueueDeleteRenamedFieldCustomer(b *pgx.Batch, id int32) BatchReader {
+	args := pgx.QueryArgs(make([]interface{}, 0, 1))
+
+	sql := `delete from "customer" where ` + `"id"=` + args.Append(id)
+	oids := []pgtype.OID{pgtype.Int4OID}
+
+	return queue(b, sql, args, oids, func(results pgx.BatchResults) error {
+		commandTag, err := results.ExecResults()
+		if err != nil {
+			return err
+		}
+		if commandTag.RowsAffected() != 1 {
+			return ErrNotFound
+		}
+		return nil
+	})
+}
+
 func DeleteRenamedFieldCustomer(ctx context.Context, db Queryer,
 	id int32,
 ) error {
@@ -186,3 +885,193 @@ func DeleteRenamedFieldCustomer(ctx context.Context, db Queryer,
 	}
 	return nil
 }
+
+// RenamedFieldCustomerWhere has a filter for each column of "customer" that builds the conditions of
+// SelectRenamedFieldCustomerWhere, CountRenamedFieldCustomerWhere, UpdateRenamedFieldCustomerWhere and DeleteRenamedFieldCustomerWhere.
+var RenamedFieldCustomerWhere = struct {
+	ID           RenamedFieldCustomerIDFilter
+	FName        RenamedFieldCustomerFNameFilter
+	LastName     RenamedFieldCustomerLastNameFilter
+	BirthDate    RenamedFieldCustomerBirthDateFilter
+	CreationTime RenamedFieldCustomerCreationTimeFilter
+	Email        RenamedFieldCustomerEmailFilter
+	Address      RenamedFieldCustomerAddressFilter
+}{
+	ID:           RenamedFieldCustomerIDFilter{columnFilter{`"id"`}},
+	FName:        RenamedFieldCustomerFNameFilter{columnFilter{`"first_name"`}},
+	LastName:     RenamedFieldCustomerLastNameFilter{columnFilter{`"last_name"`}},
+	BirthDate:    RenamedFieldCustomerBirthDateFilter{columnFilter{`"birth_date"`}},
+	CreationTime: RenamedFieldCustomerCreationTimeFilter{columnFilter{`"creation_time"`}},
+	Email:        RenamedFieldCustomerEmailFilter{columnFilter{`"email"`}},
+	Address:      RenamedFieldCustomerAddressFilter{columnFilter{`"address"`}},
+}
+
+// RenamedFieldCustomerIDFilter builds conditions on id.
+type RenamedFieldCustomerIDFilter struct{ columnFilter }
+
+func (f RenamedFieldCustomerIDFilter) Eq(v int32) Condition { return f.compare(" = ", v) }
+func (f RenamedFieldCustomerIDFilter) Ne(v int32) Condition { return f.compare(" <> ", v) }
+func (f RenamedFieldCustomerIDFilter) Lt(v int32) Condition { return f.compare(" < ", v) }
+func (f RenamedFieldCustomerIDFilter) Le(v int32) Condition { return f.compare(" <= ", v) }
+func (f RenamedFieldCustomerIDFilter) Gt(v int32) Condition { return f.compare(" > ", v) }
+func (f RenamedFieldCustomerIDFilter) Ge(v int32) Condition { return f.compare(" >= ", v) }
+
+func (f RenamedFieldCustomerIDFilter) In(vs ...int32) Condition { return f.in(len(vs), vs) }
+
+// RenamedFieldCustomerFNameFilter builds conditions on first_name.
+type RenamedFieldCustomerFNameFilter struct{ columnFilter }
+
+func (f RenamedFieldCustomerFNameFilter) Eq(v string) Condition { return f.compare(" = ", v) }
+func (f RenamedFieldCustomerFNameFilter) Ne(v string) Condition { return f.compare(" <> ", v) }
+func (f RenamedFieldCustomerFNameFilter) Lt(v string) Condition { return f.compare(" < ", v) }
+func (f RenamedFieldCustomerFNameFilter) Le(v string) Condition { return f.compare(" <= ", v) }
+func (f RenamedFieldCustomerFNameFilter) Gt(v string) Condition { return f.compare(" > ", v) }
+func (f RenamedFieldCustomerFNameFilter) Ge(v string) Condition { return f.compare(" >= ", v) }
+
+func (f RenamedFieldCustomerFNameFilter) In(vs ...string) Condition { return f.in(len(vs), vs) }
+
+// RenamedFieldCustomerLastNameFilter builds conditions on last_name.
+type RenamedFieldCustomerLastNameFilter struct{ columnFilter }
+
+func (f RenamedFieldCustomerLastNameFilter) Eq(v string) Condition { return f.compare(" = ", v) }
+func (f RenamedFieldCustomerLastNameFilter) Ne(v string) Condition { return f.compare(" <> ", v) }
+func (f RenamedFieldCustomerLastNameFilter) Lt(v string) Condition { return f.compare(" < ", v) }
+func (f RenamedFieldCustomerLastNameFilter) Le(v string) Condition { return f.compare(" <= ", v) }
+func (f RenamedFieldCustomerLastNameFilter) Gt(v string) Condition { return f.compare(" > ", v) }
+func (f RenamedFieldCustomerLastNameFilter) Ge(v string) Condition { return f.compare(" >= ", v) }
+
+func (f RenamedFieldCustomerLastNameFilter) In(vs ...string) Condition { return f.in(len(vs), vs) }
+
+// RenamedFieldCustomerBirthDateFilter builds conditions on birth_date.
+type RenamedFieldCustomerBirthDateFilter struct{ columnFilter }
+
+func (f RenamedFieldCustomerBirthDateFilter) Eq(v time.Time) Condition { return f.compare(" = ", v) }
+func (f RenamedFieldCustomerBirthDateFilter) Ne(v time.Time) Condition { return f.compare(" <> ", v) }
+func (f RenamedFieldCustomerBirthDateFilter) Lt(v time.Time) Condition { return f.compare(" < ", v) }
+func (f RenamedFieldCustomerBirthDateFilter) Le(v time.Time) Condition { return f.compare(" <= ", v) }
+func (f RenamedFieldCustomerBirthDateFilter) Gt(v time.Time) Condition { return f.compare(" > ", v) }
+func (f RenamedFieldCustomerBirthDateFilter) Ge(v time.Time) Condition { return f.compare(" >= ", v) }
+
+func (f RenamedFieldCustomerBirthDateFilter) In(vs ...time.Time) Condition { return f.in(len(vs), vs) }
+
+// RenamedFieldCustomerCreationTimeFilter builds conditions on creation_time.
+type RenamedFieldCustomerCreationTimeFilter struct{ columnFilter }
+
+func (f RenamedFieldCustomerCreationTimeFilter) Eq(v time.Time) Condition { return f.compare(" = ", v) }
+func (f RenamedFieldCustomerCreationTimeFilter) Ne(v time.Time) Condition {
+	return f.compare(" <> ", v)
+}
+func (f RenamedFieldCustomerCreationTimeFilter) Lt(v time.Time) Condition { return f.compare(" < ", v) }
+func (f RenamedFieldCustomerCreationTimeFilter) Le(v time.Time) Condition {
+	return f.compare(" <= ", v)
+}
+func (f RenamedFieldCustomerCreationTimeFilter) Gt(v time.Time) Condition { return f.compare(" > ", v) }
+func (f RenamedFieldCustomerCreationTimeFilter) Ge(v time.Time) Condition {
+	return f.compare(" >= ", v)
+}
+
+func (f RenamedFieldCustomerCreationTimeFilter) In(vs ...time.Time) Condition {
+	return f.in(len(vs), vs)
+}
+
+// RenamedFieldCustomerEmailFilter builds conditions on email.
+type RenamedFieldCustomerEmailFilter struct{ columnFilter }
+
+func (f RenamedFieldCustomerEmailFilter) Eq(v string) Condition { return f.compare(" = ", v) }
+func (f RenamedFieldCustomerEmailFilter) Ne(v string) Condition { return f.compare(" <> ", v) }
+func (f RenamedFieldCustomerEmailFilter) Lt(v string) Condition { return f.compare(" < ", v) }
+func (f RenamedFieldCustomerEmailFilter) Le(v string) Condition { return f.compare(" <= ", v) }
+func (f RenamedFieldCustomerEmailFilter) Gt(v string) Condition { return f.compare(" > ", v) }
+func (f RenamedFieldCustomerEmailFilter) Ge(v string) Condition { return f.compare(" >= ", v) }
+
+func (f RenamedFieldCustomerEmailFilter) In(vs ...string) Condition { return f.in(len(vs), vs) }
+
+// RenamedFieldCustomerAddressFilter builds conditions on address.
+type RenamedFieldCustomerAddressFilter struct{ columnFilter }
+
+func SelectRenamedFieldCustomerWhere(ctx context.Context, db Queryer, where Condition) ([]RenamedFieldCustomer, error) {
+	var args pgx.QueryArgs
+	sql := SelectAllRenamedFieldCustomerSQL + `
+where ` + where.sql(&args)
+
+	dbRows, err := prepareQuery(ctx, db, preparedName("pgxdataSelectRenamedFieldCustomerWhere", sql), sql, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer dbRows.Close()
+
+	var rows []RenamedFieldCustomer
+	for dbRows.Next() {
+		var row RenamedFieldCustomer
+		err := dbRows.Scan(
+			&row.ID,
+			&row.FName,
+			&row.LastName,
+			&row.BirthDate,
+			&row.CreationTime,
+			&row.Email,
+			&row.Address,
+		)
+		if err != nil {
+			return nil, err
+		}
+		rows = append(rows, row)
+	}
+
+	if dbRows.Err() != nil {
+		return nil, dbRows.Err()
+	}
+
+	return rows, nil
+}
+
+func CountRenamedFieldCustomerWhere(ctx context.Context, db Queryer, where Condition) (int64, error) {
+	var args pgx.QueryArgs
+	sql := countRenamedFieldCustomerSQL + ` where ` + where.sql(&args)
+
+	var n int64
+	err := prepareQueryRow(ctx, db, preparedName("pgxdataCountRenamedFieldCustomerWhere", sql), sql, args...).Scan(&n)
+	return n, err
+}
+
+// UpdateRenamedFieldCustomerWhere updates the rows matching where like UpdateRenamedFieldCustomer and returns the number of
+// rows updated. where must restrict the rows, so a Condition that matches every row like the zero Condition is
+// refused.
+func UpdateRenamedFieldCustomerWhere(ctx context.Context, db Queryer, where Condition, row *RenamedFieldCustomer) (int64, error) {
+	if where.write == nil {
+		return 0, errors.New("UpdateRenamedFieldCustomerWhere requires a condition")
+	}
+
+	sets, args, err := updateRenamedFieldCustomerSets(row)
+	if err != nil {
+		return 0, err
+	}
+	if len(sets) == 0 {
+		return 0, nil
+	}
+
+	sql := `update "customer" set ` + strings.Join(sets, ", ") + ` where ` + where.sql(&args)
+
+	commandTag, err := prepareExec(ctx, db, preparedName("pgxdataUpdateRenamedFieldCustomerWhere", sql), sql, args...)
+	if err != nil {
+		return 0, err
+	}
+	return commandTag.RowsAffected(), nil
+}
+
+// DeleteRenamedFieldCustomerWhere deletes the rows matching where and returns the number of rows deleted. where must
+// restrict the rows, so a Condition that matches every row like the zero Condition is refused.
+func DeleteRenamedFieldCustomerWhere(ctx context.Context, db Queryer, where Condition) (int64, error) {
+	if where.write == nil {
+		return 0, errors.New("DeleteRenamedFieldCustomerWhere requires a condition")
+	}
+
+	var args pgx.QueryArgs
+	sql := `delete from "customer" where ` + where.sql(&args)
+
+	commandTag, err := prepareExec(ctx, db, preparedName("pgxdataDeleteRenamedFieldCustomerWhere", sql), sql, args...)
+	if err != nil {
+		return 0, err
+	}
+	return commandTag.RowsAffected(), nil
+}
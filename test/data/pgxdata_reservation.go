@@ -0,0 +1,1059 @@
+package data
+
+// This file is automatically generated by pgxdata.
+
+import (
+	"context"
+	"strings"
+
+	"github.com/jackc/pgtype"
+	"github.com/jackc/pgx/v4"
+	errors "golang.org/x/xerrors"
+)
+
+type Reservation struct {
+	// ID is integer not null default nextval('reservation_id_seq'::regclass).
+	ID pgtype.Int4
+	// RoomNumber is integer not null.
+	RoomNumber pgtype.Int4
+	// During is tstzrange not null.
+	During pgtype.Tstzrange
+	// StayDates is daterange.
+	StayDates pgtype.Daterange
+	// Seats is int4range.
+	Seats pgtype.Int4range
+	// TicketIds is int8range.
+	TicketIds pgtype.Int8range
+	// PriceRange is numrange.
+	PriceRange pgtype.Numrange
+}
+
+const countReservationSQL = `select count(*) from "reservation"`
+
+func CountReservation(ctx context.Context, db Queryer) (int64, error) {
+	var n int64
+	err := prepareQueryRow(ctx, db, "pgxdataCountReservation", countReservationSQL).Scan(&n)
+	return n, err
+}
+
+const SelectAllReservationSQL = `select
+  "id",
+  "room_number",
+  "during",
+  "stay_dates",
+  "seats",
+  "ticket_ids",
+  "price_range"
+from "reservation"`
+
+func SelectAllReservation(ctx context.Context, db Queryer) ([]Reservation, error) {
+	var rows []Reservation
+
+	err := ForEachReservation(ctx, db, func(row *Reservation) error {
+		rows = append(rows, *row)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return rows, nil
+}
+
+// ReservationRows is a cursor over the rows of "reservation" that
+// reads one row at a time.
+type ReservationRows struct {
+	rows pgx.Rows
+	row  Reservation
+	err  error
+}
+
+// QueryReservationRows selects all rows of "reservation". The
+// returned rows must be closed.
+func QueryReservationRows(ctx context.Context, db Queryer) (*ReservationRows, error) {
+	dbRows, err := prepareQuery(ctx, db, "pgxdataSelectAllReservation", SelectAllReservationSQL)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ReservationRows{rows: dbRows}, nil
+}
+
+// Next reads the next row. It returns false when there are no more rows or an
+// error occurred.
+func (r *ReservationRows) Next() bool {
+	if r.err != nil || !r.rows.Next() {
+		return false
+	}
+
+	r.row = Reservation{}
+	r.err = r.rows.Scan(
+		&r.row.ID,
+		&r.row.RoomNumber,
+		&r.row.During,
+		&r.row.StayDates,
+		&r.row.Seats,
+		&r.row.TicketIds,
+		&r.row.PriceRange,
+	)
+	if r.err != nil {
+		r.rows.Close()
+		return false
+	}
+
+	return true
+}
+
+// Row returns the current row. It is overwritten by the next call to Next.
+func (r *ReservationRows) Row() *Reservation {
+	return &r.row
+}
+
+// Err returns the error, if any, that stopped Next.
+func (r *ReservationRows) Err() error {
+	if r.err != nil {
+		return r.err
+	}
+	return r.rows.Err()
+}
+
+// Close closes the rows. It is safe to call Close after all rows have been
+// read.
+func (r *ReservationRows) Close() {
+	r.rows.Close()
+}
+
+// ForEachReservation calls fn with each row of "reservation". The
+// row passed to fn is reused for the next row. If fn returns an error the
+// remaining rows are skipped and the error is returned.
+func ForEachReservation(ctx context.Context, db Queryer, fn func(*Reservation) error) error {
+	rows, err := QueryReservationRows(ctx, db)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		err := fn(rows.Row())
+		if err != nil {
+			return err
+		}
+	}
+
+	return rows.Err()
+}
+
+// ReservationPageCursor is the position after the last row of a
+// page returned by SelectReservationPage.
+type ReservationPageCursor struct {
+	ID int32
+}
+
+const selectReservationPageSQL = `select
+  "id",
+  "room_number",
+  "during",
+  "stay_dates",
+  "seats",
+  "ticket_ids",
+  "price_range"
+from "reservation"
+order by "id"
+limit $1`
+
+const selectReservationPageAfterSQL = `select
+  "id",
+  "room_number",
+  "during",
+  "stay_dates",
+  "seats",
+  "ticket_ids",
+  "price_range"
+from "reservation"
+where "id" > $1
+order by "id"
+limit $2`
+
+// SelectReservationPage selects up to limit rows that follow
+// after, or the first rows when after is nil, ordered by
+// "id". The returned cursor selects the next page. It is nil
+// when there are no more rows.
+func SelectReservationPage(ctx context.Context, db Queryer, after *ReservationPageCursor, limit int) ([]Reservation, *ReservationPageCursor, error) {
+	var dbRows pgx.Rows
+	var err error
+	if after == nil {
+		dbRows, err = prepareQuery(ctx, db, "pgxdataSelectReservationPage", selectReservationPageSQL, limit)
+	} else {
+		dbRows, err = prepareQuery(ctx, db, "pgxdataSelectReservationPageAfter", selectReservationPageAfterSQL, after.ID, limit)
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	defer dbRows.Close()
+
+	var rows []Reservation
+	for dbRows.Next() {
+		var row Reservation
+		err := dbRows.Scan(
+			&row.ID,
+			&row.RoomNumber,
+			&row.During,
+			&row.StayDates,
+			&row.Seats,
+			&row.TicketIds,
+			&row.PriceRange,
+		)
+		if err != nil {
+			return nil, nil, err
+		}
+		rows = append(rows, row)
+	}
+
+	if dbRows.Err() != nil {
+		return nil, nil, dbRows.Err()
+	}
+
+	if len(rows) == 0 || len(rows) < limit {
+		return rows, nil, nil
+	}
+
+	last := &rows[len(rows)-1]
+	next := &ReservationPageCursor{}
+	err = last.ID.AssignTo(&next.ID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return rows, next, nil
+}
+
+const selectReservationByPKSQL = `select
+  "id",
+  "room_number",
+  "during",
+  "stay_dates",
+  "seats",
+  "ticket_ids",
+  "price_range"
+from "reservation"
+where "id"=$1`
+
+func SelectReservationByPK(
+	ctx context.Context,
+	db Queryer,
+	id int32,
+) (*Reservation, error) {
+	var row Reservation
+	err := prepareQueryRow(ctx, db, "pgxdataSelectReservationByPK", selectReservationByPKSQL, id).Scan(
+		&row.ID,
+		&row.RoomNumber,
+		&row.During,
+		&row.StayDates,
+		&row.Seats,
+		&row.TicketIds,
+		&row.PriceRange,
+	)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrNotFound
+	} else if err != nil {
+		return nil, err
+	}
+
+	return &row, nil
+}
+
+// ReservationRangeColumn is a range column of reservation covered by a GiST
+// index that SelectReservationOverlapping can search.
+type ReservationRangeColumn string
+
+const (
+	ReservationDuringColumn    ReservationRangeColumn = "during"
+	ReservationStayDatesColumn ReservationRangeColumn = "stay_dates"
+)
+
+const selectReservationOverlappingDuringSQL = `select
+  "id",
+  "room_number",
+  "during",
+  "stay_dates",
+  "seats",
+  "ticket_ids",
+  "price_range"
+from "reservation"
+where "during" && $1`
+
+const selectReservationOverlappingStayDatesSQL = `select
+  "id",
+  "room_number",
+  "during",
+  "stay_dates",
+  "seats",
+  "ticket_ids",
+  "price_range"
+from "reservation"
+where "stay_dates" && $1`
+
+// SelectReservationOverlapping selects the rows whose column overlaps value
+// using the && operator. value must be of the type of column, e.g. a
+// pgtype.Tstzrange for ReservationDuringColumn.
+func SelectReservationOverlapping(ctx context.Context, db Queryer, column ReservationRangeColumn, value interface{}) ([]Reservation, error) {
+	var name, sql string
+	switch column {
+	case ReservationDuringColumn:
+		name, sql = "pgxdataSelectReservationOverlappingDuring", selectReservationOverlappingDuringSQL
+	case ReservationStayDatesColumn:
+		name, sql = "pgxdataSelectReservationOverlappingStayDates", selectReservationOverlappingStayDatesSQL
+	default:
+		return nil, errors.Errorf("%s is not a range column of reservation", column)
+	}
+
+	var rows []Reservation
+
+	dbRows, err := prepareQuery(ctx, db, name, sql, value)
+	if err != nil {
+		return nil, err
+	}
+
+	for dbRows.Next() {
+		var row Reservation
+		dbRows.Scan(
+			&row.ID,
+			&row.RoomNumber,
+			&row.During,
+			&row.StayDates,
+			&row.Seats,
+			&row.TicketIds,
+			&row.PriceRange,
+		)
+		rows = append(rows, row)
+	}
+
+	if dbRows.Err() != nil {
+		return nil, dbRows.Err()
+	}
+
+	return rows, nil
+}
+
+func InsertReservation(ctx context.Context, db Queryer, row *Reservation) error {
+	args := pgx.QueryArgs(make([]interface{}, 0, 7))
+
+	var columns, values []string
+
+	if row.ID.Status != pgtype.Undefined {
+		columns = append(columns, `id`)
+		values = append(values, args.Append(&row.ID))
+	}
+	if row.RoomNumber.Status != pgtype.Undefined {
+		columns = append(columns, `room_number`)
+		values = append(values, args.Append(&row.RoomNumber))
+	}
+	if row.During.Status != pgtype.Undefined {
+		columns = append(columns, `during`)
+		values = append(values, args.Append(&row.During))
+	}
+	if row.StayDates.Status != pgtype.Undefined {
+		columns = append(columns, `stay_dates`)
+		values = append(values, args.Append(&row.StayDates))
+	}
+	if row.Seats.Status != pgtype.Undefined {
+		columns = append(columns, `seats`)
+		values = append(values, args.Append(&row.Seats))
+	}
+	if row.TicketIds.Status != pgtype.Undefined {
+		columns = append(columns, `ticket_ids`)
+		values = append(values, args.Append(&row.TicketIds))
+	}
+	if row.PriceRange.Status != pgtype.Undefined {
+		columns = append(columns, `price_range`)
+		values = append(values, args.Append(&row.PriceRange))
+	}
+
+	insert := ` default values`
+	if len(columns) > 0 {
+		insert = `(` + strings.Join(columns, ", ") + `)
+values(` + strings.Join(values, ",") + `)`
+	}
+
+	sql := `insert into "reservation"` + insert + `
+returning "id", "room_number", "during", "stay_dates", "seats", "ticket_ids", "price_range"
+  `
+
+	psName := preparedName("pgxdataInsertReservation", sql)
+
+	return prepareQueryRow(ctx, db, psName, sql, args...).Scan(&row.ID, &row.RoomNumber, &row.During, &row.StayDates, &row.Seats, &row.TicketIds, &row.PriceRange)
+}
+
+// updateReservationSets returns the assignments of the SET clause for the fields of row to update and their
+// arguments.
+func updateReservationSets(row *Reservation) ([]string, pgx.QueryArgs, error) {
+	sets := make([]string, 0, 7)
+	args := pgx.QueryArgs(make([]interface{}, 0, 7))
+
+	if row.ID.Status != pgtype.Undefined {
+		sets = append(sets, `id`+"="+args.Append(&row.ID))
+	}
+	if row.RoomNumber.Status != pgtype.Undefined {
+		sets = append(sets, `room_number`+"="+args.Append(&row.RoomNumber))
+	}
+	if row.During.Status != pgtype.Undefined {
+		sets = append(sets, `during`+"="+args.Append(&row.During))
+	}
+	if row.StayDates.Status != pgtype.Undefined {
+		sets = append(sets, `stay_dates`+"="+args.Append(&row.StayDates))
+	}
+	if row.Seats.Status != pgtype.Undefined {
+		sets = append(sets, `seats`+"="+args.Append(&row.Seats))
+	}
+	if row.TicketIds.Status != pgtype.Undefined {
+		sets = append(sets, `ticket_ids`+"="+args.Append(&row.TicketIds))
+	}
+	if row.PriceRange.Status != pgtype.Undefined {
+		sets = append(sets, `price_range`+"="+args.Append(&row.PriceRange))
+	}
+
+	return sets, args, nil
+}
+
+func UpdateReservation(ctx context.Context, db Queryer,
+	id int32,
+	row *Reservation,
+) error {
+	sets, args, err := updateReservationSets(row)
+	if err != nil {
+		return err
+	}
+	if len(sets) == 0 {
+		return nil
+	}
+
+	sql := `update "reservation" set ` + strings.Join(sets, ", ") + ` where ` + `"id"=` + args.Append(id) + `
+returning "id", "room_number", "during", "stay_dates", "seats", "ticket_ids", "price_range"`
+
+	psName := preparedName("pgxdataUpdateReservation", sql)
+
+	err = prepareQueryRow(ctx, db, psName, sql, args...).Scan(&row.ID, &row.RoomNumber, &row.During, &row.StayDates, &row.Seats, &row.TicketIds, &row.PriceRange)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return ErrNotFound
+	}
+	return err
+}
+
+// ReservationConflict identifies the primary key or unique index UpsertReservation
+// detects a conflicting row by.
+type ReservationConflict int
+
+const (
+	ReservationConflictOnPK ReservationConflict = iota
+)
+
+// ReservationUpsertOptions configures UpsertReservation.
+type ReservationUpsertOptions struct {
+	// Conflict is the primary key or unique index a conflicting row is detected
+	// by. The primary key is the default.
+	Conflict ReservationConflict
+
+	// DoNothing leaves a conflicting row unchanged instead of updating it.
+	DoNothing bool
+}
+
+// UpsertReservation inserts row or, when it conflicts with an existing row,
+// updates the existing row with the fields of row that are not Undefined other than
+// those of the conflict target.
+// Like InsertReservation the persisted row is scanned into row. Nothing is
+// scanned when a conflicting row is left unchanged.
+func UpsertReservation(ctx context.Context, db Queryer, row *Reservation, opts ReservationUpsertOptions) (UpsertResult, error) {
+	args := pgx.QueryArgs(make([]interface{}, 0, 7))
+
+	var columns, values []string
+
+	if row.ID.Status != pgtype.Undefined {
+		columns = append(columns, `"id"`)
+		values = append(values, args.Append(&row.ID))
+	}
+	if row.RoomNumber.Status != pgtype.Undefined {
+		columns = append(columns, `"room_number"`)
+		values = append(values, args.Append(&row.RoomNumber))
+	}
+	if row.During.Status != pgtype.Undefined {
+		columns = append(columns, `"during"`)
+		values = append(values, args.Append(&row.During))
+	}
+	if row.StayDates.Status != pgtype.Undefined {
+		columns = append(columns, `"stay_dates"`)
+		values = append(values, args.Append(&row.StayDates))
+	}
+	if row.Seats.Status != pgtype.Undefined {
+		columns = append(columns, `"seats"`)
+		values = append(values, args.Append(&row.Seats))
+	}
+	if row.TicketIds.Status != pgtype.Undefined {
+		columns = append(columns, `"ticket_ids"`)
+		values = append(values, args.Append(&row.TicketIds))
+	}
+	if row.PriceRange.Status != pgtype.Undefined {
+		columns = append(columns, `"price_range"`)
+		values = append(values, args.Append(&row.PriceRange))
+	}
+
+	var targetColumns []string
+	switch opts.Conflict {
+	case ReservationConflictOnPK:
+		targetColumns = []string{`"id"`}
+	default:
+		return UpsertUnchanged, errors.Errorf("unknown ReservationConflict %d", opts.Conflict)
+	}
+
+	// The conflicting row is updated with the columns other than the conflict
+	// target. It is left unchanged when there are none.
+	sets := make([]string, 0, len(columns))
+nextColumn:
+	for _, c := range columns {
+		for _, tc := range targetColumns {
+			if c == tc {
+				continue nextColumn
+			}
+		}
+		sets = append(sets, c+"=excluded."+c)
+	}
+
+	action := `do update set ` + strings.Join(sets, ", ")
+	if opts.DoNothing || len(sets) == 0 {
+		action = `do nothing`
+	}
+
+	insert := ` default values`
+	if len(columns) > 0 {
+		insert = `(` + strings.Join(columns, ", ") + `)
+values(` + strings.Join(values, ",") + `)`
+	}
+
+	sql := `insert into "reservation"` + insert + `
+on conflict (` + strings.Join(targetColumns, ", ") + `) ` + action + `
+returning xmax = 0, "id", "room_number", "during", "stay_dates", "seats", "ticket_ids", "price_range"
+  `
+
+	psName := preparedName("pgxdataUpsertReservation", sql)
+
+	var inserted bool
+	err := prepareQueryRow(ctx, db, psName, sql, args...).Scan(&inserted, &row.ID, &row.RoomNumber, &row.During, &row.StayDates, &row.Seats, &row.TicketIds, &row.PriceRange)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return UpsertUnchanged, nil
+	} else if err != nil {
+		return UpsertUnchanged, err
+	}
+
+	if inserted {
+		return UpsertInserted, nil
+	}
+	return UpsertUpdated, nil
+}
+
+// insertReservationColumns returns the columns of row that are not Undefined and their
+// values for inserting row.
+func insertReservationColumns(row *Reservation) ([]string, []interface{}, error) {
+	var columns []string
+	var values []interface{}
+
+	if row.ID.Status != pgtype.Undefined {
+		columns = append(columns, `id`)
+		values = append(values, &row.ID)
+	}
+	if row.RoomNumber.Status != pgtype.Undefined {
+		columns = append(columns, `room_number`)
+		values = append(values, &row.RoomNumber)
+	}
+	if row.During.Status != pgtype.Undefined {
+		columns = append(columns, `during`)
+		values = append(values, &row.During)
+	}
+	if row.StayDates.Status != pgtype.Undefined {
+		columns = append(columns, `stay_dates`)
+		values = append(values, &row.StayDates)
+	}
+	if row.Seats.Status != pgtype.Undefined {
+		columns = append(columns, `seats`)
+		values = append(values, &row.Seats)
+	}
+	if row.TicketIds.Status != pgtype.Undefined {
+		columns = append(columns, `ticket_ids`)
+		values = append(values, &row.TicketIds)
+	}
+	if row.PriceRange.Status != pgtype.Undefined {
+		columns = append(columns, `price_range`)
+		values = append(values, &row.PriceRange)
+	}
+
+	return columns, values, nil
+}
+
+// CopyInsertReservation inserts rows with the PostgreSQL copy protocol and
+// returns the number of rows copied. The columns copied are those not
+// Undefined in the first row and every row must set the same fields. Values
+// must support the binary format.
+func CopyInsertReservation(ctx context.Context, db CopyFromer, rows []Reservation) (int64, error) {
+	if len(rows) == 0 {
+		return 0, nil
+	}
+
+	columns, _, err := insertReservationColumns(&rows[0])
+	if err != nil {
+		return 0, err
+	}
+
+	return db.CopyFrom(ctx, pgx.Identifier{"reservation"}, columns, &copyFromReservationSource{rows: rows, columns: columns, idx: -1})
+}
+
+// copyFromReservationSource is a pgx.CopyFromSource of the columns of rows.
+type copyFromReservationSource struct {
+	rows    []Reservation
+	columns []string
+	idx     int
+}
+
+func (s *copyFromReservationSource) Next() bool {
+	s.idx++
+	return s.idx < len(s.rows)
+}
+
+func (s *copyFromReservationSource) Values() ([]interface{}, error) {
+	columns, values, err := insertReservationColumns(&s.rows[s.idx])
+	if err != nil {
+		return nil, err
+	}
+	if !equalColumns(columns, s.columns) {
+		return nil, errors.Errorf("row %d does not set the same fields as row 0", s.idx)
+	}
+	return values, nil
+}
+
+func (s *copyFromReservationSource) Err() error {
+	return nil
+}
+
+// insertManyReservationTypes are the types the parameters of
+// InsertManyReservation are cast to. PostgreSQL only infers them for the
+// VALUES list of an INSERT, not for one that is selected from.
+var insertManyReservationTypes = map[string]string{
+	`id`:          `"pg_catalog"."int4"`,
+	`room_number`: `"pg_catalog"."int4"`,
+	`during`:      `"pg_catalog"."tstzrange"`,
+	`stay_dates`:  `"pg_catalog"."daterange"`,
+	`seats`:       `"pg_catalog"."int4range"`,
+	`ticket_ids`:  `"pg_catalog"."int8range"`,
+	`price_range`: `"pg_catalog"."numrange"`,
+}
+
+// InsertManyReservation inserts rows with multi-row INSERT statements for
+// a Queryer that does not support the copy protocol or for columns without a
+// binary format. The columns inserted are those not Undefined in the
+// first row and every row must set the same fields. Like InsertReservation
+// the persisted rows are scanned into rows.
+func InsertManyReservation(ctx context.Context, db Queryer, rows []Reservation) error {
+	if len(rows) == 0 {
+		return nil
+	}
+
+	columns, _, err := insertReservationColumns(&rows[0])
+	if err != nil {
+		return err
+	}
+
+	quotedColumns := make([]string, len(columns))
+	for i, column := range columns {
+		quotedColumns[i] = pgx.Identifier{column}.Sanitize()
+	}
+
+	// A statement can have at most 65535 parameters including the ordinality
+	// of each row.
+	batchSize := len(rows)
+	if batchSize > 65535/(len(columns)+1) {
+		batchSize = 65535 / (len(columns) + 1)
+	}
+
+	for start := 0; start < len(rows); start += batchSize {
+		end := start + batchSize
+		if end > len(rows) {
+			end = len(rows)
+		}
+		batch := rows[start:end]
+
+		args := pgx.QueryArgs(make([]interface{}, 0, len(batch)*(len(columns)+1)))
+		valueLists := make([]string, len(batch))
+		for i := range batch {
+			rowColumns, values, err := insertReservationColumns(&batch[i])
+			if err != nil {
+				return err
+			}
+			if !equalColumns(rowColumns, columns) {
+				return errors.Errorf("row %d does not set the same fields as row 0", start+i)
+			}
+
+			placeholders := make([]string, len(values)+1)
+			for j, v := range values {
+				placeholders[j] = args.Append(v) + "::" + insertManyReservationTypes[columns[j]]
+			}
+			placeholders[len(values)] = args.Append(int32(i)) + "::int4"
+			valueLists[i] = "(" + strings.Join(placeholders, ",") + ")"
+		}
+
+		// The rows are inserted in the order of their ordinality so they are
+		// returned in the order of batch.
+		sql := `insert into "reservation"(` + strings.Join(quotedColumns, ", ") + `)
+select ` + strings.Join(quotedColumns, ", ") + `
+from (values` + strings.Join(valueLists, ",") + `) as input(` + strings.Join(append(quotedColumns, "pgxdata_ordinality"), ", ") + `)
+order by pgxdata_ordinality
+returning "id", "room_number", "during", "stay_dates", "seats", "ticket_ids", "price_range"`
+
+		// The SQL depends on the number of rows so it is not prepared, which
+		// would leave a prepared statement for every batch size.
+		dbRows, err := db.Query(ctx, sql, args...)
+		if err != nil {
+			return err
+		}
+
+		// PostgreSQL does not guarantee the order of the returned rows, but it
+		// returns them in the order they were inserted. A different number of rows
+		// means they cannot be matched to batch.
+		n := 0
+		for dbRows.Next() {
+			if n < len(batch) {
+				row := &batch[n]
+				if err := dbRows.Scan(&row.ID, &row.RoomNumber, &row.During, &row.StayDates, &row.Seats, &row.TicketIds, &row.PriceRange); err != nil {
+					dbRows.Close()
+					return err
+				}
+			}
+			n++
+		}
+
+		if dbRows.Err() != nil {
+			return dbRows.Err()
+		}
+		if n != len(batch) {
+			return errors.Errorf("inserted %d rows but %d rows were returned", len(batch), n)
+		}
+	}
+
+	return nil
+}
+
+// QueueSelectReservationByPK queues selecting the row by primary key in b.
+// The returned reader scans the row into dst or returns ErrNotFound if there
+// is no such row.
+func QueueSelectReservationByPK(b *pgx.Batch, id int32, dst *Reservation) BatchReader {
+	args := []interface{}{id}
+	oids := []pgtype.OID{pgtype.Int4OID}
+
+	return queue(b, selectReservationByPKSQL, args, oids, func(results pgx.BatchResults) error {
+		err := results.QueryRowResults().Scan(
+			&dst.ID,
+			&dst.RoomNumber,
+			&dst.During,
+			&dst.StayDates,
+			&dst.Seats,
+			&dst.TicketIds,
+			&dst.PriceRange,
+		)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrNotFound
+		}
+		return err
+	})
+}
+
+// QueueInsertReservation queues inserting row in b. Like InsertReservation
+// the returned reader scans the persisted row into row.
+func QueueInsertReservation(b *pgx.Batch, row *Reservation) BatchReader {
+	args := pgx.QueryArgs(make([]interface{}, 0, 7))
+	oids := make([]pgtype.OID, 0, 7)
+
+	var columns, values []string
+
+	if row.ID.Status != pgtype.Undefined {
+		columns = append(columns, `id`)
+		values = append(values, args.Append(&row.ID))
+		oids = append(oids, pgtype.Int4OID)
+	}
+	if row.RoomNumber.Status != pgtype.Undefined {
+		columns = append(columns, `room_number`)
+		values = append(values, args.Append(&row.RoomNumber))
+		oids = append(oids, pgtype.Int4OID)
+	}
+	if row.During.Status != pgtype.Undefined {
+		columns = append(columns, `during`)
+		values = append(values, args.Append(&row.During))
+		oids = append(oids, pgtype.TstzrangeOID)
+	}
+	if row.StayDates.Status != pgtype.Undefined {
+		columns = append(columns, `stay_dates`)
+		values = append(values, args.Append(&row.StayDates))
+		oids = append(oids, pgtype.DaterangeOID)
+	}
+	if row.Seats.Status != pgtype.Undefined {
+		columns = append(columns, `seats`)
+		values = append(values, args.Append(&row.Seats))
+		oids = append(oids, pgtype.Int4rangeOID)
+	}
+	if row.TicketIds.Status != pgtype.Undefined {
+		columns = append(columns, `ticket_ids`)
+		values = append(values, args.Append(&row.TicketIds))
+		oids = append(oids, pgtype.Int8rangeOID)
+	}
+	if row.PriceRange.Status != pgtype.Undefined {
+		columns = append(columns, `price_range`)
+		values = append(values, args.Append(&row.PriceRange))
+		oids = append(oids, pgtype.NumrangeOID)
+	}
+
+	insert := ` default values`
+	if len(columns) > 0 {
+		insert = `(` + strings.Join(columns, ", ") + `)
+values(` + strings.Join(values, ",") + `)`
+	}
+
+	sql := `insert into "reservation"` + insert + `
+returning "id", "room_number", "during", "stay_dates", "seats", "ticket_ids", "price_range"`
+
+	return queue(b, sql, args, oids, func(results pgx.BatchResults) error {
+		return results.QueryRowResults().Scan(&row.ID, &row.RoomNumber, &row.During, &row.StayDates, &row.Seats, &row.TicketIds, &row.PriceRange)
+	})
+}
+
+// QueueUpdateReservation queues updating the row by primary key in b. Like
+// UpdateReservation the returned reader scans the persisted row into row
+// or returns ErrNotFound if there is no such row. Nothing is queued when
+// there is nothing to update.
+func QueueUpdateReservation(b *pgx.Batch, id int32, row *Reservation) BatchReader {
+	sets := make([]string, 0, 7)
+	args := pgx.QueryArgs(make([]interface{}, 0, 7))
+	oids := make([]pgtype.OID, 0, 7)
+
+	if row.ID.Status != pgtype.Undefined {
+		sets = append(sets, `id`+"="+args.Append(&row.ID))
+		oids = append(oids, pgtype.Int4OID)
+	}
+	if row.RoomNumber.Status != pgtype.Undefined {
+		sets = append(sets, `room_number`+"="+args.Append(&row.RoomNumber))
+		oids = append(oids, pgtype.Int4OID)
+	}
+	if row.During.Status != pgtype.Undefined {
+		sets = append(sets, `during`+"="+args.Append(&row.During))
+		oids = append(oids, pgtype.TstzrangeOID)
+	}
+	if row.StayDates.Status != pgtype.Undefined {
+		sets = append(sets, `stay_dates`+"="+args.Append(&row.StayDates))
+		oids = append(oids, pgtype.DaterangeOID)
+	}
+	if row.Seats.Status != pgtype.Undefined {
+		sets = append(sets, `seats`+"="+args.Append(&row.Seats))
+		oids = append(oids, pgtype.Int4rangeOID)
+	}
+	if row.TicketIds.Status != pgtype.Undefined {
+		sets = append(sets, `ticket_ids`+"="+args.Append(&row.TicketIds))
+		oids = append(oids, pgtype.Int8rangeOID)
+	}
+	if row.PriceRange.Status != pgtype.Undefined {
+		sets = append(sets, `price_range`+"="+args.Append(&row.PriceRange))
+		oids = append(oids, pgtype.NumrangeOID)
+	}
+
+	if len(sets) == 0 {
+		return func(pgx.BatchResults) error { return nil }
+	}
+
+	sql := `update "reservation" set ` + strings.Join(sets, ", ") + ` where ` + `"id"=` + args.Append(id) + `
+returning "id", "room_number", "during", "stay_dates", "seats", "ticket_ids", "price_range"`
+	oids = append(oids, pgtype.Int4OID)
+
+	return queue(b, sql, args, oids, func(results pgx.BatchResults) error {
+		err := results.QueryRowResults().Scan(&row.ID, &row.RoomNumber, &row.During, &row.StayDates, &row.Seats, &row.TicketIds, &row.PriceRange)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrNotFound
+		}
+		return err
+	})
+}
+
+// QueueDeleteReservation queues deleting the row by primary key in b. The
+// returned reader returns ErrNotFound if there is no such row.
+func QueueDeleteReservation(b *pgx.Batch, id int32) BatchReader {
+	args := pgx.QueryArgs(make([]interface{}, 0, 1))
+
+	sql := `delete from "reservation" where ` + `"id"=` + args.Append(id)
+	oids := []pgtype.OID{pgtype.Int4OID}
+
+	return queue(b, sql, args, oids, func(results pgx.BatchResults) error {
+		commandTag, err := results.ExecResults()
+		if err != nil {
+			return err
+		}
+		if commandTag.RowsAffected() != 1 {
+			return ErrNotFound
+		}
+		return nil
+	})
+}
+
+func DeleteReservation(ctx context.Context, db Queryer,
+	id int32,
+) error {
+	args := pgx.QueryArgs(make([]interface{}, 0, 1))
+
+	sql := `delete from "reservation" where ` + `"id"=` + args.Append(id)
+
+	commandTag, err := prepareExec(ctx, db, "pgxdataDeleteReservation", sql, args...)
+	if err != nil {
+		return err
+	}
+	if commandTag.RowsAffected() != 1 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+// ReservationWhere has a filter for each column of "reservation" that builds the conditions of
+// SelectReservationWhere, CountReservationWhere, UpdateReservationWhere and DeleteReservationWhere.
+var ReservationWhere = struct {
+	ID         ReservationIDFilter
+	RoomNumber ReservationRoomNumberFilter
+	During     ReservationDuringFilter
+	StayDates  ReservationStayDatesFilter
+	Seats      ReservationSeatsFilter
+	TicketIds  ReservationTicketIdsFilter
+	PriceRange ReservationPriceRangeFilter
+}{
+	ID:         ReservationIDFilter{columnFilter{`"id"`}},
+	RoomNumber: ReservationRoomNumberFilter{columnFilter{`"room_number"`}},
+	During:     ReservationDuringFilter{columnFilter{`"during"`}},
+	StayDates:  ReservationStayDatesFilter{columnFilter{`"stay_dates"`}},
+	Seats:      ReservationSeatsFilter{columnFilter{`"seats"`}},
+	TicketIds:  ReservationTicketIdsFilter{columnFilter{`"ticket_ids"`}},
+	PriceRange: ReservationPriceRangeFilter{columnFilter{`"price_range"`}},
+}
+
+// ReservationIDFilter builds conditions on id.
+type ReservationIDFilter struct{ columnFilter }
+
+func (f ReservationIDFilter) Eq(v int32) Condition { return f.compare(" = ", v) }
+func (f ReservationIDFilter) Ne(v int32) Condition { return f.compare(" <> ", v) }
+func (f ReservationIDFilter) Lt(v int32) Condition { return f.compare(" < ", v) }
+func (f ReservationIDFilter) Le(v int32) Condition { return f.compare(" <= ", v) }
+func (f ReservationIDFilter) Gt(v int32) Condition { return f.compare(" > ", v) }
+func (f ReservationIDFilter) Ge(v int32) Condition { return f.compare(" >= ", v) }
+
+func (f ReservationIDFilter) In(vs ...int32) Condition { return f.in(len(vs), vs) }
+
+// ReservationRoomNumberFilter builds conditions on room_number.
+type ReservationRoomNumberFilter struct{ columnFilter }
+
+func (f ReservationRoomNumberFilter) Eq(v int32) Condition { return f.compare(" = ", v) }
+func (f ReservationRoomNumberFilter) Ne(v int32) Condition { return f.compare(" <> ", v) }
+func (f ReservationRoomNumberFilter) Lt(v int32) Condition { return f.compare(" < ", v) }
+func (f ReservationRoomNumberFilter) Le(v int32) Condition { return f.compare(" <= ", v) }
+func (f ReservationRoomNumberFilter) Gt(v int32) Condition { return f.compare(" > ", v) }
+func (f ReservationRoomNumberFilter) Ge(v int32) Condition { return f.compare(" >= ", v) }
+
+func (f ReservationRoomNumberFilter) In(vs ...int32) Condition { return f.in(len(vs), vs) }
+
+// ReservationDuringFilter builds conditions on during.
+type ReservationDuringFilter struct{ columnFilter }
+
+// ReservationStayDatesFilter builds conditions on stay_dates.
+type ReservationStayDatesFilter struct{ columnFilter }
+
+// ReservationSeatsFilter builds conditions on seats.
+type ReservationSeatsFilter struct{ columnFilter }
+
+// ReservationTicketIdsFilter builds conditions on ticket_ids.
+type ReservationTicketIdsFilter struct{ columnFilter }
+
+// ReservationPriceRangeFilter builds conditions on price_range.
+type ReservationPriceRangeFilter struct{ columnFilter }
+
+func SelectReservationWhere(ctx context.Context, db Queryer, where Condition) ([]Reservation, error) {
+	var args pgx.QueryArgs
+	sql := SelectAllReservationSQL + `
+where ` + where.sql(&args)
+
+	dbRows, err := prepareQuery(ctx, db, preparedName("pgxdataSelectReservationWhere", sql), sql, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer dbRows.Close()
+
+	var rows []Reservation
+	for dbRows.Next() {
+		var row Reservation
+		err := dbRows.Scan(
+			&row.ID,
+			&row.RoomNumber,
+			&row.During,
+			&row.StayDates,
+			&row.Seats,
+			&row.TicketIds,
+			&row.PriceRange,
+		)
+		if err != nil {
+			return nil, err
+		}
+		rows = append(rows, row)
+	}
+
+	if dbRows.Err() != nil {
+		return nil, dbRows.Err()
+	}
+
+	return rows, nil
+}
+
+func CountReservationWhere(ctx context.Context, db Queryer, where Condition) (int64, error) {
+	var args pgx.QueryArgs
+	sql := countReservationSQL + ` where ` + where.sql(&args)
+
+	var n int64
+	err := prepareQueryRow(ctx, db, preparedName("pgxdataCountReservationWhere", sql), sql, args...).Scan(&n)
+	return n, err
+}
+
+// UpdateReservationWhere updates the rows matching where like UpdateReservation and returns the number of
+// rows updated. where must restrict the rows, so a Condition that matches every row like the zero Condition is
+// refused.
+func UpdateReservationWhere(ctx context.Context, db Queryer, where Condition, row *Reservation) (int64, error) {
+	if where.write == nil {
+		return 0, errors.New("UpdateReservationWhere requires a condition")
+	}
+
+	sets, args, err := updateReservationSets(row)
+	if err != nil {
+		return 0, err
+	}
+	if len(sets) == 0 {
+		return 0, nil
+	}
+
+	sql := `update "reservation" set ` + strings.Join(sets, ", ") + ` where ` + where.sql(&args)
+
+	commandTag, err := prepareExec(ctx, db, preparedName("pgxdataUpdateReservationWhere", sql), sql, args...)
+	if err != nil {
+		return 0, err
+	}
+	return commandTag.RowsAffected(), nil
+}
+
+// DeleteReservationWhere deletes the rows matching where and returns the number of rows deleted. where must
+// restrict the rows, so a Condition that matches every row like the zero Condition is refused.
+func DeleteReservationWhere(ctx context.Context, db Queryer, where Condition) (int64, error) {
+	if where.write == nil {
+		return 0, errors.New("DeleteReservationWhere requires a condition")
+	}
+
+	var args pgx.QueryArgs
+	sql := `delete from "reservation" where ` + where.sql(&args)
+
+	commandTag, err := prepareExec(ctx, db, preparedName("pgxdataDeleteReservationWhere", sql), sql, args...)
+	if err != nil {
+		return 0, err
+	}
+	return commandTag.RowsAffected(), nil
+}
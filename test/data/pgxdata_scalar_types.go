@@ -0,0 +1,1275 @@
+package data
+
+// This file is automatically generated by pgxdata.
+
+import (
+	"context"
+	"strings"
+
+	"github.com/jackc/pgtype"
+	"github.com/jackc/pgx/v4"
+	errors "golang.org/x/xerrors"
+	"time"
+)
+
+type ScalarTypes struct {
+	// ID is integer not null default nextval('scalar_types_id_seq'::regclass).
+	ID pgtype.Int4
+	// BoolCol is boolean.
+	BoolCol pgtype.Bool
+	// UuidCol is uuid.
+	UuidCol pgtype.UUID
+	// JsonCol is json.
+	JsonCol pgtype.JSON
+	// JsonbCol is jsonb.
+	JsonbCol pgtype.JSONB
+	// NumericCol is numeric(10,2).
+	NumericCol pgtype.Numeric
+	// RealCol is real.
+	RealCol pgtype.Float4
+	// DoubleCol is double precision.
+	DoubleCol pgtype.Float8
+	// TimestampCol is timestamp without time zone.
+	TimestampCol pgtype.Timestamp
+	// TimeCol is time without time zone.
+	TimeCol pgtype.GenericText
+	// IntervalCol is interval.
+	IntervalCol pgtype.Interval
+	// CharCol is character(3).
+	CharCol pgtype.BPChar
+}
+
+const countScalarTypesSQL = `select count(*) from "scalar_types"`
+
+func CountScalarTypes(ctx context.Context, db Queryer) (int64, error) {
+	var n int64
+	err := prepareQueryRow(ctx, db, "pgxdataCountScalarTypes", countScalarTypesSQL).Scan(&n)
+	return n, err
+}
+
+const SelectAllScalarTypesSQL = `select
+  "id",
+  "bool_col",
+  "uuid_col",
+  "json_col",
+  "jsonb_col",
+  "numeric_col",
+  "real_col",
+  "double_col",
+  "timestamp_col",
+  "time_col"::text,
+  "interval_col",
+  "char_col"
+from "scalar_types"`
+
+func SelectAllScalarTypes(ctx context.Context, db Queryer) ([]ScalarTypes, error) {
+	var rows []ScalarTypes
+
+	err := ForEachScalarTypes(ctx, db, func(row *ScalarTypes) error {
+		rows = append(rows, *row)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return rows, nil
+}
+
+// ScalarTypesRows is a cursor over the rows of "scalar_types" that
+// reads one row at a time.
+type ScalarTypesRows struct {
+	rows pgx.Rows
+	row  ScalarTypes
+	err  error
+}
+
+// QueryScalarTypesRows selects all rows of "scalar_types". The
+// returned rows must be closed.
+func QueryScalarTypesRows(ctx context.Context, db Queryer) (*ScalarTypesRows, error) {
+	dbRows, err := prepareQuery(ctx, db, "pgxdataSelectAllScalarTypes", SelectAllScalarTypesSQL)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ScalarTypesRows{rows: dbRows}, nil
+}
+
+// Next reads the next row. It returns false when there are no more rows or an
+// error occurred.
+func (r *ScalarTypesRows) Next() bool {
+	if r.err != nil || !r.rows.Next() {
+		return false
+	}
+
+	r.row = ScalarTypes{}
+	r.err = r.rows.Scan(
+		&r.row.ID,
+		&r.row.BoolCol,
+		&r.row.UuidCol,
+		&r.row.JsonCol,
+		&r.row.JsonbCol,
+		&r.row.NumericCol,
+		&r.row.RealCol,
+		&r.row.DoubleCol,
+		&r.row.TimestampCol,
+		&r.row.TimeCol,
+		&r.row.IntervalCol,
+		&r.row.CharCol,
+	)
+	if r.err != nil {
+		r.rows.Close()
+		return false
+	}
+
+	return true
+}
+
+// Row returns the current row. It is overwritten by the next call to Next.
+func (r *ScalarTypesRows) Row() *ScalarTypes {
+	return &r.row
+}
+
+// Err returns the error, if any, that stopped Next.
+func (r *ScalarTypesRows) Err() error {
+	if r.err != nil {
+		return r.err
+	}
+	return r.rows.Err()
+}
+
+// Close closes the rows. It is safe to call Close after all rows have been
+// read.
+func (r *ScalarTypesRows) Close() {
+	r.rows.Close()
+}
+
+// ForEachScalarTypes calls fn with each row of "scalar_types". The
+// row passed to fn is reused for the next row. If fn returns an error the
+// remaining rows are skipped and the error is returned.
+func ForEachScalarTypes(ctx context.Context, db Queryer, fn func(*ScalarTypes) error) error {
+	rows, err := QueryScalarTypesRows(ctx, db)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		err := fn(rows.Row())
+		if err != nil {
+			return err
+		}
+	}
+
+	return rows.Err()
+}
+
+// ScalarTypesPageCursor is the position after the last row of a
+// page returned by SelectScalarTypesPage.
+type ScalarTypesPageCursor struct {
+	ID int32
+}
+
+const selectScalarTypesPageSQL = `select
+  "id",
+  "bool_col",
+  "uuid_col",
+  "json_col",
+  "jsonb_col",
+  "numeric_col",
+  "real_col",
+  "double_col",
+  "timestamp_col",
+  "time_col"::text,
+  "interval_col",
+  "char_col"
+from "scalar_types"
+order by "id"
+limit $1`
+
+const selectScalarTypesPageAfterSQL = `select
+  "id",
+  "bool_col",
+  "uuid_col",
+  "json_col",
+  "jsonb_col",
+  "numeric_col",
+  "real_col",
+  "double_col",
+  "timestamp_col",
+  "time_col"::text,
+  "interval_col",
+  "char_col"
+from "scalar_types"
+where "id" > $1
+order by "id"
+limit $2`
+
+// SelectScalarTypesPage selects up to limit rows that follow
+// after, or the first rows when after is nil, ordered by
+// "id". The returned cursor selects the next page. It is nil
+// when there are no more rows.
+func SelectScalarTypesPage(ctx context.Context, db Queryer, after *ScalarTypesPageCursor, limit int) ([]ScalarTypes, *ScalarTypesPageCursor, error) {
+	var dbRows pgx.Rows
+	var err error
+	if after == nil {
+		dbRows, err = prepareQuery(ctx, db, "pgxdataSelectScalarTypesPage", selectScalarTypesPageSQL, limit)
+	} else {
+		dbRows, err = prepareQuery(ctx, db, "pgxdataSelectScalarTypesPageAfter", selectScalarTypesPageAfterSQL, after.ID, limit)
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	defer dbRows.Close()
+
+	var rows []ScalarTypes
+	for dbRows.Next() {
+		var row ScalarTypes
+		err := dbRows.Scan(
+			&row.ID,
+			&row.BoolCol,
+			&row.UuidCol,
+			&row.JsonCol,
+			&row.JsonbCol,
+			&row.NumericCol,
+			&row.RealCol,
+			&row.DoubleCol,
+			&row.TimestampCol,
+			&row.TimeCol,
+			&row.IntervalCol,
+			&row.CharCol,
+		)
+		if err != nil {
+			return nil, nil, err
+		}
+		rows = append(rows, row)
+	}
+
+	if dbRows.Err() != nil {
+		return nil, nil, dbRows.Err()
+	}
+
+	if len(rows) == 0 || len(rows) < limit {
+		return rows, nil, nil
+	}
+
+	last := &rows[len(rows)-1]
+	next := &ScalarTypesPageCursor{}
+	err = last.ID.AssignTo(&next.ID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return rows, next, nil
+}
+
+const selectScalarTypesByPKSQL = `select
+  "id",
+  "bool_col",
+  "uuid_col",
+  "json_col",
+  "jsonb_col",
+  "numeric_col",
+  "real_col",
+  "double_col",
+  "timestamp_col",
+  "time_col"::text,
+  "interval_col",
+  "char_col"
+from "scalar_types"
+where "id"=$1`
+
+func SelectScalarTypesByPK(
+	ctx context.Context,
+	db Queryer,
+	id int32,
+) (*ScalarTypes, error) {
+	var row ScalarTypes
+	err := prepareQueryRow(ctx, db, "pgxdataSelectScalarTypesByPK", selectScalarTypesByPKSQL, id).Scan(
+		&row.ID,
+		&row.BoolCol,
+		&row.UuidCol,
+		&row.JsonCol,
+		&row.JsonbCol,
+		&row.NumericCol,
+		&row.RealCol,
+		&row.DoubleCol,
+		&row.TimestampCol,
+		&row.TimeCol,
+		&row.IntervalCol,
+		&row.CharCol,
+	)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrNotFound
+	} else if err != nil {
+		return nil, err
+	}
+
+	return &row, nil
+}
+
+func InsertScalarTypes(ctx context.Context, db Queryer, row *ScalarTypes) error {
+	args := pgx.QueryArgs(make([]interface{}, 0, 12))
+
+	var columns, values []string
+
+	if row.ID.Status != pgtype.Undefined {
+		columns = append(columns, `id`)
+		values = append(values, args.Append(&row.ID))
+	}
+	if row.BoolCol.Status != pgtype.Undefined {
+		columns = append(columns, `bool_col`)
+		values = append(values, args.Append(&row.BoolCol))
+	}
+	if row.UuidCol.Status != pgtype.Undefined {
+		columns = append(columns, `uuid_col`)
+		values = append(values, args.Append(&row.UuidCol))
+	}
+	if row.JsonCol.Status != pgtype.Undefined {
+		columns = append(columns, `json_col`)
+		values = append(values, args.Append(&row.JsonCol))
+	}
+	if row.JsonbCol.Status != pgtype.Undefined {
+		columns = append(columns, `jsonb_col`)
+		values = append(values, args.Append(&row.JsonbCol))
+	}
+	if row.NumericCol.Status != pgtype.Undefined {
+		columns = append(columns, `numeric_col`)
+		values = append(values, args.Append(&row.NumericCol))
+	}
+	if row.RealCol.Status != pgtype.Undefined {
+		columns = append(columns, `real_col`)
+		values = append(values, args.Append(&row.RealCol))
+	}
+	if row.DoubleCol.Status != pgtype.Undefined {
+		columns = append(columns, `double_col`)
+		values = append(values, args.Append(&row.DoubleCol))
+	}
+	if row.TimestampCol.Status != pgtype.Undefined {
+		columns = append(columns, `timestamp_col`)
+		values = append(values, args.Append(&row.TimestampCol))
+	}
+	if row.TimeCol.Status != pgtype.Undefined {
+		columns = append(columns, `time_col`)
+		values = append(values, args.Append(&row.TimeCol))
+	}
+	if row.IntervalCol.Status != pgtype.Undefined {
+		columns = append(columns, `interval_col`)
+		values = append(values, args.Append(&row.IntervalCol))
+	}
+	if row.CharCol.Status != pgtype.Undefined {
+		columns = append(columns, `char_col`)
+		values = append(values, args.Append(&row.CharCol))
+	}
+
+	insert := ` default values`
+	if len(columns) > 0 {
+		insert = `(` + strings.Join(columns, ", ") + `)
+values(` + strings.Join(values, ",") + `)`
+	}
+
+	sql := `insert into "scalar_types"` + insert + `
+returning "id", "bool_col", "uuid_col", "json_col", "jsonb_col", "numeric_col", "real_col", "double_col", "timestamp_col", "time_col"::text, "interval_col", "char_col"
+  `
+
+	psName := preparedName("pgxdataInsertScalarTypes", sql)
+
+	return prepareQueryRow(ctx, db, psName, sql, args...).Scan(&row.ID, &row.BoolCol, &row.UuidCol, &row.JsonCol, &row.JsonbCol, &row.NumericCol, &row.RealCol, &row.DoubleCol, &row.TimestampCol, &row.TimeCol, &row.IntervalCol, &row.CharCol)
+}
+
+// updateScalarTypesSets returns the assignments of the SET clause for the fields of row to update and their
+// arguments.
+func updateScalarTypesSets(row *ScalarTypes) ([]string, pgx.QueryArgs, error) {
+	sets := make([]string, 0, 12)
+	args := pgx.QueryArgs(make([]interface{}, 0, 12))
+
+	if row.ID.Status != pgtype.Undefined {
+		sets = append(sets, `id`+"="+args.Append(&row.ID))
+	}
+	if row.BoolCol.Status != pgtype.Undefined {
+		sets = append(sets, `bool_col`+"="+args.Append(&row.BoolCol))
+	}
+	if row.UuidCol.Status != pgtype.Undefined {
+		sets = append(sets, `uuid_col`+"="+args.Append(&row.UuidCol))
+	}
+	if row.JsonCol.Status != pgtype.Undefined {
+		sets = append(sets, `json_col`+"="+args.Append(&row.JsonCol))
+	}
+	if row.JsonbCol.Status != pgtype.Undefined {
+		sets = append(sets, `jsonb_col`+"="+args.Append(&row.JsonbCol))
+	}
+	if row.NumericCol.Status != pgtype.Undefined {
+		sets = append(sets, `numeric_col`+"="+args.Append(&row.NumericCol))
+	}
+	if row.RealCol.Status != pgtype.Undefined {
+		sets = append(sets, `real_col`+"="+args.Append(&row.RealCol))
+	}
+	if row.DoubleCol.Status != pgtype.Undefined {
+		sets = append(sets, `double_col`+"="+args.Append(&row.DoubleCol))
+	}
+	if row.TimestampCol.Status != pgtype.Undefined {
+		sets = append(sets, `timestamp_col`+"="+args.Append(&row.TimestampCol))
+	}
+	if row.TimeCol.Status != pgtype.Undefined {
+		sets = append(sets, `time_col`+"="+args.Append(&row.TimeCol))
+	}
+	if row.IntervalCol.Status != pgtype.Undefined {
+		sets = append(sets, `interval_col`+"="+args.Append(&row.IntervalCol))
+	}
+	if row.CharCol.Status != pgtype.Undefined {
+		sets = append(sets, `char_col`+"="+args.Append(&row.CharCol))
+	}
+
+	return sets, args, nil
+}
+
+func UpdateScalarTypes(ctx context.Context, db Queryer,
+	id int32,
+	row *ScalarTypes,
+) error {
+	sets, args, err := updateScalarTypesSets(row)
+	if err != nil {
+		return err
+	}
+	if len(sets) == 0 {
+		return nil
+	}
+
+	sql := `update "scalar_types" set ` + strings.Join(sets, ", ") + ` where ` + `"id"=` + args.Append(id) + `
+returning "id", "bool_col", "uuid_col", "json_col", "jsonb_col", "numeric_col", "real_col", "double_col", "timestamp_col", "time_col"::text, "interval_col", "char_col"`
+
+	psName := preparedName("pgxdataUpdateScalarTypes", sql)
+
+	err = prepareQueryRow(ctx, db, psName, sql, args...).Scan(&row.ID, &row.BoolCol, &row.UuidCol, &row.JsonCol, &row.JsonbCol, &row.NumericCol, &row.RealCol, &row.DoubleCol, &row.TimestampCol, &row.TimeCol, &row.IntervalCol, &row.CharCol)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return ErrNotFound
+	}
+	return err
+}
+
+// ScalarTypesConflict identifies the primary key or unique index UpsertScalarTypes
+// detects a conflicting row by.
+type ScalarTypesConflict int
+
+const (
+	ScalarTypesConflictOnPK ScalarTypesConflict = iota
+)
+
+// ScalarTypesUpsertOptions configures UpsertScalarTypes.
+type ScalarTypesUpsertOptions struct {
+	// Conflict is the primary key or unique index a conflicting row is detected
+	// by. The primary key is the default.
+	Conflict ScalarTypesConflict
+
+	// DoNothing leaves a conflicting row unchanged instead of updating it.
+	DoNothing bool
+}
+
+// UpsertScalarTypes inserts row or, when it conflicts with an existing row,
+// updates the existing row with the fields of row that are not Undefined other than
+// those of the conflict target.
+// Like InsertScalarTypes the persisted row is scanned into row. Nothing is
+// scanned when a conflicting row is left unchanged.
+func UpsertScalarTypes(ctx context.Context, db Queryer, row *ScalarTypes, opts ScalarTypesUpsertOptions) (UpsertResult, error) {
+	args := pgx.QueryArgs(make([]interface{}, 0, 12))
+
+	var columns, values []string
+
+	if row.ID.Status != pgtype.Undefined {
+		columns = append(columns, `"id"`)
+		values = append(values, args.Append(&row.ID))
+	}
+	if row.BoolCol.Status != pgtype.Undefined {
+		columns = append(columns, `"bool_col"`)
+		values = append(values, args.Append(&row.BoolCol))
+	}
+	if row.UuidCol.Status != pgtype.Undefined {
+		columns = append(columns, `"uuid_col"`)
+		values = append(values, args.Append(&row.UuidCol))
+	}
+	if row.JsonCol.Status != pgtype.Undefined {
+		columns = append(columns, `"json_col"`)
+		values = append(values, args.Append(&row.JsonCol))
+	}
+	if row.JsonbCol.Status != pgtype.Undefined {
+		columns = append(columns, `"jsonb_col"`)
+		values = append(values, args.Append(&row.JsonbCol))
+	}
+	if row.NumericCol.Status != pgtype.Undefined {
+		columns = append(columns, `"numeric_col"`)
+		values = append(values, args.Append(&row.NumericCol))
+	}
+	if row.RealCol.Status != pgtype.Undefined {
+		columns = append(columns, `"real_col"`)
+		values = append(values, args.Append(&row.RealCol))
+	}
+	if row.DoubleCol.Status != pgtype.Undefined {
+		columns = append(columns, `"double_col"`)
+		values = append(values, args.Append(&row.DoubleCol))
+	}
+	if row.TimestampCol.Status != pgtype.Undefined {
+		columns = append(columns, `"timestamp_col"`)
+		values = append(values, args.Append(&row.TimestampCol))
+	}
+	if row.TimeCol.Status != pgtype.Undefined {
+		columns = append(columns, `"time_col"`)
+		values = append(values, args.Append(&row.TimeCol))
+	}
+	if row.IntervalCol.Status != pgtype.Undefined {
+		columns = append(columns, `"interval_col"`)
+		values = append(values, args.Append(&row.IntervalCol))
+	}
+	if row.CharCol.Status != pgtype.Undefined {
+		columns = append(columns, `"char_col"`)
+		values = append(values, args.Append(&row.CharCol))
+	}
+
+	var targetColumns []string
+	switch opts.Conflict {
+	case ScalarTypesConflictOnPK:
+		targetColumns = []string{`"id"`}
+	default:
+		return UpsertUnchanged, errors.Errorf("unknown ScalarTypesConflict %d", opts.Conflict)
+	}
+
+	// The conflicting row is updated with the columns other than the conflict
+	// target. It is left unchanged when there are none.
+	sets := make([]string, 0, len(columns))
+nextColumn:
+	for _, c := range columns {
+		for _, tc := range targetColumns {
+			if c == tc {
+				continue nextColumn
+			}
+		}
+		sets = append(sets, c+"=excluded."+c)
+	}
+
+	action := `do update set ` + strings.Join(sets, ", ")
+	if opts.DoNothing || len(sets) == 0 {
+		action = `do nothing`
+	}
+
+	insert := ` default values`
+	if len(columns) > 0 {
+		insert = `(` + strings.Join(columns, ", ") + `)
+values(` + strings.Join(values, ",") + `)`
+	}
+
+	sql := `insert into "scalar_types"` + insert + `
+on conflict (` + strings.Join(targetColumns, ", ") + `) ` + action + `
+returning xmax = 0, "id", "bool_col", "uuid_col", "json_col", "jsonb_col", "numeric_col", "real_col", "double_col", "timestamp_col", "time_col"::text, "interval_col", "char_col"
+  `
+
+	psName := preparedName("pgxdataUpsertScalarTypes", sql)
+
+	var inserted bool
+	err := prepareQueryRow(ctx, db, psName, sql, args...).Scan(&inserted, &row.ID, &row.BoolCol, &row.UuidCol, &row.JsonCol, &row.JsonbCol, &row.NumericCol, &row.RealCol, &row.DoubleCol, &row.TimestampCol, &row.TimeCol, &row.IntervalCol, &row.CharCol)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return UpsertUnchanged, nil
+	} else if err != nil {
+		return UpsertUnchanged, err
+	}
+
+	if inserted {
+		return UpsertInserted, nil
+	}
+	return UpsertUpdated, nil
+}
+
+// insertScalarTypesColumns returns the columns of row that are not Undefined and their
+// values for inserting row.
+func insertScalarTypesColumns(row *ScalarTypes) ([]string, []interface{}, error) {
+	var columns []string
+	var values []interface{}
+
+	if row.ID.Status != pgtype.Undefined {
+		columns = append(columns, `id`)
+		values = append(values, &row.ID)
+	}
+	if row.BoolCol.Status != pgtype.Undefined {
+		columns = append(columns, `bool_col`)
+		values = append(values, &row.BoolCol)
+	}
+	if row.UuidCol.Status != pgtype.Undefined {
+		columns = append(columns, `uuid_col`)
+		values = append(values, &row.UuidCol)
+	}
+	if row.JsonCol.Status != pgtype.Undefined {
+		columns = append(columns, `json_col`)
+		values = append(values, &row.JsonCol)
+	}
+	if row.JsonbCol.Status != pgtype.Undefined {
+		columns = append(columns, `jsonb_col`)
+		values = append(values, &row.JsonbCol)
+	}
+	if row.NumericCol.Status != pgtype.Undefined {
+		columns = append(columns, `numeric_col`)
+		values = append(values, &row.NumericCol)
+	}
+	if row.RealCol.Status != pgtype.Undefined {
+		columns = append(columns, `real_col`)
+		values = append(values, &row.RealCol)
+	}
+	if row.DoubleCol.Status != pgtype.Undefined {
+		columns = append(columns, `double_col`)
+		values = append(values, &row.DoubleCol)
+	}
+	if row.TimestampCol.Status != pgtype.Undefined {
+		columns = append(columns, `timestamp_col`)
+		values = append(values, &row.TimestampCol)
+	}
+	if row.TimeCol.Status != pgtype.Undefined {
+		columns = append(columns, `time_col`)
+		values = append(values, &row.TimeCol)
+	}
+	if row.IntervalCol.Status != pgtype.Undefined {
+		columns = append(columns, `interval_col`)
+		values = append(values, &row.IntervalCol)
+	}
+	if row.CharCol.Status != pgtype.Undefined {
+		columns = append(columns, `char_col`)
+		values = append(values, &row.CharCol)
+	}
+
+	return columns, values, nil
+}
+
+// CopyInsertScalarTypes inserts rows with the PostgreSQL copy protocol and
+// returns the number of rows copied. The columns copied are those not
+// Undefined in the first row and every row must set the same fields. Values
+// must support the binary format. time_col has no
+// binary format and must be inserted with InsertManyScalarTypes.
+func CopyInsertScalarTypes(ctx context.Context, db CopyFromer, rows []ScalarTypes) (int64, error) {
+	if len(rows) == 0 {
+		return 0, nil
+	}
+
+	columns, _, err := insertScalarTypesColumns(&rows[0])
+	if err != nil {
+		return 0, err
+	}
+
+	for _, column := range columns {
+		switch column {
+		case `time_col`:
+			return 0, errors.Errorf("column %s has no binary format for the copy protocol, use InsertManyScalarTypes", column)
+		}
+	}
+
+	return db.CopyFrom(ctx, pgx.Identifier{"scalar_types"}, columns, &copyFromScalarTypesSource{rows: rows, columns: columns, idx: -1})
+}
+
+// copyFromScalarTypesSource is a pgx.CopyFromSource of the columns of rows.
+type copyFromScalarTypesSource struct {
+	rows    []ScalarTypes
+	columns []string
+	idx     int
+}
+
+func (s *copyFromScalarTypesSource) Next() bool {
+	s.idx++
+	return s.idx < len(s.rows)
+}
+
+func (s *copyFromScalarTypesSource) Values() ([]interface{}, error) {
+	columns, values, err := insertScalarTypesColumns(&s.rows[s.idx])
+	if err != nil {
+		return nil, err
+	}
+	if !equalColumns(columns, s.columns) {
+		return nil, errors.Errorf("row %d does not set the same fields as row 0", s.idx)
+	}
+	return values, nil
+}
+
+func (s *copyFromScalarTypesSource) Err() error {
+	return nil
+}
+
+// insertManyScalarTypesTypes are the types the parameters of
+// InsertManyScalarTypes are cast to. PostgreSQL only infers them for the
+// VALUES list of an INSERT, not for one that is selected from.
+var insertManyScalarTypesTypes = map[string]string{
+	`id`:            `"pg_catalog"."int4"`,
+	`bool_col`:      `"pg_catalog"."bool"`,
+	`uuid_col`:      `"pg_catalog"."uuid"`,
+	`json_col`:      `"pg_catalog"."json"`,
+	`jsonb_col`:     `"pg_catalog"."jsonb"`,
+	`numeric_col`:   `"pg_catalog"."numeric"`,
+	`real_col`:      `"pg_catalog"."float4"`,
+	`double_col`:    `"pg_catalog"."float8"`,
+	`timestamp_col`: `"pg_catalog"."timestamp"`,
+	`time_col`:      `"pg_catalog"."time"`,
+	`interval_col`:  `"pg_catalog"."interval"`,
+	`char_col`:      `"pg_catalog"."bpchar"`,
+}
+
+// InsertManyScalarTypes inserts rows with multi-row INSERT statements for
+// a Queryer that does not support the copy protocol or for columns without a
+// binary format. The columns inserted are those not Undefined in the
+// first row and every row must set the same fields. Like InsertScalarTypes
+// the persisted rows are scanned into rows.
+func InsertManyScalarTypes(ctx context.Context, db Queryer, rows []ScalarTypes) error {
+	if len(rows) == 0 {
+		return nil
+	}
+
+	columns, _, err := insertScalarTypesColumns(&rows[0])
+	if err != nil {
+		return err
+	}
+
+	quotedColumns := make([]string, len(columns))
+	for i, column := range columns {
+		quotedColumns[i] = pgx.Identifier{column}.Sanitize()
+	}
+
+	// A statement can have at most 65535 parameters including the ordinality
+	// of each row.
+	batchSize := len(rows)
+	if batchSize > 65535/(len(columns)+1) {
+		batchSize = 65535 / (len(columns) + 1)
+	}
+
+	for start := 0; start < len(rows); start += batchSize {
+		end := start + batchSize
+		if end > len(rows) {
+			end = len(rows)
+		}
+		batch := rows[start:end]
+
+		args := pgx.QueryArgs(make([]interface{}, 0, len(batch)*(len(columns)+1)))
+		valueLists := make([]string, len(batch))
+		for i := range batch {
+			rowColumns, values, err := insertScalarTypesColumns(&batch[i])
+			if err != nil {
+				return err
+			}
+			if !equalColumns(rowColumns, columns) {
+				return errors.Errorf("row %d does not set the same fields as row 0", start+i)
+			}
+
+			placeholders := make([]string, len(values)+1)
+			for j, v := range values {
+				placeholders[j] = args.Append(v) + "::" + insertManyScalarTypesTypes[columns[j]]
+			}
+			placeholders[len(values)] = args.Append(int32(i)) + "::int4"
+			valueLists[i] = "(" + strings.Join(placeholders, ",") + ")"
+		}
+
+		// The rows are inserted in the order of their ordinality so they are
+		// returned in the order of batch.
+		sql := `insert into "scalar_types"(` + strings.Join(quotedColumns, ", ") + `)
+select ` + strings.Join(quotedColumns, ", ") + `
+from (values` + strings.Join(valueLists, ",") + `) as input(` + strings.Join(append(quotedColumns, "pgxdata_ordinality"), ", ") + `)
+order by pgxdata_ordinality
+returning "id", "bool_col", "uuid_col", "json_col", "jsonb_col", "numeric_col", "real_col", "double_col", "timestamp_col", "time_col"::text, "interval_col", "char_col"`
+
+		// The SQL depends on the number of rows so it is not prepared, which
+		// would leave a prepared statement for every batch size.
+		dbRows, err := db.Query(ctx, sql, args...)
+		if err != nil {
+			return err
+		}
+
+		// PostgreSQL does not guarantee the order of the returned rows, but it
+		// returns them in the order they were inserted. A different number of rows
+		// means they cannot be matched to batch.
+		n := 0
+		for dbRows.Next() {
+			if n < len(batch) {
+				row := &batch[n]
+				if err := dbRows.Scan(&row.ID, &row.BoolCol, &row.UuidCol, &row.JsonCol, &row.JsonbCol, &row.NumericCol, &row.RealCol, &row.DoubleCol, &row.TimestampCol, &row.TimeCol, &row.IntervalCol, &row.CharCol); err != nil {
+					dbRows.Close()
+					return err
+				}
+			}
+			n++
+		}
+
+		if dbRows.Err() != nil {
+			return dbRows.Err()
+		}
+		if n != len(batch) {
+			return errors.Errorf("inserted %d rows but %d rows were returned", len(batch), n)
+		}
+	}
+
+	return nil
+}
+
+// QueueSelectScalarTypesByPK queues selecting the row by primary key in b.
+// The returned reader scans the row into dst or returns ErrNotFound if there
+// is no such row.
+func QueueSelectScalarTypesByPK(b *pgx.Batch, id int32, dst *ScalarTypes) BatchReader {
+	args := []interface{}{id}
+	oids := []pgtype.OID{pgtype.Int4OID}
+
+	return queue(b, selectScalarTypesByPKSQL, args, oids, func(results pgx.BatchResults) error {
+		err := results.QueryRowResults().Scan(
+			&dst.ID,
+			&dst.BoolCol,
+			&dst.UuidCol,
+			&dst.JsonCol,
+			&dst.JsonbCol,
+			&dst.NumericCol,
+			&dst.RealCol,
+			&dst.DoubleCol,
+			&dst.TimestampCol,
+			&dst.TimeCol,
+			&dst.IntervalCol,
+			&dst.CharCol,
+		)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrNotFound
+		}
+		return err
+	})
+}
+
+// QueueInsertScalarTypes queues inserting row in b. Like InsertScalarTypes
+// the returned reader scans the persisted row into row.
+func QueueInsertScalarTypes(b *pgx.Batch, row *ScalarTypes) BatchReader {
+	args := pgx.QueryArgs(make([]interface{}, 0, 12))
+	oids := make([]pgtype.OID, 0, 12)
+
+	var columns, values []string
+
+	if row.ID.Status != pgtype.Undefined {
+		columns = append(columns, `id`)
+		values = append(values, args.Append(&row.ID))
+		oids = append(oids, pgtype.Int4OID)
+	}
+	if row.BoolCol.Status != pgtype.Undefined {
+		columns = append(columns, `bool_col`)
+		values = append(values, args.Append(&row.BoolCol))
+		oids = append(oids, pgtype.BoolOID)
+	}
+	if row.UuidCol.Status != pgtype.Undefined {
+		columns = append(columns, `uuid_col`)
+		values = append(values, args.Append(&row.UuidCol))
+		oids = append(oids, pgtype.UUIDOID)
+	}
+	if row.JsonCol.Status != pgtype.Undefined {
+		columns = append(columns, `json_col`)
+		values = append(values, args.Append(&row.JsonCol))
+		oids = append(oids, pgtype.JSONOID)
+	}
+	if row.JsonbCol.Status != pgtype.Undefined {
+		columns = append(columns, `jsonb_col`)
+		values = append(values, args.Append(&row.JsonbCol))
+		oids = append(oids, pgtype.JSONBOID)
+	}
+	if row.NumericCol.Status != pgtype.Undefined {
+		columns = append(columns, `numeric_col`)
+		values = append(values, args.Append(&row.NumericCol))
+		oids = append(oids, pgtype.NumericOID)
+	}
+	if row.RealCol.Status != pgtype.Undefined {
+		columns = append(columns, `real_col`)
+		values = append(values, args.Append(&row.RealCol))
+		oids = append(oids, pgtype.Float4OID)
+	}
+	if row.DoubleCol.Status != pgtype.Undefined {
+		columns = append(columns, `double_col`)
+		values = append(values, args.Append(&row.DoubleCol))
+		oids = append(oids, pgtype.Float8OID)
+	}
+	if row.TimestampCol.Status != pgtype.Undefined {
+		columns = append(columns, `timestamp_col`)
+		values = append(values, args.Append(&row.TimestampCol))
+		oids = append(oids, pgtype.TimestampOID)
+	}
+	if row.TimeCol.Status != pgtype.Undefined {
+		columns = append(columns, `time_col`)
+		values = append(values, args.Append(&row.TimeCol))
+		oids = append(oids, 0)
+	}
+	if row.IntervalCol.Status != pgtype.Undefined {
+		columns = append(columns, `interval_col`)
+		values = append(values, args.Append(&row.IntervalCol))
+		oids = append(oids, pgtype.IntervalOID)
+	}
+	if row.CharCol.Status != pgtype.Undefined {
+		columns = append(columns, `char_col`)
+		values = append(values, args.Append(&row.CharCol))
+		oids = append(oids, pgtype.BPCharOID)
+	}
+
+	insert := ` default values`
+	if len(columns) > 0 {
+		insert = `(` + strings.Join(columns, ", ") + `)
+values(` + strings.Join(values, ",") + `)`
+	}
+
+	sql := `insert into "scalar_types"` + insert + `
+returning "id", "bool_col", "uuid_col", "json_col", "jsonb_col", "numeric_col", "real_col", "double_col", "timestamp_col", "time_col"::text, "interval_col", "char_col"`
+
+	return queue(b, sql, args, oids, func(results pgx.BatchResults) error {
+		return results.QueryRowResults().Scan(&row.ID, &row.BoolCol, &row.UuidCol, &row.JsonCol, &row.JsonbCol, &row.NumericCol, &row.RealCol, &row.DoubleCol, &row.TimestampCol, &row.TimeCol, &row.IntervalCol, &row.CharCol)
+	})
+}
+
+// QueueUpdateScalarTypes queues updating the row by primary key in b. Like
+// UpdateScalarTypes the returned reader scans the persisted row into row
+// or returns ErrNotFound if there is no such row. Nothing is queued when
+// there is nothing to update.
+func QueueUpdateScalarTypes(b *pgx.Batch, id int32, row *ScalarTypes) BatchReader {
+	sets := make([]string, 0, 12)
+	args := pgx.QueryArgs(make([]interface{}, 0, 12))
+	oids := make([]pgtype.OID, 0, 12)
+
+	if row.ID.Status != pgtype.Undefined {
+		sets = append(sets, `id`+"="+args.Append(&row.ID))
+		oids = append(oids, pgtype.Int4OID)
+	}
+	if row.BoolCol.Status != pgtype.Undefined {
+		sets = append(sets, `bool_col`+"="+args.Append(&row.BoolCol))
+		oids = append(oids, pgtype.BoolOID)
+	}
+	if row.UuidCol.Status != pgtype.Undefined {
+		sets = append(sets, `uuid_col`+"="+args.Append(&row.UuidCol))
+		oids = append(oids, pgtype.UUIDOID)
+	}
+	if row.JsonCol.Status != pgtype.Undefined {
+		sets = append(sets, `json_col`+"="+args.Append(&row.JsonCol))
+		oids = append(oids, pgtype.JSONOID)
+	}
+	if row.JsonbCol.Status != pgtype.Undefined {
+		sets = append(sets, `jsonb_col`+"="+args.Append(&row.JsonbCol))
+		oids = append(oids, pgtype.JSONBOID)
+	}
+	if row.NumericCol.Status != pgtype.Undefined {
+		sets = append(sets, `numeric_col`+"="+args.Append(&row.NumericCol))
+		oids = append(oids, pgtype.NumericOID)
+	}
+	if row.RealCol.Status != pgtype.Undefined {
+		sets = append(sets, `real_col`+"="+args.Append(&row.RealCol))
+		oids = append(oids, pgtype.Float4OID)
+	}
+	if row.DoubleCol.Status != pgtype.Undefined {
+		sets = append(sets, `double_col`+"="+args.Append(&row.DoubleCol))
+		oids = append(oids, pgtype.Float8OID)
+	}
+	if row.TimestampCol.Status != pgtype.Undefined {
+		sets = append(sets, `timestamp_col`+"="+args.Append(&row.TimestampCol))
+		oids = append(oids, pgtype.TimestampOID)
+	}
+	if row.TimeCol.Status != pgtype.Undefined {
+		sets = append(sets, `time_col`+"="+args.Append(&row.TimeCol))
+		oids = append(oids, 0)
+	}
+	if row.IntervalCol.Status != pgtype.Undefined {
+		sets = append(sets, `interval_col`+"="+args.Append(&row.IntervalCol))
+		oids = append(oids, pgtype.IntervalOID)
+	}
+	if row.CharCol.Status != pgtype.Undefined {
+		sets = append(sets, `char_col`+"="+args.Append(&row.CharCol))
+		oids = append(oids, pgtype.BPCharOID)
+	}
+
+	if len(sets) == 0 {
+		return func(pgx.BatchResults) error { return nil }
+	}
+
+	sql := `update "scalar_types" set ` + strings.Join(sets, ", ") + ` where ` + `"id"=` + args.Append(id) + `
+returning "id", "bool_col", "uuid_col", "json_col", "jsonb_col", "numeric_col", "real_col", "double_col", "timestamp_col", "time_col"::text, "interval_col", "char_col"`
+	oids = append(oids, pgtype.Int4OID)
+
+	return queue(b, sql, args, oids, func(results pgx.BatchResults) error {
+		err := results.QueryRowResults().Scan(&row.ID, &row.BoolCol, &row.UuidCol, &row.JsonCol, &row.JsonbCol, &row.NumericCol, &row.RealCol, &row.DoubleCol, &row.TimestampCol, &row.TimeCol, &row.IntervalCol, &row.CharCol)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrNotFound
+		}
+		return err
+	})
+}
+
+// QueueDeleteScalarTypes queues deleting the row by primary key in b. The
+// returned reader returns ErrNotFound if there is no such row.
+func QueueDeleteScalarTypes(b *pgx.Batch, id int32) BatchReader {
+	args := pgx.QueryArgs(make([]interface{}, 0, 1))
+
+	sql := `delete from "scalar_types" where ` + `"id"=` + args.Append(id)
+	oids := []pgtype.OID{pgtype.Int4OID}
+
+	return queue(b, sql, args, oids, func(results pgx.BatchResults) error {
+		commandTag, err := results.ExecResults()
+		if err != nil {
+			return err
+		}
+		if commandTag.RowsAffected() != 1 {
+			return ErrNotFound
+		}
+		return nil
+	})
+}
+
+func DeleteScalarTypes(ctx context.Context, db Queryer,
+	id int32,
+) error {
+	args := pgx.QueryArgs(make([]interface{}, 0, 1))
+
+	sql := `delete from "scalar_types" where ` + `"id"=` + args.Append(id)
+
+	commandTag, err := prepareExec(ctx, db, "pgxdataDeleteScalarTypes", sql, args...)
+	if err != nil {
+		return err
+	}
+	if commandTag.RowsAffected() != 1 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+// ScalarTypesWhere has a filter for each column of "scalar_types" that builds the conditions of
+// SelectScalarTypesWhere, CountScalarTypesWhere, UpdateScalarTypesWhere and DeleteScalarTypesWhere.
+var ScalarTypesWhere = struct {
+	ID           ScalarTypesIDFilter
+	BoolCol      ScalarTypesBoolColFilter
+	UuidCol      ScalarTypesUuidColFilter
+	JsonCol      ScalarTypesJsonColFilter
+	JsonbCol     ScalarTypesJsonbColFilter
+	NumericCol   ScalarTypesNumericColFilter
+	RealCol      ScalarTypesRealColFilter
+	DoubleCol    ScalarTypesDoubleColFilter
+	TimestampCol ScalarTypesTimestampColFilter
+	TimeCol      ScalarTypesTimeColFilter
+	IntervalCol  ScalarTypesIntervalColFilter
+	CharCol      ScalarTypesCharColFilter
+}{
+	ID:           ScalarTypesIDFilter{columnFilter{`"id"`}},
+	BoolCol:      ScalarTypesBoolColFilter{columnFilter{`"bool_col"`}},
+	UuidCol:      ScalarTypesUuidColFilter{columnFilter{`"uuid_col"`}},
+	JsonCol:      ScalarTypesJsonColFilter{columnFilter{`"json_col"`}},
+	JsonbCol:     ScalarTypesJsonbColFilter{columnFilter{`"jsonb_col"`}},
+	NumericCol:   ScalarTypesNumericColFilter{columnFilter{`"numeric_col"`}},
+	RealCol:      ScalarTypesRealColFilter{columnFilter{`"real_col"`}},
+	DoubleCol:    ScalarTypesDoubleColFilter{columnFilter{`"double_col"`}},
+	TimestampCol: ScalarTypesTimestampColFilter{columnFilter{`"timestamp_col"`}},
+	TimeCol:      ScalarTypesTimeColFilter{columnFilter{`"time_col"`}},
+	IntervalCol:  ScalarTypesIntervalColFilter{columnFilter{`"interval_col"`}},
+	CharCol:      ScalarTypesCharColFilter{columnFilter{`"char_col"`}},
+}
+
+// ScalarTypesIDFilter builds conditions on id.
+type ScalarTypesIDFilter struct{ columnFilter }
+
+func (f ScalarTypesIDFilter) Eq(v int32) Condition { return f.compare(" = ", v) }
+func (f ScalarTypesIDFilter) Ne(v int32) Condition { return f.compare(" <> ", v) }
+func (f ScalarTypesIDFilter) Lt(v int32) Condition { return f.compare(" < ", v) }
+func (f ScalarTypesIDFilter) Le(v int32) Condition { return f.compare(" <= ", v) }
+func (f ScalarTypesIDFilter) Gt(v int32) Condition { return f.compare(" > ", v) }
+func (f ScalarTypesIDFilter) Ge(v int32) Condition { return f.compare(" >= ", v) }
+
+func (f ScalarTypesIDFilter) In(vs ...int32) Condition { return f.in(len(vs), vs) }
+
+// ScalarTypesBoolColFilter builds conditions on bool_col.
+type ScalarTypesBoolColFilter struct{ columnFilter }
+
+func (f ScalarTypesBoolColFilter) Eq(v bool) Condition { return f.compare(" = ", v) }
+func (f ScalarTypesBoolColFilter) Ne(v bool) Condition { return f.compare(" <> ", v) }
+func (f ScalarTypesBoolColFilter) Lt(v bool) Condition { return f.compare(" < ", v) }
+func (f ScalarTypesBoolColFilter) Le(v bool) Condition { return f.compare(" <= ", v) }
+func (f ScalarTypesBoolColFilter) Gt(v bool) Condition { return f.compare(" > ", v) }
+func (f ScalarTypesBoolColFilter) Ge(v bool) Condition { return f.compare(" >= ", v) }
+
+func (f ScalarTypesBoolColFilter) In(vs ...bool) Condition { return f.in(len(vs), vs) }
+
+// ScalarTypesUuidColFilter builds conditions on uuid_col.
+type ScalarTypesUuidColFilter struct{ columnFilter }
+
+func (f ScalarTypesUuidColFilter) Eq(v [16]byte) Condition { return f.compare(" = ", v) }
+func (f ScalarTypesUuidColFilter) Ne(v [16]byte) Condition { return f.compare(" <> ", v) }
+func (f ScalarTypesUuidColFilter) Lt(v [16]byte) Condition { return f.compare(" < ", v) }
+func (f ScalarTypesUuidColFilter) Le(v [16]byte) Condition { return f.compare(" <= ", v) }
+func (f ScalarTypesUuidColFilter) Gt(v [16]byte) Condition { return f.compare(" > ", v) }
+func (f ScalarTypesUuidColFilter) Ge(v [16]byte) Condition { return f.compare(" >= ", v) }
+
+func (f ScalarTypesUuidColFilter) In(vs ...[16]byte) Condition { return f.in(len(vs), vs) }
+
+// ScalarTypesJsonColFilter builds conditions on json_col.
+type ScalarTypesJsonColFilter struct{ columnFilter }
+
+// ScalarTypesJsonbColFilter builds conditions on jsonb_col.
+type ScalarTypesJsonbColFilter struct{ columnFilter }
+
+// ScalarTypesNumericColFilter builds conditions on numeric_col.
+type ScalarTypesNumericColFilter struct{ columnFilter }
+
+func (f ScalarTypesNumericColFilter) Eq(v string) Condition { return f.compare(" = ", v) }
+func (f ScalarTypesNumericColFilter) Ne(v string) Condition { return f.compare(" <> ", v) }
+func (f ScalarTypesNumericColFilter) Lt(v string) Condition { return f.compare(" < ", v) }
+func (f ScalarTypesNumericColFilter) Le(v string) Condition { return f.compare(" <= ", v) }
+func (f ScalarTypesNumericColFilter) Gt(v string) Condition { return f.compare(" > ", v) }
+func (f ScalarTypesNumericColFilter) Ge(v string) Condition { return f.compare(" >= ", v) }
+
+func (f ScalarTypesNumericColFilter) In(vs ...string) Condition {
+	values := make([]string, len(vs))
+	for i, v := range vs {
+		values[i] = string(v)
+	}
+	return f.in(len(vs), arrayLiteral(values))
+}
+
+// ScalarTypesRealColFilter builds conditions on real_col.
+type ScalarTypesRealColFilter struct{ columnFilter }
+
+func (f ScalarTypesRealColFilter) Eq(v float32) Condition { return f.compare(" = ", v) }
+func (f ScalarTypesRealColFilter) Ne(v float32) Condition { return f.compare(" <> ", v) }
+func (f ScalarTypesRealColFilter) Lt(v float32) Condition { return f.compare(" < ", v) }
+func (f ScalarTypesRealColFilter) Le(v float32) Condition { return f.compare(" <= ", v) }
+func (f ScalarTypesRealColFilter) Gt(v float32) Condition { return f.compare(" > ", v) }
+func (f ScalarTypesRealColFilter) Ge(v float32) Condition { return f.compare(" >= ", v) }
+
+func (f ScalarTypesRealColFilter) In(vs ...float32) Condition { return f.in(len(vs), vs) }
+
+// ScalarTypesDoubleColFilter builds conditions on double_col.
+type ScalarTypesDoubleColFilter struct{ columnFilter }
+
+func (f ScalarTypesDoubleColFilter) Eq(v float64) Condition { return f.compare(" = ", v) }
+func (f ScalarTypesDoubleColFilter) Ne(v float64) Condition { return f.compare(" <> ", v) }
+func (f ScalarTypesDoubleColFilter) Lt(v float64) Condition { return f.compare(" < ", v) }
+func (f ScalarTypesDoubleColFilter) Le(v float64) Condition { return f.compare(" <= ", v) }
+func (f ScalarTypesDoubleColFilter) Gt(v float64) Condition { return f.compare(" > ", v) }
+func (f ScalarTypesDoubleColFilter) Ge(v float64) Condition { return f.compare(" >= ", v) }
+
+func (f ScalarTypesDoubleColFilter) In(vs ...float64) Condition { return f.in(len(vs), vs) }
+
+// ScalarTypesTimestampColFilter builds conditions on timestamp_col.
+type ScalarTypesTimestampColFilter struct{ columnFilter }
+
+func (f ScalarTypesTimestampColFilter) Eq(v time.Time) Condition { return f.compare(" = ", v) }
+func (f ScalarTypesTimestampColFilter) Ne(v time.Time) Condition { return f.compare(" <> ", v) }
+func (f ScalarTypesTimestampColFilter) Lt(v time.Time) Condition { return f.compare(" < ", v) }
+func (f ScalarTypesTimestampColFilter) Le(v time.Time) Condition { return f.compare(" <= ", v) }
+func (f ScalarTypesTimestampColFilter) Gt(v time.Time) Condition { return f.compare(" > ", v) }
+func (f ScalarTypesTimestampColFilter) Ge(v time.Time) Condition { return f.compare(" >= ", v) }
+
+func (f ScalarTypesTimestampColFilter) In(vs ...time.Time) Condition { return f.in(len(vs), vs) }
+
+// ScalarTypesTimeColFilter builds conditions on time_col.
+type ScalarTypesTimeColFilter struct{ columnFilter }
+
+func (f ScalarTypesTimeColFilter) Eq(v string) Condition { return f.compare(" = ", v) }
+func (f ScalarTypesTimeColFilter) Ne(v string) Condition { return f.compare(" <> ", v) }
+func (f ScalarTypesTimeColFilter) Lt(v string) Condition { return f.compare(" < ", v) }
+func (f ScalarTypesTimeColFilter) Le(v string) Condition { return f.compare(" <= ", v) }
+func (f ScalarTypesTimeColFilter) Gt(v string) Condition { return f.compare(" > ", v) }
+func (f ScalarTypesTimeColFilter) Ge(v string) Condition { return f.compare(" >= ", v) }
+
+func (f ScalarTypesTimeColFilter) In(vs ...string) Condition {
+	values := make([]string, len(vs))
+	for i, v := range vs {
+		values[i] = string(v)
+	}
+	return f.in(len(vs), arrayLiteral(values))
+}
+
+// ScalarTypesIntervalColFilter builds conditions on interval_col.
+type ScalarTypesIntervalColFilter struct{ columnFilter }
+
+// ScalarTypesCharColFilter builds conditions on char_col.
+type ScalarTypesCharColFilter struct{ columnFilter }
+
+func (f ScalarTypesCharColFilter) Eq(v string) Condition { return f.compare(" = ", v) }
+func (f ScalarTypesCharColFilter) Ne(v string) Condition { return f.compare(" <> ", v) }
+func (f ScalarTypesCharColFilter) Lt(v string) Condition { return f.compare(" < ", v) }
+func (f ScalarTypesCharColFilter) Le(v string) Condition { return f.compare(" <= ", v) }
+func (f ScalarTypesCharColFilter) Gt(v string) Condition { return f.compare(" > ", v) }
+func (f ScalarTypesCharColFilter) Ge(v string) Condition { return f.compare(" >= ", v) }
+
+func (f ScalarTypesCharColFilter) In(vs ...string) Condition { return f.in(len(vs), vs) }
+
+func SelectScalarTypesWhere(ctx context.Context, db Queryer, where Condition) ([]ScalarTypes, error) {
+	var args pgx.QueryArgs
+	sql := SelectAllScalarTypesSQL + `
+where ` + where.sql(&args)
+
+	dbRows, err := prepareQuery(ctx, db, preparedName("pgxdataSelectScalarTypesWhere", sql), sql, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer dbRows.Close()
+
+	var rows []ScalarTypes
+	for dbRows.Next() {
+		var row ScalarTypes
+		err := dbRows.Scan(
+			&row.ID,
+			&row.BoolCol,
+			&row.UuidCol,
+			&row.JsonCol,
+			&row.JsonbCol,
+			&row.NumericCol,
+			&row.RealCol,
+			&row.DoubleCol,
+			&row.TimestampCol,
+			&row.TimeCol,
+			&row.IntervalCol,
+			&row.CharCol,
+		)
+		if err != nil {
+			return nil, err
+		}
+		rows = append(rows, row)
+	}
+
+	if dbRows.Err() != nil {
+		return nil, dbRows.Err()
+	}
+
+	return rows, nil
+}
+
+func CountScalarTypesWhere(ctx context.Context, db Queryer, where Condition) (int64, error) {
+	var args pgx.QueryArgs
+	sql := countScalarTypesSQL + ` where ` + where.sql(&args)
+
+	var n int64
+	err := prepareQueryRow(ctx, db, preparedName("pgxdataCountScalarTypesWhere", sql), sql, args...).Scan(&n)
+	return n, err
+}
+
+// UpdateScalarTypesWhere updates the rows matching where like UpdateScalarTypes and returns the number of
+// rows updated. where must restrict the rows, so a Condition that matches every row like the zero Condition is
+// refused.
+func UpdateScalarTypesWhere(ctx context.Context, db Queryer, where Condition, row *ScalarTypes) (int64, error) {
+	if where.write == nil {
+		return 0, errors.New("UpdateScalarTypesWhere requires a condition")
+	}
+
+	sets, args, err := updateScalarTypesSets(row)
+	if err != nil {
+		return 0, err
+	}
+	if len(sets) == 0 {
+		return 0, nil
+	}
+
+	sql := `update "scalar_types" set ` + strings.Join(sets, ", ") + ` where ` + where.sql(&args)
+
+	commandTag, err := prepareExec(ctx, db, preparedName("pgxdataUpdateScalarTypesWhere", sql), sql, args...)
+	if err != nil {
+		return 0, err
+	}
+	return commandTag.RowsAffected(), nil
+}
+
+// DeleteScalarTypesWhere deletes the rows matching where and returns the number of rows deleted. where must
+// restrict the rows, so a Condition that matches every row like the zero Condition is refused.
+func DeleteScalarTypesWhere(ctx context.Context, db Queryer, where Condition) (int64, error) {
+	if where.write == nil {
+		return 0, errors.New("DeleteScalarTypesWhere requires a condition")
+	}
+
+	var args pgx.QueryArgs
+	sql := `delete from "scalar_types" where ` + where.sql(&args)
+
+	commandTag, err := prepareExec(ctx, db, preparedName("pgxdataDeleteScalarTypesWhere", sql), sql, args...)
+	if err != nil {
+		return 0, err
+	}
+	return commandTag.RowsAffected(), nil
+}
@@ -12,8 +12,11 @@ import (
 )
 
 type Semester struct {
-	Year        pgtype.Int2
-	Season      pgtype.Varchar
+	// Year is smallint not null.
+	Year pgtype.Int2
+	// Season is character varying not null.
+	Season pgtype.Varchar
+	// Description is text not null.
 	Description pgtype.Text
 }
 
@@ -34,26 +37,171 @@ from "semester"`
 func SelectAllSemester(ctx context.Context, db Queryer) ([]Semester, error) {
 	var rows []Semester
 
+	err := ForEachSemester(ctx, db, func(row *Semester) error {
+		rows = append(rows, *row)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return rows, nil
+}
+
+// SemesterRows is a cursor over the rows of "semester" that
+// reads one row at a time.
+type SemesterRows struct {
+	rows pgx.Rows
+	row  Semester
+	err  error
+}
+
+// QuerySemesterRows selects all rows of "semester". The
+// returned rows must be closed.
+func QuerySemesterRows(ctx context.Context, db Queryer) (*SemesterRows, error) {
 	dbRows, err := prepareQuery(ctx, db, "pgxdataSelectAllSemester", SelectAllSemesterSQL)
 	if err != nil {
 		return nil, err
 	}
 
+	return &SemesterRows{rows: dbRows}, nil
+}
+
+// Next reads the next row. It returns false when there are no more rows or an
+// error occurred.
+func (r *SemesterRows) Next() bool {
+	if r.err != nil || !r.rows.Next() {
+		return false
+	}
+
+	r.row = Semester{}
+	r.err = r.rows.Scan(
+		&r.row.Year,
+		&r.row.Season,
+		&r.row.Description,
+	)
+	if r.err != nil {
+		r.rows.Close()
+		return false
+	}
+
+	return true
+}
+
+// Row returns the current row. It is overwritten by the next call to Next.
+func (r *SemesterRows) Row() *Semester {
+	return &r.row
+}
+
+// Err returns the error, if any, that stopped Next.
+func (r *SemesterRows) Err() error {
+	if r.err != nil {
+		return r.err
+	}
+	return r.rows.Err()
+}
+
+// Close closes the rows. It is safe to call Close after all rows have been
+// read.
+func (r *SemesterRows) Close() {
+	r.rows.Close()
+}
+
+// ForEachSemester calls fn with each row of "semester". The
+// row passed to fn is reused for the next row. If fn returns an error the
+// remaining rows are skipped and the error is returned.
+func ForEachSemester(ctx context.Context, db Queryer, fn func(*Semester) error) error {
+	rows, err := QuerySemesterRows(ctx, db)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		err := fn(rows.Row())
+		if err != nil {
+			return err
+		}
+	}
+
+	return rows.Err()
+}
+
+// SemesterPageCursor is the position after the last row of a
+// page returned by SelectSemesterPage.
+type SemesterPageCursor struct {
+	Year   int16
+	Season string
+}
+
+const selectSemesterPageSQL = `select
+  "year",
+  "season",
+  "description"
+from "semester"
+order by "year", "season"
+limit $1`
+
+const selectSemesterPageAfterSQL = `select
+  "year",
+  "season",
+  "description"
+from "semester"
+where ("year", "season") > ($1, $2)
+order by "year", "season"
+limit $3`
+
+// SelectSemesterPage selects up to limit rows that follow
+// after, or the first rows when after is nil, ordered by
+// "year", "season". The returned cursor selects the next page. It is nil
+// when there are no more rows.
+func SelectSemesterPage(ctx context.Context, db Queryer, after *SemesterPageCursor, limit int) ([]Semester, *SemesterPageCursor, error) {
+	var dbRows pgx.Rows
+	var err error
+	if after == nil {
+		dbRows, err = prepareQuery(ctx, db, "pgxdataSelectSemesterPage", selectSemesterPageSQL, limit)
+	} else {
+		dbRows, err = prepareQuery(ctx, db, "pgxdataSelectSemesterPageAfter", selectSemesterPageAfterSQL, after.Year, after.Season, limit)
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	defer dbRows.Close()
+
+	var rows []Semester
 	for dbRows.Next() {
 		var row Semester
-		dbRows.Scan(
+		err := dbRows.Scan(
 			&row.Year,
 			&row.Season,
 			&row.Description,
 		)
+		if err != nil {
+			return nil, nil, err
+		}
 		rows = append(rows, row)
 	}
 
 	if dbRows.Err() != nil {
-		return nil, dbRows.Err()
+		return nil, nil, dbRows.Err()
 	}
 
-	return rows, nil
+	if len(rows) == 0 || len(rows) < limit {
+		return rows, nil, nil
+	}
+
+	last := &rows[len(rows)-1]
+	next := &SemesterPageCursor{}
+	err = last.Year.AssignTo(&next.Year)
+	if err != nil {
+		return nil, nil, err
+	}
+	err = last.Season.AssignTo(&next.Season)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return rows, next, nil
 }
 
 const selectSemesterByPKSQL = `select
@@ -102,21 +250,24 @@ func InsertSemester(ctx context.Context, db Queryer, row *Semester) error {
 		values = append(values, args.Append(&row.Description))
 	}
 
-	sql := `insert into "semester"(` + strings.Join(columns, ", ") + `)
-values(` + strings.Join(values, ",") + `)
-returning "year", "season"
+	insert := ` default values`
+	if len(columns) > 0 {
+		insert = `(` + strings.Join(columns, ", ") + `)
+values(` + strings.Join(values, ",") + `)`
+	}
+
+	sql := `insert into "semester"` + insert + `
+returning "year", "season", "description"
   `
 
 	psName := preparedName("pgxdataInsertSemester", sql)
 
-	return prepareQueryRow(ctx, db, psName, sql, args...).Scan(&row.Year, &row.Season)
+	return prepareQueryRow(ctx, db, psName, sql, args...).Scan(&row.Year, &row.Season, &row.Description)
 }
 
-func UpdateSemester(ctx context.Context, db Queryer,
-	year int16,
-	season string,
-	row *Semester,
-) error {
+// updateSemesterSets returns the assignments of the SET clause for the fields of row to update and their
+// arguments.
+func updateSemesterSets(row *Semester) ([]string, pgx.QueryArgs, error) {
 	sets := make([]string, 0, 3)
 	args := pgx.QueryArgs(make([]interface{}, 0, 3))
 
@@ -130,24 +281,414 @@ func UpdateSemester(ctx context.Context, db Queryer,
 		sets = append(sets, `description`+"="+args.Append(&row.Description))
 	}
 
+	return sets, args, nil
+}
+
+func UpdateSemester(ctx context.Context, db Queryer,
+	year int16,
+	season string,
+	row *Semester,
+) error {
+	sets, args, err := updateSemesterSets(row)
+	if err != nil {
+		return err
+	}
 	if len(sets) == 0 {
 		return nil
 	}
 
-	sql := `update "semester" set ` + strings.Join(sets, ", ") + ` where ` + `"year"=` + args.Append(year) + ` and "season"=` + args.Append(season)
+	sql := `update "semester" set ` + strings.Join(sets, ", ") + ` where ` + `"year"=` + args.Append(year) + ` and "season"=` + args.Append(season) + `
+returning "year", "season", "description"`
 
 	psName := preparedName("pgxdataUpdateSemester", sql)
 
-	commandTag, err := prepareExec(ctx, db, psName, sql, args...)
+	err = prepareQueryRow(ctx, db, psName, sql, args...).Scan(&row.Year, &row.Season, &row.Description)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return ErrNotFound
+	}
+	return err
+}
+
+// SemesterConflict identifies the primary key or unique index UpsertSemester
+// detects a conflicting row by.
+type SemesterConflict int
+
+const (
+	SemesterConflictOnPK SemesterConflict = iota
+)
+
+// SemesterUpsertOptions configures UpsertSemester.
+type SemesterUpsertOptions struct {
+	// Conflict is the primary key or unique index a conflicting row is detected
+	// by. The primary key is the default.
+	Conflict SemesterConflict
+
+	// DoNothing leaves a conflicting row unchanged instead of updating it.
+	DoNothing bool
+}
+
+// UpsertSemester inserts row or, when it conflicts with an existing row,
+// updates the existing row with the fields of row that are not Undefined other than
+// those of the conflict target.
+// Like InsertSemester the persisted row is scanned into row. Nothing is
+// scanned when a conflicting row is left unchanged.
+func UpsertSemester(ctx context.Context, db Queryer, row *Semester, opts SemesterUpsertOptions) (UpsertResult, error) {
+	args := pgx.QueryArgs(make([]interface{}, 0, 3))
+
+	var columns, values []string
+
+	if row.Year.Status != pgtype.Undefined {
+		columns = append(columns, `"year"`)
+		values = append(values, args.Append(&row.Year))
+	}
+	if row.Season.Status != pgtype.Undefined {
+		columns = append(columns, `"season"`)
+		values = append(values, args.Append(&row.Season))
+	}
+	if row.Description.Status != pgtype.Undefined {
+		columns = append(columns, `"description"`)
+		values = append(values, args.Append(&row.Description))
+	}
+
+	var targetColumns []string
+	switch opts.Conflict {
+	case SemesterConflictOnPK:
+		targetColumns = []string{`"year"`, `"season"`}
+	default:
+		return UpsertUnchanged, errors.Errorf("unknown SemesterConflict %d", opts.Conflict)
+	}
+
+	// The conflicting row is updated with the columns other than the conflict
+	// target. It is left unchanged when there are none.
+	sets := make([]string, 0, len(columns))
+nextColumn:
+	for _, c := range columns {
+		for _, tc := range targetColumns {
+			if c == tc {
+				continue nextColumn
+			}
+		}
+		sets = append(sets, c+"=excluded."+c)
+	}
+
+	action := `do update set ` + strings.Join(sets, ", ")
+	if opts.DoNothing || len(sets) == 0 {
+		action = `do nothing`
+	}
+
+	insert := ` default values`
+	if len(columns) > 0 {
+		insert = `(` + strings.Join(columns, ", ") + `)
+values(` + strings.Join(values, ",") + `)`
+	}
+
+	sql := `insert into "semester"` + insert + `
+on conflict (` + strings.Join(targetColumns, ", ") + `) ` + action + `
+returning xmax = 0, "year", "season", "description"
+  `
+
+	psName := preparedName("pgxdataUpsertSemester", sql)
+
+	var inserted bool
+	err := prepareQueryRow(ctx, db, psName, sql, args...).Scan(&inserted, &row.Year, &row.Season, &row.Description)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return UpsertUnchanged, nil
+	} else if err != nil {
+		return UpsertUnchanged, err
+	}
+
+	if inserted {
+		return UpsertInserted, nil
+	}
+	return UpsertUpdated, nil
+}
+
+// insertSemesterColumns returns the columns of row that are not Undefined and their
+// values for inserting row.
+func insertSemesterColumns(row *Semester) ([]string, []interface{}, error) {
+	var columns []string
+	var values []interface{}
+
+	if row.Year.Status != pgtype.Undefined {
+		columns = append(columns, `year`)
+		values = append(values, &row.Year)
+	}
+	if row.Season.Status != pgtype.Undefined {
+		columns = append(columns, `season`)
+		values = append(values, &row.Season)
+	}
+	if row.Description.Status != pgtype.Undefined {
+		columns = append(columns, `description`)
+		values = append(values, &row.Description)
+	}
+
+	return columns, values, nil
+}
+
+// CopyInsertSemester inserts rows with the PostgreSQL copy protocol and
+// returns the number of rows copied. The columns copied are those not
+// Undefined in the first row and every row must set the same fields. Values
+// must support the binary format.
+func CopyInsertSemester(ctx context.Context, db CopyFromer, rows []Semester) (int64, error) {
+	if len(rows) == 0 {
+		return 0, nil
+	}
+
+	columns, _, err := insertSemesterColumns(&rows[0])
+	if err != nil {
+		return 0, err
+	}
+
+	return db.CopyFrom(ctx, pgx.Identifier{"semester"}, columns, &copyFromSemesterSource{rows: rows, columns: columns, idx: -1})
+}
+
+// copyFromSemesterSource is a pgx.CopyFromSource of the columns of rows.
+type copyFromSemesterSource struct {
+	rows    []Semester
+	columns []string
+	idx     int
+}
+
+func (s *copyFromSemesterSource) Next() bool {
+	s.idx++
+	return s.idx < len(s.rows)
+}
+
+func (s *copyFromSemesterSource) Values() ([]interface{}, error) {
+	columns, values, err := insertSemesterColumns(&s.rows[s.idx])
+	if err != nil {
+		return nil, err
+	}
+	if !equalColumns(columns, s.columns) {
+		return nil, errors.Errorf("row %d does not set the same fields as row 0", s.idx)
+	}
+	return values, nil
+}
+
+func (s *copyFromSemesterSource) Err() error {
+	return nil
+}
+
+// insertManySemesterTypes are the types the parameters of
+// InsertManySemester are cast to. PostgreSQL only infers them for the
+// VALUES list of an INSERT, not for one that is selected from.
+var insertManySemesterTypes = map[string]string{
+	`year`:        `"pg_catalog"."int2"`,
+	`season`:      `"pg_catalog"."varchar"`,
+	`description`: `"pg_catalog"."text"`,
+}
+
+// InsertManySemester inserts rows with multi-row INSERT statements for
+// a Queryer that does not support the copy protocol or for columns without a
+// binary format. The columns inserted are those not Undefined in the
+// first row and every row must set the same fields. Like InsertSemester
+// the persisted rows are scanned into rows.
+func InsertManySemester(ctx context.Context, db Queryer, rows []Semester) error {
+	if len(rows) == 0 {
+		return nil
+	}
+
+	columns, _, err := insertSemesterColumns(&rows[0])
 	if err != nil {
 		return err
 	}
-	if commandTag.RowsAffected() != 1 {
-		return ErrNotFound
+
+	quotedColumns := make([]string, len(columns))
+	for i, column := range columns {
+		quotedColumns[i] = pgx.Identifier{column}.Sanitize()
+	}
+
+	// A statement can have at most 65535 parameters including the ordinality
+	// of each row.
+	batchSize := len(rows)
+	if batchSize > 65535/(len(columns)+1) {
+		batchSize = 65535 / (len(columns) + 1)
+	}
+
+	for start := 0; start < len(rows); start += batchSize {
+		end := start + batchSize
+		if end > len(rows) {
+			end = len(rows)
+		}
+		batch := rows[start:end]
+
+		args := pgx.QueryArgs(make([]interface{}, 0, len(batch)*(len(columns)+1)))
+		valueLists := make([]string, len(batch))
+		for i := range batch {
+			rowColumns, values, err := insertSemesterColumns(&batch[i])
+			if err != nil {
+				return err
+			}
+			if !equalColumns(rowColumns, columns) {
+				return errors.Errorf("row %d does not set the same fields as row 0", start+i)
+			}
+
+			placeholders := make([]string, len(values)+1)
+			for j, v := range values {
+				placeholders[j] = args.Append(v) + "::" + insertManySemesterTypes[columns[j]]
+			}
+			placeholders[len(values)] = args.Append(int32(i)) + "::int4"
+			valueLists[i] = "(" + strings.Join(placeholders, ",") + ")"
+		}
+
+		// The rows are inserted in the order of their ordinality so they are
+		// returned in the order of batch.
+		sql := `insert into "semester"(` + strings.Join(quotedColumns, ", ") + `)
+select ` + strings.Join(quotedColumns, ", ") + `
+from (values` + strings.Join(valueLists, ",") + `) as input(` + strings.Join(append(quotedColumns, "pgxdata_ordinality"), ", ") + `)
+order by pgxdata_ordinality
+returning "year", "season", "description"`
+
+		// The SQL depends on the number of rows so it is not prepared, which
+		// would leave a prepared statement for every batch size.
+		dbRows, err := db.Query(ctx, sql, args...)
+		if err != nil {
+			return err
+		}
+
+		// PostgreSQL does not guarantee the order of the returned rows, but it
+		// returns them in the order they were inserted. A different number of rows
+		// means they cannot be matched to batch.
+		n := 0
+		for dbRows.Next() {
+			if n < len(batch) {
+				row := &batch[n]
+				if err := dbRows.Scan(&row.Year, &row.Season, &row.Description); err != nil {
+					dbRows.Close()
+					return err
+				}
+			}
+			n++
+		}
+
+		if dbRows.Err() != nil {
+			return dbRows.Err()
+		}
+		if n != len(batch) {
+			return errors.Errorf("inserted %d rows but %d rows were returned", len(batch), n)
+		}
 	}
+
 	return nil
 }
 
+// QueueSelectSemesterByPK queues selecting the row by primary key in b.
+// The returned reader scans the row into dst or returns ErrNotFound if there
+// is no such row.
+func QueueSelectSemesterByPK(b *pgx.Batch, year int16, season string, dst *Semester) BatchReader {
+	args := []interface{}{year, season}
+	oids := []pgtype.OID{pgtype.Int2OID, pgtype.VarcharOID}
+
+	return queue(b, selectSemesterByPKSQL, args, oids, func(results pgx.BatchResults) error {
+		err := results.QueryRowResults().Scan(
+			&dst.Year,
+			&dst.Season,
+			&dst.Description,
+		)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrNotFound
+		}
+		return err
+	})
+}
+
+// QueueInsertSemester queues inserting row in b. Like InsertSemester
+// the returned reader scans the persisted row into row.
+func QueueInsertSemester(b *pgx.Batch, row *Semester) BatchReader {
+	args := pgx.QueryArgs(make([]interface{}, 0, 3))
+	oids := make([]pgtype.OID, 0, 3)
+
+	var columns, values []string
+
+	if row.Year.Status != pgtype.Undefined {
+		columns = append(columns, `year`)
+		values = append(values, args.Append(&row.Year))
+		oids = append(oids, pgtype.Int2OID)
+	}
+	if row.Season.Status != pgtype.Undefined {
+		columns = append(columns, `season`)
+		values = append(values, args.Append(&row.Season))
+		oids = append(oids, pgtype.VarcharOID)
+	}
+	if row.Description.Status != pgtype.Undefined {
+		columns = append(columns, `description`)
+		values = append(values, args.Append(&row.Description))
+		oids = append(oids, pgtype.TextOID)
+	}
+
+	insert := ` default values`
+	if len(columns) > 0 {
+		insert = `(` + strings.Join(columns, ", ") + `)
+values(` + strings.Join(values, ",") + `)`
+	}
+
+	sql := `insert into "semester"` + insert + `
+returning "year", "season", "description"`
+
+	return queue(b, sql, args, oids, func(results pgx.BatchResults) error {
+		return results.QueryRowResults().Scan(&row.Year, &row.Season, &row.Description)
+	})
+}
+
+// QueueUpdateSemester queues updating the row by primary key in b. Like
+// UpdateSemester the returned reader scans the persisted row into row
+// or returns ErrNotFound if there is no such row. Nothing is queued when
+// there is nothing to update.
+func QueueUpdateSemester(b *pgx.Batch, year int16, season string, row *Semester) BatchReader {
+	sets := make([]string, 0, 3)
+	args := pgx.QueryArgs(make([]interface{}, 0, 3))
+	oids := make([]pgtype.OID, 0, 3)
+
+	if row.Year.Status != pgtype.Undefined {
+		sets = append(sets, `year`+"="+args.Append(&row.Year))
+		oids = append(oids, pgtype.Int2OID)
+	}
+	if row.Season.Status != pgtype.Undefined {
+		sets = append(sets, `season`+"="+args.Append(&row.Season))
+		oids = append(oids, pgtype.VarcharOID)
+	}
+	if row.Description.Status != pgtype.Undefined {
+		sets = append(sets, `description`+"="+args.Append(&row.Description))
+		oids = append(oids, pgtype.TextOID)
+	}
+
+	if len(sets) == 0 {
+		return func(pgx.BatchResults) error { return nil }
+	}
+
+	sql := `update "semester" set ` + strings.Join(sets, ", ") + ` where ` + `"year"=` + args.Append(year) + ` and "season"=` + args.Append(season) + `
+returning "year", "season", "description"`
+	oids = append(oids, pgtype.Int2OID, pgtype.VarcharOID)
+
+	return queue(b, sql, args, oids, func(results pgx.BatchResults) error {
+		err := results.QueryRowResults().Scan(&row.Year, &row.Season, &row.Description)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrNotFound
+		}
+		return err
+	})
+}
+
+// QueueDeleteSemester queues deleting the row by primary key in b. The
+// returned reader returns ErrNotFound if there is no such row.
+func QueueDeleteSemester(b *pgx.Batch, year int16, season string) BatchReader {
+	args := pgx.QueryArgs(make([]interface{}, 0, 2))
+
+	sql := `delete from "semester" where ` + `"year"=` + args.Append(year) + ` and "season"=` + args.Append(season)
+	oids := []pgtype.OID{pgtype.Int2OID, pgtype.VarcharOID}
+
+	return queue(b, sql, args, oids, func(results pgx.BatchResults) error {
+		commandTag, err := results.ExecResults()
+		if err != nil {
+			return err
+		}
+		if commandTag.RowsAffected() != 1 {
+			return ErrNotFound
+		}
+		return nil
+	})
+}
+
 func DeleteSemester(ctx context.Context, db Queryer,
 	year int16,
 	season string,
@@ -165,3 +706,134 @@ func DeleteSemester(ctx context.Context, db Queryer,
 	}
 	return nil
 }
+
+// SemesterWhere has a filter for each column of "semester" that builds the conditions of
+// SelectSemesterWhere, CountSemesterWhere, UpdateSemesterWhere and DeleteSemesterWhere.
+var SemesterWhere = struct {
+	Year        SemesterYearFilter
+	Season      SemesterSeasonFilter
+	Description SemesterDescriptionFilter
+}{
+	Year:        SemesterYearFilter{columnFilter{`"year"`}},
+	Season:      SemesterSeasonFilter{columnFilter{`"season"`}},
+	Description: SemesterDescriptionFilter{columnFilter{`"description"`}},
+}
+
+// SemesterYearFilter builds conditions on year.
+type SemesterYearFilter struct{ columnFilter }
+
+func (f SemesterYearFilter) Eq(v int16) Condition { return f.compare(" = ", v) }
+func (f SemesterYearFilter) Ne(v int16) Condition { return f.compare(" <> ", v) }
+func (f SemesterYearFilter) Lt(v int16) Condition { return f.compare(" < ", v) }
+func (f SemesterYearFilter) Le(v int16) Condition { return f.compare(" <= ", v) }
+func (f SemesterYearFilter) Gt(v int16) Condition { return f.compare(" > ", v) }
+func (f SemesterYearFilter) Ge(v int16) Condition { return f.compare(" >= ", v) }
+
+func (f SemesterYearFilter) In(vs ...int16) Condition { return f.in(len(vs), vs) }
+
+// SemesterSeasonFilter builds conditions on season.
+type SemesterSeasonFilter struct{ columnFilter }
+
+func (f SemesterSeasonFilter) Eq(v string) Condition { return f.compare(" = ", v) }
+func (f SemesterSeasonFilter) Ne(v string) Condition { return f.compare(" <> ", v) }
+func (f SemesterSeasonFilter) Lt(v string) Condition { return f.compare(" < ", v) }
+func (f SemesterSeasonFilter) Le(v string) Condition { return f.compare(" <= ", v) }
+func (f SemesterSeasonFilter) Gt(v string) Condition { return f.compare(" > ", v) }
+func (f SemesterSeasonFilter) Ge(v string) Condition { return f.compare(" >= ", v) }
+
+func (f SemesterSeasonFilter) In(vs ...string) Condition { return f.in(len(vs), vs) }
+
+// SemesterDescriptionFilter builds conditions on description.
+type SemesterDescriptionFilter struct{ columnFilter }
+
+func (f SemesterDescriptionFilter) Eq(v string) Condition { return f.compare(" = ", v) }
+func (f SemesterDescriptionFilter) Ne(v string) Condition { return f.compare(" <> ", v) }
+func (f SemesterDescriptionFilter) Lt(v string) Condition { return f.compare(" < ", v) }
+func (f SemesterDescriptionFilter) Le(v string) Condition { return f.compare(" <= ", v) }
+func (f SemesterDescriptionFilter) Gt(v string) Condition { return f.compare(" > ", v) }
+func (f SemesterDescriptionFilter) Ge(v string) Condition { return f.compare(" >= ", v) }
+
+func (f SemesterDescriptionFilter) In(vs ...string) Condition { return f.in(len(vs), vs) }
+
+func SelectSemesterWhere(ctx context.Context, db Queryer, where Condition) ([]Semester, error) {
+	var args pgx.QueryArgs
+	sql := SelectAllSemesterSQL + `
+where ` + where.sql(&args)
+
+	dbRows, err := prepareQuery(ctx, db, preparedName("pgxdataSelectSemesterWhere", sql), sql, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer dbRows.Close()
+
+	var rows []Semester
+	for dbRows.Next() {
+		var row Semester
+		err := dbRows.Scan(
+			&row.Year,
+			&row.Season,
+			&row.Description,
+		)
+		if err != nil {
+			return nil, err
+		}
+		rows = append(rows, row)
+	}
+
+	if dbRows.Err() != nil {
+		return nil, dbRows.Err()
+	}
+
+	return rows, nil
+}
+
+func CountSemesterWhere(ctx context.Context, db Queryer, where Condition) (int64, error) {
+	var args pgx.QueryArgs
+	sql := countSemesterSQL + ` where ` + where.sql(&args)
+
+	var n int64
+	err := prepareQueryRow(ctx, db, preparedName("pgxdataCountSemesterWhere", sql), sql, args...).Scan(&n)
+	return n, err
+}
+
+// UpdateSemesterWhere updates the rows matching where like UpdateSemester and returns the number of
+// rows updated. where must restrict the rows, so a Condition that matches every row like the zero Condition is
+// refused.
+func UpdateSemesterWhere(ctx context.Context, db Queryer, where Condition, row *Semester) (int64, error) {
+	if where.write == nil {
+		return 0, errors.New("UpdateSemesterWhere requires a condition")
+	}
+
+	sets, args, err := updateSemesterSets(row)
+	if err != nil {
+		return 0, err
+	}
+	if len(sets) == 0 {
+		return 0, nil
+	}
+
+	sql := `update "semester" set ` + strings.Join(sets, ", ") + ` where ` + where.sql(&args)
+
+	commandTag, err := prepareExec(ctx, db, preparedName("pgxdataUpdateSemesterWhere", sql), sql, args...)
+	if err != nil {
+		return 0, err
+	}
+	return commandTag.RowsAffected(), nil
+}
+
+// DeleteSemesterWhere deletes the rows matching where and returns the number of rows deleted. where must
+// restrict the rows, so a Condition that matches every row like the zero Condition is refused.
+func DeleteSemesterWhere(ctx context.Context, db Queryer, where Condition) (int64, error) {
+	if where.write == nil {
+		return 0, errors.New("DeleteSemesterWhere requires a condition")
+	}
+
+	var args pgx.QueryArgs
+	sql := `delete from "semester" where ` + where.sql(&args)
+
+	commandTag, err := prepareExec(ctx, db, preparedName("pgxdataDeleteSemesterWhere", sql), sql, args...)
+	if err != nil {
+		return 0, err
+	}
+	return commandTag.RowsAffected(), nil
+}
@@ -0,0 +1,781 @@
+package data
+
+// This file is automatically generated by pgxdata.
+
+import (
+	"context"
+	"strings"
+
+	"github.com/jackc/pgtype"
+	"github.com/jackc/pgx/v4"
+	errors "golang.org/x/xerrors"
+)
+
+type UuidKey struct {
+	// ID is uuid not null.
+	ID pgtype.UUID
+	// Name is character varying not null.
+	Name pgtype.Varchar
+}
+
+const countUuidKeySQL = `select count(*) from "uuid_key"`
+
+func CountUuidKey(ctx context.Context, db Queryer) (int64, error) {
+	var n int64
+	err := prepareQueryRow(ctx, db, "pgxdataCountUuidKey", countUuidKeySQL).Scan(&n)
+	return n, err
+}
+
+const SelectAllUuidKeySQL = `select
+  "id",
+  "name"
+from "uuid_key"`
+
+func SelectAllUuidKey(ctx context.Context, db Queryer) ([]UuidKey, error) {
+	var rows []UuidKey
+
+	err := ForEachUuidKey(ctx, db, func(row *UuidKey) error {
+		rows = append(rows, *row)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return rows, nil
+}
+
+// UuidKeyRows is a cursor over the rows of "uuid_key" that
+// reads one row at a time.
+type UuidKeyRows struct {
+	rows pgx.Rows
+	row  UuidKey
+	err  error
+}
+
+// QueryUuidKeyRows selects all rows of "uuid_key". The
+// returned rows must be closed.
+func QueryUuidKeyRows(ctx context.Context, db Queryer) (*UuidKeyRows, error) {
+	dbRows, err := prepareQuery(ctx, db, "pgxdataSelectAllUuidKey", SelectAllUuidKeySQL)
+	if err != nil {
+		return nil, err
+	}
+
+	return &UuidKeyRows{rows: dbRows}, nil
+}
+
+// Next reads the next row. It returns false when there are no more rows or an
+// error occurred.
+func (r *UuidKeyRows) Next() bool {
+	if r.err != nil || !r.rows.Next() {
+		return false
+	}
+
+	r.row = UuidKey{}
+	r.err = r.rows.Scan(
+		&r.row.ID,
+		&r.row.Name,
+	)
+	if r.err != nil {
+		r.rows.Close()
+		return false
+	}
+
+	return true
+}
+
+// Row returns the current row. It is overwritten by the next call to Next.
+func (r *UuidKeyRows) Row() *UuidKey {
+	return &r.row
+}
+
+// Err returns the error, if any, that stopped Next.
+func (r *UuidKeyRows) Err() error {
+	if r.err != nil {
+		return r.err
+	}
+	return r.rows.Err()
+}
+
+// Close closes the rows. It is safe to call Close after all rows have been
+// read.
+func (r *UuidKeyRows) Close() {
+	r.rows.Close()
+}
+
+// ForEachUuidKey calls fn with each row of "uuid_key". The
+// row passed to fn is reused for the next row. If fn returns an error the
+// remaining rows are skipped and the error is returned.
+func ForEachUuidKey(ctx context.Context, db Queryer, fn func(*UuidKey) error) error {
+	rows, err := QueryUuidKeyRows(ctx, db)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		err := fn(rows.Row())
+		if err != nil {
+			return err
+		}
+	}
+
+	return rows.Err()
+}
+
+// UuidKeyPageCursor is the position after the last row of a
+// page returned by SelectUuidKeyPage.
+type UuidKeyPageCursor struct {
+	ID [16]byte
+}
+
+const selectUuidKeyPageSQL = `select
+  "id",
+  "name"
+from "uuid_key"
+order by "id"
+limit $1`
+
+const selectUuidKeyPageAfterSQL = `select
+  "id",
+  "name"
+from "uuid_key"
+where "id" > $1
+order by "id"
+limit $2`
+
+// SelectUuidKeyPage selects up to limit rows that follow
+// after, or the first rows when after is nil, ordered by
+// "id". The returned cursor selects the next page. It is nil
+// when there are no more rows.
+func SelectUuidKeyPage(ctx context.Context, db Queryer, after *UuidKeyPageCursor, limit int) ([]UuidKey, *UuidKeyPageCursor, error) {
+	var dbRows pgx.Rows
+	var err error
+	if after == nil {
+		dbRows, err = prepareQuery(ctx, db, "pgxdataSelectUuidKeyPage", selectUuidKeyPageSQL, limit)
+	} else {
+		dbRows, err = prepareQuery(ctx, db, "pgxdataSelectUuidKeyPageAfter", selectUuidKeyPageAfterSQL, after.ID, limit)
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	defer dbRows.Close()
+
+	var rows []UuidKey
+	for dbRows.Next() {
+		var row UuidKey
+		err := dbRows.Scan(
+			&row.ID,
+			&row.Name,
+		)
+		if err != nil {
+			return nil, nil, err
+		}
+		rows = append(rows, row)
+	}
+
+	if dbRows.Err() != nil {
+		return nil, nil, dbRows.Err()
+	}
+
+	if len(rows) == 0 || len(rows) < limit {
+		return rows, nil, nil
+	}
+
+	last := &rows[len(rows)-1]
+	next := &UuidKeyPageCursor{}
+	err = last.ID.AssignTo(&next.ID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return rows, next, nil
+}
+
+const selectUuidKeyByPKSQL = `select
+  "id",
+  "name"
+from "uuid_key"
+where "id"=$1`
+
+func SelectUuidKeyByPK(
+	ctx context.Context,
+	db Queryer,
+	id [16]byte,
+) (*UuidKey, error) {
+	var row UuidKey
+	err := prepareQueryRow(ctx, db, "pgxdataSelectUuidKeyByPK", selectUuidKeyByPKSQL, id).Scan(
+		&row.ID,
+		&row.Name,
+	)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrNotFound
+	} else if err != nil {
+		return nil, err
+	}
+
+	return &row, nil
+}
+
+func InsertUuidKey(ctx context.Context, db Queryer, row *UuidKey) error {
+	args := pgx.QueryArgs(make([]interface{}, 0, 2))
+
+	var columns, values []string
+
+	if row.ID.Status != pgtype.Undefined {
+		columns = append(columns, `id`)
+		values = append(values, args.Append(&row.ID))
+	}
+	if row.Name.Status != pgtype.Undefined {
+		columns = append(columns, `name`)
+		values = append(values, args.Append(&row.Name))
+	}
+
+	insert := ` default values`
+	if len(columns) > 0 {
+		insert = `(` + strings.Join(columns, ", ") + `)
+values(` + strings.Join(values, ",") + `)`
+	}
+
+	sql := `insert into "uuid_key"` + insert + `
+returning "id", "name"
+  `
+
+	psName := preparedName("pgxdataInsertUuidKey", sql)
+
+	return prepareQueryRow(ctx, db, psName, sql, args...).Scan(&row.ID, &row.Name)
+}
+
+// updateUuidKeySets returns the assignments of the SET clause for the fields of row to update and their
+// arguments.
+func updateUuidKeySets(row *UuidKey) ([]string, pgx.QueryArgs, error) {
+	sets := make([]string, 0, 2)
+	args := pgx.QueryArgs(make([]interface{}, 0, 2))
+
+	if row.ID.Status != pgtype.Undefined {
+		sets = append(sets, `id`+"="+args.Append(&row.ID))
+	}
+	if row.Name.Status != pgtype.Undefined {
+		sets = append(sets, `name`+"="+args.Append(&row.Name))
+	}
+
+	return sets, args, nil
+}
+
+func UpdateUuidKey(ctx context.Context, db Queryer,
+	id [16]byte,
+	row *UuidKey,
+) error {
+	sets, args, err := updateUuidKeySets(row)
+	if err != nil {
+		return err
+	}
+	if len(sets) == 0 {
+		return nil
+	}
+
+	sql := `update "uuid_key" set ` + strings.Join(sets, ", ") + ` where ` + `"id"=` + args.Append(id) + `
+returning "id", "name"`
+
+	psName := preparedName("pgxdataUpdateUuidKey", sql)
+
+	err = prepareQueryRow(ctx, db, psName, sql, args...).Scan(&row.ID, &row.Name)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return ErrNotFound
+	}
+	return err
+}
+
+// UuidKeyConflict identifies the primary key or unique index UpsertUuidKey
+// detects a conflicting row by.
+type UuidKeyConflict int
+
+const (
+	UuidKeyConflictOnPK UuidKeyConflict = iota
+)
+
+// UuidKeyUpsertOptions configures UpsertUuidKey.
+type UuidKeyUpsertOptions struct {
+	// Conflict is the primary key or unique index a conflicting row is detected
+	// by. The primary key is the default.
+	Conflict UuidKeyConflict
+
+	// DoNothing leaves a conflicting row unchanged instead of updating it.
+	DoNothing bool
+}
+
+// UpsertUuidKey inserts row or, when it conflicts with an existing row,
+// updates the existing row with the fields of row that are not Undefined other than
+// those of the conflict target.
+// Like InsertUuidKey the persisted row is scanned into row. Nothing is
+// scanned when a conflicting row is left unchanged.
+func UpsertUuidKey(ctx context.Context, db Queryer, row *UuidKey, opts UuidKeyUpsertOptions) (UpsertResult, error) {
+	args := pgx.QueryArgs(make([]interface{}, 0, 2))
+
+	var columns, values []string
+
+	if row.ID.Status != pgtype.Undefined {
+		columns = append(columns, `"id"`)
+		values = append(values, args.Append(&row.ID))
+	}
+	if row.Name.Status != pgtype.Undefined {
+		columns = append(columns, `"name"`)
+		values = append(values, args.Append(&row.Name))
+	}
+
+	var targetColumns []string
+	switch opts.Conflict {
+	case UuidKeyConflictOnPK:
+		targetColumns = []string{`"id"`}
+	default:
+		return UpsertUnchanged, errors.Errorf("unknown UuidKeyConflict %d", opts.Conflict)
+	}
+
+	// The conflicting row is updated with the columns other than the conflict
+	// target. It is left unchanged when there are none.
+	sets := make([]string, 0, len(columns))
+nextColumn:
+	for _, c := range columns {
+		for _, tc := range targetColumns {
+			if c == tc {
+				continue nextColumn
+			}
+		}
+		sets = append(sets, c+"=excluded."+c)
+	}
+
+	action := `do update set ` + strings.Join(sets, ", ")
+	if opts.DoNothing || len(sets) == 0 {
+		action = `do nothing`
+	}
+
+	insert := ` default values`
+	if len(columns) > 0 {
+		insert = `(` + strings.Join(columns, ", ") + `)
+values(` + strings.Join(values, ",") + `)`
+	}
+
+	sql := `insert into "uuid_key"` + insert + `
+on conflict (` + strings.Join(targetColumns, ", ") + `) ` + action + `
+returning xmax = 0, "id", "name"
+  `
+
+	psName := preparedName("pgxdataUpsertUuidKey", sql)
+
+	var inserted bool
+	err := prepareQueryRow(ctx, db, psName, sql, args...).Scan(&inserted, &row.ID, &row.Name)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return UpsertUnchanged, nil
+	} else if err != nil {
+		return UpsertUnchanged, err
+	}
+
+	if inserted {
+		return UpsertInserted, nil
+	}
+	return UpsertUpdated, nil
+}
+
+// insertUuidKeyColumns returns the columns of row that are not Undefined and their
+// values for inserting row.
+func insertUuidKeyColumns(row *UuidKey) ([]string, []interface{}, error) {
+	var columns []string
+	var values []interface{}
+
+	if row.ID.Status != pgtype.Undefined {
+		columns = append(columns, `id`)
+		values = append(values, &row.ID)
+	}
+	if row.Name.Status != pgtype.Undefined {
+		columns = append(columns, `name`)
+		values = append(values, &row.Name)
+	}
+
+	return columns, values, nil
+}
+
+// CopyInsertUuidKey inserts rows with the PostgreSQL copy protocol and
+// returns the number of rows copied. The columns copied are those not
+// Undefined in the first row and every row must set the same fields. Values
+// must support the binary format.
+func CopyInsertUuidKey(ctx context.Context, db CopyFromer, rows []UuidKey) (int64, error) {
+	if len(rows) == 0 {
+		return 0, nil
+	}
+
+	columns, _, err := insertUuidKeyColumns(&rows[0])
+	if err != nil {
+		return 0, err
+	}
+
+	return db.CopyFrom(ctx, pgx.Identifier{"uuid_key"}, columns, &copyFromUuidKeySource{rows: rows, columns: columns, idx: -1})
+}
+
+// copyFromUuidKeySource is a pgx.CopyFromSource of the columns of rows.
+type copyFromUuidKeySource struct {
+	rows    []UuidKey
+	columns []string
+	idx     int
+}
+
+func (s *copyFromUuidKeySource) Next() bool {
+	s.idx++
+	return s.idx < len(s.rows)
+}
+
+func (s *copyFromUuidKeySource) Values() ([]interface{}, error) {
+	columns, values, err := insertUuidKeyColumns(&s.rows[s.idx])
+	if err != nil {
+		return nil, err
+	}
+	if !equalColumns(columns, s.columns) {
+		return nil, errors.Errorf("row %d does not set the same fields as row 0", s.idx)
+	}
+	return values, nil
+}
+
+func (s *copyFromUuidKeySource) Err() error {
+	return nil
+}
+
+// insertManyUuidKeyTypes are the types the parameters of
+// InsertManyUuidKey are cast to. PostgreSQL only infers them for the
+// VALUES list of an INSERT, not for one that is selected from.
+var insertManyUuidKeyTypes = map[string]string{
+	`id`:   `"pg_catalog"."uuid"`,
+	`name`: `"pg_catalog"."varchar"`,
+}
+
+// InsertManyUuidKey inserts rows with multi-row INSERT statements for
+// a Queryer that does not support the copy protocol or for columns without a
+// binary format. The columns inserted are those not Undefined in the
+// first row and every row must set the same fields. Like InsertUuidKey
+// the persisted rows are scanned into rows.
+func InsertManyUuidKey(ctx context.Context, db Queryer, rows []UuidKey) error {
+	if len(rows) == 0 {
+		return nil
+	}
+
+	columns, _, err := insertUuidKeyColumns(&rows[0])
+	if err != nil {
+		return err
+	}
+
+	quotedColumns := make([]string, len(columns))
+	for i, column := range columns {
+		quotedColumns[i] = pgx.Identifier{column}.Sanitize()
+	}
+
+	// A statement can have at most 65535 parameters including the ordinality
+	// of each row.
+	batchSize := len(rows)
+	if batchSize > 65535/(len(columns)+1) {
+		batchSize = 65535 / (len(columns) + 1)
+	}
+
+	for start := 0; start < len(rows); start += batchSize {
+		end := start + batchSize
+		if end > len(rows) {
+			end = len(rows)
+		}
+		batch := rows[start:end]
+
+		args := pgx.QueryArgs(make([]interface{}, 0, len(batch)*(len(columns)+1)))
+		valueLists := make([]string, len(batch))
+		for i := range batch {
+			rowColumns, values, err := insertUuidKeyColumns(&batch[i])
+			if err != nil {
+				return err
+			}
+			if !equalColumns(rowColumns, columns) {
+				return errors.Errorf("row %d does not set the same fields as row 0", start+i)
+			}
+
+			placeholders := make([]string, len(values)+1)
+			for j, v := range values {
+				placeholders[j] = args.Append(v) + "::" + insertManyUuidKeyTypes[columns[j]]
+			}
+			placeholders[len(values)] = args.Append(int32(i)) + "::int4"
+			valueLists[i] = "(" + strings.Join(placeholders, ",") + ")"
+		}
+
+		// The rows are inserted in the order of their ordinality so they are
+		// returned in the order of batch.
+		sql := `insert into "uuid_key"(` + strings.Join(quotedColumns, ", ") + `)
+select ` + strings.Join(quotedColumns, ", ") + `
+from (values` + strings.Join(valueLists, ",") + `) as input(` + strings.Join(append(quotedColumns, "pgxdata_ordinality"), ", ") + `)
+order by pgxdata_ordinality
+returning "id", "name"`
+
+		// The SQL depends on the number of rows so it is not prepared, which
+		// would leave a prepared statement for every batch size.
+		dbRows, err := db.Query(ctx, sql, args...)
+		if err != nil {
+			return err
+		}
+
+		// PostgreSQL does not guarantee the order of the returned rows, but it
+		// returns them in the order they were inserted. A different number of rows
+		// means they cannot be matched to batch.
+		n := 0
+		for dbRows.Next() {
+			if n < len(batch) {
+				row := &batch[n]
+				if err := dbRows.Scan(&row.ID, &row.Name); err != nil {
+					dbRows.Close()
+					return err
+				}
+			}
+			n++
+		}
+
+		if dbRows.Err() != nil {
+			return dbRows.Err()
+		}
+		if n != len(batch) {
+			return errors.Errorf("inserted %d rows but %d rows were returned", len(batch), n)
+		}
+	}
+
+	return nil
+}
+
+// QueueSelectUuidKeyByPK queues selecting the row by primary key in b.
+// The returned reader scans the row into dst or returns ErrNotFound if there
+// is no such row.
+func QueueSelectUuidKeyByPK(b *pgx.Batch, id [16]byte, dst *UuidKey) BatchReader {
+	args := []interface{}{id}
+	oids := []pgtype.OID{pgtype.UUIDOID}
+
+	return queue(b, selectUuidKeyByPKSQL, args, oids, func(results pgx.BatchResults) error {
+		err := results.QueryRowResults().Scan(
+			&dst.ID,
+			&dst.Name,
+		)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrNotFound
+		}
+		return err
+	})
+}
+
+// QueueInsertUuidKey queues inserting row in b. Like InsertUuidKey
+// the returned reader scans the persisted row into row.
+func QueueInsertUuidKey(b *pgx.Batch, row *UuidKey) BatchReader {
+	args := pgx.QueryArgs(make([]interface{}, 0, 2))
+	oids := make([]pgtype.OID, 0, 2)
+
+	var columns, values []string
+
+	if row.ID.Status != pgtype.Undefined {
+		columns = append(columns, `id`)
+		values = append(values, args.Append(&row.ID))
+		oids = append(oids, pgtype.UUIDOID)
+	}
+	if row.Name.Status != pgtype.Undefined {
+		columns = append(columns, `name`)
+		values = append(values, args.Append(&row.Name))
+		oids = append(oids, pgtype.VarcharOID)
+	}
+
+	insert := ` default values`
+	if len(columns) > 0 {
+		insert = `(` + strings.Join(columns, ", ") + `)
+values(` + strings.Join(values, ",") + `)`
+	}
+
+	sql := `insert into "uuid_key"` + insert + `
+returning "id", "name"`
+
+	return queue(b, sql, args, oids, func(results pgx.BatchResults) error {
+		return results.QueryRowResults().Scan(&row.ID, &row.Name)
+	})
+}
+
+// QueueUpdateUuidKey queues updating the row by primary key in b. Like
+// UpdateUuidKey the returned reader scans the persisted row into row
+// or returns ErrNotFound if there is no such row. Nothing is queued when
+// there is nothing to update.
+func QueueUpdateUuidKey(b *pgx.Batch, id [16]byte, row *UuidKey) BatchReader {
+	sets := make([]string, 0, 2)
+	args := pgx.QueryArgs(make([]interface{}, 0, 2))
+	oids := make([]pgtype.OID, 0, 2)
+
+	if row.ID.Status != pgtype.Undefined {
+		sets = append(sets, `id`+"="+args.Append(&row.ID))
+		oids = append(oids, pgtype.UUIDOID)
+	}
+	if row.Name.Status != pgtype.Undefined {
+		sets = append(sets, `name`+"="+args.Append(&row.Name))
+		oids = append(oids, pgtype.VarcharOID)
+	}
+
+	if len(sets) == 0 {
+		return func(pgx.BatchResults) error { return nil }
+	}
+
+	sql := `update "uuid_key" set ` + strings.Join(sets, ", ") + ` where ` + `"id"=` + args.Append(id) + `
+returning "id", "name"`
+	oids = append(oids, pgtype.UUIDOID)
+
+	return queue(b, sql, args, oids, func(results pgx.BatchResults) error {
+		err := results.QueryRowResults().Scan(&row.ID, &row.Name)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrNotFound
+		}
+		return err
+	})
+}
+
+// QueueDeleteUuidKey queues deleting the row by primary key in b. The
+// returned reader returns ErrNotFound if there is no such row.
+func QueueDeleteUuidKey(b *pgx.Batch, id [16]byte) BatchReader {
+	args := pgx.QueryArgs(make([]interface{}, 0, 1))
+
+	sql := `delete from "uuid_key" where ` + `"id"=` + args.Append(id)
+	oids := []pgtype.OID{pgtype.UUIDOID}
+
+	return queue(b, sql, args, oids, func(results pgx.BatchResults) error {
+		commandTag, err := results.ExecResults()
+		if err != nil {
+			return err
+		}
+		if commandTag.RowsAffected() != 1 {
+			return ErrNotFound
+		}
+		return nil
+	})
+}
+
+func DeleteUuidKey(ctx context.Context, db Queryer,
+	id [16]byte,
+) error {
+	args := pgx.QueryArgs(make([]interface{}, 0, 1))
+
+	sql := `delete from "uuid_key" where ` + `"id"=` + args.Append(id)
+
+	commandTag, err := prepareExec(ctx, db, "pgxdataDeleteUuidKey", sql, args...)
+	if err != nil {
+		return err
+	}
+	if commandTag.RowsAffected() != 1 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+// UuidKeyWhere has a filter for each column of "uuid_key" that builds the conditions of
+// SelectUuidKeyWhere, CountUuidKeyWhere, UpdateUuidKeyWhere and DeleteUuidKeyWhere.
+var UuidKeyWhere = struct {
+	ID   UuidKeyIDFilter
+	Name UuidKeyNameFilter
+}{
+	ID:   UuidKeyIDFilter{columnFilter{`"id"`}},
+	Name: UuidKeyNameFilter{columnFilter{`"name"`}},
+}
+
+// UuidKeyIDFilter builds conditions on id.
+type UuidKeyIDFilter struct{ columnFilter }
+
+func (f UuidKeyIDFilter) Eq(v [16]byte) Condition { return f.compare(" = ", v) }
+func (f UuidKeyIDFilter) Ne(v [16]byte) Condition { return f.compare(" <> ", v) }
+func (f UuidKeyIDFilter) Lt(v [16]byte) Condition { return f.compare(" < ", v) }
+func (f UuidKeyIDFilter) Le(v [16]byte) Condition { return f.compare(" <= ", v) }
+func (f UuidKeyIDFilter) Gt(v [16]byte) Condition { return f.compare(" > ", v) }
+func (f UuidKeyIDFilter) Ge(v [16]byte) Condition { return f.compare(" >= ", v) }
+
+func (f UuidKeyIDFilter) In(vs ...[16]byte) Condition { return f.in(len(vs), vs) }
+
+// UuidKeyNameFilter builds conditions on name.
+type UuidKeyNameFilter struct{ columnFilter }
+
+func (f UuidKeyNameFilter) Eq(v string) Condition { return f.compare(" = ", v) }
+func (f UuidKeyNameFilter) Ne(v string) Condition { return f.compare(" <> ", v) }
+func (f UuidKeyNameFilter) Lt(v string) Condition { return f.compare(" < ", v) }
+func (f UuidKeyNameFilter) Le(v string) Condition { return f.compare(" <= ", v) }
+func (f UuidKeyNameFilter) Gt(v string) Condition { return f.compare(" > ", v) }
+func (f UuidKeyNameFilter) Ge(v string) Condition { return f.compare(" >= ", v) }
+
+func (f UuidKeyNameFilter) In(vs ...string) Condition { return f.in(len(vs), vs) }
+
+func SelectUuidKeyWhere(ctx context.Context, db Queryer, where Condition) ([]UuidKey, error) {
+	var args pgx.QueryArgs
+	sql := SelectAllUuidKeySQL + `
+where ` + where.sql(&args)
+
+	dbRows, err := prepareQuery(ctx, db, preparedName("pgxdataSelectUuidKeyWhere", sql), sql, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer dbRows.Close()
+
+	var rows []UuidKey
+	for dbRows.Next() {
+		var row UuidKey
+		err := dbRows.Scan(
+			&row.ID,
+			&row.Name,
+		)
+		if err != nil {
+			return nil, err
+		}
+		rows = append(rows, row)
+	}
+
+	if dbRows.Err() != nil {
+		return nil, dbRows.Err()
+	}
+
+	return rows, nil
+}
+
+func CountUuidKeyWhere(ctx context.Context, db Queryer, where Condition) (int64, error) {
+	var args pgx.QueryArgs
+	sql := countUuidKeySQL + ` where ` + where.sql(&args)
+
+	var n int64
+	err := prepareQueryRow(ctx, db, preparedName("pgxdataCountUuidKeyWhere", sql), sql, args...).Scan(&n)
+	return n, err
+}
+
+// UpdateUuidKeyWhere updates the rows matching where like UpdateUuidKey and returns the number of
+// rows updated. where must restrict the rows, so a Condition that matches every row like the zero Condition is
+// refused.
+func UpdateUuidKeyWhere(ctx context.Context, db Queryer, where Condition, row *UuidKey) (int64, error) {
+	if where.write == nil {
+		return 0, errors.New("UpdateUuidKeyWhere requires a condition")
+	}
+
+	sets, args, err := updateUuidKeySets(row)
+	if err != nil {
+		return 0, err
+	}
+	if len(sets) == 0 {
+		return 0, nil
+	}
+
+	sql := `update "uuid_key" set ` + strings.Join(sets, ", ") + ` where ` + where.sql(&args)
+
+	commandTag, err := prepareExec(ctx, db, preparedName("pgxdataUpdateUuidKeyWhere", sql), sql, args...)
+	if err != nil {
+		return 0, err
+	}
+	return commandTag.RowsAffected(), nil
+}
+
+// DeleteUuidKeyWhere deletes the rows matching where and returns the number of rows deleted. where must
+// restrict the rows, so a Condition that matches every row like the zero Condition is refused.
+func DeleteUuidKeyWhere(ctx context.Context, db Queryer, where Condition) (int64, error) {
+	if where.write == nil {
+		return 0, errors.New("DeleteUuidKeyWhere requires a condition")
+	}
+
+	var args pgx.QueryArgs
+	sql := `delete from "uuid_key" where ` + where.sql(&args)
+
+	commandTag, err := prepareExec(ctx, db, preparedName("pgxdataDeleteUuidKeyWhere", sql), sql, args...)
+	if err != nil {
+		return 0, err
+	}
+	return commandTag.RowsAffected(), nil
+}
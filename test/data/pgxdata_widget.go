@@ -12,8 +12,11 @@ import (
 )
 
 type Widget struct {
-	ID     pgtype.Int8
-	Name   pgtype.Varchar
+	// ID is bigint not null default nextval('widget_id_seq'::regclass).
+	ID pgtype.Int8
+	// Name is character varying not null.
+	Name pgtype.Varchar
+	// Weight is smallint not null.
 	Weight pgtype.Int2
 }
 
@@ -34,26 +37,166 @@ from "widget"`
 func SelectAllWidget(ctx context.Context, db Queryer) ([]Widget, error) {
 	var rows []Widget
 
+	err := ForEachWidget(ctx, db, func(row *Widget) error {
+		rows = append(rows, *row)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return rows, nil
+}
+
+// WidgetRows is a cursor over the rows of "widget" that
+// reads one row at a time.
+type WidgetRows struct {
+	rows pgx.Rows
+	row  Widget
+	err  error
+}
+
+// QueryWidgetRows selects all rows of "widget". The
+// returned rows must be closed.
+func QueryWidgetRows(ctx context.Context, db Queryer) (*WidgetRows, error) {
 	dbRows, err := prepareQuery(ctx, db, "pgxdataSelectAllWidget", SelectAllWidgetSQL)
 	if err != nil {
 		return nil, err
 	}
 
+	return &WidgetRows{rows: dbRows}, nil
+}
+
+// Next reads the next row. It returns false when there are no more rows or an
+// error occurred.
+func (r *WidgetRows) Next() bool {
+	if r.err != nil || !r.rows.Next() {
+		return false
+	}
+
+	r.row = Widget{}
+	r.err = r.rows.Scan(
+		&r.row.ID,
+		&r.row.Name,
+		&r.row.Weight,
+	)
+	if r.err != nil {
+		r.rows.Close()
+		return false
+	}
+
+	return true
+}
+
+// Row returns the current row. It is overwritten by the next call to Next.
+func (r *WidgetRows) Row() *Widget {
+	return &r.row
+}
+
+// Err returns the error, if any, that stopped Next.
+func (r *WidgetRows) Err() error {
+	if r.err != nil {
+		return r.err
+	}
+	return r.rows.Err()
+}
+
+// Close closes the rows. It is safe to call Close after all rows have been
+// read.
+func (r *WidgetRows) Close() {
+	r.rows.Close()
+}
+
+// ForEachWidget calls fn with each row of "widget". The
+// row passed to fn is reused for the next row. If fn returns an error the
+// remaining rows are skipped and the error is returned.
+func ForEachWidget(ctx context.Context, db Queryer, fn func(*Widget) error) error {
+	rows, err := QueryWidgetRows(ctx, db)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		err := fn(rows.Row())
+		if err != nil {
+			return err
+		}
+	}
+
+	return rows.Err()
+}
+
+// WidgetPageCursor is the position after the last row of a
+// page returned by SelectWidgetPage.
+type WidgetPageCursor struct {
+	ID int64
+}
+
+const selectWidgetPageSQL = `select
+  "id",
+  "name",
+  "weight"
+from "widget"
+order by "id"
+limit $1`
+
+const selectWidgetPageAfterSQL = `select
+  "id",
+  "name",
+  "weight"
+from "widget"
+where "id" > $1
+order by "id"
+limit $2`
+
+// SelectWidgetPage selects up to limit rows that follow
+// after, or the first rows when after is nil, ordered by
+// "id". The returned cursor selects the next page. It is nil
+// when there are no more rows.
+func SelectWidgetPage(ctx context.Context, db Queryer, after *WidgetPageCursor, limit int) ([]Widget, *WidgetPageCursor, error) {
+	var dbRows pgx.Rows
+	var err error
+	if after == nil {
+		dbRows, err = prepareQuery(ctx, db, "pgxdataSelectWidgetPage", selectWidgetPageSQL, limit)
+	} else {
+		dbRows, err = prepareQuery(ctx, db, "pgxdataSelectWidgetPageAfter", selectWidgetPageAfterSQL, after.ID, limit)
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	defer dbRows.Close()
+
+	var rows []Widget
 	for dbRows.Next() {
 		var row Widget
-		dbRows.Scan(
+		err := dbRows.Scan(
 			&row.ID,
 			&row.Name,
 			&row.Weight,
 		)
+		if err != nil {
+			return nil, nil, err
+		}
 		rows = append(rows, row)
 	}
 
 	if dbRows.Err() != nil {
-		return nil, dbRows.Err()
+		return nil, nil, dbRows.Err()
 	}
 
-	return rows, nil
+	if len(rows) == 0 || len(rows) < limit {
+		return rows, nil, nil
+	}
+
+	last := &rows[len(rows)-1]
+	next := &WidgetPageCursor{}
+	err = last.ID.AssignTo(&next.ID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return rows, next, nil
 }
 
 const selectWidgetByPKSQL = `select
@@ -101,20 +244,24 @@ func InsertWidget(ctx context.Context, db Queryer, row *Widget) error {
 		values = append(values, args.Append(&row.Weight))
 	}
 
-	sql := `insert into "widget"(` + strings.Join(columns, ", ") + `)
-values(` + strings.Join(values, ",") + `)
-returning "id"
+	insert := ` default values`
+	if len(columns) > 0 {
+		insert = `(` + strings.Join(columns, ", ") + `)
+values(` + strings.Join(values, ",") + `)`
+	}
+
+	sql := `insert into "widget"` + insert + `
+returning "id", "name", "weight"
   `
 
 	psName := preparedName("pgxdataInsertWidget", sql)
 
-	return prepareQueryRow(ctx, db, psName, sql, args...).Scan(&row.ID)
+	return prepareQueryRow(ctx, db, psName, sql, args...).Scan(&row.ID, &row.Name, &row.Weight)
 }
 
-func UpdateWidget(ctx context.Context, db Queryer,
-	id int64,
-	row *Widget,
-) error {
+// updateWidgetSets returns the assignments of the SET clause for the fields of row to update and their
+// arguments.
+func updateWidgetSets(row *Widget) ([]string, pgx.QueryArgs, error) {
 	sets := make([]string, 0, 3)
 	args := pgx.QueryArgs(make([]interface{}, 0, 3))
 
@@ -128,24 +275,413 @@ func UpdateWidget(ctx context.Context, db Queryer,
 		sets = append(sets, `weight`+"="+args.Append(&row.Weight))
 	}
 
+	return sets, args, nil
+}
+
+func UpdateWidget(ctx context.Context, db Queryer,
+	id int64,
+	row *Widget,
+) error {
+	sets, args, err := updateWidgetSets(row)
+	if err != nil {
+		return err
+	}
 	if len(sets) == 0 {
 		return nil
 	}
 
-	sql := `update "widget" set ` + strings.Join(sets, ", ") + ` where ` + `"id"=` + args.Append(id)
+	sql := `update "widget" set ` + strings.Join(sets, ", ") + ` where ` + `"id"=` + args.Append(id) + `
+returning "id", "name", "weight"`
 
 	psName := preparedName("pgxdataUpdateWidget", sql)
 
-	commandTag, err := prepareExec(ctx, db, psName, sql, args...)
+	err = prepareQueryRow(ctx, db, psName, sql, args...).Scan(&row.ID, &row.Name, &row.Weight)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return ErrNotFound
+	}
+	return err
+}
+
+// WidgetConflict identifies the primary key or unique index UpsertWidget
+// detects a conflicting row by.
+type WidgetConflict int
+
+const (
+	WidgetConflictOnPK WidgetConflict = iota
+)
+
+// WidgetUpsertOptions configures UpsertWidget.
+type WidgetUpsertOptions struct {
+	// Conflict is the primary key or unique index a conflicting row is detected
+	// by. The primary key is the default.
+	Conflict WidgetConflict
+
+	// DoNothing leaves a conflicting row unchanged instead of updating it.
+	DoNothing bool
+}
+
+// UpsertWidget inserts row or, when it conflicts with an existing row,
+// updates the existing row with the fields of row that are not Undefined other than
+// those of the conflict target.
+// Like InsertWidget the persisted row is scanned into row. Nothing is
+// scanned when a conflicting row is left unchanged.
+func UpsertWidget(ctx context.Context, db Queryer, row *Widget, opts WidgetUpsertOptions) (UpsertResult, error) {
+	args := pgx.QueryArgs(make([]interface{}, 0, 3))
+
+	var columns, values []string
+
+	if row.ID.Status != pgtype.Undefined {
+		columns = append(columns, `"id"`)
+		values = append(values, args.Append(&row.ID))
+	}
+	if row.Name.Status != pgtype.Undefined {
+		columns = append(columns, `"name"`)
+		values = append(values, args.Append(&row.Name))
+	}
+	if row.Weight.Status != pgtype.Undefined {
+		columns = append(columns, `"weight"`)
+		values = append(values, args.Append(&row.Weight))
+	}
+
+	var targetColumns []string
+	switch opts.Conflict {
+	case WidgetConflictOnPK:
+		targetColumns = []string{`"id"`}
+	default:
+		return UpsertUnchanged, errors.Errorf("unknown WidgetConflict %d", opts.Conflict)
+	}
+
+	// The conflicting row is updated with the columns other than the conflict
+	// target. It is left unchanged when there are none.
+	sets := make([]string, 0, len(columns))
+nextColumn:
+	for _, c := range columns {
+		for _, tc := range targetColumns {
+			if c == tc {
+				continue nextColumn
+			}
+		}
+		sets = append(sets, c+"=excluded."+c)
+	}
+
+	action := `do update set ` + strings.Join(sets, ", ")
+	if opts.DoNothing || len(sets) == 0 {
+		action = `do nothing`
+	}
+
+	insert := ` default values`
+	if len(columns) > 0 {
+		insert = `(` + strings.Join(columns, ", ") + `)
+values(` + strings.Join(values, ",") + `)`
+	}
+
+	sql := `insert into "widget"` + insert + `
+on conflict (` + strings.Join(targetColumns, ", ") + `) ` + action + `
+returning xmax = 0, "id", "name", "weight"
+  `
+
+	psName := preparedName("pgxdataUpsertWidget", sql)
+
+	var inserted bool
+	err := prepareQueryRow(ctx, db, psName, sql, args...).Scan(&inserted, &row.ID, &row.Name, &row.Weight)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return UpsertUnchanged, nil
+	} else if err != nil {
+		return UpsertUnchanged, err
+	}
+
+	if inserted {
+		return UpsertInserted, nil
+	}
+	return UpsertUpdated, nil
+}
+
+// insertWidgetColumns returns the columns of row that are not Undefined and their
+// values for inserting row.
+func insertWidgetColumns(row *Widget) ([]string, []interface{}, error) {
+	var columns []string
+	var values []interface{}
+
+	if row.ID.Status != pgtype.Undefined {
+		columns = append(columns, `id`)
+		values = append(values, &row.ID)
+	}
+	if row.Name.Status != pgtype.Undefined {
+		columns = append(columns, `name`)
+		values = append(values, &row.Name)
+	}
+	if row.Weight.Status != pgtype.Undefined {
+		columns = append(columns, `weight`)
+		values = append(values, &row.Weight)
+	}
+
+	return columns, values, nil
+}
+
+// CopyInsertWidget inserts rows with the PostgreSQL copy protocol and
+// returns the number of rows copied. The columns copied are those not
+// Undefined in the first row and every row must set the same fields. Values
+// must support the binary format.
+func CopyInsertWidget(ctx context.Context, db CopyFromer, rows []Widget) (int64, error) {
+	if len(rows) == 0 {
+		return 0, nil
+	}
+
+	columns, _, err := insertWidgetColumns(&rows[0])
+	if err != nil {
+		return 0, err
+	}
+
+	return db.CopyFrom(ctx, pgx.Identifier{"widget"}, columns, &copyFromWidgetSource{rows: rows, columns: columns, idx: -1})
+}
+
+// copyFromWidgetSource is a pgx.CopyFromSource of the columns of rows.
+type copyFromWidgetSource struct {
+	rows    []Widget
+	columns []string
+	idx     int
+}
+
+func (s *copyFromWidgetSource) Next() bool {
+	s.idx++
+	return s.idx < len(s.rows)
+}
+
+func (s *copyFromWidgetSource) Values() ([]interface{}, error) {
+	columns, values, err := insertWidgetColumns(&s.rows[s.idx])
+	if err != nil {
+		return nil, err
+	}
+	if !equalColumns(columns, s.columns) {
+		return nil, errors.Errorf("row %d does not set the same fields as row 0", s.idx)
+	}
+	return values, nil
+}
+
+func (s *copyFromWidgetSource) Err() error {
+	return nil
+}
+
+// insertManyWidgetTypes are the types the parameters of
+// InsertManyWidget are cast to. PostgreSQL only infers them for the
+// VALUES list of an INSERT, not for one that is selected from.
+var insertManyWidgetTypes = map[string]string{
+	`id`:     `"pg_catalog"."int8"`,
+	`name`:   `"pg_catalog"."varchar"`,
+	`weight`: `"pg_catalog"."int2"`,
+}
+
+// InsertManyWidget inserts rows with multi-row INSERT statements for
+// a Queryer that does not support the copy protocol or for columns without a
+// binary format. The columns inserted are those not Undefined in the
+// first row and every row must set the same fields. Like InsertWidget
+// the persisted rows are scanned into rows.
+func InsertManyWidget(ctx context.Context, db Queryer, rows []Widget) error {
+	if len(rows) == 0 {
+		return nil
+	}
+
+	columns, _, err := insertWidgetColumns(&rows[0])
 	if err != nil {
 		return err
 	}
-	if commandTag.RowsAffected() != 1 {
-		return ErrNotFound
+
+	quotedColumns := make([]string, len(columns))
+	for i, column := range columns {
+		quotedColumns[i] = pgx.Identifier{column}.Sanitize()
 	}
+
+	// A statement can have at most 65535 parameters including the ordinality
+	// of each row.
+	batchSize := len(rows)
+	if batchSize > 65535/(len(columns)+1) {
+		batchSize = 65535 / (len(columns) + 1)
+	}
+
+	for start := 0; start < len(rows); start += batchSize {
+		end := start + batchSize
+		if end > len(rows) {
+			end = len(rows)
+		}
+		batch := rows[start:end]
+
+		args := pgx.QueryArgs(make([]interface{}, 0, len(batch)*(len(columns)+1)))
+		valueLists := make([]string, len(batch))
+		for i := range batch {
+			rowColumns, values, err := insertWidgetColumns(&batch[i])
+			if err != nil {
+				return err
+			}
+			if !equalColumns(rowColumns, columns) {
+				return errors.Errorf("row %d does not set the same fields as row 0", start+i)
+			}
+
+			placeholders := make([]string, len(values)+1)
+			for j, v := range values {
+				placeholders[j] = args.Append(v) + "::" + insertManyWidgetTypes[columns[j]]
+			}
+			placeholders[len(values)] = args.Append(int32(i)) + "::int4"
+			valueLists[i] = "(" + strings.Join(placeholders, ",") + ")"
+		}
+
+		// The rows are inserted in the order of their ordinality so they are
+		// returned in the order of batch.
+		sql := `insert into "widget"(` + strings.Join(quotedColumns, ", ") + `)
+select ` + strings.Join(quotedColumns, ", ") + `
+from (values` + strings.Join(valueLists, ",") + `) as input(` + strings.Join(append(quotedColumns, "pgxdata_ordinality"), ", ") + `)
+order by pgxdata_ordinality
+returning "id", "name", "weight"`
+
+		// The SQL depends on the number of rows so it is not prepared, which
+		// would leave a prepared statement for every batch size.
+		dbRows, err := db.Query(ctx, sql, args...)
+		if err != nil {
+			return err
+		}
+
+		// PostgreSQL does not guarantee the order of the returned rows, but it
+		// returns them in the order they were inserted. A different number of rows
+		// means they cannot be matched to batch.
+		n := 0
+		for dbRows.Next() {
+			if n < len(batch) {
+				row := &batch[n]
+				if err := dbRows.Scan(&row.ID, &row.Name, &row.Weight); err != nil {
+					dbRows.Close()
+					return err
+				}
+			}
+			n++
+		}
+
+		if dbRows.Err() != nil {
+			return dbRows.Err()
+		}
+		if n != len(batch) {
+			return errors.Errorf("inserted %d rows but %d rows were returned", len(batch), n)
+		}
+	}
+
 	return nil
 }
 
+// QueueSelectWidgetByPK queues selecting the row by primary key in b.
+// The returned reader scans the row into dst or returns ErrNotFound if there
+// is no such row.
+func QueueSelectWidgetByPK(b *pgx.Batch, id int64, dst *Widget) BatchReader {
+	args := []interface{}{id}
+	oids := []pgtype.OID{pgtype.Int8OID}
+
+	return queue(b, selectWidgetByPKSQL, args, oids, func(results pgx.BatchResults) error {
+		err := results.QueryRowResults().Scan(
+			&dst.ID,
+			&dst.Name,
+			&dst.Weight,
+		)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrNotFound
+		}
+		return err
+	})
+}
+
+// QueueInsertWidget queues inserting row in b. Like InsertWidget
+// the returned reader scans the persisted row into row.
+func QueueInsertWidget(b *pgx.Batch, row *Widget) BatchReader {
+	args := pgx.QueryArgs(make([]interface{}, 0, 3))
+	oids := make([]pgtype.OID, 0, 3)
+
+	var columns, values []string
+
+	if row.ID.Status != pgtype.Undefined {
+		columns = append(columns, `id`)
+		values = append(values, args.Append(&row.ID))
+		oids = append(oids, pgtype.Int8OID)
+	}
+	if row.Name.Status != pgtype.Undefined {
+		columns = append(columns, `name`)
+		values = append(values, args.Append(&row.Name))
+		oids = append(oids, pgtype.VarcharOID)
+	}
+	if row.Weight.Status != pgtype.Undefined {
+		columns = append(columns, `weight`)
+		values = append(values, args.Append(&row.Weight))
+		oids = append(oids, pgtype.Int2OID)
+	}
+
+	insert := ` default values`
+	if len(columns) > 0 {
+		insert = `(` + strings.Join(columns, ", ") + `)
+values(` + strings.Join(values, ",") + `)`
+	}
+
+	sql := `insert into "widget"` + insert + `
+returning "id", "name", "weight"`
+
+	return queue(b, sql, args, oids, func(results pgx.BatchResults) error {
+		return results.QueryRowResults().Scan(&row.ID, &row.Name, &row.Weight)
+	})
+}
+
+// QueueUpdateWidget queues updating the row by primary key in b. Like
+// UpdateWidget the returned reader scans the persisted row into row
+// or returns ErrNotFound if there is no such row. Nothing is queued when
+// there is nothing to update.
+func QueueUpdateWidget(b *pgx.Batch, id int64, row *Widget) BatchReader {
+	sets := make([]string, 0, 3)
+	args := pgx.QueryArgs(make([]interface{}, 0, 3))
+	oids := make([]pgtype.OID, 0, 3)
+
+	if row.ID.Status != pgtype.Undefined {
+		sets = append(sets, `id`+"="+args.Append(&row.ID))
+		oids = append(oids, pgtype.Int8OID)
+	}
+	if row.Name.Status != pgtype.Undefined {
+		sets = append(sets, `name`+"="+args.Append(&row.Name))
+		oids = append(oids, pgtype.VarcharOID)
+	}
+	if row.Weight.Status != pgtype.Undefined {
+		sets = append(sets, `weight`+"="+args.Append(&row.Weight))
+		oids = append(oids, pgtype.Int2OID)
+	}
+
+	if len(sets) == 0 {
+		return func(pgx.BatchResults) error { return nil }
+	}
+
+	sql := `update "widget" set ` + strings.Join(sets, ", ") + ` where ` + `"id"=` + args.Append(id) + `
+returning "id", "name", "weight"`
+	oids = append(oids, pgtype.Int8OID)
+
+	return queue(b, sql, args, oids, func(results pgx.BatchResults) error {
+		err := results.QueryRowResults().Scan(&row.ID, &row.Name, &row.Weight)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrNotFound
+		}
+		return err
+	})
+}
+
+// QueueDeleteWidget queues deleting the row by primary key in b. The
+// returned reader returns ErrNotFound if there is no such row.
+func QueueDeleteWidget(b *pgx.Batch, id int64) BatchReader {
+	args := pgx.QueryArgs(make([]interface{}, 0, 1))
+
+	sql := `delete from "widget" where ` + `"id"=` + args.Append(id)
+	oids := []pgtype.OID{pgtype.Int8OID}
+
+	return queue(b, sql, args, oids, func(results pgx.BatchResults) error {
+		commandTag, err := results.ExecResults()
+		if err != nil {
+			return err
+		}
+		if commandTag.RowsAffected() != 1 {
+			return ErrNotFound
+		}
+		return nil
+	})
+}
+
 func DeleteWidget(ctx context.Context, db Queryer,
 	id int64,
 ) error {
@@ -162,3 +698,134 @@ func DeleteWidget(ctx context.Context, db Queryer,
 	}
 	return nil
 }
+
+// WidgetWhere has a filter for each column of "widget" that builds the conditions of
+// SelectWidgetWhere, CountWidgetWhere, UpdateWidgetWhere and DeleteWidgetWhere.
+var WidgetWhere = struct {
+	ID     WidgetIDFilter
+	Name   WidgetNameFilter
+	Weight WidgetWeightFilter
+}{
+	ID:     WidgetIDFilter{columnFilter{`"id"`}},
+	Name:   WidgetNameFilter{columnFilter{`"name"`}},
+	Weight: WidgetWeightFilter{columnFilter{`"weight"`}},
+}
+
+// WidgetIDFilter builds conditions on id.
+type WidgetIDFilter struct{ columnFilter }
+
+func (f WidgetIDFilter) Eq(v int64) Condition { return f.compare(" = ", v) }
+func (f WidgetIDFilter) Ne(v int64) Condition { return f.compare(" <> ", v) }
+func (f WidgetIDFilter) Lt(v int64) Condition { return f.compare(" < ", v) }
+func (f WidgetIDFilter) Le(v int64) Condition { return f.compare(" <= ", v) }
+func (f WidgetIDFilter) Gt(v int64) Condition { return f.compare(" > ", v) }
+func (f WidgetIDFilter) Ge(v int64) Condition { return f.compare(" >= ", v) }
+
+func (f WidgetIDFilter) In(vs ...int64) Condition { return f.in(len(vs), vs) }
+
+// WidgetNameFilter builds conditions on name.
+type WidgetNameFilter struct{ columnFilter }
+
+func (f WidgetNameFilter) Eq(v string) Condition { return f.compare(" = ", v) }
+func (f WidgetNameFilter) Ne(v string) Condition { return f.compare(" <> ", v) }
+func (f WidgetNameFilter) Lt(v string) Condition { return f.compare(" < ", v) }
+func (f WidgetNameFilter) Le(v string) Condition { return f.compare(" <= ", v) }
+func (f WidgetNameFilter) Gt(v string) Condition { return f.compare(" > ", v) }
+func (f WidgetNameFilter) Ge(v string) Condition { return f.compare(" >= ", v) }
+
+func (f WidgetNameFilter) In(vs ...string) Condition { return f.in(len(vs), vs) }
+
+// WidgetWeightFilter builds conditions on weight.
+type WidgetWeightFilter struct{ columnFilter }
+
+func (f WidgetWeightFilter) Eq(v int16) Condition { return f.compare(" = ", v) }
+func (f WidgetWeightFilter) Ne(v int16) Condition { return f.compare(" <> ", v) }
+func (f WidgetWeightFilter) Lt(v int16) Condition { return f.compare(" < ", v) }
+func (f WidgetWeightFilter) Le(v int16) Condition { return f.compare(" <= ", v) }
+func (f WidgetWeightFilter) Gt(v int16) Condition { return f.compare(" > ", v) }
+func (f WidgetWeightFilter) Ge(v int16) Condition { return f.compare(" >= ", v) }
+
+func (f WidgetWeightFilter) In(vs ...int16) Condition { return f.in(len(vs), vs) }
+
+func SelectWidgetWhere(ctx context.Context, db Queryer, where Condition) ([]Widget, error) {
+	var args pgx.QueryArgs
+	sql := SelectAllWidgetSQL + `
+where ` + where.sql(&args)
+
+	dbRows, err := prepareQuery(ctx, db, preparedName("pgxdataSelectWidgetWhere", sql), sql, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer dbRows.Close()
+
+	var rows []Widget
+	for dbRows.Next() {
+		var row Widget
+		err := dbRows.Scan(
+			&row.ID,
+			&row.Name,
+			&row.Weight,
+		)
+		if err != nil {
+			return nil, err
+		}
+		rows = append(rows, row)
+	}
+
+	if dbRows.Err() != nil {
+		return nil, dbRows.Err()
+	}
+
+	return rows, nil
+}
+
+func CountWidgetWhere(ctx context.Context, db Queryer, where Condition) (int64, error) {
+	var args pgx.QueryArgs
+	sql := countWidgetSQL + ` where ` + where.sql(&args)
+
+	var n int64
+	err := prepareQueryRow(ctx, db, preparedName("pgxdataCountWidgetWhere", sql), sql, args...).Scan(&n)
+	return n, err
+}
+
+// UpdateWidgetWhere updates the rows matching where like UpdateWidget and returns the number of
+// rows updated. where must restrict the rows, so a Condition that matches every row like the zero Condition is
+// refused.
+func UpdateWidgetWhere(ctx context.Context, db Queryer, where Condition, row *Widget) (int64, error) {
+	if where.write == nil {
+		return 0, errors.New("UpdateWidgetWhere requires a condition")
+	}
+
+	sets, args, err := updateWidgetSets(row)
+	if err != nil {
+		return 0, err
+	}
+	if len(sets) == 0 {
+		return 0, nil
+	}
+
+	sql := `update "widget" set ` + strings.Join(sets, ", ") + ` where ` + where.sql(&args)
+
+	commandTag, err := prepareExec(ctx, db, preparedName("pgxdataUpdateWidgetWhere", sql), sql, args...)
+	if err != nil {
+		return 0, err
+	}
+	return commandTag.RowsAffected(), nil
+}
+
+// DeleteWidgetWhere deletes the rows matching where and returns the number of rows deleted. where must
+// restrict the rows, so a Condition that matches every row like the zero Condition is refused.
+func DeleteWidgetWhere(ctx context.Context, db Queryer, where Condition) (int64, error) {
+	if where.write == nil {
+		return 0, errors.New("DeleteWidgetWhere requires a condition")
+	}
+
+	var args pgx.QueryArgs
+	sql := `delete from "widget" where ` + where.sql(&args)
+
+	commandTag, err := prepareExec(ctx, db, preparedName("pgxdataDeleteWidgetWhere", sql), sql, args...)
+	if err != nil {
+		return 0, err
+	}
+	return commandTag.RowsAffected(), nil
+}
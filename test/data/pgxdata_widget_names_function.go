@@ -0,0 +1,34 @@
+package data
+
+// This file is automatically generated by pgxdata.
+
+import (
+	"context"
+	"github.com/jackc/pgtype"
+)
+
+const widgetNamesSQL = `select "widget_names"($1)`
+
+// WidgetNames calls widget_names and returns its results.
+func WidgetNames(ctx context.Context, db Queryer, minWeight int16) ([]pgtype.Varchar, error) {
+	rows, err := prepareQuery(ctx, db, preparedName("pgxdataWidgetNames", widgetNamesSQL), widgetNamesSQL, minWeight)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var results []pgtype.Varchar
+	for rows.Next() {
+		var result pgtype.Varchar
+		if err := rows.Scan(&result); err != nil {
+			return nil, err
+		}
+		results = append(results, result)
+	}
+
+	if rows.Err() != nil {
+		return nil, rows.Err()
+	}
+
+	return results, nil
+}
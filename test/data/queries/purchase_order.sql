@@ -0,0 +1,18 @@
+-- name: PurchaseOrderStatus :one
+-- params: id
+select id, status, previous_status
+from purchase_order
+where id = $1;
+
+-- name: PurchaseOrdersWithStatus :many
+-- params: status
+select id, customer_id
+from purchase_order
+where status = $1
+order by id;
+
+-- name: CancelPurchaseOrders :exec
+-- params: customer_id
+update purchase_order
+set previous_status = status, status = 'cancelled'
+where customer_id = $1 and status <> 'cancelled';
@@ -1,11 +1,29 @@
-drop table if exists customer;
+drop function if exists customer_count(text);
+drop function if exists widget_names(smallint);
+drop function if exists customer_names(integer);
+drop function if exists purchase_order_statuses(integer);
+drop procedure if exists cancel_purchase_order(integer);
+drop table if exists customer cascade;
+drop domain if exists email_address;
+drop type if exists address;
+create domain email_address as text check (value like '%@%');
+create type address as (
+  street text,
+  city text,
+  postal_code varchar(10)
+);
+
 create table customer (
   id serial primary key,
   first_name varchar not null,
   last_name varchar not null,
   birth_date date,
-  creation_time timestamptz not null default now()
+  creation_time timestamptz not null default now(),
+  email email_address,
+  address address
 );
+create index on customer (last_name, first_name);
+create unique index on customer (email);
 
 drop table if exists widget;
 create table widget (
@@ -40,3 +58,133 @@ create table ip_types (
   ip_inet inet,
   ip_cidr cidr
 );
+
+create schema if not exists billing;
+
+drop table if exists billing.customer;
+create table billing.customer (
+  id serial primary key,
+  account_number varchar not null,
+  credit_limit integer not null
+);
+
+drop table if exists scalar_types;
+create table scalar_types (
+  id serial primary key,
+  bool_col boolean,
+  uuid_col uuid,
+  json_col json,
+  jsonb_col jsonb,
+  numeric_col numeric(10, 2),
+  real_col real,
+  double_col double precision,
+  timestamp_col timestamp,
+  time_col time,
+  interval_col interval,
+  char_col char(3)
+);
+
+drop table if exists uuid_key;
+create table uuid_key (
+  id uuid primary key,
+  name varchar not null
+);
+
+drop table if exists array_types;
+create table array_types (
+  id serial primary key,
+  tags text[],
+  permission_ids bigint[],
+  flags boolean[],
+  uuids uuid[],
+  amounts numeric[],
+  occurred_at timestamptz[]
+);
+
+drop table if exists purchase_order;
+drop type if exists order_status;
+create type order_status as enum ('pending', 'shipped', 'delivered', 'cancelled');
+
+create table purchase_order (
+  id serial primary key,
+  status order_status not null,
+  previous_status order_status,
+  customer_id integer references customer
+);
+
+drop table if exists reservation;
+create table reservation (
+  id serial primary key,
+  room_number integer not null,
+  during tstzrange not null,
+  stay_dates daterange,
+  seats int4range,
+  ticket_ids int8range,
+  price_range numrange,
+  exclude using gist (during with &&)
+);
+create index on reservation using gist (stay_dates);
+
+drop table if exists account;
+create table account (
+  id bigint generated always as identity primary key,
+  name text not null,
+  nickname varchar(50),
+  balance numeric(10, 2) not null default 0,
+  opened_on date not null,
+  closed_at timestamptz,
+  external_id uuid,
+  tags text[],
+  status order_status not null default 'pending',
+  billing_address address,
+  settings jsonb,
+  display_name text generated always as (coalesce(nickname, name)) stored
+);
+
+drop table if exists line_item;
+create table line_item (
+  id integer generated by default as identity primary key,
+  sku varchar(20) not null unique,
+  quantity integer not null default 1,
+  unit_price numeric(10, 2) not null,
+  total numeric(12, 2) generated always as (quantity * unit_price) stored
+);
+
+create function customer_count(last_name_prefix text) returns bigint
+language sql stable as $$
+  select count(*) from customer where last_name like last_name_prefix || '%'
+$$;
+
+create function widget_names(min_weight smallint) returns setof varchar
+language sql stable as $$
+  select name from widget where weight >= min_weight order by name
+$$;
+
+create function customer_names(min_id integer, out id integer, out full_name text) returns setof record
+language sql stable as $$
+  select id, first_name || ' ' || last_name from customer where id >= min_id order by id
+$$;
+
+create function purchase_order_statuses(min_id integer) returns table(id integer, status order_status)
+language sql stable as $$
+  select id, status from purchase_order where id >= min_id order by id
+$$;
+
+create function customers_named(varchar) returns setof customer
+language sql stable as $$
+  select * from customer where last_name = $1 order by id
+$$;
+
+create function newest_customer() returns customer
+language sql stable as $$
+  select * from customer order by id desc limit 1
+$$;
+
+create procedure cancel_purchase_order(order_id integer, out old_status order_status, out cancelled_on date)
+language plpgsql as $$
+begin
+  select status into old_status from purchase_order where id = order_id;
+  update purchase_order set previous_status = status, status = 'cancelled' where id = order_id;
+  cancelled_on := current_date;
+end
+$$;
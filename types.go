@@ -0,0 +1,180 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// TypeConfig is a [[types]] entry in config.toml. It maps either a PostgreSQL
+// type or a single column to Go types. GoBoxType is used for the row struct
+// field and must have a Status field like the pgtype types. GoType is used for
+// primary key parameters.
+type TypeConfig struct {
+	PgType       string `toml:"pg_type"`
+	Column       string `toml:"column"`
+	GoBoxType    string `toml:"go_box_type"`
+	GoType       string `toml:"go_type"`
+	Import       string `toml:"import"`
+	GoTypeImport string `toml:"go_type_import"`
+}
+
+// goTypeImports are the imports required by the Go types in pgToGoTypeMap.
+var goTypeImports = map[string]string{
+	"time.Time":   "time",
+	"[]time.Time": "time",
+}
+
+func validateTypeConfigs(types []TypeConfig) error {
+	for _, tc := range types {
+		if (tc.PgType == "") == (tc.Column == "") {
+			return errors.New("types entries must have exactly one of pg_type or column")
+		}
+		if tc.GoBoxType == "" {
+			return fmt.Errorf("types entry for %s%s is missing go_box_type", tc.PgType, tc.Column)
+		}
+		if tc.Column != "" {
+			if n := len(strings.Split(tc.Column, ".")); n != 2 && n != 3 {
+				return fmt.Errorf("types entry column %s must be table.column or schema.table.column", tc.Column)
+			}
+		}
+	}
+
+	return nil
+}
+
+// match returns the precedence of tc for the column or 0 if tc does not apply.
+// An entry for the column takes precedence over an entry for the domain of the
+// column which takes precedence over an entry for its PostgreSQL type.
+func (tc TypeConfig) match(schema, tableName string, c Column) int {
+	if tc.Column != "" {
+		parts := strings.Split(tc.Column, ".")
+		if len(parts) == 3 && parts[0] == schema && parts[1] == tableName && parts[2] == c.ColumnName {
+			return 3
+		}
+		if len(parts) == 2 && parts[0] == tableName && parts[1] == c.ColumnName {
+			return 3
+		}
+		return 0
+	}
+
+	if c.DomainName != "" && tc.PgType == c.DomainName {
+		return 2
+	}
+	if tc.PgType == c.pgTypeName() {
+		return 1
+	}
+	return 0
+}
+
+// resolveColumnType sets the Go types of c. The best matching types entry
+// takes precedence over the built-in mappings and the generated enum and
+// composite types. Domains are mapped like their base type unless a types entry
+// names the domain. GoType is left empty when there is no mapping for it as it
+// is only needed for primary key columns.
+func resolveColumnType(c *Column, schema, tableName string, types []TypeConfig, ut *userTypes) error {
+	pgType := c.pgTypeName()
+	c.SelectCast = pgSelectCasts[pgType]
+
+	var best *TypeConfig
+	bestPrecedence := 0
+	for i := range types {
+		if p := types[i].match(schema, tableName, *c); p > bestPrecedence {
+			best, bestPrecedence = &types[i], p
+		}
+	}
+	if best != nil {
+		c.GoBoxType = best.GoBoxType
+		c.BoxTypeImport = best.Import
+		c.GoType = best.GoType
+		c.GoTypeImport = best.GoTypeImport
+		if c.GoTypeImport == "" && best.GoType != "" && typeQualifier(best.GoType) == typeQualifier(best.GoBoxType) {
+			c.GoTypeImport = best.Import
+		}
+		c.customType = true
+		return nil
+	}
+
+	if c.DataType == "USER-DEFINED" {
+		key := userTypeKey(c.UDTSchema, c.UDTName)
+		// pgx does not know the OIDs of user-defined types so they are selected
+		// as text.
+		if e := ut.enums[key]; e != nil {
+			c.GoBoxType = e.boxTypeName()
+			c.GoType = e.GoName
+			c.SelectCast = "text"
+			return nil
+		}
+		if ct := ut.composites[key]; ct != nil {
+			c.GoBoxType = ct.boxTypeName()
+			c.SelectCast = "text"
+			return nil
+		}
+		return fmt.Errorf("%s.%s.%s has unsupported type %s", schema, tableName, c.ColumnName, pgType)
+	}
+
+	boxType, ok := pgToBoxTypeMap[pgType]
+	if !ok {
+		return fmt.Errorf("%s.%s.%s has unsupported type %s", schema, tableName, c.ColumnName, pgType)
+	}
+	c.GoBoxType = boxType
+	c.GoType = pgToGoTypeMap[pgType]
+	c.GoTypeImport = goTypeImports[c.GoType]
+
+	return nil
+}
+
+// useGoField makes the row struct field of c use its Go type for the go
+// field_style. Columns mapped by a [[types]] entry and columns without a Go
+// type keep their box type.
+func useGoField(c *Column) {
+	if c.customType || c.GoType == "" {
+		return
+	}
+	c.GoField = true
+
+	// pgtype.Numeric cannot be assigned to a string so numeric is selected as
+	// text.
+	if c.pgTypeName() == "numeric" {
+		c.SelectCast = "text"
+	}
+}
+
+// typeQualifier returns the package name t is qualified with.
+func typeQualifier(t string) string {
+	t = strings.TrimLeft(t, "*[]")
+	if i := strings.LastIndex(t, "."); i >= 0 {
+		return t[:i]
+	}
+	return ""
+}
+
+// tableImports returns the imports needed by the generated code for table
+// other than those always imported by the row template.
+func tableImports(table Table) []string {
+	set := make(map[string]struct{})
+	for _, c := range table.Columns {
+		if i := c.fieldTypeImport(); i != "" {
+			set[i] = struct{}{}
+		}
+	}
+	// Primary key parameters and the Where filters use the Go types of the
+	// columns.
+	for _, c := range table.Columns {
+		if c.GoType != "" && c.GoTypeImport != "" {
+			set[c.GoTypeImport] = struct{}{}
+		}
+	}
+
+	// The row template always imports pgtype.
+	delete(set, "github.com/jackc/pgtype")
+
+	imports := make([]string, 0, len(set))
+	for i := range set {
+		imports = append(imports, i)
+	}
+	sort.Strings(imports)
+
+	return imports
+}
@@ -0,0 +1,236 @@
+package main
+
+import (
+	"bytes"
+	"go/parser"
+	"go/token"
+	"strconv"
+	"testing"
+)
+
+func TestResolveColumnType(t *testing.T) {
+	t.Parallel()
+
+	types := []TypeConfig{
+		{PgType: "numeric", GoBoxType: "shopspring.Numeric", Import: "github.com/jackc/pgx-shopspring-decimal", GoType: "decimal.Decimal", GoTypeImport: "github.com/shopspring/decimal"},
+		{Column: "invoice.total", GoBoxType: "money.Money", Import: "example.com/money", GoType: "money.Amount"},
+		{Column: "billing.invoice.tax", GoBoxType: "money.Tax", Import: "example.com/money"},
+		{PgType: "mood", GoBoxType: "mood.Box", Import: "example.com/mood", GoType: "mood.Mood"},
+		{PgType: "price", GoBoxType: "money.Price", Import: "example.com/money"},
+	}
+
+	ut := newUserTypes()
+	ut.enums["public.order_status"] = &Enum{Schema: "public", Name: "order_status", GoName: "OrderStatus"}
+	ut.enums["public.mood"] = &Enum{Schema: "public", Name: "mood", GoName: "Mood"}
+	ut.composites["public.address"] = &CompositeType{Schema: "public", Name: "address", GoName: "Address"}
+
+	tests := []struct {
+		schema    string
+		tableName string
+		input     Column
+		expected  Column
+	}{
+		{
+			schema:    "public",
+			tableName: "widget",
+			input:     Column{ColumnName: "id", DataType: "bigint"},
+			expected:  Column{ColumnName: "id", DataType: "bigint", GoBoxType: "pgtype.Int8", GoType: "int64"},
+		},
+		{
+			schema:    "public",
+			tableName: "customer",
+			input:     Column{ColumnName: "birth_date", DataType: "date"},
+			expected:  Column{ColumnName: "birth_date", DataType: "date", GoBoxType: "pgtype.Date", GoType: "time.Time", GoTypeImport: "time"},
+		},
+		{
+			schema:    "public",
+			tableName: "ip_types",
+			input:     Column{ColumnName: "ip_inet", DataType: "inet"},
+			expected:  Column{ColumnName: "ip_inet", DataType: "inet", GoBoxType: "pgtype.Inet"},
+		},
+		{
+			schema:    "public",
+			tableName: "uuid_key",
+			input:     Column{ColumnName: "id", DataType: "uuid"},
+			expected:  Column{ColumnName: "id", DataType: "uuid", GoBoxType: "pgtype.UUID", GoType: "[16]byte"},
+		},
+		{
+			schema:    "public",
+			tableName: "array_types",
+			input:     Column{ColumnName: "permission_ids", DataType: "ARRAY", UDTName: "_int8"},
+			expected:  Column{ColumnName: "permission_ids", DataType: "ARRAY", UDTName: "_int8", GoBoxType: "pgtype.Int8Array", GoType: "[]int64"},
+		},
+		{
+			schema:    "public",
+			tableName: "scalar_types",
+			input:     Column{ColumnName: "time_col", DataType: "time without time zone"},
+			expected:  Column{ColumnName: "time_col", DataType: "time without time zone", GoBoxType: "pgtype.GenericText", GoType: "string", SelectCast: "text"},
+		},
+		{
+			schema:    "public",
+			tableName: "invoice",
+			input:     Column{ColumnName: "subtotal", DataType: "numeric"},
+			expected:  Column{ColumnName: "subtotal", DataType: "numeric", GoBoxType: "shopspring.Numeric", BoxTypeImport: "github.com/jackc/pgx-shopspring-decimal", GoType: "decimal.Decimal", GoTypeImport: "github.com/shopspring/decimal", customType: true},
+		},
+		{
+			schema:    "public",
+			tableName: "invoice",
+			input:     Column{ColumnName: "total", DataType: "numeric"},
+			expected:  Column{ColumnName: "total", DataType: "numeric", GoBoxType: "money.Money", BoxTypeImport: "example.com/money", GoType: "money.Amount", GoTypeImport: "example.com/money", customType: true},
+		},
+		{
+			schema:    "billing",
+			tableName: "invoice",
+			input:     Column{ColumnName: "tax", DataType: "numeric"},
+			expected:  Column{ColumnName: "tax", DataType: "numeric", GoBoxType: "money.Tax", BoxTypeImport: "example.com/money", customType: true},
+		},
+		{
+			schema:    "public",
+			tableName: "invoice",
+			input:     Column{ColumnName: "tax", DataType: "numeric"},
+			expected:  Column{ColumnName: "tax", DataType: "numeric", GoBoxType: "shopspring.Numeric", BoxTypeImport: "github.com/jackc/pgx-shopspring-decimal", GoType: "decimal.Decimal", GoTypeImport: "github.com/shopspring/decimal", customType: true},
+		},
+		{
+			schema:    "public",
+			tableName: "purchase_order",
+			input:     Column{ColumnName: "status", DataType: "USER-DEFINED", UDTSchema: "public", UDTName: "order_status"},
+			expected:  Column{ColumnName: "status", DataType: "USER-DEFINED", UDTSchema: "public", UDTName: "order_status", GoBoxType: "OrderStatusBox", GoType: "OrderStatus", SelectCast: "text"},
+		},
+		{
+			schema:    "public",
+			tableName: "person",
+			input:     Column{ColumnName: "mood", DataType: "USER-DEFINED", UDTSchema: "public", UDTName: "mood"},
+			expected:  Column{ColumnName: "mood", DataType: "USER-DEFINED", UDTSchema: "public", UDTName: "mood", GoBoxType: "mood.Box", BoxTypeImport: "example.com/mood", GoType: "mood.Mood", GoTypeImport: "example.com/mood", customType: true},
+		},
+		{
+			schema:    "public",
+			tableName: "customer",
+			input:     Column{ColumnName: "email", DataType: "text", DomainSchema: "public", DomainName: "email_address"},
+			expected:  Column{ColumnName: "email", DataType: "text", DomainSchema: "public", DomainName: "email_address", GoBoxType: "pgtype.Text", GoType: "string"},
+		},
+		{
+			schema:    "public",
+			tableName: "product",
+			input:     Column{ColumnName: "list_price", DataType: "numeric", DomainSchema: "public", DomainName: "price"},
+			expected:  Column{ColumnName: "list_price", DataType: "numeric", DomainSchema: "public", DomainName: "price", GoBoxType: "money.Price", BoxTypeImport: "example.com/money", customType: true},
+		},
+		{
+			schema:    "public",
+			tableName: "customer",
+			input:     Column{ColumnName: "address", DataType: "USER-DEFINED", UDTSchema: "public", UDTName: "address"},
+			expected:  Column{ColumnName: "address", DataType: "USER-DEFINED", UDTSchema: "public", UDTName: "address", GoBoxType: "AddressBox", SelectCast: "text"},
+		},
+	}
+
+	for i, tt := range tests {
+		actual := tt.input
+		err := resolveColumnType(&actual, tt.schema, tt.tableName, types, ut)
+		if err != nil {
+			t.Errorf("%d. Unexpected error: %v", i, err)
+			continue
+		}
+		if actual != tt.expected {
+			t.Errorf("%d. Expected %v, but got %v", i, tt.expected, actual)
+		}
+	}
+
+	c := Column{ColumnName: "location", DataType: "point"}
+	if err := resolveColumnType(&c, "public", "store", nil, newUserTypes()); err == nil {
+		t.Error("Expected unsupported type to be an error, but it was not")
+	}
+
+	c = Column{ColumnName: "location", DataType: "USER-DEFINED", UDTSchema: "public", UDTName: "text"}
+	if err := resolveColumnType(&c, "public", "store", nil, ut); err == nil {
+		t.Error("Expected user-defined type that is not an enum to be an error, but it was not")
+	}
+}
+
+func TestValidateTypeConfigs(t *testing.T) {
+	t.Parallel()
+
+	tests := []TypeConfig{
+		{GoBoxType: "money.Money"},
+		{PgType: "money", Column: "invoice.total", GoBoxType: "money.Money"},
+		{PgType: "money"},
+		{Column: "total", GoBoxType: "money.Money"},
+	}
+
+	for i, tt := range tests {
+		if err := validateTypeConfigs([]TypeConfig{tt}); err == nil {
+			t.Errorf("%d. Expected %v to be invalid, but it was not", i, tt)
+		}
+	}
+}
+
+func TestWriteTableCrudImports(t *testing.T) {
+	t.Parallel()
+
+	table := Table{
+		TableName:  "invoice",
+		StructName: "Invoice",
+		Columns: []Column{
+			{ColumnName: "number", FieldName: "Number", VarName: "number", GoBoxType: "pgtype.Date", GoType: "time.Time", GoTypeImport: "time"},
+			{ColumnName: "total", FieldName: "Total", VarName: "total", GoBoxType: "money.Money", BoxTypeImport: "example.com/money", GoType: "decimal.Decimal", GoTypeImport: "github.com/shopspring/decimal"},
+		},
+	}
+	table.PrimaryKeyColumns = []*Column{&table.Columns[0]}
+
+	buf := &bytes.Buffer{}
+	err := writeTableCrud(buf, loadTemplates(), "data", table)
+	if err != nil {
+		t.Fatalf("writeTableCrud unexpectedly failed: %v", err)
+	}
+
+	file, err := parser.ParseFile(token.NewFileSet(), "invoice.go", buf.Bytes(), parser.ImportsOnly)
+	if err != nil {
+		t.Fatalf("generated code does not parse: %v", err)
+	}
+
+	imports := make(map[string]bool)
+	for _, spec := range file.Imports {
+		path, _ := strconv.Unquote(spec.Path.Value)
+		imports[path] = true
+	}
+
+	// The go_type_import of a non-primary key column is needed by its Where
+	// filter.
+	for _, path := range []string{"time", "example.com/money", "github.com/shopspring/decimal"} {
+		if !imports[path] {
+			t.Errorf("Expected import %s, but it was missing", path)
+		}
+	}
+}
+
+func TestUseGoField(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		column    Column
+		fieldType string
+		fieldArg  string
+	}{
+		{Column{FieldName: "ID", DataType: "bigint", GoBoxType: "pgtype.Int8", GoType: "int64", NotNull: true}, "int64", "row.ID"},
+		{Column{FieldName: "Nickname", DataType: "text", GoBoxType: "pgtype.Text", GoType: "string"}, "*string", "row.Nickname"},
+		{Column{FieldName: "OpenedOn", DataType: "date", GoBoxType: "pgtype.Date", GoType: "time.Time", GoTypeImport: "time"}, "*time.Time", "row.OpenedOn"},
+		{Column{FieldName: "Tags", DataType: "ARRAY", UDTName: "_text", GoBoxType: "pgtype.TextArray", GoType: "[]string"}, "[]string", "row.Tags"},
+		{Column{FieldName: "Settings", DataType: "jsonb", GoBoxType: "pgtype.JSONB"}, "pgtype.JSONB", "&row.Settings"},
+		{Column{FieldName: "Total", DataType: "money", GoBoxType: "money.Money", GoType: "decimal.Decimal", customType: true}, "money.Money", "&row.Total"},
+	}
+
+	for i, tt := range tests {
+		c := tt.column
+		useGoField(&c)
+		if c.FieldType() != tt.fieldType {
+			t.Errorf("%d. Expected FieldType to be %s, but it was %s", i, tt.fieldType, c.FieldType())
+		}
+		if c.FieldArg() != tt.fieldArg {
+			t.Errorf("%d. Expected FieldArg to be %s, but it was %s", i, tt.fieldArg, c.FieldArg())
+		}
+	}
+
+	c := Column{FieldName: "Balance", DataType: "numeric", GoBoxType: "pgtype.Numeric", GoType: "string", NotNull: true}
+	useGoField(&c)
+	if c.SelectCast != "text" {
+		t.Errorf("Expected numeric to be selected as text, but SelectCast was %q", c.SelectCast)
+	}
+}
@@ -0,0 +1,197 @@
+package main
+
+import (
+	"fmt"
+	"sort"
+)
+
+// userTypes are the enum and composite types used by columns keyed by
+// schema-qualified name.
+type userTypes struct {
+	enums      map[string]*Enum
+	composites map[string]*CompositeType
+	loaded     map[string]bool
+}
+
+// generatedTypes are the user-defined types Go types are generated for sorted
+// by Go name.
+type generatedTypes struct {
+	Enums      []*Enum
+	Composites []*CompositeType
+}
+
+func newUserTypes() *userTypes {
+	return &userTypes{
+		enums:      make(map[string]*Enum),
+		composites: make(map[string]*CompositeType),
+		loaded:     make(map[string]bool),
+	}
+}
+
+func userTypeKey(schema, name string) string {
+	return schema + "." + name
+}
+
+// load reads the user-defined types of columns from cat. The types of the
+// attributes of composite types are read as well.
+func (ut *userTypes) load(cat catalog, columns []Column) error {
+	for _, c := range columns {
+		if c.DataType != "USER-DEFINED" {
+			continue
+		}
+		key := userTypeKey(c.UDTSchema, c.UDTName)
+		if ut.loaded[key] {
+			continue
+		}
+		ut.loaded[key] = true
+
+		e, err := cat.enum(c.UDTSchema, c.UDTName)
+		if err != nil {
+			return err
+		}
+		if e != nil {
+			e.GoName = pgCaseToGoPublicCase(e.Name)
+			ut.enums[key] = e
+			continue
+		}
+
+		ct, err := cat.compositeType(c.UDTSchema, c.UDTName)
+		if err != nil {
+			return err
+		}
+		if ct != nil {
+			ct.GoName = pgCaseToGoPublicCase(ct.Name)
+			ut.composites[key] = ct
+			if err := ut.load(cat, ct.Attributes); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// resolveAttributes sets the field names and Go types of the attributes of the
+// composite types. It returns the errors for attributes with unsupported types.
+func (ut *userTypes) resolveAttributes(types []TypeConfig) []string {
+	keys := make([]string, 0, len(ut.composites))
+	for key := range ut.composites {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var unsupported []string
+	for _, key := range keys {
+		ct := ut.composites[key]
+		for i := range ct.Attributes {
+			a := &ct.Attributes[i]
+			a.FieldName = pgCaseToGoPublicCase(a.ColumnName)
+			a.VarName = pgCaseToGoPrivateCase(a.ColumnName)
+			if err := resolveColumnType(a, ct.Schema, ct.Name, types, ut); err != nil {
+				unsupported = append(unsupported, err.Error())
+			}
+		}
+	}
+
+	return unsupported
+}
+
+// used returns the types that columns of tables and the parameters and results
+// of queries and functions were mapped to, directly or through the attributes
+// of a composite type. It is an error for two generated types to share a Go
+// name.
+func (ut *userTypes) used(tables []Table, queryFiles []QueryFile, functions []Function) (*generatedTypes, error) {
+	names := make(map[string]string)
+	for _, t := range tables {
+		names[t.StructName] = "table " + t.TableName
+	}
+	for _, f := range queryFiles {
+		for _, q := range f.Queries {
+			names[q.Name+"Row"] = "query " + q.Name
+		}
+	}
+	for _, f := range functions {
+		if len(f.Columns) > 0 && f.RowType == "" {
+			if other, ok := names[f.RowName()]; ok {
+				return nil, fmt.Errorf("%s and function %s both generate type %s", other, f.FunctionName, f.RowName())
+			}
+			names[f.RowName()] = "function " + f.FunctionName
+		}
+	}
+
+	gt := &generatedTypes{}
+	seen := make(map[string]bool)
+	var visit func(columns []Column) error
+	visit = func(columns []Column) error {
+		for _, c := range columns {
+			if c.DataType != "USER-DEFINED" {
+				continue
+			}
+			key := userTypeKey(c.UDTSchema, c.UDTName)
+			if seen[key] {
+				continue
+			}
+
+			var kind, goName, boxTypeName string
+			var attributes []Column
+			if e := ut.enums[key]; e != nil && c.GoBoxType == e.boxTypeName() {
+				gt.Enums = append(gt.Enums, e)
+				kind, goName, boxTypeName = "enum", e.GoName, e.boxTypeName()
+			} else if ct := ut.composites[key]; ct != nil && c.GoBoxType == ct.boxTypeName() {
+				gt.Composites = append(gt.Composites, ct)
+				kind, goName, boxTypeName = "composite type", ct.GoName, ct.boxTypeName()
+				attributes = ct.Attributes
+			} else {
+				continue
+			}
+			seen[key] = true
+
+			for _, name := range []string{goName, boxTypeName} {
+				if other, ok := names[name]; ok {
+					return fmt.Errorf("%s and %s %s both generate type %s", other, kind, key, name)
+				}
+				names[name] = kind + " " + key
+			}
+
+			if err := visit(attributes); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	for _, t := range tables {
+		if err := visit(t.Columns); err != nil {
+			return nil, err
+		}
+	}
+	for _, f := range queryFiles {
+		for _, q := range f.Queries {
+			if err := visit(q.Params); err != nil {
+				return nil, err
+			}
+			if err := visit(q.Columns); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	for _, f := range functions {
+		if err := visit(f.Params); err != nil {
+			return nil, err
+		}
+		if err := visit(f.Columns); err != nil {
+			return nil, err
+		}
+		if f.Result != nil {
+			if err := visit([]Column{*f.Result}); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	sort.Slice(gt.Enums, func(i, j int) bool { return gt.Enums[i].GoName < gt.Enums[j].GoName })
+	sort.Slice(gt.Composites, func(i, j int) bool { return gt.Composites[i].GoName < gt.Composites[j].GoName })
+
+	return gt, nil
+}
@@ -0,0 +1,53 @@
+package main
+
+import (
+	"testing"
+)
+
+func TestUserTypesUsed(t *testing.T) {
+	t.Parallel()
+
+	ut := newUserTypes()
+	ut.enums["public.status"] = &Enum{Schema: "public", Name: "status", GoName: "Status"}
+	ut.enums["billing.status"] = &Enum{Schema: "billing", Name: "status", GoName: "Status"}
+	ut.composites["public.address"] = &CompositeType{
+		Schema: "public",
+		Name:   "address",
+		GoName: "Address",
+		Attributes: []Column{
+			{ColumnName: "status", DataType: "USER-DEFINED", UDTSchema: "public", UDTName: "status", GoBoxType: "StatusBox"},
+		},
+	}
+
+	tables := []Table{
+		{
+			TableName:  "purchase_order",
+			StructName: "PurchaseOrder",
+			Columns: []Column{
+				{ColumnName: "shipping_address", DataType: "USER-DEFINED", UDTSchema: "public", UDTName: "address", GoBoxType: "AddressBox"},
+				{ColumnName: "billing_status", DataType: "USER-DEFINED", UDTSchema: "billing", UDTName: "status", GoBoxType: "StatusBox"},
+			},
+		},
+	}
+
+	if _, err := ut.used(tables, nil, nil); err == nil {
+		t.Error("Expected used to fail for enums with the same Go name, but it did not")
+	}
+
+	tables[0].Columns = tables[0].Columns[:1]
+	gt, err := ut.used(tables, nil, nil)
+	if err != nil {
+		t.Fatalf("used unexpectedly failed: %v", err)
+	}
+	if len(gt.Enums) != 1 || gt.Enums[0] != ut.enums["public.status"] {
+		t.Errorf("Expected enum public.status to be used through address, but got %v", gt.Enums)
+	}
+	if len(gt.Composites) != 1 || gt.Composites[0] != ut.composites["public.address"] {
+		t.Errorf("Expected composite type public.address to be used, but got %v", gt.Composites)
+	}
+
+	tables = append(tables, Table{TableName: "address", StructName: "Address"})
+	if _, err := ut.used(tables, nil, nil); err == nil {
+		t.Error("Expected used to fail for a composite type and table with the same Go name, but it did not")
+	}
+}